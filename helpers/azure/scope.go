@@ -0,0 +1,20 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package azure
+
+import "strings"
+
+// ScopeIsWithin returns whether the scope is the same as, or nested beneath, the parent scope - for example a
+// Resource Group is within its Subscription. Scopes are compared using their Resource IDs, case-insensitively, as
+// such a Subscription isn't considered to be within the Management Group it belongs to.
+func ScopeIsWithin(scope, parentScope string) bool {
+	scope = strings.TrimSuffix(strings.ToLower(scope), "/")
+	parentScope = strings.TrimSuffix(strings.ToLower(parentScope), "/")
+
+	if scope == parentScope {
+		return true
+	}
+
+	return strings.HasPrefix(scope, parentScope+"/")
+}
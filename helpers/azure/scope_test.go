@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package azure_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+)
+
+func TestScopeIsWithin(t *testing.T) {
+	testData := []struct {
+		scope       string
+		parentScope string
+		expected    bool
+	}{
+		{
+			scope:       "/subscriptions/00000000-0000-0000-0000-000000000000",
+			parentScope: "/subscriptions/00000000-0000-0000-0000-000000000000",
+			expected:    true,
+		},
+		{
+			scope:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+			parentScope: "/subscriptions/00000000-0000-0000-0000-000000000000",
+			expected:    true,
+		},
+		{
+			scope:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/EXAMPLE/providers/Microsoft.Storage/storageAccounts/example",
+			parentScope: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/",
+			expected:    true,
+		},
+		{
+			scope:       "/subscriptions/00000000-0000-0000-0000-000000000000",
+			parentScope: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+			expected:    false,
+		},
+		{
+			scope:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example2",
+			parentScope: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+			expected:    false,
+		},
+		{
+			scope:       "/subscriptions/00000000-0000-0000-0000-000000000000",
+			parentScope: "/providers/Microsoft.Management/managementGroups/example",
+			expected:    false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q within %q", v.scope, v.parentScope)
+
+		if actual := azure.ScopeIsWithin(v.scope, v.parentScope); actual != v.expected {
+			t.Fatalf("expected %t but got %t", v.expected, actual)
+		}
+	}
+}
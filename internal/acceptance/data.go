@@ -5,7 +5,6 @@ package acceptance
 
 import (
 	"fmt"
-	"hash/fnv"
 	"math"
 	"math/rand"
 	"os"
@@ -33,6 +32,9 @@ func init() {
 		os.Setenv("ARM_SUBSCRIPTION_ID", vcr.SubscriptionPlaceholder)
 		os.Setenv("ARM_SUBSCRIPTION_ID_ALT", vcr.SubscriptionPlaceholderAlt)
 		os.Setenv("ARM_SUBSCRIPTION_ID_ALT2", vcr.SubscriptionPlaceholderAlt2)
+
+		// The recorded polling intervals aren't relevant when replaying
+		os.Setenv("GO_AZURE_SDK_SKIP_POLLING_DELAY", "true")
 	}
 }
 
@@ -65,48 +67,25 @@ type TestData struct {
 
 	// resourceLabel is the local used for the resource - generally "test""
 	resourceLabel string
-}
 
-// vcrRandTimeInt produces a stable 18-digit integer from a hash of the test name.
-// It mimics the YYMMddHHmmsshhRRRR shape of RandTimeInt but is deterministic.
-func vcrRandTimeInt(testName string) int {
-	h := fnv.New64a()
-	h.Write([]byte(testName))
-	u := h.Sum64()
-
-	// Use a fixed date prefix so the result is always 18 digits and never time-dependent.
-	// We use 20450101 (8 digits) followed by 10 digits from the hash.
-	const fixedPrefix = "20450101"
-	postfix := fmt.Sprintf("%010d", u%10000000000)
-	i, _ := strconv.Atoi(fixedPrefix + postfix)
-	return i
-}
-
-// vcrRandString produces a stable random string from the provided rng.
-func vcrRandString(rng *rand.Rand, strlen int) string {
-	result := make([]byte, strlen)
-	for i := range result {
-		result[i] = charSetAlphaNum[rng.Intn(len(charSetAlphaNum))]
-	}
-	return string(result)
+	// random is the source of random values recorded alongside the cassette when running via VCR
+	random *testRandom
 }
 
 // BuildTestData generates some test data for the given resource
 func BuildTestData(t *testing.T, resourceType string, resourceLabel string) TestData {
-	var randomInt int
-	var randomString string
+	var random *testRandom
+	randomInt := RandTimeInt()
+	randomString := randString(5)
 	if os.Getenv("TC_TEST_VIA_VCR") != "" {
-		// In VCR mode, seed from the test name so all random values are
-		// stable across runs. Both values share the same rng so they are
-		// deterministic relative to each other as well.
-		h := fnv.New64a()
-		h.Write([]byte(t.Name()))
-		rng := rand.New(rand.NewSource(int64(h.Sum64())))
-		randomInt = vcrRandTimeInt(t.Name())
-		randomString = vcrRandString(rng, 5)
-	} else {
-		randomInt = RandTimeInt()
-		randomString = randString(5)
+		// In VCR mode the random values are recorded alongside the cassette, so that replaying uses the same values
+		r, err := newTestRandom(t.Name())
+		if err != nil {
+			t.Fatalf("retrieving VCR values: %+v", err)
+		}
+		random = r
+		randomInt = random.timeInt("RandomInteger")
+		randomString = random.stringFromCharSet("RandomString", 5, charSetAlphaNum)
 	}
 
 	testData := TestData{
@@ -118,6 +97,7 @@ func BuildTestData(t *testing.T, resourceType string, resourceLabel string) Test
 
 		ResourceType:  resourceType,
 		resourceLabel: resourceLabel,
+		random:        random,
 	}
 
 	if features.UseDynamicTestLocations() {
@@ -129,6 +109,9 @@ func BuildTestData(t *testing.T, resourceType string, resourceLabel string) Test
 			Ternary:   os.Getenv("ARM_TEST_LOCATION_ALT2"),
 		}
 	}
+	if random != nil {
+		testData.Locations = random.locations(testData.Locations)
+	}
 
 	testData.Subscriptions = Subscriptions{
 		Primary:   os.Getenv("ARM_SUBSCRIPTION_ID"),
@@ -173,6 +156,10 @@ func (td *TestData) RandomStringOfLength(length int) string {
 		panic("Invalid Test: RandomStringOfLength: length argument must be between 1 and 1024 characters")
 	}
 
+	if td.random != nil {
+		return td.random.stringFromCharSet(fmt.Sprintf("RandomStringOfLength(%d)", length), length, charSetAlphaNum)
+	}
+
 	return randString(length)
 }
 
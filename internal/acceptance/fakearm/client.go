@@ -0,0 +1,87 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package fakearm
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/auth"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"golang.org/x/oauth2"
+)
+
+const (
+	SubscriptionId = "00000000-0000-0000-0000-000000000000"
+	TenantId       = "00000000-0000-0000-0000-000000000010"
+	ClientId       = "00000000-0000-0000-0000-000000000020"
+	ObjectId       = "00000000-0000-0000-0000-000000000030"
+
+	accessToken = "fakearm"
+)
+
+var _ auth.Authorizer = authorizer{}
+
+// authorizer returns a static access token which is accepted by the Server.
+type authorizer struct{}
+
+func (authorizer) Token(_ context.Context, _ *http.Request) (*oauth2.Token, error) {
+	return &oauth2.Token{
+		AccessToken: accessToken,
+		TokenType:   "Bearer",
+		Expiry:      time.Now().Add(time.Hour),
+	}, nil
+}
+
+func (authorizer) AuxiliaryTokens(_ context.Context, _ *http.Request) ([]*oauth2.Token, error) {
+	return nil, nil
+}
+
+// ClientBuilder returns a ClientBuilder for a client which sends Resource Manager requests to the Server and
+// authenticates using a static access token.
+func (s *Server) ClientBuilder() clients.ClientBuilder {
+	env := environments.AzurePublic()
+	env.ResourceManager = environments.ResourceManagerAPI(s.URL)
+
+	userFeatures := features.Default()
+	userFeatures.EnhancedValidation.Locations = false
+	userFeatures.EnhancedValidation.ResourceProviders = false
+
+	return clients.ClientBuilder{
+		AuthConfig: &auth.Credentials{
+			Environment: *env,
+			ClientID:    ClientId,
+			TenantID:    TenantId,
+		},
+		Features:       userFeatures,
+		SubscriptionID: SubscriptionId,
+
+		Account: &clients.ResourceManagerAccount{
+			Environment:                      *env,
+			ClientId:                         ClientId,
+			ObjectId:                         ObjectId,
+			SubscriptionId:                   SubscriptionId,
+			TenantId:                         TenantId,
+			AuthenticatedAsAServicePrincipal: true,
+		},
+		Authorizer: authorizer{},
+	}
+}
+
+// Client builds a client from ClientBuilder, failing the test if it can't be built.
+func (s *Server) Client() *clients.Client {
+	s.t.Helper()
+
+	ctx := context.Background()
+	client, err := clients.Build(ctx, s.ClientBuilder())
+	if err != nil {
+		s.t.Fatalf("building client for fakearm server: %+v", err)
+	}
+	client.StopContext = ctx
+
+	return client
+}
@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package fakearm
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// Fault is an error returned by the Server instead of handling a request, used to test error handling and retries.
+type Fault struct {
+	// Method is the HTTP method of the requests to fail, requests with any method are failed when empty.
+	Method string
+
+	// Path is a regular expression matched case-insensitively against the path of the requests to fail, requests to
+	// any path are failed when empty.
+	Path string
+
+	// StatusCode is the HTTP status code to return.
+	StatusCode int
+
+	// Code and Message are returned in the ARM error response.
+	Code    string
+	Message string
+
+	// Headers are returned in addition to the error response, e.g. `Retry-After`.
+	Headers map[string]string
+
+	// Count is the number of matching requests to fail, all matching requests are failed when zero.
+	Count int
+}
+
+type fault struct {
+	Fault
+	path      *regexp.Regexp
+	remaining int
+}
+
+// InjectFault fails requests matching the fault. Faults are matched in the order they were injected.
+func (s *Server) InjectFault(f Fault) {
+	s.t.Helper()
+
+	injected := &fault{
+		Fault:     f,
+		remaining: f.Count,
+	}
+	if f.Path != "" {
+		re, err := regexp.Compile("(?i)" + f.Path)
+		if err != nil {
+			s.t.Fatalf("compiling fault path %q: %+v", f.Path, err)
+		}
+		injected.path = re
+	}
+	if injected.Code == "" {
+		injected.Code = http.StatusText(f.StatusCode)
+	}
+	if injected.Message == "" {
+		injected.Message = "fault injected by fakearm"
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = append(s.faults, injected)
+}
+
+// matchFault returns the first fault matching the request, if any, consuming one of its failures.
+func (s *Server) matchFault(r *http.Request) *fault {
+	for _, f := range s.faults {
+		if f.Method != "" && !strings.EqualFold(f.Method, r.Method) {
+			continue
+		}
+		if f.path != nil && !f.path.MatchString(r.URL.Path) {
+			continue
+		}
+		if f.Count > 0 {
+			if f.remaining == 0 {
+				continue
+			}
+			f.remaining--
+		}
+		return f
+	}
+	return nil
+}
+
+func (f *fault) write(w http.ResponseWriter) {
+	for k, v := range f.Headers {
+		w.Header().Set(k, v)
+	}
+	writeError(w, f.StatusCode, f.Code, f.Message)
+}
@@ -0,0 +1,114 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package fakearm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// ResourceHarness runs the Create, Read, Update, Delete and Import functions of a resource against a Server, using
+// the same Plugin SDK entry points as Terraform. Typed resources can be tested using `sdk.WrappedResource`.
+type ResourceHarness struct {
+	resource *pluginsdk.Resource
+	client   *clients.Client
+}
+
+// ResourceHarness returns a ResourceHarness for resource using a client built against the Server.
+func (s *Server) ResourceHarness(resource *pluginsdk.Resource) *ResourceHarness {
+	return &ResourceHarness{
+		resource: resource,
+		client:   s.Client(),
+	}
+}
+
+// Create creates the resource from config, a map of the Terraform configuration, returning the resulting state.
+func (h *ResourceHarness) Create(config map[string]interface{}) (*terraform.InstanceState, error) {
+	return h.apply(nil, config)
+}
+
+// Read refreshes state, returning nil if the resource no longer exists.
+func (h *ResourceHarness) Read(state *terraform.InstanceState) (*terraform.InstanceState, error) {
+	newState, diags := h.resource.RefreshWithoutUpgrade(context.Background(), state, h.client)
+	return newState, diagnosticsToError(diags)
+}
+
+// Update updates the resource in state to match config, returning the resulting state.
+func (h *ResourceHarness) Update(state *terraform.InstanceState, config map[string]interface{}) (*terraform.InstanceState, error) {
+	if state == nil || state.ID == "" {
+		return nil, errors.New("updating a resource requires an existing state")
+	}
+	return h.apply(state, config)
+}
+
+// Delete deletes the resource in state.
+func (h *ResourceHarness) Delete(state *terraform.InstanceState) error {
+	_, diags := h.resource.Apply(context.Background(), state, &terraform.InstanceDiff{Destroy: true}, h.client)
+	return diagnosticsToError(diags)
+}
+
+// Import imports the resource with the given ID and reads it, returning the resulting state.
+func (h *ResourceHarness) Import(id string) (*terraform.InstanceState, error) {
+	if h.resource.Importer == nil || h.resource.Importer.StateContext == nil {
+		return nil, errors.New("the resource doesn't support import")
+	}
+
+	ctx := context.Background()
+	data := h.resource.Data(&terraform.InstanceState{ID: id})
+	imported, err := h.resource.Importer.StateContext(ctx, data, h.client)
+	if err != nil {
+		return nil, fmt.Errorf("importing %q: %+v", id, err)
+	}
+	if len(imported) != 1 {
+		return nil, fmt.Errorf("expected 1 resource to be imported but got %d", len(imported))
+	}
+
+	state := imported[0].State()
+	if state == nil {
+		return nil, fmt.Errorf("importing %q returned no state", id)
+	}
+
+	newState, err := h.Read(state)
+	if err != nil {
+		return nil, err
+	}
+	if newState == nil {
+		return nil, fmt.Errorf("imported resource %q no longer exists", id)
+	}
+
+	return newState, nil
+}
+
+func (h *ResourceHarness) apply(state *terraform.InstanceState, config map[string]interface{}) (*terraform.InstanceState, error) {
+	ctx := context.Background()
+	resourceConfig := terraform.NewResourceConfigRaw(config)
+
+	if diags := h.resource.Validate(resourceConfig); diags.HasError() {
+		return nil, diagnosticsToError(diags)
+	}
+
+	diff, err := h.resource.SimpleDiff(ctx, state, resourceConfig, h.client)
+	if err != nil {
+		return nil, fmt.Errorf("planning: %+v", err)
+	}
+
+	newState, diags := h.resource.Apply(ctx, state, diff, h.client)
+	return newState, diagnosticsToError(diags)
+}
+
+func diagnosticsToError(diags diag.Diagnostics) error {
+	var err error
+	for _, d := range diags {
+		if d.Severity == diag.Error {
+			err = errors.Join(err, fmt.Errorf("%s: %s", d.Summary, d.Detail))
+		}
+	}
+	return err
+}
@@ -0,0 +1,599 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package fakearm
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+)
+
+const (
+	// AzureAsyncOperationHeader is the header returned by Long Running Operations which are polled for a `status`.
+	AzureAsyncOperationHeader = "Azure-AsyncOperation"
+
+	// LocationHeader is the header returned by Long Running Operations which are polled until they return a `200 OK`.
+	LocationHeader = "Location"
+
+	operationsPath = "/providers/Microsoft.FakeARM/operations/"
+)
+
+// Server is an in-process stand-in for the Azure Resource Manager API, used to test the CRUD logic of resources
+// without an Azure Subscription. Any resource ID can be created with a PUT, read with a GET, updated with a PATCH
+// (applied as a JSON Merge Patch) and removed with a DELETE, after which it returns a 404. A GET of a collection
+// lists the resources within it.
+//
+// Requests which need specific behaviour (e.g. a POST to `listKeys`) can be handled using HandleFunc, and errors can
+// be injected using InjectFault.
+type Server struct {
+	// URL is the base URL of the Server, which is used as the Resource Manager endpoint.
+	URL string
+
+	t      *testing.T
+	server *httptest.Server
+
+	mu                   sync.Mutex
+	resources            map[string]*resource
+	operations           map[string]*operation
+	nextOperationId      int
+	handlers             []handler
+	faults               []*fault
+	requests             []Request
+	longRunningOpsHeader string
+	pollsUntilComplete   int
+}
+
+// Request is a request received by the Server.
+type Request struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Body   string
+}
+
+type resource struct {
+	id        string
+	body      map[string]interface{}
+	operation *operation
+}
+
+type operation struct {
+	id          string
+	method      string
+	resourceKey string
+	state       string
+	remaining   int
+	done        bool
+}
+
+type handler struct {
+	method  string
+	path    *regexp.Regexp
+	handler http.HandlerFunc
+}
+
+// NewServer starts a Server which is stopped when the test completes. Since polling delays are skipped for the
+// duration of the test, the test can't be run in parallel.
+func NewServer(t *testing.T) *Server {
+	t.Helper()
+	t.Setenv("GO_AZURE_SDK_SKIP_POLLING_DELAY", "true")
+
+	s := &Server{
+		t:                  t,
+		resources:          make(map[string]*resource),
+		operations:         make(map[string]*operation),
+		pollsUntilComplete: 1,
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	s.URL = s.server.URL
+	t.Cleanup(s.server.Close)
+
+	return s
+}
+
+// UseLongRunningOperations makes PUT, PATCH and DELETE requests complete asynchronously, returning header (either
+// AzureAsyncOperationHeader or LocationHeader) as the URL to poll. The operation completes after it's been polled
+// the given number of times - a GET of the resource also counts as a poll. An empty header makes requests complete
+// synchronously, which is the default.
+func (s *Server) UseLongRunningOperations(header string, polls int) {
+	s.t.Helper()
+	if header != "" && header != AzureAsyncOperationHeader && header != LocationHeader {
+		s.t.Fatalf("unsupported Long Running Operation header %q", header)
+	}
+	if polls < 1 {
+		polls = 1
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.longRunningOpsHeader = header
+	s.pollsUntilComplete = polls
+}
+
+// HandleFunc registers handler for requests with the given method whose path matches the regular expression path.
+// Handlers are matched case-insensitively, in the order they were registered, before the generic behaviour.
+func (s *Server) HandleFunc(method, path string, handlerFunc http.HandlerFunc) {
+	s.t.Helper()
+	re, err := regexp.Compile("(?i)^" + path + "$")
+	if err != nil {
+		s.t.Fatalf("compiling path %q: %+v", path, err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.handlers = append(s.handlers, handler{
+		method:  method,
+		path:    re,
+		handler: handlerFunc,
+	})
+}
+
+// Requests returns the requests received by the Server, in the order they were received.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Request{}, s.requests...)
+}
+
+// Resource returns a copy of the body of the resource with the given ID and whether it exists.
+func (s *Server) Resource(id string) (map[string]interface{}, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, ok := s.resources[strings.ToLower(id)]
+	if !ok {
+		return nil, false
+	}
+	return s.render(r), true
+}
+
+// SetResource creates or replaces the resource with the given ID, for setting up existing resources in a test.
+func (s *Server) SetResource(id string, body map[string]interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.resources[strings.ToLower(id)] = newResource(id, copyMap(body))
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidRequestContent", fmt.Sprintf("reading request body: %+v", err))
+		return
+	}
+
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{
+		Method: r.Method,
+		Path:   r.URL.Path,
+		Query:  r.URL.Query(),
+		Body:   string(body),
+	})
+	f := s.matchFault(r)
+	h := s.matchHandler(r)
+	s.mu.Unlock()
+
+	if r.Header.Get("Authorization") != "Bearer "+accessToken {
+		writeError(w, http.StatusUnauthorized, "AuthenticationFailed", "the `Authorization` header was missing or invalid")
+		return
+	}
+
+	if f != nil {
+		f.write(w)
+		return
+	}
+
+	if h != nil {
+		r.Body = io.NopCloser(strings.NewReader(string(body)))
+		h(w, r)
+		return
+	}
+
+	path := strings.TrimSuffix(r.URL.Path, "/")
+	if strings.HasPrefix(strings.ToLower(path), strings.ToLower(operationsPath)) {
+		s.pollOperation(w, r, strings.TrimPrefix(path[len(operationsPath):], "/"))
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		s.get(w, path)
+	case http.MethodPut:
+		s.put(w, r, path, body)
+	case http.MethodPatch:
+		s.patch(w, r, path, body)
+	case http.MethodDelete:
+		s.delete(w, r, path)
+	default:
+		writeError(w, http.StatusBadRequest, "UnhandledRequest", fmt.Sprintf("no handler is registered for `%s %s`", r.Method, path))
+	}
+}
+
+func (s *Server) matchHandler(r *http.Request) http.HandlerFunc {
+	for _, h := range s.handlers {
+		if strings.EqualFold(h.method, r.Method) && h.path.MatchString(r.URL.Path) {
+			return h.handler
+		}
+	}
+	return nil
+}
+
+func (s *Server) get(w http.ResponseWriter, path string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := strings.ToLower(path)
+	if r, ok := s.resources[key]; ok {
+		if r.operation != nil {
+			s.advance(r.operation)
+		}
+		// the resource may have been removed by completing a delete
+		if r, ok := s.resources[key]; ok {
+			writeJSON(w, http.StatusOK, s.render(r))
+			return
+		}
+	}
+
+	if isCollection(path) {
+		items := make([]interface{}, 0)
+		for _, k := range s.sortedKeys() {
+			if s.inCollection(key, k) {
+				items = append(items, s.render(s.resources[k]))
+			}
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"value": items,
+		})
+		return
+	}
+
+	writeNotFound(w, path)
+}
+
+func (s *Server) put(w http.ResponseWriter, r *http.Request, path string, body []byte) {
+	payload, err := decodeBody(body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidRequestContent", err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := strings.ToLower(path)
+	_, exists := s.resources[key]
+	res := newResource(path, payload)
+	s.resources[key] = res
+
+	status := http.StatusCreated
+	state := "Creating"
+	if exists {
+		status = http.StatusOK
+		state = "Updating"
+	}
+
+	s.respond(w, r, res, status, state)
+}
+
+func (s *Server) patch(w http.ResponseWriter, r *http.Request, path string, body []byte) {
+	payload, err := decodeBody(body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidRequestContent", err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	res, ok := s.resources[strings.ToLower(path)]
+	if !ok {
+		writeNotFound(w, path)
+		return
+	}
+
+	mergePatch(res.body, payload)
+	s.respond(w, r, res, http.StatusOK, "Updating")
+}
+
+func (s *Server) delete(w http.ResponseWriter, r *http.Request, path string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := strings.ToLower(path)
+	res, ok := s.resources[key]
+	if !ok {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	if s.longRunningOpsHeader == "" {
+		s.remove(key)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	op := s.newOperation(r.Method, key, "Deleting")
+	res.operation = op
+	w.Header().Set(s.longRunningOpsHeader, s.operationUrl(op, r))
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// respond writes the response to a PUT or PATCH, which either completes immediately or starts a Long Running
+// Operation with the resource in the given provisioning state.
+func (s *Server) respond(w http.ResponseWriter, r *http.Request, res *resource, status int, state string) {
+	res.operation = nil
+	if s.longRunningOpsHeader == "" {
+		writeJSON(w, status, s.render(res))
+		return
+	}
+
+	op := s.newOperation(r.Method, strings.ToLower(res.id), state)
+	res.operation = op
+	w.Header().Set(s.longRunningOpsHeader, s.operationUrl(op, r))
+
+	if r.Method == http.MethodPatch {
+		if s.longRunningOpsHeader == LocationHeader {
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		writeJSON(w, http.StatusOK, s.render(res))
+		return
+	}
+	writeJSON(w, http.StatusCreated, s.render(res))
+}
+
+func (s *Server) pollOperation(w http.ResponseWriter, r *http.Request, id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	op, ok := s.operations[strings.ToLower(id)]
+	if !ok || r.Method != http.MethodGet {
+		writeNotFound(w, r.URL.Path)
+		return
+	}
+
+	s.advance(op)
+
+	if r.URL.Query().Get("kind") == strings.ToLower(LocationHeader) {
+		switch {
+		case !op.done:
+			w.Header().Set(LocationHeader, s.operationUrl(op, r))
+			w.WriteHeader(http.StatusAccepted)
+		case op.method == http.MethodDelete:
+			w.WriteHeader(http.StatusOK)
+		default:
+			if res, ok := s.resources[op.resourceKey]; ok {
+				writeJSON(w, http.StatusOK, s.render(res))
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}
+		return
+	}
+
+	status := "InProgress"
+	if op.done {
+		status = "Succeeded"
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"id":     op.id,
+		"name":   op.id,
+		"status": status,
+	})
+}
+
+func (s *Server) newOperation(method, resourceKey, state string) *operation {
+	s.nextOperationId++
+	op := &operation{
+		id:          fmt.Sprintf("%08d", s.nextOperationId),
+		method:      method,
+		resourceKey: resourceKey,
+		state:       state,
+		remaining:   s.pollsUntilComplete,
+	}
+	s.operations[op.id] = op
+	return op
+}
+
+func (s *Server) operationUrl(op *operation, r *http.Request) string {
+	query := url.Values{}
+	query.Set("api-version", r.URL.Query().Get("api-version"))
+	if s.longRunningOpsHeader == LocationHeader {
+		query.Set("kind", strings.ToLower(LocationHeader))
+	}
+	return fmt.Sprintf("%s%s%s?%s", s.URL, operationsPath, op.id, query.Encode())
+}
+
+// advance records a poll of the operation, completing it once it's been polled enough times.
+func (s *Server) advance(op *operation) {
+	if op.done {
+		return
+	}
+
+	op.remaining--
+	if op.remaining > 0 {
+		return
+	}
+
+	op.done = true
+	if res, ok := s.resources[op.resourceKey]; ok && res.operation == op {
+		res.operation = nil
+		if op.method == http.MethodDelete {
+			s.remove(op.resourceKey)
+		}
+	}
+}
+
+// remove deletes the resource and any nested resources.
+func (s *Server) remove(key string) {
+	for k := range s.resources {
+		if k == key || strings.HasPrefix(k, key+"/") {
+			delete(s.resources, k)
+		}
+	}
+}
+
+func (s *Server) render(r *resource) map[string]interface{} {
+	body := copyMap(r.body)
+	state := "Succeeded"
+	if r.operation != nil && !r.operation.done {
+		state = r.operation.state
+	}
+
+	properties, ok := body["properties"].(map[string]interface{})
+	if !ok {
+		properties = make(map[string]interface{})
+		body["properties"] = properties
+	}
+	properties["provisioningState"] = state
+
+	return body
+}
+
+func (s *Server) sortedKeys() []string {
+	keys := make([]string, 0, len(s.resources))
+	for k := range s.resources {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// inCollection determines whether the resource with the key is a member of the collection. The resources in a
+// Resource Group (`/resourceGroups/{name}/resources`) are any resources nested within it, otherwise resources are
+// members of the collection they're directly beneath.
+func (s *Server) inCollection(collection, key string) bool {
+	if strings.HasSuffix(collection, "/resources") {
+		if group := strings.TrimSuffix(collection, "/resources"); isResourceGroup(group) {
+			return strings.HasPrefix(key, group+"/providers/")
+		}
+	}
+
+	remaining, ok := strings.CutPrefix(key, collection+"/")
+	return ok && !strings.Contains(remaining, "/")
+}
+
+func newResource(id string, body map[string]interface{}) *resource {
+	segments := strings.Split(strings.Trim(id, "/"), "/")
+	body["id"] = id
+	body["name"] = segments[len(segments)-1]
+	if t := resourceType(segments); t != "" {
+		body["type"] = t
+	}
+
+	return &resource{
+		id:   id,
+		body: body,
+	}
+}
+
+// isCollection determines whether path is a collection of resources rather than a resource. Resource IDs are
+// comprised of key/value pairs, both before and after the last `/providers/{namespace}` segments.
+func isCollection(path string) bool {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i := len(segments) - 2; i >= 0; i-- {
+		if strings.EqualFold(segments[i], "providers") {
+			return (len(segments)-(i+2))%2 == 1
+		}
+	}
+	return len(segments)%2 == 1
+}
+
+func isResourceGroup(key string) bool {
+	segments := strings.Split(strings.Trim(key, "/"), "/")
+	return len(segments) == 4 && strings.EqualFold(segments[0], "subscriptions") && strings.EqualFold(segments[2], "resourceGroups")
+}
+
+func resourceType(segments []string) string {
+	for i := len(segments) - 2; i >= 0; i-- {
+		if strings.EqualFold(segments[i], "providers") {
+			types := []string{segments[i+1]}
+			for j := i + 2; j < len(segments); j += 2 {
+				types = append(types, segments[j])
+			}
+			return strings.Join(types, "/")
+		}
+	}
+
+	if len(segments) >= 2 && strings.EqualFold(segments[len(segments)-2], "resourceGroups") {
+		return "Microsoft.Resources/resourceGroups"
+	}
+	return ""
+}
+
+func decodeBody(body []byte) (map[string]interface{}, error) {
+	payload := make(map[string]interface{})
+	if len(strings.TrimSpace(string(body))) == 0 {
+		return payload, nil
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, fmt.Errorf("unmarshaling request body: %+v", err)
+	}
+	return payload, nil
+}
+
+// mergePatch applies patch to target as a JSON Merge Patch (RFC 7386).
+func mergePatch(target, patch map[string]interface{}) {
+	for k, v := range patch {
+		if v == nil {
+			delete(target, k)
+			continue
+		}
+
+		if patchMap, ok := v.(map[string]interface{}); ok {
+			if targetMap, ok := target[k].(map[string]interface{}); ok {
+				mergePatch(targetMap, patchMap)
+				continue
+			}
+		}
+		target[k] = v
+	}
+}
+
+func copyMap(input map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{})
+	if input == nil {
+		return output
+	}
+
+	contents, err := json.Marshal(input)
+	if err != nil {
+		panic(fmt.Sprintf("marshaling resource: %+v", err))
+	}
+
+	if err := json.Unmarshal(contents, &output); err != nil {
+		panic(fmt.Sprintf("unmarshaling resource: %+v", err))
+	}
+	return output
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":    code,
+			"message": message,
+		},
+	})
+}
+
+func writeNotFound(w http.ResponseWriter, path string) {
+	writeError(w, http.StatusNotFound, "ResourceNotFound", fmt.Sprintf("The Resource '%s' was not found.", path))
+}
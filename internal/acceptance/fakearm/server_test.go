@@ -0,0 +1,247 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package fakearm_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/fakearm"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource"
+)
+
+const resourceGroupId = "/subscriptions/" + fakearm.SubscriptionId + "/resourceGroups/example"
+
+func TestServer_ResourceLifecycle(t *testing.T) {
+	s := fakearm.NewServer(t)
+	id := resourceGroupId + "/providers/Microsoft.Example/things/thing1"
+
+	do := func(method, path, body string) (int, http.Header, map[string]interface{}) {
+		return request(t, s, method, path, body)
+	}
+
+	if status, _, _ := do(http.MethodGet, id, ""); status != http.StatusNotFound {
+		t.Fatalf("expected a 404 before creation but got %d", status)
+	}
+
+	status, _, body := do(http.MethodPut, id, `{"location":"westeurope","properties":{"sku":"Basic","enabled":true}}`)
+	if status != http.StatusCreated {
+		t.Fatalf("expected a 201 from creation but got %d", status)
+	}
+	if body["id"] != id || body["name"] != "thing1" || body["type"] != "Microsoft.Example/things" {
+		t.Fatalf("expected the id, name and type to be set but got %+v", body)
+	}
+
+	status, _, body = do(http.MethodPatch, id, `{"properties":{"sku":"Standard","enabled":null}}`)
+	if status != http.StatusOK {
+		t.Fatalf("expected a 200 from update but got %d", status)
+	}
+	properties := body["properties"].(map[string]interface{})
+	if properties["sku"] != "Standard" || properties["provisioningState"] != "Succeeded" {
+		t.Fatalf("expected the patch to be merged but got %+v", properties)
+	}
+	if _, ok := properties["enabled"]; ok {
+		t.Fatalf("expected `enabled` to be removed by the patch but got %+v", properties)
+	}
+
+	status, _, body = do(http.MethodGet, resourceGroupId+"/providers/Microsoft.Example/things", "")
+	if status != http.StatusOK || len(body["value"].([]interface{})) != 1 {
+		t.Fatalf("expected the collection to contain 1 item but got %d: %+v", status, body)
+	}
+
+	if status, _, _ := do(http.MethodDelete, id, ""); status != http.StatusOK {
+		t.Fatalf("expected a 200 from deletion but got %d", status)
+	}
+	if status, _, _ := do(http.MethodGet, id, ""); status != http.StatusNotFound {
+		t.Fatalf("expected a 404 after deletion but got %d", status)
+	}
+	if status, _, _ := do(http.MethodDelete, id, ""); status != http.StatusNoContent {
+		t.Fatalf("expected a 204 from deleting a missing resource but got %d", status)
+	}
+}
+
+func TestServer_LocationLongRunningOperation(t *testing.T) {
+	s := fakearm.NewServer(t)
+	s.UseLongRunningOperations(fakearm.LocationHeader, 2)
+	id := resourceGroupId + "/providers/Microsoft.Example/things/thing1"
+
+	poll := func(header http.Header) {
+		location := header.Get(fakearm.LocationHeader)
+		if location == "" {
+			t.Fatalf("expected a %q header to be returned", fakearm.LocationHeader)
+		}
+		path := strings.TrimPrefix(location, s.URL)
+		path = path[:strings.Index(path, "?")]
+
+		if status, _, _ := request(t, s, http.MethodGet, path+"?kind=location", ""); status != http.StatusAccepted {
+			t.Fatalf("expected the first poll to return a 202 but got %d", status)
+		}
+		if status, _, _ := request(t, s, http.MethodGet, path+"?kind=location", ""); status != http.StatusOK {
+			t.Fatalf("expected the second poll to return a 200 but got %d", status)
+		}
+	}
+
+	status, header, body := request(t, s, http.MethodPut, id, `{"location":"westeurope"}`)
+	if status != http.StatusCreated {
+		t.Fatalf("expected a 201 from creation but got %d", status)
+	}
+	if state := body["properties"].(map[string]interface{})["provisioningState"]; state != "Creating" {
+		t.Fatalf("expected the resource to be `Creating` but got %q", state)
+	}
+	poll(header)
+
+	status, header, _ = request(t, s, http.MethodDelete, id, "")
+	if status != http.StatusAccepted {
+		t.Fatalf("expected a 202 from deletion but got %d", status)
+	}
+	if _, ok := s.Resource(id); !ok {
+		t.Fatalf("expected the resource to exist until the deletion completes")
+	}
+	poll(header)
+
+	if status, _, _ := request(t, s, http.MethodGet, id, ""); status != http.StatusNotFound {
+		t.Fatalf("expected a 404 after deletion but got %d", status)
+	}
+}
+
+func TestServer_ResourceGroup(t *testing.T) {
+	for _, header := range []string{"", fakearm.AzureAsyncOperationHeader} {
+		t.Run(header, func(t *testing.T) {
+			s := fakearm.NewServer(t)
+			s.UseLongRunningOperations(header, 3)
+
+			h := s.ResourceHarness(resource.Registration{}.SupportedResources()["azurerm_resource_group"])
+
+			state, err := h.Create(map[string]interface{}{
+				"name":     "example",
+				"location": "West Europe",
+				"tags": map[string]interface{}{
+					"env": "test",
+				},
+			})
+			if err != nil {
+				t.Fatalf("creating: %+v", err)
+			}
+			if state.ID != resourceGroupId {
+				t.Fatalf("expected the ID to be %q but got %q", resourceGroupId, state.ID)
+			}
+			if state.Attributes["location"] != "westeurope" || state.Attributes["tags.env"] != "test" {
+				t.Fatalf("unexpected state after creation: %+v", state.Attributes)
+			}
+
+			state, err = h.Update(state, map[string]interface{}{
+				"name":     "example",
+				"location": "West Europe",
+				"tags": map[string]interface{}{
+					"env": "prod",
+				},
+			})
+			if err != nil {
+				t.Fatalf("updating: %+v", err)
+			}
+			if state.Attributes["tags.env"] != "prod" {
+				t.Fatalf("expected the tags to be updated but got %+v", state.Attributes)
+			}
+
+			imported, err := h.Import(resourceGroupId)
+			if err != nil {
+				t.Fatalf("importing: %+v", err)
+			}
+			if imported.Attributes["tags.env"] != "prod" {
+				t.Fatalf("expected the imported tags to match but got %+v", imported.Attributes)
+			}
+
+			if err := h.Delete(state); err != nil {
+				t.Fatalf("deleting: %+v", err)
+			}
+			if _, ok := s.Resource(resourceGroupId); ok {
+				t.Fatalf("expected the resource group to have been deleted")
+			}
+
+			state, err = h.Read(state)
+			if err != nil {
+				t.Fatalf("reading after deletion: %+v", err)
+			}
+			if state != nil {
+				t.Fatalf("expected the resource group to be removed from state but got %+v", state)
+			}
+		})
+	}
+}
+
+func TestServer_Faults(t *testing.T) {
+	s := fakearm.NewServer(t)
+	h := s.ResourceHarness(resource.Registration{}.SupportedResources()["azurerm_resource_group"])
+	config := map[string]interface{}{
+		"name":     "example",
+		"location": "West Europe",
+	}
+
+	s.InjectFault(fakearm.Fault{
+		Method:     http.MethodPut,
+		Path:       "/resourceGroups/example$",
+		StatusCode: http.StatusConflict,
+		Code:       "ResourceGroupBeingDeleted",
+		Count:      1,
+	})
+	if _, err := h.Create(config); err == nil || !strings.Contains(err.Error(), "ResourceGroupBeingDeleted") {
+		t.Fatalf("expected the injected fault to be returned but got %+v", err)
+	}
+
+	s.InjectFault(fakearm.Fault{
+		Method:     http.MethodPut,
+		StatusCode: http.StatusTooManyRequests,
+		Headers: map[string]string{
+			"Retry-After": "0",
+		},
+		Count: 1,
+	})
+	if _, err := h.Create(config); err != nil {
+		t.Fatalf("expected the throttled request to be retried but got %+v", err)
+	}
+
+	s.InjectFault(fakearm.Fault{
+		Method:     http.MethodDelete,
+		StatusCode: http.StatusForbidden,
+		Code:       "AuthorizationFailed",
+	})
+	state, err := h.Import(resourceGroupId)
+	if err != nil {
+		t.Fatalf("importing: %+v", err)
+	}
+	if err := h.Delete(state); err == nil || !strings.Contains(err.Error(), "AuthorizationFailed") {
+		t.Fatalf("expected the injected fault to be returned from deletion but got %+v", err)
+	}
+	if _, ok := s.Resource(resourceGroupId); !ok {
+		t.Fatalf("expected the resource group to still exist")
+	}
+}
+
+func request(t *testing.T, s *fakearm.Server, method, path, body string) (int, http.Header, map[string]interface{}) {
+	t.Helper()
+
+	uri := s.URL + path
+	if !strings.Contains(path, "?") {
+		uri += "?"
+	} else {
+		uri += "&"
+	}
+	req, err := http.NewRequest(method, uri+"api-version=2020-01-01", strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+	req.Header.Set("Authorization", "Bearer fakearm")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	result := make(map[string]interface{})
+	_ = json.NewDecoder(resp.Body).Decode(&result)
+	return resp.StatusCode, resp.Header, result
+}
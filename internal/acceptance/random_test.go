@@ -18,3 +18,20 @@ func TestAccRandTimeInt(t *testing.T) {
 		}
 	})
 }
+
+func TestTestRandomWithoutValuesFile(t *testing.T) {
+	// cassettes recorded before values files were introduced must replay with the values they were recorded with
+	t.Setenv("TC_TEST_VIA_VCR", "replay")
+
+	r, err := newTestRandom("TestAccExample_basic")
+	if err != nil {
+		t.Fatalf("building test random: %+v", err)
+	}
+
+	if actual := r.timeInt("RandomInteger"); actual != 204501019680328785 {
+		t.Fatalf("expected RandomInteger to be 204501019680328785 but got %d", actual)
+	}
+	if actual := r.stringFromCharSet("RandomString", 5, charSetAlphaNum); actual != "07kbc" {
+		t.Fatalf("expected RandomString to be %q but got %q", "07kbc", actual)
+	}
+}
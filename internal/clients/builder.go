@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
 	"time"
 
@@ -36,6 +37,13 @@ type ClientBuilder struct {
 	SubscriptionID              string
 	TerraformVersion            string
 	TestName                    string
+
+	// Account, Authorizer and Transport allow the client to be built against a fake Resource Manager API (such as
+	// `fakearm.Server`) without authenticating against Azure. When Authorizer is set it's used for every API and
+	// Account must also be set.
+	Account    *ResourceManagerAccount
+	Authorizer auth.Authorizer
+	Transport  http.RoundTripper
 }
 
 const azureStackEnvironmentError = `
@@ -51,6 +59,10 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		return nil, errors.New(azureStackEnvironmentError)
 	}
 
+	if builder.Authorizer != nil {
+		return buildWithAuthorizer(ctx, builder)
+	}
+
 	var resourceManagerAuth, storageAuth, synapseAuth, batchManagementAuth, keyVaultAuth auth.Authorizer
 
 	resourceManagerAuth, err = auth.NewAuthorizerFromCredentials(ctx, *builder.AuthConfig, builder.AuthConfig.Environment.ResourceManager)
@@ -155,6 +167,10 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		ResourceManagerEndpoint: *resourceManagerEndpoint,
 	}
 
+	if builder.Transport != nil {
+		o.Transport = builder.Transport
+	}
+
 	// go-vcr integration
 	// TC_TEST_VIA_VCR can be set to `true` or `record` see the testing guides for more information
 	if os.Getenv("TC_TEST_VIA_VCR") != "" && builder.TestName != "" {
@@ -191,3 +207,66 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 
 	return &client, nil
 }
+
+// buildWithAuthorizer builds a Client using the Authorizer and Account from the builder, rather than authenticating
+// using the credentials in the AuthConfig. Only the Environment is used from the AuthConfig.
+func buildWithAuthorizer(ctx context.Context, builder ClientBuilder) (*Client, error) {
+	if builder.Account == nil {
+		return nil, errors.New("an `Account` must be specified when building a client with an `Authorizer`")
+	}
+
+	resourceManagerEndpoint, ok := builder.AuthConfig.Environment.ResourceManager.Endpoint()
+	if !ok {
+		return nil, errors.New("unable to determine resource manager endpoint for the current environment")
+	}
+
+	client := Client{
+		Account: builder.Account,
+	}
+
+	authorizer := builder.Authorizer
+	o := &common.ClientOptions{
+		Authorizers: &common.Authorizers{
+			BatchManagement: authorizer,
+			KeyVault:        authorizer,
+			ManagedHSM:      authorizer,
+			ResourceManager: authorizer,
+			Storage:         authorizer,
+			Synapse:         authorizer,
+			AuthorizerFunc: common.ApiAuthorizerFunc(func(api environments.Api) (auth.Authorizer, error) {
+				return authorizer, nil
+			}),
+		},
+
+		AuthConfig:  builder.AuthConfig,
+		Environment: builder.AuthConfig.Environment,
+		Features:    builder.Features,
+
+		SubscriptionId:   builder.Account.SubscriptionId,
+		TenantId:         builder.Account.TenantId,
+		PartnerId:        builder.PartnerID,
+		TerraformVersion: builder.TerraformVersion,
+
+		BatchManagementAuthorizer: authWrapper.AutorestAuthorizer(authorizer),
+		KeyVaultAuthorizer:        authWrapper.AutorestAuthorizer(authorizer).BearerAuthorizerCallback(),
+		ManagedHSMAuthorizer:      authWrapper.AutorestAuthorizer(authorizer).BearerAuthorizerCallback(),
+		ResourceManagerAuthorizer: authWrapper.AutorestAuthorizer(authorizer),
+		SynapseAuthorizer:         authWrapper.AutorestAuthorizer(authorizer),
+
+		CustomCorrelationRequestID:  builder.CustomCorrelationRequestID,
+		DisableCorrelationRequestID: builder.DisableCorrelationRequestID,
+		DisableTerraformPartnerID:   builder.DisableTerraformPartnerID,
+		SkipProviderReg:             true,
+		StorageUseAzureAD:           builder.StorageUseAzureAD,
+
+		ResourceManagerEndpoint: *resourceManagerEndpoint,
+
+		Transport: builder.Transport,
+	}
+
+	if err := client.Build(ctx, o); err != nil {
+		return nil, fmt.Errorf("building Client: %+v", err)
+	}
+
+	return &client, nil
+}
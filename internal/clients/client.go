@@ -22,6 +22,7 @@ import (
 	storagecache_2024_07_01 "github.com/hashicorp/go-azure-sdk/resource-manager/storagecache/2024-07-01"
 	systemcentervirtualmachinemanager_2023_10_07 "github.com/hashicorp/go-azure-sdk/resource-manager/systemcentervirtualmachinemanager/2023-10-07"
 	workloads_v2024_09_01 "github.com/hashicorp/go-azure-sdk/resource-manager/workloads/2024-09-01"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients/resourcegraph"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	aadb2c "github.com/hashicorp/terraform-provider-azurerm/internal/services/aadb2c/client"
@@ -265,6 +266,7 @@ type Client struct {
 	RedisEnterprise                   *redisenterprise.Client
 	Relay                             *relay.Client
 	Resource                          *resource.Client
+	ResourceGraph                     *resourcegraph.Client
 	Search                            *search.Client
 	SecurityCenter                    *securityCenter.Client
 	Sentinel                          *sentinel.Client
@@ -616,6 +618,9 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	if client.Resource, err = resource.NewClient(o); err != nil {
 		return fmt.Errorf("building clients for Resource: %+v", err)
 	}
+	if client.ResourceGraph, err = resourcegraph.NewClient(o); err != nil {
+		return fmt.Errorf("building clients for Resource Graph: %+v", err)
+	}
 	if client.Search, err = search.NewClient(o); err != nil {
 		return fmt.Errorf("building clients for Search: %+v", err)
 	}
@@ -0,0 +1,86 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegraph
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resourcegraph/2024-04-01/resources"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+// pageSize is the maximum number of rows returned per page, which is the maximum supported by the API
+const pageSize = 1000
+
+type Client struct {
+	ResourcesClient *resources.ResourcesClient
+}
+
+func NewClient(o *common.ClientOptions) (*Client, error) {
+	resourcesClient, err := resources.NewResourcesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Resource Graph Resources client: %+v", err)
+	}
+	o.Configure(resourcesClient.Client, o.Authorizers.ResourceManager)
+
+	return &Client{
+		ResourcesClient: resourcesClient,
+	}, nil
+}
+
+// ListResourceIds runs the KQL query against Azure Resource Graph, scoped to either the specified Subscriptions or
+// Management Groups, and returns the values of the `id` column across all pages of results.
+func (c *Client) ListResourceIds(ctx context.Context, query string, subscriptionIds []string, managementGroupIds []string) ([]string, error) {
+	input := resources.QueryRequest{
+		Query: query,
+		Options: &resources.QueryRequestOptions{
+			ResultFormat: pointer.To(resources.ResultFormatObjectArray),
+			Top:          pointer.To(int64(pageSize)),
+		},
+	}
+	if len(subscriptionIds) > 0 {
+		input.Subscriptions = pointer.To(subscriptionIds)
+	}
+	if len(managementGroupIds) > 0 {
+		input.ManagementGroups = pointer.To(managementGroupIds)
+	}
+
+	ids := make([]string, 0)
+	for {
+		resp, err := c.ResourcesClient.Resources(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("querying Resource Graph: %+v", err)
+		}
+		if resp.Model == nil {
+			return nil, fmt.Errorf("querying Resource Graph: model was nil")
+		}
+
+		rows, ok := resp.Model.Data.([]interface{})
+		if !ok && resp.Model.Data != nil {
+			return nil, fmt.Errorf("querying Resource Graph: expected the data to be an array but got %T", resp.Model.Data)
+		}
+
+		for _, row := range rows {
+			values, ok := row.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("querying Resource Graph: expected each row to be an object but got %T", row)
+			}
+
+			id, ok := values["id"].(string)
+			if !ok || id == "" {
+				return nil, fmt.Errorf("querying Resource Graph: expected each row to contain an `id` column")
+			}
+			ids = append(ids, id)
+		}
+
+		if pointer.From(resp.Model.SkipToken) == "" {
+			break
+		}
+		input.Options.SkipToken = resp.Model.SkipToken
+	}
+
+	return ids, nil
+}
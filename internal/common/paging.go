@@ -0,0 +1,74 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+type pageLimitKey struct{}
+
+// WithPageLimit returns a context which stops the paged List operations using it from retrieving further pages once
+// `limit` items have been retrieved, where a limit of 0 means all pages are retrieved. The limit is shared by every
+// request using the context, so it should only be used for a single List operation.
+func WithPageLimit(ctx context.Context, limit int64) context.Context {
+	if limit <= 0 {
+		return ctx
+	}
+
+	remaining := &atomic.Int64{}
+	remaining.Store(limit)
+	return context.WithValue(ctx, pageLimitKey{}, remaining)
+}
+
+// pageLimitMiddleware removes the link to the next page from the response once the number of items specified by
+// WithPageLimit has been retrieved, since the paged List operations in go-azure-sdk otherwise retrieve every page
+func pageLimitMiddleware() client.ResponseMiddleware {
+	return func(request *http.Request, response *http.Response) (*http.Response, error) {
+		remaining, ok := request.Context().Value(pageLimitKey{}).(*atomic.Int64)
+		if !ok || response.StatusCode != http.StatusOK || response.Body == nil {
+			return response, nil
+		}
+		if !strings.HasPrefix(strings.ToLower(response.Header.Get("Content-Type")), "application/json") {
+			return response, nil
+		}
+
+		body, err := io.ReadAll(response.Body)
+		response.Body.Close()
+		if err != nil {
+			return response, err
+		}
+		response.Body = io.NopCloser(bytes.NewReader(body))
+
+		var page map[string]json.RawMessage
+		if err := json.Unmarshal(body, &page); err != nil {
+			return response, nil
+		}
+		var values []json.RawMessage
+		if err := json.Unmarshal(page["value"], &values); err != nil {
+			return response, nil
+		}
+		if remaining.Add(-int64(len(values))) > 0 {
+			return response, nil
+		}
+
+		delete(page, "nextLink")
+		delete(page, "@odata.nextLink")
+		if body, err = json.Marshal(page); err != nil {
+			return response, err
+		}
+		response.Body = io.NopCloser(bytes.NewReader(body))
+		response.ContentLength = int64(len(body))
+
+		return response, nil
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestPageLimitMiddleware(t *testing.T) {
+	testData := []struct {
+		name             string
+		limit            int64
+		pages            int
+		expectedNextLink []bool
+	}{
+		{
+			name:             "no limit",
+			limit:            0,
+			pages:            3,
+			expectedNextLink: []bool{true, true, true},
+		},
+		{
+			name:             "limit within the first page",
+			limit:            1,
+			pages:            1,
+			expectedNextLink: []bool{false},
+		},
+		{
+			name:             "limit at the end of a page",
+			limit:            4,
+			pages:            2,
+			expectedNextLink: []bool{true, false},
+		},
+		{
+			name:             "limit within a later page",
+			limit:            5,
+			pages:            3,
+			expectedNextLink: []bool{true, true, false},
+		},
+	}
+
+	middleware := pageLimitMiddleware()
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		req, err := http.NewRequestWithContext(WithPageLimit(context.Background(), v.limit), http.MethodGet, "https://management.azure.com/example", nil)
+		if err != nil {
+			t.Fatalf("building request: %+v", err)
+		}
+
+		for page := 0; page < v.pages; page++ {
+			resp := &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{"Content-Type": []string{"application/json; charset=utf-8"}},
+				Body:       io.NopCloser(strings.NewReader(`{"value": [{"name": "a"}, {"name": "b"}], "nextLink": "https://management.azure.com/example?page=next"}`)),
+			}
+
+			resp, err = middleware(req, resp)
+			if err != nil {
+				t.Fatalf("running middleware for page %d: %+v", page, err)
+			}
+
+			var body struct {
+				Value    []interface{} `json:"value"`
+				NextLink *string       `json:"nextLink"`
+			}
+			if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+				t.Fatalf("decoding page %d: %+v", page, err)
+			}
+			if len(body.Value) != 2 {
+				t.Fatalf("expected page %d to contain 2 items but got %d", page, len(body.Value))
+			}
+			if actual := body.NextLink != nil; actual != v.expectedNextLink[page] {
+				t.Fatalf("expected the next link for page %d to be present %t but got %t", page, v.expectedNextLink[page], actual)
+			}
+		}
+	}
+}
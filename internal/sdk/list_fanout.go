@@ -0,0 +1,267 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/management/2020-05-01/managementgroups"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+const (
+	listFanOutSubscriptionId    = "subscription_id"
+	listFanOutSubscriptionIds   = "subscription_ids"
+	listFanOutManagementGroupId = "management_group_id"
+
+	// listFanOutConcurrency is the maximum number of Subscriptions which are listed concurrently
+	listFanOutConcurrency = 8
+
+	managementGroupDescendantTypeSubscription = "Microsoft.Management/managementGroups/subscriptions"
+)
+
+// listAuthorizationFailureRegex matches the errors returned by the API when the caller doesn't have permission
+// to list the resources within a Subscription
+var listAuthorizationFailureRegex = regexp.MustCompile(`unexpected status 403 |AuthorizationFailed|SubscriptionNotFound`)
+
+// listFanOutSchemaAttributes returns the attributes used to list resources across multiple Subscriptions, which are
+// available for all List Resources using the default schema.
+func listFanOutSchemaAttributes() map[string]listschema.Attribute {
+	return map[string]listschema.Attribute{
+		listFanOutSubscriptionIds: listschema.ListAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			Description: "A list of Subscription IDs to list resources in.",
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.UniqueValues(),
+				listvalidator.ValueStringsAre(
+					typehelpers.WrappedStringValidator{
+						Func: validation.IsUUID,
+					},
+				),
+				listvalidator.ConflictsWith(path.MatchRoot(listFanOutSubscriptionId), path.MatchRoot(listFanOutManagementGroupId)),
+			},
+		},
+
+		listFanOutManagementGroupId: listschema.StringAttribute{
+			Optional:    true,
+			Description: "The ID of a Management Group, resources are listed in all Subscriptions within this Management Group and its descendants.",
+			Validators: []validator.String{
+				typehelpers.WrappedStringValidator{
+					Func: commonids.ValidateManagementGroupID,
+				},
+				stringvalidator.ConflictsWith(path.MatchRoot(listFanOutSubscriptionId), path.MatchRoot(listFanOutSubscriptionIds)),
+			},
+		},
+	}
+}
+
+// fanOutSubscriptionIds returns the Subscription IDs the List Resource should be queried in, from either the
+// `subscription_ids` or all Subscriptions within the `management_group_id`.
+func (r *FrameworkListResourceWrapper) fanOutSubscriptionIds(ctx context.Context, data DefaultListModel) ([]string, error) {
+	subscriptionIds := make([]string, 0)
+
+	if !data.SubscriptionIds.IsNull() && !data.SubscriptionIds.IsUnknown() {
+		if diags := data.SubscriptionIds.ElementsAs(ctx, &subscriptionIds, false); diags.HasError() {
+			return nil, fmt.Errorf("retrieving `%s`: %+v", listFanOutSubscriptionIds, diags)
+		}
+		return subscriptionIds, nil
+	}
+
+	if data.ManagementGroupId.IsNull() || data.ManagementGroupId.IsUnknown() {
+		return subscriptionIds, nil
+	}
+
+	id, err := commonids.ParseManagementGroupID(data.ManagementGroupId.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.Client.ManagementGroups.GroupsClient.GetDescendantsComplete(ctx, *id, managementgroups.DefaultGetDescendantsOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("retrieving descendants of %s: %+v", id, err)
+	}
+
+	for _, item := range resp.Items {
+		if !strings.EqualFold(pointer.From(item.Type), managementGroupDescendantTypeSubscription) || pointer.From(item.Name) == "" {
+			continue
+		}
+		subscriptionIds = append(subscriptionIds, *item.Name)
+	}
+
+	slices.Sort(subscriptionIds)
+
+	return slices.Compact(subscriptionIds), nil
+}
+
+// listAcrossSubscriptions lists the resources in each of the specified Subscriptions concurrently, streaming the results
+// as they're returned. Subscriptions which the caller doesn't have permission to list resources in are reported as warnings.
+func (r *FrameworkListResourceWrapper) listAcrossSubscriptions(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, subscriptionIds []string) {
+	// retrieve the deadline from the supplied context
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		// This *should* never happen given the List Wrapper instantiates a context with a timeout
+		SetResponseErrorDiagnostic(stream, "internal-error", "context had no deadline")
+		return
+	}
+
+	stream.Results = streamListResultsConcurrently(deadline, subscriptionIds, func(ctx context.Context, subscriptionId string, push func(list.ListResult) bool) {
+		r.listInSubscription(ctx, request, subscriptionId, push)
+	})
+}
+
+// streamListResultsConcurrently returns a results iterator which calls fn for each of the items, with at most
+// listFanOutConcurrency running concurrently, streaming the results as they're pushed. Once the consumer stops
+// iterating the context passed to fn is cancelled, and any further results are discarded.
+func streamListResultsConcurrently[T any](deadline time.Time, items []T, fn func(ctx context.Context, item T, push func(list.ListResult) bool)) func(push func(list.ListResult) bool) {
+	return func(push func(list.ListResult) bool) {
+		deadlineCtx, cancel := context.WithDeadline(context.Background(), deadline)
+		defer cancel()
+
+		results := make(chan list.ListResult)
+		go func() {
+			defer close(results)
+
+			wg := sync.WaitGroup{}
+			semaphore := make(chan struct{}, listFanOutConcurrency)
+			send := func(result list.ListResult) bool {
+				select {
+				case results <- result:
+					return true
+				case <-deadlineCtx.Done():
+					return false
+				}
+			}
+
+			for _, item := range items {
+				select {
+				case semaphore <- struct{}{}:
+				case <-deadlineCtx.Done():
+					wg.Wait()
+					return
+				}
+
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					defer func() { <-semaphore }()
+
+					fn(deadlineCtx, item, send)
+				}()
+			}
+
+			wg.Wait()
+		}()
+
+		for result := range results {
+			if !push(result) {
+				// stop processing any further items and drain any in-flight results
+				cancel()
+				for range results {
+				}
+				return
+			}
+		}
+	}
+}
+
+// listInSubscription calls the wrapped List Resource scoped to a single Subscription, pushing each of the results.
+func (r *FrameworkListResourceWrapper) listInSubscription(ctx context.Context, request list.ListRequest, subscriptionId string, push func(list.ListResult) bool) bool {
+	config, err := withListSubscriptionId(ctx, request.Config, subscriptionId)
+	if err != nil {
+		return push(list.ListResult{
+			Diagnostics: diag.Diagnostics{
+				diag.NewErrorDiagnostic(fmt.Sprintf("building config for Subscription %q", subscriptionId), err.Error()),
+			},
+		})
+	}
+	request.Config = *config
+
+	stream := list.ListResultsStream{}
+	r.FrameworkListWrappedResource.List(ctx, request, &stream, r.ResourceMetadata)
+	if stream.Results == nil {
+		return true
+	}
+
+	for result := range stream.Results {
+		if result.Diagnostics.HasError() {
+			result.Diagnostics = listSubscriptionDiagnostics(subscriptionId, result.Diagnostics, result.Resource == nil)
+		}
+
+		if !push(result) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// listSubscriptionDiagnostics adds the Subscription to the error diagnostics for a List Result. Where listing the
+// Subscription failed due to missing permissions, the errors are instead returned as warnings so that listing the
+// remaining Subscriptions continues.
+func listSubscriptionDiagnostics(subscriptionId string, input diag.Diagnostics, diagnosticsOnly bool) diag.Diagnostics {
+	output := make(diag.Diagnostics, 0, len(input))
+
+	for _, d := range input {
+		if d.Severity() != diag.SeverityError {
+			output = append(output, d)
+			continue
+		}
+
+		summary := fmt.Sprintf("Subscription %q: %s", subscriptionId, d.Summary())
+		if diagnosticsOnly && listAuthorizationFailureRegex.MatchString(d.Detail()) {
+			output = append(output, diag.NewWarningDiagnostic(fmt.Sprintf("skipping %s", summary), d.Detail()))
+			continue
+		}
+
+		output = append(output, diag.NewErrorDiagnostic(summary, d.Detail()))
+	}
+
+	return output
+}
+
+// withListSubscriptionId returns a copy of the config scoped to a single Subscription, with the `subscription_id`
+// set and the `subscription_ids` and `management_group_id` attributes removed.
+func withListSubscriptionId(ctx context.Context, config tfsdk.Config, subscriptionId string) (*tfsdk.Config, error) {
+	raw := make(map[string]tftypes.Value)
+	if err := config.Raw.As(&raw); err != nil {
+		return nil, fmt.Errorf("converting config: %+v", err)
+	}
+
+	// the map returned is shared with the config, which is used concurrently for each Subscription, so is copied
+	values := maps.Clone(raw)
+
+	attrTypes := config.Schema.Type().(attr.TypeWithAttributeTypes).AttributeTypes()
+
+	values[listFanOutSubscriptionId] = tftypes.NewValue(tftypes.String, subscriptionId)
+	values[listFanOutSubscriptionIds] = tftypes.NewValue(attrTypes[listFanOutSubscriptionIds].TerraformType(ctx), nil)
+	values[listFanOutManagementGroupId] = tftypes.NewValue(tftypes.String, nil)
+
+	return &tfsdk.Config{
+		Raw:    tftypes.NewValue(config.Schema.Type().TerraformType(ctx), values),
+		Schema: config.Schema,
+	}, nil
+}
@@ -0,0 +1,169 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type fanOutTestListResource struct {
+	inFlight    atomic.Int32
+	maxInFlight atomic.Int32
+}
+
+func (*fanOutTestListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "azurerm_fan_out_test"
+}
+
+func (*fanOutTestListResource) ResourceFunc() *pluginsdk.Resource {
+	return &pluginsdk.Resource{}
+}
+
+func (l *fanOutTestListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, _ ResourceMetadata) {
+	var data DefaultListModel
+	if diags := request.Config.Get(ctx, &data); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	inFlight := l.inFlight.Add(1)
+	defer l.inFlight.Add(-1)
+	for {
+		current := l.maxInFlight.Load()
+		if inFlight <= current || l.maxInFlight.CompareAndSwap(current, inFlight) {
+			break
+		}
+	}
+	time.Sleep(10 * time.Millisecond)
+
+	subscriptionId := data.SubscriptionId.ValueString()
+	switch subscriptionId {
+	case "00000000-0000-0000-0000-000000000003":
+		SetResponseErrorDiagnostic(stream, "listing `azurerm_fan_out_test`", fmt.Errorf("unexpected status 403 (403 Forbidden) with error: AuthorizationFailed: no access"))
+	case "00000000-0000-0000-0000-000000000004":
+		SetResponseErrorDiagnostic(stream, "listing `azurerm_fan_out_test`", fmt.Errorf("unexpected status 500 (500 Internal Server Error)"))
+	default:
+		stream.Results = func(push func(list.ListResult) bool) {
+			for i := range 2 {
+				if !push(list.ListResult{DisplayName: fmt.Sprintf("%s/%d", subscriptionId, i)}) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func TestFrameworkListResourceWrapper_ListAcrossSubscriptions(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	wrapped := &fanOutTestListResource{}
+	wrapper := FrameworkListResourceWrapper{
+		FrameworkListWrappedResource: wrapped,
+	}
+
+	// the attributes handled by the wrapper have been removed from the config by this point
+	schema := defaultListSchema()
+
+	values := make(map[string]tftypes.Value)
+	for k, v := range schema.Type().TerraformType(ctx).(tftypes.Object).AttributeTypes {
+		values[k] = tftypes.NewValue(v, nil)
+	}
+	request := list.ListRequest{
+		Config: tfsdk.Config{
+			Raw:    tftypes.NewValue(schema.Type().TerraformType(ctx), values),
+			Schema: schema,
+		},
+	}
+
+	subscriptionIds := make([]string, 0)
+	for i := range 20 {
+		subscriptionIds = append(subscriptionIds, fmt.Sprintf("00000000-0000-0000-0000-%012d", i))
+	}
+
+	stream := list.ListResultsStream{}
+	wrapper.listAcrossSubscriptions(ctx, request, &stream, subscriptionIds)
+
+	displayNames := make([]string, 0)
+	warnings := make(diag.Diagnostics, 0)
+	errors := make(diag.Diagnostics, 0)
+	for result := range stream.Results {
+		if result.DisplayName != "" {
+			displayNames = append(displayNames, result.DisplayName)
+		}
+		warnings.Append(result.Diagnostics.Warnings()...)
+		errors.Append(result.Diagnostics.Errors()...)
+	}
+
+	if len(displayNames) != 36 {
+		t.Fatalf("expected 36 results but got %d: %v", len(displayNames), displayNames)
+	}
+	if !slices.Contains(displayNames, "00000000-0000-0000-0000-000000000019/1") {
+		t.Fatalf("expected the results to include the last Subscription but got %v", displayNames)
+	}
+	if len(warnings) != 1 || warnings[0].Summary() != "skipping Subscription \"00000000-0000-0000-0000-000000000003\": listing `azurerm_fan_out_test`" {
+		t.Fatalf("expected a single warning for the forbidden Subscription but got %+v", warnings)
+	}
+	if len(errors) != 1 || errors[0].Summary() != "Subscription \"00000000-0000-0000-0000-000000000004\": listing `azurerm_fan_out_test`" {
+		t.Fatalf("expected a single error for the failed Subscription but got %+v", errors)
+	}
+	if max := wrapped.maxInFlight.Load(); max > listFanOutConcurrency {
+		t.Fatalf("expected at most %d Subscriptions to be listed concurrently but got %d", listFanOutConcurrency, max)
+	}
+}
+
+func TestFrameworkListResourceWrapper_ListAcrossSubscriptionsStopsWhenPushReturnsFalse(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	wrapper := FrameworkListResourceWrapper{
+		FrameworkListWrappedResource: &fanOutTestListResource{},
+	}
+
+	// the attributes handled by the wrapper have been removed from the config by this point
+	schema := defaultListSchema()
+
+	values := make(map[string]tftypes.Value)
+	for k, v := range schema.Type().TerraformType(ctx).(tftypes.Object).AttributeTypes {
+		values[k] = tftypes.NewValue(v, nil)
+	}
+	request := list.ListRequest{
+		Config: tfsdk.Config{
+			Raw:    tftypes.NewValue(schema.Type().TerraformType(ctx), values),
+			Schema: schema,
+		},
+	}
+
+	subscriptionIds := make([]string, 0)
+	for i := 10; i < 30; i++ {
+		subscriptionIds = append(subscriptionIds, fmt.Sprintf("00000000-0000-0000-0000-%012d", i))
+	}
+
+	stream := list.ListResultsStream{}
+	wrapper.listAcrossSubscriptions(ctx, request, &stream, subscriptionIds)
+
+	count := 0
+	for range stream.Results {
+		count++
+		if count == 3 {
+			break
+		}
+	}
+
+	if count != 3 {
+		t.Fatalf("expected 3 results but got %d", count)
+	}
+}
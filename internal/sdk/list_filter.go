@@ -0,0 +1,245 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+const (
+	listFilterLocation  = "location"
+	listFilterNameRegex = "name_regex"
+	listFilterTags      = "tags"
+)
+
+// listFilterSchemaAttributes returns the filter attributes which are available for all List Resources.
+func listFilterSchemaAttributes() map[string]listschema.Attribute {
+	return map[string]listschema.Attribute{
+		listFilterLocation: listschema.StringAttribute{
+			Optional:    true,
+			Description: "Only return resources in this Azure Region.",
+			Validators: []validator.String{
+				typehelpers.WrappedStringValidator{
+					Func: validation.StringIsNotEmpty,
+				},
+			},
+		},
+
+		listFilterNameRegex: listschema.StringAttribute{
+			Optional:    true,
+			Description: "Only return resources whose name matches this regular expression.",
+			Validators: []validator.String{
+				typehelpers.WrappedStringValidator{
+					Func: validation.StringIsValidRegExp,
+				},
+			},
+		},
+
+		listFilterTags: listschema.MapAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			Description: "Only return resources which have all of these tags.",
+		},
+	}
+}
+
+// ListFilter contains the filters which are applied client-side by the FrameworkListResourceWrapper to the results
+// of every List Resource. List Resources can additionally apply these filters server-side (for example using
+// ResourceManagerTagFilter) where the API supports this, via the matching fields in the DefaultListModel. Filters
+// which are defined by the custom schema of a List Resource are instead handled by the List Resource itself.
+type ListFilter struct {
+	Location  *string
+	NameRegex *regexp.Regexp
+	Tags      map[string]string
+}
+
+// expandListFilter retrieves the filters from the List Resource configuration. Attributes defined by the custom schema
+// of the List Resource (if any) are skipped, since these are handled by the List Resource itself.
+func expandListFilter(ctx context.Context, config tfsdk.Config, customSchema *listschema.Schema) (*ListFilter, error) {
+	filter := ListFilter{}
+
+	isFilter := func(name string) bool {
+		if customSchema == nil {
+			return true
+		}
+		_, exists := customSchema.Attributes[name]
+		return !exists
+	}
+
+	if isFilter(listFilterLocation) {
+		var loc types.String
+		if diags := config.GetAttribute(ctx, path.Root(listFilterLocation), &loc); diags.HasError() {
+			return nil, fmt.Errorf("retrieving `%s`: %+v", listFilterLocation, diags)
+		}
+		if !loc.IsNull() && !loc.IsUnknown() {
+			filter.Location = loc.ValueStringPointer()
+		}
+	}
+
+	if isFilter(listFilterNameRegex) {
+		var nameRegex types.String
+		if diags := config.GetAttribute(ctx, path.Root(listFilterNameRegex), &nameRegex); diags.HasError() {
+			return nil, fmt.Errorf("retrieving `%s`: %+v", listFilterNameRegex, diags)
+		}
+		if !nameRegex.IsNull() && !nameRegex.IsUnknown() {
+			r, err := regexp.Compile(nameRegex.ValueString())
+			if err != nil {
+				return nil, fmt.Errorf("compiling `%s`: %+v", listFilterNameRegex, err)
+			}
+			filter.NameRegex = r
+		}
+	}
+
+	if isFilter(listFilterTags) {
+		var tags map[string]string
+		if diags := config.GetAttribute(ctx, path.Root(listFilterTags), &tags); diags.HasError() {
+			return nil, fmt.Errorf("retrieving `%s`: %+v", listFilterTags, diags)
+		}
+		if len(tags) > 0 {
+			filter.Tags = tags
+		}
+	}
+
+	return &filter, nil
+}
+
+// IsEmpty returns whether no filters have been specified
+func (f ListFilter) IsEmpty() bool {
+	return f.Location == nil && f.NameRegex == nil && len(f.Tags) == 0
+}
+
+// ResourceManagerTagFilter returns the `$filter` used by Resource Manager List APIs which support filtering on a tag
+// server-side, such as the Resources and Resource Groups APIs. Since these only support filtering on a single tag, the
+// first tag (ordered by name) is used - as such any remaining tags must still be filtered client-side.
+func ResourceManagerTagFilter(tags map[string]string) *string {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	name := slices.Min(slices.Collect(maps.Keys(tags)))
+	escape := strings.NewReplacer("'", "''")
+	return pointer.To(fmt.Sprintf("tagName eq '%s' and tagValue eq '%s'", escape.Replace(name), escape.Replace(tags[name])))
+}
+
+// Matches returns whether the resource with the specified name, location and tags satisfies all the filters. A nil
+// location or tags denotes that the resource doesn't support these, in which case any filter on them won't match.
+func (f ListFilter) Matches(name string, loc *string, tags *map[string]string) bool {
+	if f.NameRegex != nil && !f.NameRegex.MatchString(name) {
+		return false
+	}
+
+	if f.Location != nil {
+		if loc == nil || location.Normalize(*loc) != location.Normalize(*f.Location) {
+			return false
+		}
+	}
+
+	if len(f.Tags) > 0 {
+		if tags == nil {
+			return false
+		}
+		for k, v := range f.Tags {
+			if actual, ok := (*tags)[k]; !ok || actual != v {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// matchesListResult returns whether the List Result satisfies all the filters, based on the `name`, `location` and
+// `tags` attributes of the resource - falling back to the Display Name where the resource has no `name` attribute.
+func (f ListFilter) matchesListResult(ctx context.Context, result list.ListResult) bool {
+	name := result.DisplayName
+	var nameAttr types.String
+	if diags := result.Resource.GetAttribute(ctx, path.Root("name"), &nameAttr); !diags.HasError() && !nameAttr.IsNull() {
+		name = nameAttr.ValueString()
+	}
+
+	var loc *string
+	var locationAttr types.String
+	if diags := result.Resource.GetAttribute(ctx, path.Root("location"), &locationAttr); !diags.HasError() {
+		loc = locationAttr.ValueStringPointer()
+	}
+
+	var tags *map[string]string
+	var tagsAttr map[string]string
+	if diags := result.Resource.GetAttribute(ctx, path.Root("tags"), &tagsAttr); !diags.HasError() {
+		tags = &tagsAttr
+	}
+
+	return f.Matches(name, loc, tags)
+}
+
+// filterListResults wraps the results iterator so that only the results satisfying the filters are pushed. Results
+// containing error diagnostics, or only diagnostics, are always pushed so that these are surfaced to the user.
+func (f ListFilter) filterListResults(ctx context.Context, results func(push func(list.ListResult) bool)) func(push func(list.ListResult) bool) {
+	return func(push func(list.ListResult) bool) {
+		results(func(result list.ListResult) bool {
+			if !result.Diagnostics.HasError() && result.Resource != nil && !f.matchesListResult(ctx, result) {
+				return true
+			}
+
+			return push(result)
+		})
+	}
+}
+
+// configForListSchema returns a copy of the config without any attributes added by the FrameworkListResourceWrapper
+// which aren't defined in the specified schema, so that it can be decoded into the model for the List Resource.
+func configForListSchema(ctx context.Context, config tfsdk.Config, schema listschema.Schema) (*tfsdk.Config, error) {
+	objectType := schema.Type().TerraformType(ctx)
+
+	if config.Raw.IsNull() {
+		return &tfsdk.Config{
+			Raw:    tftypes.NewValue(objectType, nil),
+			Schema: schema,
+		}, nil
+	}
+	if !config.Raw.IsKnown() {
+		return &tfsdk.Config{
+			Raw:    tftypes.NewValue(objectType, tftypes.UnknownValue),
+			Schema: schema,
+		}, nil
+	}
+
+	raw := make(map[string]tftypes.Value)
+	if err := config.Raw.As(&raw); err != nil {
+		return nil, fmt.Errorf("converting config: %+v", err)
+	}
+
+	// the map returned is shared with the supplied config, so is copied to avoid modifying it
+	values := maps.Clone(raw)
+
+	for k := range values {
+		_, isAttribute := schema.Attributes[k]
+		_, isBlock := schema.Blocks[k]
+		if !isAttribute && !isBlock {
+			delete(values, k)
+		}
+	}
+
+	return &tfsdk.Config{
+		Raw:    tftypes.NewValue(objectType, values),
+		Schema: schema,
+	}, nil
+}
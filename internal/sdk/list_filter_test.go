@@ -0,0 +1,252 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestListFilter_Matches(t *testing.T) {
+	testData := []struct {
+		name     string
+		filter   ListFilter
+		resource string
+		location *string
+		tags     *map[string]string
+		expected bool
+	}{
+		{
+			name:     "no filters",
+			filter:   ListFilter{},
+			resource: "example",
+			expected: true,
+		},
+		{
+			name: "name matches",
+			filter: ListFilter{
+				NameRegex: regexp.MustCompile("^prod-"),
+			},
+			resource: "prod-example",
+			expected: true,
+		},
+		{
+			name: "name doesn't match",
+			filter: ListFilter{
+				NameRegex: regexp.MustCompile("^prod-"),
+			},
+			resource: "dev-example",
+			expected: false,
+		},
+		{
+			name: "location matches when normalized",
+			filter: ListFilter{
+				Location: pointer.To("West Europe"),
+			},
+			resource: "example",
+			location: pointer.To("westeurope"),
+			expected: true,
+		},
+		{
+			name: "location doesn't match",
+			filter: ListFilter{
+				Location: pointer.To("westeurope"),
+			},
+			resource: "example",
+			location: pointer.To("northeurope"),
+			expected: false,
+		},
+		{
+			name: "location isn't supported by the resource",
+			filter: ListFilter{
+				Location: pointer.To("westeurope"),
+			},
+			resource: "example",
+			expected: false,
+		},
+		{
+			name: "all tags match",
+			filter: ListFilter{
+				Tags: map[string]string{"env": "prod", "team": "networking"},
+			},
+			resource: "example",
+			tags:     pointer.To(map[string]string{"env": "prod", "team": "networking", "other": "value"}),
+			expected: true,
+		},
+		{
+			name: "only some tags match",
+			filter: ListFilter{
+				Tags: map[string]string{"env": "prod", "team": "networking"},
+			},
+			resource: "example",
+			tags:     pointer.To(map[string]string{"env": "prod"}),
+			expected: false,
+		},
+		{
+			name: "tag value doesn't match",
+			filter: ListFilter{
+				Tags: map[string]string{"env": "prod"},
+			},
+			resource: "example",
+			tags:     pointer.To(map[string]string{"env": "dev"}),
+			expected: false,
+		},
+		{
+			name: "tags aren't supported by the resource",
+			filter: ListFilter{
+				Tags: map[string]string{"env": "prod"},
+			},
+			resource: "example",
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		if actual := v.filter.Matches(v.resource, v.location, v.tags); actual != v.expected {
+			t.Fatalf("expected %t but got %t for %q", v.expected, actual, v.name)
+		}
+	}
+}
+
+func TestExpandListFilter_CustomSchema(t *testing.T) {
+	ctx := context.Background()
+
+	// the custom schema defines its own `tags`, which are handled by the List Resource rather than the wrapper
+	customSchema := listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"tags": listschema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+	}
+	mergedSchema := listschema.Schema{
+		Attributes: listFilterSchemaAttributes(),
+	}
+
+	config := tfsdk.Config{
+		Raw: tftypes.NewValue(mergedSchema.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"location":   tftypes.NewValue(tftypes.String, "westeurope"),
+			"name_regex": tftypes.NewValue(tftypes.String, nil),
+			"tags": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+				"env": tftypes.NewValue(tftypes.String, "prod"),
+			}),
+		}),
+		Schema: mergedSchema,
+	}
+
+	filter, err := expandListFilter(ctx, config, &customSchema)
+	if err != nil {
+		t.Fatalf("expanding list filter: %+v", err)
+	}
+	if filter.Location == nil || *filter.Location != "westeurope" {
+		t.Fatalf("expected `location` to be %q but got %v", "westeurope", filter.Location)
+	}
+	if filter.Tags != nil {
+		t.Fatalf("expected `tags` defined by the custom schema to be skipped but got %+v", filter.Tags)
+	}
+}
+
+func TestResourceManagerTagFilter(t *testing.T) {
+	testData := []struct {
+		name     string
+		tags     map[string]string
+		expected *string
+	}{
+		{
+			name: "no tags",
+		},
+		{
+			name:     "single tag",
+			tags:     map[string]string{"env": "prod"},
+			expected: pointer.To("tagName eq 'env' and tagValue eq 'prod'"),
+		},
+		{
+			name:     "first tag by name",
+			tags:     map[string]string{"team": "networking", "env": "prod"},
+			expected: pointer.To("tagName eq 'env' and tagValue eq 'prod'"),
+		},
+		{
+			name:     "values are escaped",
+			tags:     map[string]string{"owner's": "it's"},
+			expected: pointer.To("tagName eq 'owner''s' and tagValue eq 'it''s'"),
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		if actual := ResourceManagerTagFilter(v.tags); pointer.From(actual) != pointer.From(v.expected) {
+			t.Fatalf("expected %q but got %q for %q", pointer.From(v.expected), pointer.From(actual), v.name)
+		}
+	}
+}
+
+func TestConfigForListSchema(t *testing.T) {
+	ctx := context.Background()
+
+	customSchema := listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"parent_id": listschema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+
+	mergedSchema := listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"parent_id": listschema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+	for k, v := range listFilterSchemaAttributes() {
+		mergedSchema.Attributes[k] = v
+	}
+
+	config := tfsdk.Config{
+		Raw: tftypes.NewValue(mergedSchema.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"parent_id":  tftypes.NewValue(tftypes.String, "/subscriptions/00000000-0000-0000-0000-000000000000"),
+			"location":   tftypes.NewValue(tftypes.String, "westeurope"),
+			"name_regex": tftypes.NewValue(tftypes.String, nil),
+			"tags":       tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+		}),
+		Schema: mergedSchema,
+	}
+
+	filter, err := expandListFilter(ctx, config, nil)
+	if err != nil {
+		t.Fatalf("expanding list filter: %+v", err)
+	}
+	if filter.Location == nil || *filter.Location != "westeurope" {
+		t.Fatalf("expected `location` to be %q but got %v", "westeurope", filter.Location)
+	}
+	if filter.NameRegex != nil || filter.Tags != nil {
+		t.Fatalf("expected `name_regex` and `tags` to be unset but got %+v", *filter)
+	}
+
+	actual, err := configForListSchema(ctx, config, customSchema)
+	if err != nil {
+		t.Fatalf("removing list filter attributes: %+v", err)
+	}
+
+	var model struct {
+		ParentId string `tfsdk:"parent_id"`
+	}
+	if diags := actual.Get(ctx, &model); diags.HasError() {
+		t.Fatalf("decoding config without list filter attributes: %+v", diags)
+	}
+	if model.ParentId != "/subscriptions/00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("expected `parent_id` to be retained but got %q", model.ParentId)
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+const (
+	listTimeoutsBlock      = "timeouts"
+	listTimeoutsList       = "list"
+	listMaxResults         = "max_results"
+	listDefaultListTimeout = 60 * time.Minute
+)
+
+// listTimeoutsSchemaBlock returns the `timeouts` block which is available for all List Resources
+func listTimeoutsSchemaBlock() listschema.Block {
+	return listschema.SingleNestedBlock{
+		Attributes: map[string]listschema.Attribute{
+			listTimeoutsList: listschema.StringAttribute{
+				Optional:    true,
+				Description: "The maximum amount of time allowed for listing resources, specified as a duration (for example `30s`, `10m` or `2h`). Defaults to `60m`.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validateListTimeout,
+					},
+				},
+			},
+		},
+	}
+}
+
+// listMaxResultsSchemaAttribute returns the `max_results` attribute which is available for all List Resources
+func listMaxResultsSchemaAttribute() listschema.Attribute {
+	return listschema.Int64Attribute{
+		Optional:    true,
+		Description: "The maximum number of resources to return. Once this number of resources has been returned no further resources are retrieved.",
+	}
+}
+
+func validateListTimeout(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("expected %q to be a valid duration (for example `30s`, `10m` or `2h`): %+v", k, err))
+		return
+	}
+	if d <= 0 {
+		errors = append(errors, fmt.Errorf("expected %q to be greater than zero, got %s", k, v))
+	}
+
+	return
+}
+
+// ListOptions contains the options which are handled by the FrameworkListResourceWrapper for every List Resource
+type ListOptions struct {
+	// Timeout is the maximum amount of time allowed for listing resources
+	Timeout time.Duration
+
+	// MaxResults is the maximum number of resources to return, where 0 means no limit
+	MaxResults int64
+}
+
+// expandListOptions retrieves the options from the List Resource configuration, limiting the maximum number of
+// results to the number of results Terraform is expecting when specified.
+func expandListOptions(ctx context.Context, config tfsdk.Config, limit int64) (*ListOptions, error) {
+	options := ListOptions{
+		Timeout:    listDefaultListTimeout,
+		MaxResults: limit,
+	}
+
+	var timeout types.String
+	if diags := config.GetAttribute(ctx, path.Root(listTimeoutsBlock).AtName(listTimeoutsList), &timeout); diags.HasError() {
+		return nil, fmt.Errorf("retrieving `%s.%s`: %+v", listTimeoutsBlock, listTimeoutsList, diags)
+	}
+	if !timeout.IsNull() && !timeout.IsUnknown() {
+		d, err := time.ParseDuration(timeout.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("parsing `%s.%s`: %+v", listTimeoutsBlock, listTimeoutsList, err)
+		}
+		options.Timeout = d
+	}
+
+	var maxResults types.Int64
+	if diags := config.GetAttribute(ctx, path.Root(listMaxResults), &maxResults); diags.HasError() {
+		return nil, fmt.Errorf("retrieving `%s`: %+v", listMaxResults, diags)
+	}
+	if !maxResults.IsNull() && !maxResults.IsUnknown() {
+		if maxResults.ValueInt64() < 1 {
+			return nil, fmt.Errorf("expected `%s` to be at least 1, got %d", listMaxResults, maxResults.ValueInt64())
+		}
+		if options.MaxResults <= 0 || maxResults.ValueInt64() < options.MaxResults {
+			options.MaxResults = maxResults.ValueInt64()
+		}
+	}
+
+	return &options, nil
+}
+
+// limitListResults wraps the results iterator so that no further results are retrieved once the maximum number of
+// resources has been pushed. Results containing only diagnostics don't count towards the limit.
+func (o ListOptions) limitListResults(results func(push func(list.ListResult) bool)) func(push func(list.ListResult) bool) {
+	return func(push func(list.ListResult) bool) {
+		count := int64(0)
+		results(func(result list.ListResult) bool {
+			if !push(result) {
+				return false
+			}
+
+			if result.Resource != nil && !result.Diagnostics.HasError() {
+				count++
+			}
+
+			return count < o.MaxResults
+		})
+	}
+}
+
+// ListPageLimitContext returns the context to use for the paged List operation which retrieves the results of a List
+// Resource, which stops retrieving further pages once `request.Limit` items have been retrieved. This must only be used
+// for the List operation whose items are each pushed as a result, rather than when items are skipped or to retrieve
+// parent resources, since these would otherwise count towards the limit.
+func ListPageLimitContext(ctx context.Context, request list.ListRequest) context.Context {
+	return common.WithPageLimit(ctx, request.Limit)
+}
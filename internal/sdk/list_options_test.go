@@ -0,0 +1,140 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestExpandListOptions(t *testing.T) {
+	ctx := context.Background()
+
+	wrapper := FrameworkListResourceWrapper{
+		FrameworkListWrappedResource: &fanOutTestListResource{},
+	}
+	schemaResp := list.ListResourceSchemaResponse{}
+	wrapper.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	testData := []struct {
+		name               string
+		timeout            *string
+		maxResults         *int64
+		limit              int64
+		expectedTimeout    time.Duration
+		expectedMaxResults int64
+		expectError        bool
+	}{
+		{
+			name:               "defaults",
+			expectedTimeout:    listDefaultListTimeout,
+			expectedMaxResults: 0,
+		},
+		{
+			name:               "custom timeout",
+			timeout:            pointer.To("5m"),
+			expectedTimeout:    5 * time.Minute,
+			expectedMaxResults: 0,
+		},
+		{
+			name:               "limit only",
+			limit:              100,
+			expectedTimeout:    listDefaultListTimeout,
+			expectedMaxResults: 100,
+		},
+		{
+			name:               "max results lower than limit",
+			maxResults:         pointer.To[int64](10),
+			limit:              100,
+			expectedTimeout:    listDefaultListTimeout,
+			expectedMaxResults: 10,
+		},
+		{
+			name:               "max results higher than limit",
+			maxResults:         pointer.To[int64](1000),
+			limit:              100,
+			expectedTimeout:    listDefaultListTimeout,
+			expectedMaxResults: 100,
+		},
+		{
+			name:        "max results less than one",
+			maxResults:  pointer.To[int64](0),
+			expectError: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		values := make(map[string]tftypes.Value)
+		for k, attrType := range objectType.AttributeTypes {
+			values[k] = tftypes.NewValue(attrType, nil)
+		}
+		if v.timeout != nil {
+			values[listTimeoutsBlock] = tftypes.NewValue(objectType.AttributeTypes[listTimeoutsBlock], map[string]tftypes.Value{
+				listTimeoutsList: tftypes.NewValue(tftypes.String, *v.timeout),
+			})
+		}
+		if v.maxResults != nil {
+			values[listMaxResults] = tftypes.NewValue(tftypes.Number, *v.maxResults)
+		}
+		config := tfsdk.Config{
+			Raw:    tftypes.NewValue(objectType, values),
+			Schema: schemaResp.Schema,
+		}
+
+		actual, err := expandListOptions(ctx, config, v.limit)
+		if v.expectError {
+			if err == nil {
+				t.Fatalf("expected an error but didn't get one for %q", v.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("expanding list options for %q: %+v", v.name, err)
+		}
+
+		if actual.Timeout != v.expectedTimeout {
+			t.Fatalf("expected a timeout of %s but got %s for %q", v.expectedTimeout, actual.Timeout, v.name)
+		}
+		if actual.MaxResults != v.expectedMaxResults {
+			t.Fatalf("expected max results of %d but got %d for %q", v.expectedMaxResults, actual.MaxResults, v.name)
+		}
+	}
+}
+
+func TestListOptions_LimitListResults(t *testing.T) {
+	retrieved := 0
+	results := func(push func(list.ListResult) bool) {
+		for i := range 10 {
+			retrieved++
+			if !push(list.ListResult{
+				DisplayName: fmt.Sprintf("result-%d", i),
+				Resource:    &tfsdk.Resource{},
+			}) {
+				return
+			}
+		}
+	}
+
+	count := 0
+	for range (ListOptions{MaxResults: 3}).limitListResults(results) {
+		count++
+	}
+
+	if count != 3 {
+		t.Fatalf("expected 3 results but got %d", count)
+	}
+	if retrieved != 3 {
+		t.Fatalf("expected no further results to be retrieved once the limit was reached but %d were retrieved", retrieved)
+	}
+}
@@ -5,12 +5,12 @@ package sdk
 
 import (
 	"context"
-	"time"
 
 	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourcegroups"
 	"github.com/hashicorp/terraform-plugin-framework/list"
 	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -30,6 +30,17 @@ type FrameworkListResourceWrapper struct {
 type DefaultListModel struct {
 	ResourceGroupName types.String `tfsdk:"resource_group_name"`
 	SubscriptionId    types.String `tfsdk:"subscription_id"`
+
+	// The following are handled by the FrameworkListResourceWrapper, which calls the List Resource once per Subscription
+	// with the `subscription_id` set - as such these will always be null when the List Resource is called.
+	SubscriptionIds   types.List   `tfsdk:"subscription_ids"`
+	ManagementGroupId types.String `tfsdk:"management_group_id"`
+
+	// The following filters are applied client-side by the FrameworkListResourceWrapper, and are exposed here
+	// so that they can also be applied server-side where the API supports this.
+	Location  types.String `tfsdk:"location"`
+	NameRegex types.String `tfsdk:"name_regex"`
+	Tags      types.Map    `tfsdk:"tags"`
 }
 
 func (r *FrameworkListResourceWrapper) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
@@ -39,11 +50,41 @@ func (r *FrameworkListResourceWrapper) Metadata(ctx context.Context, request res
 func (r *FrameworkListResourceWrapper) ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
 	if l, ok := r.FrameworkListWrappedResource.(FrameworkListWrappedResourceWithConfig); ok {
 		l.ListResourceConfigSchema(ctx, request, response)
-		return
+
+		// the filters are available for all List Resources, unless the custom schema defines an attribute of the same name
+		for k, v := range listFilterSchemaAttributes() {
+			if _, exists := response.Schema.Attributes[k]; !exists {
+				if response.Schema.Attributes == nil {
+					response.Schema.Attributes = make(map[string]listschema.Attribute)
+				}
+				response.Schema.Attributes[k] = v
+			}
+		}
+	} else {
+		response.Schema = defaultListSchema()
+
+		// Azure Resource Graph is only available for List Resources which opt-in to this
+		if _, ok := r.FrameworkListWrappedResource.(FrameworkListWrappedResourceWithResourceGraph); ok {
+			response.Schema.Attributes[listResourceGraphAttribute] = listResourceGraphSchemaAttribute()
+		}
 	}
 
+	// the timeouts and maximum number of results are handled by the wrapper for all List Resources
+	if response.Schema.Attributes == nil {
+		response.Schema.Attributes = make(map[string]listschema.Attribute)
+	}
+	response.Schema.Attributes[listMaxResults] = listMaxResultsSchemaAttribute()
+
+	if response.Schema.Blocks == nil {
+		response.Schema.Blocks = make(map[string]listschema.Block)
+	}
+	response.Schema.Blocks[listTimeoutsBlock] = listTimeoutsSchemaBlock()
+}
+
+// defaultListSchema returns the schema used for List Resources which don't define a custom schema
+func defaultListSchema() listschema.Schema {
 	// most resources default to RG and Subscription, so unless we need to customise that above, we can default it here.
-	response.Schema = listschema.Schema{
+	s := listschema.Schema{
 		Attributes: map[string]listschema.Attribute{
 			"resource_group_name": listschema.StringAttribute{
 				Optional: true,
@@ -63,13 +104,107 @@ func (r *FrameworkListResourceWrapper) ListResourceConfigSchema(ctx context.Cont
 			},
 		},
 	}
+
+	for k, v := range listFanOutSchemaAttributes() {
+		s.Attributes[k] = v
+	}
+
+	for k, v := range listFilterSchemaAttributes() {
+		s.Attributes[k] = v
+	}
+
+	return s
 }
 
 func (r *FrameworkListResourceWrapper) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
-	ctx, cancel := context.WithTimeout(ctx, time.Minute*60) // TODO - Custom Timeouts
+	options, err := expandListOptions(ctx, request.Config, request.Limit)
+	if err != nil {
+		SetResponseErrorDiagnostic(stream, "expanding list options", err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, options.Timeout)
 	defer cancel()
 
-	r.FrameworkListWrappedResource.List(ctx, request, stream, r.ResourceMetadata)
+	var customSchema *listschema.Schema
+	if l, ok := r.FrameworkListWrappedResource.(FrameworkListWrappedResourceWithConfig); ok {
+		schemaResp := list.ListResourceSchemaResponse{}
+		l.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &schemaResp)
+		customSchema = &schemaResp.Schema
+	}
+
+	filter, err := expandListFilter(ctx, request.Config, customSchema)
+	if err != nil {
+		SetResponseErrorDiagnostic(stream, "expanding list filters", err)
+		return
+	}
+
+	// the List Resource can stop retrieving further pages once the maximum number of results has been retrieved (see
+	// ListPageLimitContext), unless results are then discarded by the filters applied client-side below
+	request.Limit = 0
+	if filter.IsEmpty() {
+		request.Limit = options.MaxResults
+	}
+
+	if customSchema != nil {
+		// the filter attributes and options are added to the custom schema by the wrapper, so are removed from the
+		// config to allow it to be decoded into the model for the List Resource
+		config, err := configForListSchema(ctx, request.Config, *customSchema)
+		if err != nil {
+			SetResponseErrorDiagnostic(stream, "removing list filters and options from config", err)
+			return
+		}
+		request.Config = *config
+
+		r.FrameworkListWrappedResource.List(ctx, request, stream, r.ResourceMetadata)
+	} else {
+		useResourceGraph := false
+		if _, ok := r.FrameworkListWrappedResource.(FrameworkListWrappedResourceWithResourceGraph); ok {
+			var v types.Bool
+			if diags := request.Config.GetAttribute(ctx, path.Root(listResourceGraphAttribute), &v); diags.HasError() {
+				stream.Results = list.ListResultsStreamDiagnostics(diags)
+				return
+			}
+			useResourceGraph = v.ValueBool()
+		}
+
+		// the attributes handled by the wrapper are removed to allow the config to be decoded into the DefaultListModel
+		config, err := configForListSchema(ctx, request.Config, defaultListSchema())
+		if err != nil {
+			SetResponseErrorDiagnostic(stream, "removing list options from config", err)
+			return
+		}
+		request.Config = *config
+
+		var data DefaultListModel
+		if diags := request.Config.Get(ctx, &data); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+
+		switch {
+		case useResourceGraph:
+			r.listUsingResourceGraph(ctx, request, stream, data, *filter, options.MaxResults)
+		case data.SubscriptionIds.IsNull() && data.ManagementGroupId.IsNull():
+			r.FrameworkListWrappedResource.List(ctx, request, stream, r.ResourceMetadata)
+		default:
+			subscriptionIds, err := r.fanOutSubscriptionIds(ctx, data)
+			if err != nil {
+				SetResponseErrorDiagnostic(stream, "retrieving Subscriptions to list", err)
+				return
+			}
+
+			r.listAcrossSubscriptions(ctx, request, stream, subscriptionIds)
+		}
+	}
+
+	if stream.Results != nil && !filter.IsEmpty() {
+		stream.Results = filter.filterListResults(ctx, stream.Results)
+	}
+
+	if stream.Results != nil && options.MaxResults > 0 {
+		stream.Results = options.limitListResults(stream.Results)
+	}
 }
 
 func (r *FrameworkListResourceWrapper) RawV5Schemas(ctx context.Context, _ list.RawV5SchemaRequest, response *list.RawV5SchemaResponse) {
@@ -96,6 +231,8 @@ var (
 type FrameworkListWrappedResource interface {
 	Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse)
 
+	// List retrieves the resources, where `request.Limit` is the maximum number of results which will be returned
+	// (or 0 when there's no limit) - see ListPageLimitContext to stop retrieving further pages once this is reached
 	List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata ResourceMetadata)
 
 	// RawV5Schemas(ctx context.Context, request list.RawV5SchemaRequest, response *list.RawV5SchemaResponse)
@@ -111,6 +248,17 @@ type FrameworkListWrappedResourceWithConfig interface {
 	ListResourceConfigSchema(ctx context.Context, request list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse)
 }
 
+// FrameworkListWrappedResourceWithResourceGraph is implemented by List Resources using the default schema which
+// support discovering resources using Azure Resource Graph, rather than the List APIs for the Resource Provider.
+// The resource IDs are retrieved using a single (paged) query, and each resource is then retrieved using the Read
+// function of the resource - as such, the Read function must set the Resource Identity.
+type FrameworkListWrappedResourceWithResourceGraph interface {
+	FrameworkListWrappedResource
+
+	// ResourceGraphResourceType returns the Azure Resource Type to query, for example `Microsoft.KeyVault/vaults`
+	ResourceGraphResourceType() string
+}
+
 func EncodeListResult(ctx context.Context, resourceData *terraformschema.ResourceData, result *list.ListResult) {
 	tfTypeIdentity, err := resourceData.TfTypeIdentityState()
 	if err != nil {
@@ -0,0 +1,161 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+const listResourceGraphAttribute = "use_resource_graph"
+
+func listResourceGraphSchemaAttribute() listschema.Attribute {
+	return listschema.BoolAttribute{
+		Optional:    true,
+		Description: "Should Azure Resource Graph be used to discover the resources? Each resource is then retrieved individually.",
+	}
+}
+
+// listUsingResourceGraph discovers the IDs of the matching resources using a single (paged) Azure Resource Graph query,
+// and then retrieves each resource concurrently using the Read function of the resource.
+func (r *FrameworkListResourceWrapper) listUsingResourceGraph(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, data DefaultListModel, filter ListFilter, limit int64) {
+	// retrieve the deadline from the supplied context
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		// This *should* never happen given the List Wrapper instantiates a context with a timeout
+		SetResponseErrorDiagnostic(stream, "internal-error", "context had no deadline")
+		return
+	}
+
+	resourceType := r.FrameworkListWrappedResource.(FrameworkListWrappedResourceWithResourceGraph).ResourceGraphResourceType()
+
+	subscriptionIds := make([]string, 0)
+	managementGroupIds := make([]string, 0)
+	switch {
+	case !data.ManagementGroupId.IsNull():
+		id, err := commonids.ParseManagementGroupID(data.ManagementGroupId.ValueString())
+		if err != nil {
+			SetResponseErrorDiagnostic(stream, "parsing `management_group_id`", err)
+			return
+		}
+		managementGroupIds = append(managementGroupIds, id.GroupId)
+	case !data.SubscriptionIds.IsNull():
+		if diags := data.SubscriptionIds.ElementsAs(ctx, &subscriptionIds, false); diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	case !data.SubscriptionId.IsNull():
+		subscriptionIds = append(subscriptionIds, data.SubscriptionId.ValueString())
+	default:
+		subscriptionIds = append(subscriptionIds, r.SubscriptionId)
+	}
+
+	query := resourceGraphListQuery(resourceType, data.ResourceGroupName.ValueString(), filter, limit)
+	ids, err := r.Client.ResourceGraph.ListResourceIds(ctx, query, subscriptionIds, managementGroupIds)
+	if err != nil {
+		SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s` using Azure Resource Graph", resourceType), err)
+		return
+	}
+
+	resource := r.FrameworkListWrappedResource.ResourceFunc()
+
+	stream.Results = streamListResultsConcurrently(deadline, ids, func(ctx context.Context, id string, push func(list.ListResult) bool) {
+		result := request.NewListResult(ctx)
+		result.DisplayName = id[strings.LastIndex(id, "/")+1:]
+
+		rd := resource.Data(&terraform.InstanceState{})
+		rd.SetId(id)
+
+		if err := readListResource(ctx, resource, rd, r.Client); err != nil {
+			SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("retrieving %s", id), err)
+			return
+		}
+
+		// the resource has been deleted since it was returned from Azure Resource Graph
+		if rd.Id() == "" {
+			return
+		}
+
+		if name, ok := rd.GetOk("name"); ok {
+			result.DisplayName = name.(string)
+		}
+
+		EncodeListResult(ctx, rd, &result)
+		push(result)
+	})
+}
+
+// readListResource calls the Read function of the resource, which populates the Resource Data
+func readListResource(ctx context.Context, resource *pluginsdk.Resource, rd *pluginsdk.ResourceData, meta interface{}) error {
+	switch {
+	case resource.Read != nil:
+		return resource.Read(rd, meta)
+	case resource.ReadWithoutTimeout != nil:
+		if diags := resource.ReadWithoutTimeout(ctx, rd, meta); diags.HasError() {
+			return fmt.Errorf("%+v", diags)
+		}
+	case resource.ReadContext != nil:
+		ctx, cancel := context.WithTimeout(ctx, rd.Timeout(pluginsdk.TimeoutRead))
+		defer cancel()
+		if diags := resource.ReadContext(ctx, rd, meta); diags.HasError() {
+			return fmt.Errorf("%+v", diags)
+		}
+	default:
+		return fmt.Errorf("the resource doesn't define a Read function")
+	}
+
+	return nil
+}
+
+// resourceGraphListQuery builds the KQL query used to discover the resources of the specified type, applying
+// the Resource Group, filters and maximum number of results server-side.
+func resourceGraphListQuery(resourceType string, resourceGroupName string, filter ListFilter, limit int64) string {
+	clauses := []string{
+		"resources",
+		fmt.Sprintf("where type =~ %s", kqlString(resourceType)),
+	}
+
+	if resourceGroupName != "" {
+		clauses = append(clauses, fmt.Sprintf("where resourceGroup =~ %s", kqlString(resourceGroupName)))
+	}
+
+	if filter.Location != nil {
+		clauses = append(clauses, fmt.Sprintf("where location =~ %s", kqlString(location.Normalize(*filter.Location))))
+	}
+
+	if filter.NameRegex != nil {
+		clauses = append(clauses, fmt.Sprintf("where name matches regex %s", kqlString(filter.NameRegex.String())))
+	}
+
+	tagNames := make([]string, 0, len(filter.Tags))
+	for k := range filter.Tags {
+		tagNames = append(tagNames, k)
+	}
+	slices.Sort(tagNames)
+	for _, k := range tagNames {
+		clauses = append(clauses, fmt.Sprintf("where tags[%s] == %s", kqlString(k), kqlString(filter.Tags[k])))
+	}
+
+	clauses = append(clauses, "project id", "order by id asc")
+
+	if limit > 0 {
+		clauses = append(clauses, fmt.Sprintf("take %d", limit))
+	}
+
+	return strings.Join(clauses, " | ")
+}
+
+// kqlString returns the value as a quoted and escaped KQL string literal
+func kqlString(input string) string {
+	return fmt.Sprintf("'%s'", strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(input))
+}
@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+func TestResourceGraphListQuery(t *testing.T) {
+	testData := []struct {
+		name              string
+		resourceType      string
+		resourceGroupName string
+		filter            ListFilter
+		limit             int64
+		expected          string
+	}{
+		{
+			name:         "resource type only",
+			resourceType: "Microsoft.KeyVault/vaults",
+			expected:     "resources | where type =~ 'Microsoft.KeyVault/vaults' | project id | order by id asc",
+		},
+		{
+			name:              "resource group",
+			resourceType:      "Microsoft.KeyVault/vaults",
+			resourceGroupName: "example-resources",
+			expected:          "resources | where type =~ 'Microsoft.KeyVault/vaults' | where resourceGroup =~ 'example-resources' | project id | order by id asc",
+		},
+		{
+			name:         "all filters",
+			resourceType: "Microsoft.Storage/storageAccounts",
+			filter: ListFilter{
+				Location:  pointer.To("West Europe"),
+				NameRegex: regexp.MustCompile(`^prod\d+$`),
+				Tags: map[string]string{
+					"team": "networking",
+					"env":  "prod",
+				},
+			},
+			expected: `resources | where type =~ 'Microsoft.Storage/storageAccounts' | where location =~ 'westeurope' | where name matches regex '^prod\\d+$' | where tags['env'] == 'prod' | where tags['team'] == 'networking' | project id | order by id asc`,
+		},
+		{
+			name:         "values are escaped",
+			resourceType: "Microsoft.KeyVault/vaults",
+			filter: ListFilter{
+				Tags: map[string]string{
+					"owner's": "it's",
+				},
+			},
+			expected: `resources | where type =~ 'Microsoft.KeyVault/vaults' | where tags['owner\'s'] == 'it\'s' | project id | order by id asc`,
+		},
+		{
+			name:         "limit",
+			resourceType: "Microsoft.KeyVault/vaults",
+			limit:        10,
+			expected:     "resources | where type =~ 'Microsoft.KeyVault/vaults' | project id | order by id asc | take 10",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		if actual := resourceGraphListQuery(v.resourceType, v.resourceGroupName, v.filter, v.limit); actual != v.expected {
+			t.Fatalf("expected %q but got %q for %q", v.expected, actual, v.name)
+		}
+	}
+}
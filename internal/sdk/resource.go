@@ -123,6 +123,22 @@ type ResourceWithIdentityTypeOverride interface {
 	IdentityType() pluginsdk.ResourceTypeForIdentity
 }
 
+// ResourceWithCustomIdentity is an optional interface for resources where the ID can't be parsed using a Resource ID type,
+// or where only a subset of the IDs supported by the Resource ID type are valid (for example a Scoped Resource ID where
+// only a specific type of Scope is supported). When importing using the resource identity data, the ID is set using the
+// IdentityImporter and then validated using the IDValidationFunc.
+//
+// Resources implementing this interface must set the resource identity data in the Read function.
+type ResourceWithCustomIdentity interface {
+	Resource
+
+	// IdentitySchema returns the schema for the resource identity, where each attribute should be RequiredForImport
+	IdentitySchema() map[string]*pluginsdk.Schema
+
+	// IdentityImporter returns a function which sets the ID of the resource from the resource identity data
+	IdentityImporter() pluginsdk.IdentityImporterFunc
+}
+
 // ResourceWithUpdate is an optional interface
 //
 // Notably the Arguments for Resources implementing this interface
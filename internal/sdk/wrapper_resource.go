@@ -52,6 +52,43 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 		return &duration
 	}
 
+	importValidateFunc := func(id string) error {
+		fn := rw.resource.IDValidationFunc()
+
+		warnings, errs := fn(id, "id")
+		if len(warnings) > 0 {
+			for _, warning := range warnings {
+				rw.logger.Warn(warning)
+			}
+		}
+		if len(errs) > 0 {
+			out := ""
+			for _, err := range errs {
+				out += err.Error()
+			}
+			return errors.New(out)
+		}
+
+		return nil
+	}
+
+	importThenFunc := func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+		if v, ok := rw.resource.(ResourceWithCustomImporter); ok {
+			metaData := runArgs(d, meta, rw.logger)
+
+			ctx, cancel := context.WithTimeout(ctx, rw.resource.Read().Timeout)
+			defer cancel()
+			err := v.CustomImporter()(ctx, metaData)
+			if err != nil {
+				return nil, err
+			}
+
+			return []*pluginsdk.ResourceData{metaData.ResourceData}, nil
+		}
+
+		return schema.ImportStatePassthroughContext(ctx, d, meta)
+	}
+
 	resource := schema.Resource{
 		Schema: *resourceSchema,
 
@@ -82,40 +119,7 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 			Read:   d(rw.resource.Read().Timeout),
 			Delete: d(rw.resource.Delete().Timeout),
 		},
-		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
-			fn := rw.resource.IDValidationFunc()
-
-			warnings, errs := fn(id, "id")
-			if len(warnings) > 0 {
-				for _, warning := range warnings {
-					rw.logger.Warn(warning)
-				}
-			}
-			if len(errs) > 0 {
-				out := ""
-				for _, err := range errs {
-					out += err.Error()
-				}
-				return errors.New(out)
-			}
-
-			return nil
-		}, func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
-			if v, ok := rw.resource.(ResourceWithCustomImporter); ok {
-				metaData := runArgs(d, meta, rw.logger)
-
-				ctx, cancel := context.WithTimeout(ctx, rw.resource.Read().Timeout)
-				defer cancel()
-				err := v.CustomImporter()(ctx, metaData)
-				if err != nil {
-					return nil, err
-				}
-
-				return []*pluginsdk.ResourceData{metaData.ResourceData}, nil
-			}
-
-			return schema.ImportStatePassthroughContext(ctx, d, meta)
-		}),
+		Importer: pluginsdk.ImporterValidatingResourceIdThen(importValidateFunc, importThenFunc),
 	}
 
 	// Not all resources support update - so this is an separate interface
@@ -191,22 +195,19 @@ and we recommend using the %[2]q resource instead.
 			SchemaFunc: pluginsdk.GenerateIdentitySchema(resourceId, idType),
 		}
 
-		resource.Importer = pluginsdk.ImporterValidatingIdentityThen(resourceId, func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
-			if v, ok := rw.resource.(ResourceWithCustomImporter); ok {
-				metaData := runArgs(d, meta, rw.logger)
+		resource.Importer = pluginsdk.ImporterValidatingIdentityThen(resourceId, importThenFunc, idType)
+	}
 
-				ctx, cancel := context.WithTimeout(ctx, rw.resource.Read().Timeout)
-				defer cancel()
-				err := v.CustomImporter()(ctx, metaData)
-				if err != nil {
-					return nil, err
-				}
+	if v, ok := rw.resource.(ResourceWithCustomIdentity); ok {
+		if _, ok := rw.resource.(ResourceWithIdentity); ok {
+			return nil, fmt.Errorf("Resource %q can implement either ResourceWithIdentity or ResourceWithCustomIdentity but not both", rw.resource.ResourceType())
+		}
 
-				return []*pluginsdk.ResourceData{metaData.ResourceData}, nil
-			}
+		resource.Identity = &schema.ResourceIdentity{
+			SchemaFunc: v.IdentitySchema,
+		}
 
-			return schema.ImportStatePassthroughContext(ctx, d, meta)
-		}, idType)
+		resource.Importer = pluginsdk.ImporterValidatingResourceIdOrIdentityThen(importValidateFunc, v.IdentityImporter(), importThenFunc)
 	}
 
 	return &resource, nil
@@ -0,0 +1,39 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package aadb2c_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccAadb2CDirectory_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_aadb_2_c_directory", "test")
+	r := AadB2cDirectoryResource{}
+
+	checkedFields := map[string]struct{}{
+		"subscription_id": {},
+		"name":            {},
+		"resource_group":  {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_aadb_2_c_directory.test", checkedFields),
+				statecheck.ExpectIdentityValue("azurerm_aadb_2_c_directory.test", tfjsonpath.New("subscription_id"), knownvalue.StringExact(data.Subscriptions.Primary)),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_aadb_2_c_directory.test", tfjsonpath.New("name"), tfjsonpath.New("domain_name")),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_aadb_2_c_directory.test", tfjsonpath.New("resource_group"), tfjsonpath.New("resource_group_name")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/advisor/2023-01-01/suppressions"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -17,7 +18,10 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name advisor_suppression -service-package-name advisor -properties "name,resource_uri:resource_id,recommendation_id" -no-subscription-id
+
 var _ sdk.Resource = AdvisorSuppressionResource{}
+var _ sdk.ResourceWithIdentity = AdvisorSuppressionResource{}
 
 type AdvisorSuppressionResource struct{}
 
@@ -111,7 +115,7 @@ func (r AdvisorSuppressionResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -149,6 +153,10 @@ func (AdvisorSuppressionResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -177,3 +185,7 @@ func (AdvisorSuppressionResource) Delete() sdk.ResourceFunc {
 func (AdvisorSuppressionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return suppressions.ValidateScopedSuppressionID
 }
+
+func (AdvisorSuppressionResource) Identity() resourceids.ResourceId {
+	return &suppressions.ScopedSuppressionId{}
+}
@@ -0,0 +1,38 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package advisor_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccAdvisorSuppression_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_advisor_suppression", "test")
+	r := AdvisorSuppressionResource{}
+
+	checkedFields := map[string]struct{}{
+		"name":              {},
+		"recommendation_id": {},
+		"resource_uri":      {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_advisor_suppression.test", checkedFields),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_advisor_suppression.test", tfjsonpath.New("name"), tfjsonpath.New("name")),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_advisor_suppression.test", tfjsonpath.New("recommendation_id"), tfjsonpath.New("recommendation_id")),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_advisor_suppression.test", tfjsonpath.New("resource_uri"), tfjsonpath.New("resource_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
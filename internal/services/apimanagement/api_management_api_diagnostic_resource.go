@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/apidiagnostic"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/logger"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/schemaz"
@@ -23,6 +24,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name api_management_api_diagnostic -service-package-name apimanagement -properties "resource_group_name,service_name:api_management_name,api_id:api_name,diagnostic_id:identifier" -known-values "subscription_id:data.Subscriptions.Primary"
+
 func resourceApiManagementApiDiagnostic() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceApiManagementApiDiagnosticCreateUpdate,
@@ -30,10 +33,11 @@ func resourceApiManagementApiDiagnostic() *pluginsdk.Resource {
 		Update: resourceApiManagementApiDiagnosticCreateUpdate,
 		Delete: resourceApiManagementApiDiagnosticDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := apidiagnostic.ParseApiDiagnosticID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&apidiagnostic.ApiDiagnosticId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&apidiagnostic.ApiDiagnosticId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -271,6 +275,9 @@ func resourceApiManagementApiDiagnosticCreateUpdate(d *pluginsdk.ResourceData, m
 		return fmt.Errorf("reading ID for Diagnostic %s: ID is empty", id)
 	}
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 
 	return resourceApiManagementApiDiagnosticRead(d, meta)
 }
@@ -335,7 +342,7 @@ func resourceApiManagementApiDiagnosticRead(d *pluginsdk.ResourceData, meta inte
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, diagnosticId)
 }
 
 func resourceApiManagementApiDiagnosticDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package apimanagement_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccApiManagementApiRelease_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_api_release", "test")
+	r := ApiManagementApiReleaseResource{}
+
+	checkedFields := map[string]struct{}{
+		"release_id":          {},
+		"api_id":              {},
+		"resource_group_name": {},
+		"service_name":        {},
+		"subscription_id":     {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_api_management_api_release.test", checkedFields),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_api_management_api_release.test", tfjsonpath.New("release_id"), tfjsonpath.New("name")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_api_management_api_release.test", tfjsonpath.New("api_id"), tfjsonpath.New("api_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_api_management_api_release.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("api_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_api_management_api_release.test", tfjsonpath.New("service_name"), tfjsonpath.New("api_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_api_management_api_release.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("api_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
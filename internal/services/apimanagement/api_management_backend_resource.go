@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2024-05-01/backend"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -26,16 +27,20 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name api_management_backend -service-package-name apimanagement -properties "resource_group_name,service_name:api_management_name,backend_id:name" -known-values "subscription_id:data.Subscriptions.Primary" -test-params "basic" -test-resource-type ApiManagementAuthorizationBackendResource
+
 func resourceApiManagementBackend() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceApiManagementBackendCreateUpdate,
 		Read:   resourceApiManagementBackendRead,
 		Update: resourceApiManagementBackendCreateUpdate,
 		Delete: resourceApiManagementBackendDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := backend.ParseBackendID(id)
-			return err
-		}),
+
+		Importer: pluginsdk.ImporterValidatingIdentity(&backend.BackendId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&backend.BackendId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -411,6 +416,10 @@ func resourceApiManagementBackendCreateUpdate(d *pluginsdk.ResourceData, meta in
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
+
 	return resourceApiManagementBackendRead(d, meta)
 }
 
@@ -465,7 +474,7 @@ func resourceApiManagementBackendRead(d *pluginsdk.ResourceData, meta interface{
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceApiManagementBackendDelete(d *pluginsdk.ResourceData, meta interface{}) error {
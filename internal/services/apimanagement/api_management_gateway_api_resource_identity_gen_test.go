@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package apimanagement_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccApiManagementGatewayApi_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_gateway_api", "test")
+	r := ApiManagementGatewayAPIResource{}
+
+	checkedFields := map[string]struct{}{
+		"api_id":              {},
+		"gateway_id":          {},
+		"resource_group_name": {},
+		"service_name":        {},
+		"subscription_id":     {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_api_management_gateway_api.test", checkedFields),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_api_management_gateway_api.test", tfjsonpath.New("api_id"), tfjsonpath.New("api_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_api_management_gateway_api.test", tfjsonpath.New("gateway_id"), tfjsonpath.New("gateway_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_api_management_gateway_api.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("gateway_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_api_management_gateway_api.test", tfjsonpath.New("service_name"), tfjsonpath.New("gateway_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_api_management_gateway_api.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("gateway_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package apimanagement_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccApiManagementGateway_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_gateway", "test")
+	r := ApiManagementGatewayResource{}
+
+	checkedFields := map[string]struct{}{
+		"gateway_id":          {},
+		"resource_group_name": {},
+		"service_name":        {},
+		"subscription_id":     {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_api_management_gateway.test", checkedFields),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_api_management_gateway.test", tfjsonpath.New("gateway_id"), tfjsonpath.New("name")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_api_management_gateway.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("api_management_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_api_management_gateway.test", tfjsonpath.New("service_name"), tfjsonpath.New("api_management_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_api_management_gateway.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("api_management_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/openidconnectprovider"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/schemaz"
@@ -20,16 +21,20 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name api_management_openid_connect_provider -service-package-name apimanagement -properties "name,resource_group_name,service_name:api_management_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type ApiManagementOpenIDConnectProviderResource
+
 func resourceApiManagementOpenIDConnectProvider() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceApiManagementOpenIDConnectProviderCreateUpdate,
 		Read:   resourceApiManagementOpenIDConnectProviderRead,
 		Update: resourceApiManagementOpenIDConnectProviderCreateUpdate,
 		Delete: resourceApiManagementOpenIDConnectProviderDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := openidconnectprovider.ParseOpenidConnectProviderID(id)
-			return err
-		}),
+
+		Importer: pluginsdk.ImporterValidatingIdentity(&openidconnectprovider.OpenidConnectProviderId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&openidconnectprovider.OpenidConnectProviderId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -115,6 +120,9 @@ func resourceApiManagementOpenIDConnectProviderCreateUpdate(d *pluginsdk.Resourc
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 
 	return resourceApiManagementOpenIDConnectProviderRead(d, meta)
 }
@@ -152,7 +160,7 @@ func resourceApiManagementOpenIDConnectProviderRead(d *pluginsdk.ResourceData, m
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceApiManagementOpenIDConnectProviderDelete(d *pluginsdk.ResourceData, meta interface{}) error {
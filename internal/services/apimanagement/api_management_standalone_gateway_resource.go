@@ -14,12 +14,15 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2024-05-01/apigateway"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name api_management_standalone_gateway -service-package-name apimanagement -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 type ApiManagementStandaloneGatewayModel struct {
 	Name               string            `tfschema:"name"`
 	ResourceGroupName  string            `tfschema:"resource_group_name"`
@@ -38,6 +41,7 @@ type GatewaySkuModel struct {
 type ApiManagementStandaloneGatewayResource struct{}
 
 var _ sdk.ResourceWithUpdate = ApiManagementStandaloneGatewayResource{}
+var _ sdk.ResourceWithIdentity = ApiManagementStandaloneGatewayResource{}
 
 func (r ApiManagementStandaloneGatewayResource) ResourceType() string {
 	return "azurerm_api_management_standalone_gateway"
@@ -51,6 +55,10 @@ func (r ApiManagementStandaloneGatewayResource) IDValidationFunc() pluginsdk.Sch
 	return apigateway.ValidateGatewayID
 }
 
+func (r ApiManagementStandaloneGatewayResource) Identity() resourceids.ResourceId {
+	return &apigateway.GatewayId{}
+}
+
 func (r ApiManagementStandaloneGatewayResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -169,7 +177,7 @@ func (r ApiManagementStandaloneGatewayResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -257,6 +265,10 @@ func (r ApiManagementStandaloneGatewayResource) Read() sdk.ResourceFunc {
 				state.Tags = pointer.From(model.Tags)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/api"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/product"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/subscription"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -28,6 +29,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name api_management_subscription -service-package-name apimanagement -properties "name:subscription_id,resource_group_name,service_name:api_management_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 func resourceApiManagementSubscription() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceApiManagementSubscriptionCreateUpdate,
@@ -35,10 +38,11 @@ func resourceApiManagementSubscription() *pluginsdk.Resource {
 		Update: resourceApiManagementSubscriptionCreateUpdate,
 		Delete: resourceApiManagementSubscriptionDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := subscription.ParseSubscriptions2ID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&subscription.Subscriptions2Id{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&subscription.Subscriptions2Id{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -209,6 +213,9 @@ func resourceApiManagementSubscriptionCreateUpdate(d *pluginsdk.ResourceData, me
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 
 	return resourceApiManagementSubscriptionRead(d, meta)
 }
@@ -275,7 +282,7 @@ func resourceApiManagementSubscriptionRead(d *pluginsdk.ResourceData, meta inter
 		d.Set("secondary_key", pointer.From(model.SecondaryKey))
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceApiManagementSubscriptionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/user"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/schemaz"
@@ -20,16 +21,20 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name api_management_user -service-package-name apimanagement -properties "resource_group_name,service_name:api_management_name,user_id" -known-values "subscription_id:data.Subscriptions.Primary"
+
 func resourceApiManagementUser() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceApiManagementUserCreateUpdate,
 		Read:   resourceApiManagementUserRead,
 		Update: resourceApiManagementUserCreateUpdate,
 		Delete: resourceApiManagementUserDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := user.ParseUserID(id)
-			return err
-		}),
+
+		Importer: pluginsdk.ImporterValidatingIdentity(&user.UserId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&user.UserId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(45 * time.Minute),
@@ -155,6 +160,9 @@ func resourceApiManagementUserCreateUpdate(d *pluginsdk.ResourceData, meta inter
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 
 	return resourceApiManagementUserRead(d, meta)
 }
@@ -194,7 +202,7 @@ func resourceApiManagementUserRead(d *pluginsdk.ResourceData, meta interface{})
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceApiManagementUserDelete(d *pluginsdk.ResourceData, meta interface{}) error {
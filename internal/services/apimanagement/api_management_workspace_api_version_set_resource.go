@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2024-05-01/apiversionset"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2024-05-01/apiversionsets"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2024-05-01/workspace"
@@ -21,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name api_management_workspace_api_version_set -service-package-name apimanagement -properties "version_set_id:name" -compare-values "subscription_id:api_management_workspace_id,resource_group_name:api_management_workspace_id,service_name:api_management_workspace_id,workspace_id:api_management_workspace_id"
+
 type ApiManagementWorkspaceApiVersionSetModel struct {
 	Name                     string `tfschema:"name"`
 	ApiManagementWorkspaceId string `tfschema:"api_management_workspace_id"`
@@ -36,6 +39,7 @@ type ApiManagementWorkspaceApiVersionSetResource struct{}
 var _ sdk.ResourceWithUpdate = ApiManagementWorkspaceApiVersionSetResource{}
 
 var _ sdk.ResourceWithCustomizeDiff = ApiManagementWorkspaceApiVersionSetResource{}
+var _ sdk.ResourceWithIdentity = ApiManagementWorkspaceApiVersionSetResource{}
 
 func (r ApiManagementWorkspaceApiVersionSetResource) ResourceType() string {
 	return "azurerm_api_management_workspace_api_version_set"
@@ -49,6 +53,10 @@ func (r ApiManagementWorkspaceApiVersionSetResource) IDValidationFunc() pluginsd
 	return apiversionset.ValidateWorkspaceApiVersionSetID
 }
 
+func (r ApiManagementWorkspaceApiVersionSetResource) Identity() resourceids.ResourceId {
+	return &apiversionset.WorkspaceApiVersionSetId{}
+}
+
 func (r ApiManagementWorkspaceApiVersionSetResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -151,6 +159,9 @@ func (r ApiManagementWorkspaceApiVersionSetResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			return nil
 		},
@@ -258,6 +269,10 @@ func (r ApiManagementWorkspaceApiVersionSetResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&model)
 		},
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/keyvault"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2024-05-01/certificate"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2024-05-01/workspace"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
@@ -21,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name api_management_workspace_certificate -service-package-name apimanagement -properties "certificate_id:name" -compare-values "subscription_id:api_management_workspace_id,resource_group_name:api_management_workspace_id,service_name:api_management_workspace_id,workspace_id:api_management_workspace_id"
+
 type ApiManagementWorkspaceCertificateModel struct {
 	Name                         string `tfschema:"name"`
 	ApiManagementWorkspaceId     string `tfschema:"api_management_workspace_id"`
@@ -38,6 +41,7 @@ type ApiManagementWorkspaceCertificateModel struct {
 type ApiManagementWorkspaceCertificateResource struct{}
 
 var _ sdk.ResourceWithUpdate = ApiManagementWorkspaceCertificateResource{}
+var _ sdk.ResourceWithIdentity = ApiManagementWorkspaceCertificateResource{}
 
 func (r ApiManagementWorkspaceCertificateResource) ResourceType() string {
 	return "azurerm_api_management_workspace_certificate"
@@ -51,6 +55,10 @@ func (r ApiManagementWorkspaceCertificateResource) IDValidationFunc() pluginsdk.
 	return certificate.ValidateWorkspaceCertificateID
 }
 
+func (r ApiManagementWorkspaceCertificateResource) Identity() resourceids.ResourceId {
+	return &certificate.WorkspaceCertificateId{}
+}
+
 func (r ApiManagementWorkspaceCertificateResource) Arguments() map[string]*pluginsdk.Schema {
 	args := map[string]*pluginsdk.Schema{
 		"name": {
@@ -181,6 +189,9 @@ func (r ApiManagementWorkspaceCertificateResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			return nil
 		},
@@ -228,6 +239,10 @@ func (r ApiManagementWorkspaceCertificateResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&model)
 		},
 	}
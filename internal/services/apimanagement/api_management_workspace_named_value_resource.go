@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/keyvault"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2024-05-01/namedvalue"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2024-05-01/workspace"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -18,11 +19,14 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name api_management_workspace_named_value -service-package-name apimanagement -properties "named_value_id:name" -compare-values "subscription_id:api_management_workspace_id,resource_group_name:api_management_workspace_id,service_name:api_management_workspace_id,workspace_id:api_management_workspace_id"
+
 type ApiManagementWorkspaceNamedValueResource struct{}
 
 var (
 	_ sdk.ResourceWithUpdate        = ApiManagementWorkspaceNamedValueResource{}
 	_ sdk.ResourceWithCustomizeDiff = ApiManagementWorkspaceNamedValueResource{}
+	_ sdk.ResourceWithIdentity      = ApiManagementWorkspaceNamedValueResource{}
 )
 
 func (r ApiManagementWorkspaceNamedValueResource) ResourceType() string {
@@ -37,6 +41,10 @@ func (r ApiManagementWorkspaceNamedValueResource) IDValidationFunc() pluginsdk.S
 	return namedvalue.ValidateWorkspaceNamedValueID
 }
 
+func (r ApiManagementWorkspaceNamedValueResource) Identity() resourceids.ResourceId {
+	return &namedvalue.WorkspaceNamedValueId{}
+}
+
 type ApiManagementWorkspaceNamedValueModel struct {
 	ApiManagementWorkspaceId string     `tfschema:"api_management_workspace_id"`
 	DisplayName              string     `tfschema:"display_name"`
@@ -125,6 +133,7 @@ func (r ApiManagementWorkspaceNamedValueResource) Attributes() map[string]*plugi
 
 func (r ApiManagementWorkspaceNamedValueResource) CustomizeDiff() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
 			var model ApiManagementWorkspaceNamedValueModel
 			if err := metadata.DecodeDiff(&model); err != nil {
@@ -185,6 +194,9 @@ func (r ApiManagementWorkspaceNamedValueResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			return nil
 		},
@@ -235,6 +247,10 @@ func (r ApiManagementWorkspaceNamedValueResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&model)
 		},
 	}
@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package apimanagement_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccApiManagementWorkspaceNamedValue_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_workspace_named_value", "test")
+	r := ApiManagementWorkspaceNamedValueResource{}
+
+	checkedFields := map[string]struct{}{
+		"named_value_id":      {},
+		"resource_group_name": {},
+		"service_name":        {},
+		"subscription_id":     {},
+		"workspace_id":        {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_api_management_workspace_named_value.test", checkedFields),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_api_management_workspace_named_value.test", tfjsonpath.New("named_value_id"), tfjsonpath.New("name")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_api_management_workspace_named_value.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("api_management_workspace_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_api_management_workspace_named_value.test", tfjsonpath.New("service_name"), tfjsonpath.New("api_management_workspace_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_api_management_workspace_named_value.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("api_management_workspace_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_api_management_workspace_named_value.test", tfjsonpath.New("workspace_id"), tfjsonpath.New("api_management_workspace_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
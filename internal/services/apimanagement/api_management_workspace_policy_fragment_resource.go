@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2024-05-01/policyfragment"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/schemaz"
@@ -18,6 +19,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name api_management_workspace_policy_fragment -service-package-name apimanagement -properties "name" -compare-values "subscription_id:api_management_workspace_id,resource_group_name:api_management_workspace_id,service_name:api_management_workspace_id,workspace_id:api_management_workspace_id" -test-resource-type ApiManagementWorkspacePolicyFragmentTestResource
+
 type ApiManagementWorkspacePolicyFragmentModel struct {
 	Name                     string `tfschema:"name"`
 	ApiManagementWorkspaceId string `tfschema:"api_management_workspace_id"`
@@ -29,6 +32,7 @@ type ApiManagementWorkspacePolicyFragmentModel struct {
 type ApiManagementWorkspacePolicyFragmentResource struct{}
 
 var _ sdk.ResourceWithUpdate = ApiManagementWorkspacePolicyFragmentResource{}
+var _ sdk.ResourceWithIdentity = ApiManagementWorkspacePolicyFragmentResource{}
 
 func (r ApiManagementWorkspacePolicyFragmentResource) ResourceType() string {
 	return "azurerm_api_management_workspace_policy_fragment"
@@ -42,6 +46,10 @@ func (r ApiManagementWorkspacePolicyFragmentResource) IDValidationFunc() plugins
 	return policyfragment.ValidateWorkspacePolicyFragmentID
 }
 
+func (r ApiManagementWorkspacePolicyFragmentResource) Identity() resourceids.ResourceId {
+	return &policyfragment.WorkspacePolicyFragmentId{}
+}
+
 func (r ApiManagementWorkspacePolicyFragmentResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": schemaz.SchemaApiManagementChildName(),
@@ -117,7 +125,7 @@ func (r ApiManagementWorkspacePolicyFragmentResource) Create() sdk.ResourceFunc
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -220,6 +228,10 @@ func (r ApiManagementWorkspacePolicyFragmentResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -0,0 +1,434 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package appservice
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourcegroups"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/appserviceplans"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type AppServiceListModel struct {
+	ResourceGroupName types.String `tfsdk:"resource_group_name"`
+	SubscriptionId    types.String `tfsdk:"subscription_id"`
+	ServicePlanId     types.String `tfsdk:"service_plan_id"`
+}
+
+type WebAppSlotListModel struct {
+	AppServiceId  types.String `tfsdk:"app_service_id"`
+	ServicePlanId types.String `tfsdk:"service_plan_id"`
+}
+
+type FunctionAppSlotListModel struct {
+	FunctionAppId types.String `tfsdk:"function_app_id"`
+	ServicePlanId types.String `tfsdk:"service_plan_id"`
+}
+
+// appServiceSite contains the details of a Site required to route it to the matching resource and filter it
+type appServiceSite struct {
+	id              string
+	name            string
+	kind            string
+	serverFarmId    string
+	flexConsumption bool
+	location        string
+	tags            map[string]string
+}
+
+func appServiceListResourceConfigSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"resource_group_name": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: resourcegroups.ValidateName,
+					},
+				},
+			},
+			"subscription_id": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.IsUUID,
+					},
+				},
+			},
+			"service_plan_id": servicePlanIdListSchemaAttribute(),
+		},
+	}
+}
+
+func webAppSlotListResourceConfigSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"app_service_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: commonids.ValidateAppServiceID,
+					},
+				},
+			},
+			"service_plan_id": servicePlanIdListSchemaAttribute(),
+		},
+	}
+}
+
+func functionAppSlotListResourceConfigSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"function_app_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: commonids.ValidateFunctionAppID,
+					},
+				},
+			},
+			"service_plan_id": servicePlanIdListSchemaAttribute(),
+		},
+	}
+}
+
+func servicePlanIdListSchemaAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional: true,
+		Validators: []validator.String{
+			typehelpers.WrappedStringValidator{
+				Func: commonids.ValidateAppServicePlanID,
+			},
+		},
+	}
+}
+
+// siteKindMatches returns whether the Site is managed by the Web App or Function App resource for the specified
+// Operating System, since the Linux and Windows variants share a single API resource type and differ only by `kind`
+func siteKindMatches(site appServiceSite, functionApp bool, linux bool) bool {
+	kind := strings.ToLower(site.kind)
+
+	// Logic App Standard sites are managed by `azurerm_logic_app_standard`
+	if strings.Contains(kind, "workflowapp") {
+		return false
+	}
+
+	if strings.Contains(kind, "functionapp") != functionApp || strings.Contains(kind, "linux") != linux {
+		return false
+	}
+
+	// Flex Consumption Function Apps are managed by `azurerm_function_app_flex_consumption`
+	return !functionApp || !site.flexConsumption
+}
+
+// siteOnServicePlan returns whether the Site is hosted on the specified App Service Plan, where one is specified
+func siteOnServicePlan(site appServiceSite, servicePlanId *commonids.AppServicePlanId) bool {
+	if servicePlanId == nil {
+		return true
+	}
+
+	// use case insensitive parsing as the API may return `serverfarms` instead of literal `serverFarms`
+	id, err := commonids.ParseAppServicePlanIDInsensitively(site.serverFarmId)
+	if err != nil {
+		return false
+	}
+
+	return strings.EqualFold(id.ID(), servicePlanId.ID())
+}
+
+// listAppServiceSites streams the Web Apps or Function Apps for the specified Operating System, retrieving each
+// Site using the Read function of the resource as this requires several additional API calls
+func listAppServiceSites(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata sdk.ResourceMetadata, r sdk.Resource, functionApp bool, linux bool) {
+	var data AppServiceListModel
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	subscriptionId := metadata.SubscriptionId
+	if !data.SubscriptionId.IsNull() {
+		subscriptionId = data.SubscriptionId.ValueString()
+	}
+
+	sites := make([]appServiceSite, 0)
+
+	switch {
+	case !data.ServicePlanId.IsNull():
+		servicePlanId, err := commonids.ParseAppServicePlanID(data.ServicePlanId.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("parsing App Service Plan ID for `%s`", r.ResourceType()), err)
+			return
+		}
+
+		resp, err := metadata.Client.AppService.ServicePlanClient.ListWebAppsComplete(ctx, *servicePlanId, appserviceplans.DefaultListWebAppsOperationOptions())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s` on %s", r.ResourceType(), servicePlanId), err)
+			return
+		}
+
+		for _, item := range resp.Items {
+			// the Sites hosted on an App Service Plan include any Deployment Slots
+			if !strings.EqualFold(pointer.From(item.Type), "Microsoft.Web/sites") {
+				continue
+			}
+
+			site := appServiceSite{
+				id:       pointer.From(item.Id),
+				name:     pointer.From(item.Name),
+				kind:     pointer.From(item.Kind),
+				location: item.Location,
+				tags:     pointer.From(item.Tags),
+			}
+			if props := item.Properties; props != nil {
+				site.serverFarmId = pointer.From(props.ServerFarmId)
+				site.flexConsumption = props.FunctionAppConfig != nil
+			}
+			sites = append(sites, site)
+		}
+	case !data.ResourceGroupName.IsNull():
+		resp, err := metadata.Client.AppService.WebAppsClient.ListByResourceGroupComplete(ctx, commonids.NewResourceGroupID(subscriptionId, data.ResourceGroupName.ValueString()), webapps.DefaultListByResourceGroupOperationOptions())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", r.ResourceType()), err)
+			return
+		}
+
+		for _, item := range resp.Items {
+			sites = append(sites, appServiceSiteFromModel(item))
+		}
+	default:
+		resp, err := metadata.Client.AppService.WebAppsClient.ListComplete(ctx, commonids.NewSubscriptionID(subscriptionId))
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", r.ResourceType()), err)
+			return
+		}
+
+		for _, item := range resp.Items {
+			sites = append(sites, appServiceSiteFromModel(item))
+		}
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		sdk.SetResponseErrorDiagnostic(stream, "internal-error", "context had no deadline")
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		ctx, cancel := context.WithDeadline(context.Background(), deadline)
+		defer cancel()
+
+		for _, site := range sites {
+			if !siteKindMatches(site, functionApp, linux) {
+				continue
+			}
+
+			result := request.NewListResult(ctx)
+			result.DisplayName = site.name
+
+			var id resourceids.ResourceId
+			if functionApp {
+				functionAppId, err := commonids.ParseFunctionAppIDInsensitively(site.id)
+				if err != nil {
+					sdk.SetErrorDiagnosticAndPushListResult(result, push, "parsing Function App ID", err)
+					return
+				}
+				if !data.ResourceGroupName.IsNull() && !strings.EqualFold(functionAppId.ResourceGroupName, data.ResourceGroupName.ValueString()) {
+					continue
+				}
+				id = functionAppId
+			} else {
+				appServiceId, err := commonids.ParseAppServiceIDInsensitively(site.id)
+				if err != nil {
+					sdk.SetErrorDiagnosticAndPushListResult(result, push, "parsing App Service ID", err)
+					return
+				}
+				if !data.ResourceGroupName.IsNull() && !strings.EqualFold(appServiceId.ResourceGroupName, data.ResourceGroupName.ValueString()) {
+					continue
+				}
+				id = appServiceId
+			}
+
+			if !pushAppServiceListResult(ctx, metadata, r, id, site, request.IncludeResource, result, push) {
+				return
+			}
+		}
+	}
+}
+
+// listWebAppSlots streams the Deployment Slots of the Web App configured in `app_service_id`
+func listWebAppSlots(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata sdk.ResourceMetadata, r sdk.Resource, linux bool) {
+	var data WebAppSlotListModel
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	appServiceId, err := commonids.ParseAppServiceID(data.AppServiceId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("parsing App Service ID for `%s`", r.ResourceType()), err)
+		return
+	}
+
+	listAppServiceSlots(ctx, request, stream, metadata, r, *appServiceId, data.ServicePlanId, false, linux)
+}
+
+// listFunctionAppSlots streams the Deployment Slots of the Function App configured in `function_app_id`
+func listFunctionAppSlots(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata sdk.ResourceMetadata, r sdk.Resource, linux bool) {
+	var data FunctionAppSlotListModel
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	functionAppId, err := commonids.ParseFunctionAppID(data.FunctionAppId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("parsing Function App ID for `%s`", r.ResourceType()), err)
+		return
+	}
+
+	appServiceId := commonids.NewAppServiceID(functionAppId.SubscriptionId, functionAppId.ResourceGroupName, functionAppId.SiteName)
+	listAppServiceSlots(ctx, request, stream, metadata, r, appServiceId, data.ServicePlanId, true, linux)
+}
+
+// listAppServiceSlots streams the Deployment Slots of the specified Web App or Function App, retrieving each Slot
+// using the Read function of the resource
+func listAppServiceSlots(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata sdk.ResourceMetadata, r sdk.Resource, parentId commonids.AppServiceId, servicePlanIdRaw types.String, functionApp bool, linux bool) {
+	var servicePlanId *commonids.AppServicePlanId
+	if !servicePlanIdRaw.IsNull() {
+		id, err := commonids.ParseAppServicePlanID(servicePlanIdRaw.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("parsing App Service Plan ID for `%s`", r.ResourceType()), err)
+			return
+		}
+		servicePlanId = id
+	}
+
+	resp, err := metadata.Client.AppService.WebAppsClient.ListSlotsComplete(ctx, parentId)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s` for %s", r.ResourceType(), parentId), err)
+		return
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		sdk.SetResponseErrorDiagnostic(stream, "internal-error", "context had no deadline")
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		ctx, cancel := context.WithDeadline(context.Background(), deadline)
+		defer cancel()
+
+		for _, item := range resp.Items {
+			site := appServiceSiteFromModel(item)
+			if !siteKindMatches(site, functionApp, linux) || !siteOnServicePlan(site, servicePlanId) {
+				continue
+			}
+
+			result := request.NewListResult(ctx)
+
+			// use case insensitive parsing as the API may return `Sites` instead of literal `sites`
+			id, err := webapps.ParseSlotIDInsensitively(site.id)
+			if err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, "parsing Slot ID", err)
+				return
+			}
+			result.DisplayName = id.SlotName
+
+			if !pushAppServiceListResult(ctx, metadata, r, id, site, request.IncludeResource, result, push) {
+				return
+			}
+		}
+	}
+}
+
+func appServiceSiteFromModel(input webapps.Site) appServiceSite {
+	site := appServiceSite{
+		id:       pointer.From(input.Id),
+		name:     pointer.From(input.Name),
+		kind:     pointer.From(input.Kind),
+		location: input.Location,
+		tags:     pointer.From(input.Tags),
+	}
+
+	if props := input.Properties; props != nil {
+		site.serverFarmId = pointer.From(props.ServerFarmId)
+		site.flexConsumption = props.FunctionAppConfig != nil
+	}
+
+	return site
+}
+
+// pushAppServiceListResult populates the List Result using the Read function of the resource and pushes it to the
+// stream, returning false when no further results should be pushed. Reading a Site retrieves its configuration, app
+// settings etc., so this is only done when the resource is included - otherwise only the top-level fields from the
+// listed Site are set.
+func pushAppServiceListResult(ctx context.Context, metadata sdk.ResourceMetadata, r sdk.Resource, id resourceids.ResourceId, site appServiceSite, includeResource bool, result list.ListResult, push func(list.ListResult) bool) bool {
+	meta := sdk.NewResourceMetaData(metadata.Client, r)
+	meta.SetID(id)
+
+	if !includeResource {
+		// the top-level fields are available from the listed Site, which allows the List Results to be filtered
+		meta.ResourceData.Set("name", result.DisplayName)
+		meta.ResourceData.Set("tags", site.tags)
+		// the Deployment Slots have no `resource_group_name` or `location`, which are set on the parent Site instead
+		if siteId, ok := id.(*commonids.AppServiceId); ok {
+			meta.ResourceData.Set("resource_group_name", siteId.ResourceGroupName)
+			meta.ResourceData.Set("location", location.Normalize(site.location))
+		}
+
+		if err := pluginsdk.SetResourceIdentityData(meta.ResourceData, id); err != nil {
+			sdk.SetErrorDiagnosticAndPushListResult(result, push, "setting resource identity data", err)
+			return false
+		}
+
+		sdk.EncodeListResult(ctx, meta.ResourceData, &result)
+		if result.Diagnostics.HasError() {
+			push(result)
+			return false
+		}
+
+		return push(result)
+	}
+
+	read := r.Read()
+	ctx, cancel := context.WithTimeout(ctx, read.Timeout)
+	defer cancel()
+
+	if err := read.Func(ctx, meta); err != nil {
+		sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("retrieving %s", id), err)
+		return false
+	}
+
+	// the Site has been deleted since it was listed
+	if meta.ResourceData.Id() == "" {
+		return true
+	}
+
+	sdk.EncodeListResult(ctx, meta.ResourceData, &result)
+	if result.Diagnostics.HasError() {
+		push(result)
+		return false
+	}
+
+	return push(result)
+}
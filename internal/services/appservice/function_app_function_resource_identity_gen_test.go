@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package appservice_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccFunctionAppFunction_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_function_app_function", "test")
+	r := FunctionAppFunctionResource{}
+
+	checkedFields := map[string]struct{}{
+		"name":                {},
+		"resource_group_name": {},
+		"site_name":           {},
+		"subscription_id":     {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_function_app_function.test", checkedFields),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_function_app_function.test", tfjsonpath.New("name"), tfjsonpath.New("name")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_function_app_function.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("function_app_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_function_app_function.test", tfjsonpath.New("site_name"), tfjsonpath.New("function_app_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_function_app_function.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("function_app_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
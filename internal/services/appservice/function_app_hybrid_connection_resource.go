@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/relay/2021-11-01/hybridconnections"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/relay/2021-11-01/namespaces"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
@@ -22,6 +23,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name function_app_hybrid_connection -service-package-name appservice -compare-values "subscription_id:function_app_id,resource_group_name:function_app_id,site_name:function_app_id,hybrid_connection_namespace_name:relay_id,name:relay_id"
+
 type FunctionAppHybridConnectionResource struct{}
 
 type FunctionAppHybridConnectionModel struct {
@@ -40,6 +43,7 @@ type FunctionAppHybridConnectionModel struct {
 var _ sdk.ResourceWithUpdate = FunctionAppHybridConnectionResource{}
 
 var _ sdk.ResourceWithCustomImporter = FunctionAppHybridConnectionResource{}
+var _ sdk.ResourceWithIdentity = FunctionAppHybridConnectionResource{}
 
 func (r FunctionAppHybridConnectionResource) ModelObject() interface{} {
 	return &FunctionAppHybridConnectionModel{}
@@ -53,6 +57,10 @@ func (r FunctionAppHybridConnectionResource) IDValidationFunc() pluginsdk.Schema
 	return webapps.ValidateRelayID
 }
 
+func (r FunctionAppHybridConnectionResource) Identity() resourceids.ResourceId {
+	return &webapps.RelayId{}
+}
+
 func (r FunctionAppHybridConnectionResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"function_app_id": {
@@ -182,6 +190,9 @@ func (r FunctionAppHybridConnectionResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			return nil
 		},
@@ -234,6 +245,10 @@ func (r FunctionAppHybridConnectionResource) Read() sdk.ResourceFunc {
 
 					if keys, err := relayNamespaceClient.ListKeys(ctx, namespaces.NewAuthorizationRuleID(relayId.SubscriptionId, relayId.ResourceGroupName, appHybridConn.ServiceBusNamespace, appHybridConn.SendKeyName)); err != nil && keys.Model != nil {
 						appHybridConn.SendKeyValue = pointer.From(keys.Model.PrimaryKey)
+						if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+							return err
+						}
+
 						return metadata.Encode(&appHybridConn)
 					}
 
@@ -246,6 +261,10 @@ func (r FunctionAppHybridConnectionResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&appHybridConn)
 		},
 	}
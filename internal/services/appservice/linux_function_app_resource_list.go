@@ -0,0 +1,33 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package appservice
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type LinuxFunctionAppResourceList struct{}
+
+var _ sdk.FrameworkListWrappedResourceWithConfig = new(LinuxFunctionAppResourceList)
+
+func (LinuxFunctionAppResourceList) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = LinuxFunctionAppResource{}.ResourceType()
+}
+
+func (LinuxFunctionAppResourceList) ResourceFunc() *pluginsdk.Resource {
+	return sdk.WrappedResource(LinuxFunctionAppResource{})
+}
+
+func (LinuxFunctionAppResourceList) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = appServiceListResourceConfigSchema()
+}
+
+func (LinuxFunctionAppResourceList) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata sdk.ResourceMetadata) {
+	listAppServiceSites(ctx, request, stream, metadata, LinuxFunctionAppResource{}, true, true)
+}
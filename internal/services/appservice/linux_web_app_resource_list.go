@@ -0,0 +1,33 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package appservice
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type LinuxWebAppResourceList struct{}
+
+var _ sdk.FrameworkListWrappedResourceWithConfig = new(LinuxWebAppResourceList)
+
+func (LinuxWebAppResourceList) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = LinuxWebAppResource{}.ResourceType()
+}
+
+func (LinuxWebAppResourceList) ResourceFunc() *pluginsdk.Resource {
+	return sdk.WrappedResource(LinuxWebAppResource{})
+}
+
+func (LinuxWebAppResourceList) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = appServiceListResourceConfigSchema()
+}
+
+func (LinuxWebAppResourceList) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata sdk.ResourceMetadata) {
+	listAppServiceSites(ctx, request, stream, metadata, LinuxWebAppResource{}, false, true)
+}
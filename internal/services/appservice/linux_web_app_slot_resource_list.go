@@ -0,0 +1,33 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package appservice
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type LinuxWebAppSlotResourceList struct{}
+
+var _ sdk.FrameworkListWrappedResourceWithConfig = new(LinuxWebAppSlotResourceList)
+
+func (LinuxWebAppSlotResourceList) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = LinuxWebAppSlotResource{}.ResourceType()
+}
+
+func (LinuxWebAppSlotResourceList) ResourceFunc() *pluginsdk.Resource {
+	return sdk.WrappedResource(LinuxWebAppSlotResource{})
+}
+
+func (LinuxWebAppSlotResourceList) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = webAppSlotListResourceConfigSchema()
+}
+
+func (LinuxWebAppSlotResourceList) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata sdk.ResourceMetadata) {
+	listWebAppSlots(ctx, request, stream, metadata, LinuxWebAppSlotResource{}, true)
+}
@@ -87,5 +87,13 @@ func (r Registration) EphemeralResources() []func() ephemeral.EphemeralResource
 func (r Registration) ListResources() []sdk.FrameworkListWrappedResource {
 	return []sdk.FrameworkListWrappedResource{
 		&ServicePlanResourceList{},
+		&LinuxWebAppResourceList{},
+		&WindowsWebAppResourceList{},
+		&LinuxFunctionAppResourceList{},
+		&WindowsFunctionAppResourceList{},
+		&LinuxWebAppSlotResourceList{},
+		&WindowsWebAppSlotResourceList{},
+		&LinuxFunctionAppSlotResourceList{},
+		&WindowsFunctionAppSlotResourceList{},
 	}
 }
@@ -46,7 +46,7 @@ func (ServicePlanResourceList) List(ctx context.Context, request list.ListReques
 
 	switch {
 	case !data.ResourceGroupName.IsNull():
-		resp, err := client.ListByResourceGroupComplete(ctx, commonids.NewResourceGroupID(subscriptionID, data.ResourceGroupName.ValueString()))
+		resp, err := client.ListByResourceGroupComplete(sdk.ListPageLimitContext(ctx, request), commonids.NewResourceGroupID(subscriptionID, data.ResourceGroupName.ValueString()))
 		if err != nil {
 			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", r.ResourceType()), err)
 			return
@@ -54,7 +54,7 @@ func (ServicePlanResourceList) List(ctx context.Context, request list.ListReques
 
 		results = resp.Items
 	default:
-		resp, err := client.ListComplete(ctx, commonids.NewSubscriptionID(subscriptionID), appserviceplans.DefaultListOperationOptions())
+		resp, err := client.ListComplete(sdk.ListPageLimitContext(ctx, request), commonids.NewSubscriptionID(subscriptionID), appserviceplans.DefaultListOperationOptions())
 		if err != nil {
 			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", r.ResourceType()), err)
 			return
@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/staticsites"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -19,9 +20,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name static_web_app_custom_domain -service-package-name appservice -properties "name:domain_name" -compare-values "subscription_id:static_web_app_id,resource_group_name:static_web_app_id,static_site_name:static_web_app_id"
+
 type StaticWebAppCustomDomainResource struct{}
 
 var _ sdk.Resource = StaticWebAppCustomDomainResource{}
+var _ sdk.ResourceWithIdentity = StaticWebAppCustomDomainResource{}
 
 type StaticWebAppCustomDomainResourceModel struct {
 	DomainName      string `tfschema:"domain_name"`
@@ -164,6 +168,9 @@ func (r StaticWebAppCustomDomainResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			return nil
 		},
@@ -204,6 +211,10 @@ func (r StaticWebAppCustomDomainResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -232,3 +243,7 @@ func (r StaticWebAppCustomDomainResource) Delete() sdk.ResourceFunc {
 func (r StaticWebAppCustomDomainResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return staticsites.ValidateCustomDomainID
 }
+
+func (r StaticWebAppCustomDomainResource) Identity() resourceids.ResourceId {
+	return &staticsites.CustomDomainId{}
+}
@@ -12,14 +12,18 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/staticsites"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name static_web_app_function_app_registration -service-package-name appservice -compare-values "subscription_id:static_web_app_id,resource_group_name:static_web_app_id,static_site_name:static_web_app_id,name:function_app_id"
+
 type StaticWebAppFunctionAppRegistrationResource struct{}
 
 var _ sdk.Resource = StaticWebAppFunctionAppRegistrationResource{}
+var _ sdk.ResourceWithIdentity = StaticWebAppFunctionAppRegistrationResource{}
 
 type StaticWebAppFunctionAppRegistrationModel struct {
 	StaticWebAppID string `tfschema:"static_web_app_id"`
@@ -38,6 +42,10 @@ func (r StaticWebAppFunctionAppRegistrationResource) IDValidationFunc() pluginsd
 	return staticsites.ValidateUserProvidedFunctionAppID
 }
 
+func (r StaticWebAppFunctionAppRegistrationResource) Identity() resourceids.ResourceId {
+	return &staticsites.UserProvidedFunctionAppId{}
+}
+
 func (r StaticWebAppFunctionAppRegistrationResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"static_web_app_id": {
@@ -128,6 +136,9 @@ func (r StaticWebAppFunctionAppRegistrationResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			return nil
 		},
@@ -168,6 +179,10 @@ func (r StaticWebAppFunctionAppRegistrationResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package appservice_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccStaticWebAppFunctionAppRegistration_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_static_web_app_function_app_registration", "test")
+	r := StaticWebAppFunctionAppRegistrationResource{}
+
+	checkedFields := map[string]struct{}{
+		"name":                {},
+		"resource_group_name": {},
+		"static_site_name":    {},
+		"subscription_id":     {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_static_web_app_function_app_registration.test", checkedFields),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_static_web_app_function_app_registration.test", tfjsonpath.New("name"), tfjsonpath.New("function_app_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_static_web_app_function_app_registration.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("static_web_app_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_static_web_app_function_app_registration.test", tfjsonpath.New("static_site_name"), tfjsonpath.New("static_web_app_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_static_web_app_function_app_registration.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("static_web_app_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/resourceproviders"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/staticsites"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -24,11 +25,14 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name static_web_app -service-package-name appservice -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 type StaticWebAppResource struct{}
 
 var _ sdk.ResourceWithUpdate = StaticWebAppResource{}
 
 var _ sdk.ResourceWithCustomizeDiff = StaticWebAppResource{}
+var _ sdk.ResourceWithIdentity = StaticWebAppResource{}
 
 type StaticWebAppResourceModel struct {
 	Name                string                                     `tfschema:"name"`
@@ -164,6 +168,10 @@ func (r StaticWebAppResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return staticsites.ValidateStaticSiteID
 }
 
+func (r StaticWebAppResource) Identity() resourceids.ResourceId {
+	return &staticsites.StaticSiteId{}
+}
+
 func (r StaticWebAppResource) ResourceType() string {
 	return "azurerm_static_web_app"
 }
@@ -239,6 +247,9 @@ func (r StaticWebAppResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			if len(model.AppSettings) > 0 {
 				appSettings := staticsites.StringDictionary{
@@ -362,6 +373,10 @@ func (r StaticWebAppResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
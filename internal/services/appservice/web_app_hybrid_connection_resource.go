@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/relay/2021-11-01/hybridconnections"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/relay/2021-11-01/namespaces"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
@@ -22,6 +23,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name web_app_hybrid_connection -service-package-name appservice -compare-values "subscription_id:web_app_id,resource_group_name:web_app_id,site_name:web_app_id,hybrid_connection_namespace_name:relay_id,name:relay_id"
+
 type WebAppHybridConnectionResource struct{}
 
 type WebAppHybridConnectionModel struct {
@@ -40,6 +43,7 @@ type WebAppHybridConnectionModel struct {
 var _ sdk.ResourceWithUpdate = WebAppHybridConnectionResource{}
 
 var _ sdk.ResourceWithCustomImporter = WebAppHybridConnectionResource{}
+var _ sdk.ResourceWithIdentity = WebAppHybridConnectionResource{}
 
 func (r WebAppHybridConnectionResource) ModelObject() interface{} {
 	return &WebAppHybridConnectionModel{}
@@ -53,6 +57,10 @@ func (r WebAppHybridConnectionResource) IDValidationFunc() pluginsdk.SchemaValid
 	return webapps.ValidateRelayID
 }
 
+func (r WebAppHybridConnectionResource) Identity() resourceids.ResourceId {
+	return &webapps.RelayId{}
+}
+
 func (r WebAppHybridConnectionResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"web_app_id": {
@@ -182,6 +190,9 @@ func (r WebAppHybridConnectionResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			return nil
 		},
@@ -234,6 +245,10 @@ func (r WebAppHybridConnectionResource) Read() sdk.ResourceFunc {
 
 					if keys, err := relayNamespaceClient.ListKeys(ctx, namespaces.NewAuthorizationRuleID(relayId.SubscriptionId, relayId.ResourceGroupName, appHybridConn.ServiceBusNamespace, appHybridConn.SendKeyName)); err != nil && keys.Model != nil {
 						appHybridConn.SendKeyValue = pointer.From(keys.Model.PrimaryKey)
+						if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+							return err
+						}
+
 						return metadata.Encode(&appHybridConn)
 					}
 
@@ -246,6 +261,10 @@ func (r WebAppHybridConnectionResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&appHybridConn)
 		},
 	}
@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package appservice_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccWebAppHybridConnection_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_app_hybrid_connection", "test")
+	r := WebAppHybridConnectionResource{}
+
+	checkedFields := map[string]struct{}{
+		"hybrid_connection_namespace_name": {},
+		"name":                             {},
+		"resource_group_name":              {},
+		"site_name":                        {},
+		"subscription_id":                  {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_web_app_hybrid_connection.test", checkedFields),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_web_app_hybrid_connection.test", tfjsonpath.New("hybrid_connection_namespace_name"), tfjsonpath.New("relay_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_web_app_hybrid_connection.test", tfjsonpath.New("name"), tfjsonpath.New("relay_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_web_app_hybrid_connection.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("web_app_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_web_app_hybrid_connection.test", tfjsonpath.New("site_name"), tfjsonpath.New("web_app_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_web_app_hybrid_connection.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("web_app_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
@@ -1,6 +1,8 @@
 // Copyright IBM Corp. 2014, 2025
 // SPDX-License-Identifier: MPL-2.0
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name windows_function_app -properties "name,resource_group_name" -service-package-name appservice -test-params "B1" -known-values "subscription_id:data.Subscriptions.Primary"
+
 package appservice
 
 import (
@@ -18,6 +20,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/keyvault"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/resourceproviders"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
@@ -92,6 +95,8 @@ var _ sdk.ResourceWithCustomizeDiff = WindowsFunctionAppResource{}
 
 var _ sdk.ResourceWithStateMigration = WindowsFunctionAppResource{}
 
+var _ sdk.ResourceWithIdentity = WindowsFunctionAppResource{}
+
 func (r WindowsFunctionAppResource) ModelObject() interface{} {
 	return &WindowsFunctionAppModel{}
 }
@@ -100,6 +105,10 @@ func (r WindowsFunctionAppResource) ResourceType() string {
 	return "azurerm_windows_function_app"
 }
 
+func (r WindowsFunctionAppResource) Identity() resourceids.ResourceId {
+	return &commonids.FunctionAppId{}
+}
+
 func (r WindowsFunctionAppResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return commonids.ValidateFunctionAppID
 }
@@ -605,6 +614,9 @@ func (r WindowsFunctionAppResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
 
 			stickySettings := helpers.ExpandStickySettings(functionApp.StickySettings)
 
@@ -856,7 +868,7 @@ func (r WindowsFunctionAppResource) Read() sdk.ResourceFunc {
 				}
 			}
 
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, id)
 		},
 	}
 }
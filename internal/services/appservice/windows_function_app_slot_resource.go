@@ -1,6 +1,8 @@
 // Copyright IBM Corp. 2014, 2025
 // SPDX-License-Identifier: MPL-2.0
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name windows_function_app_slot -properties "name" -service-package-name appservice -test-params "B1" -compare-values "subscription_id:function_app_id,resource_group_name:function_app_id,site_name:function_app_id"
+
 package appservice
 
 import (
@@ -18,6 +20,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/keyvault"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/resourceproviders"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
@@ -81,6 +84,8 @@ var _ sdk.ResourceWithUpdate = WindowsFunctionAppSlotResource{}
 
 var _ sdk.ResourceWithStateMigration = WindowsFunctionAppSlotResource{}
 
+var _ sdk.ResourceWithIdentity = WindowsFunctionAppSlotResource{}
+
 func (r WindowsFunctionAppSlotResource) ModelObject() interface{} {
 	return &WindowsFunctionAppSlotModel{}
 }
@@ -89,6 +94,10 @@ func (r WindowsFunctionAppSlotResource) ResourceType() string {
 	return "azurerm_windows_function_app_slot"
 }
 
+func (r WindowsFunctionAppSlotResource) Identity() resourceids.ResourceId {
+	return &webapps.SlotId{}
+}
+
 func (r WindowsFunctionAppSlotResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return webapps.ValidateSlotID
 }
@@ -617,6 +626,9 @@ func (r WindowsFunctionAppSlotResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			backupConfig, err := helpers.ExpandBackupConfig(functionAppSlot.Backup)
 			if err != nil {
@@ -834,7 +846,7 @@ func (r WindowsFunctionAppSlotResource) Read() sdk.ResourceFunc {
 				}
 			}
 
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, id)
 		},
 	}
 }
@@ -0,0 +1,33 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package appservice
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type WindowsFunctionAppSlotResourceList struct{}
+
+var _ sdk.FrameworkListWrappedResourceWithConfig = new(WindowsFunctionAppSlotResourceList)
+
+func (WindowsFunctionAppSlotResourceList) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = WindowsFunctionAppSlotResource{}.ResourceType()
+}
+
+func (WindowsFunctionAppSlotResourceList) ResourceFunc() *pluginsdk.Resource {
+	return sdk.WrappedResource(WindowsFunctionAppSlotResource{})
+}
+
+func (WindowsFunctionAppSlotResourceList) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = functionAppSlotListResourceConfigSchema()
+}
+
+func (WindowsFunctionAppSlotResourceList) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata sdk.ResourceMetadata) {
+	listFunctionAppSlots(ctx, request, stream, metadata, WindowsFunctionAppSlotResource{}, false)
+}
@@ -1,6 +1,8 @@
 // Copyright IBM Corp. 2014, 2025
 // SPDX-License-Identifier: MPL-2.0
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name windows_web_app -properties "name,resource_group_name" -service-package-name appservice -known-values "subscription_id:data.Subscriptions.Primary"
+
 package appservice
 
 import (
@@ -16,6 +18,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/resourceproviders"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
@@ -75,6 +78,7 @@ var (
 	_ sdk.ResourceWithCustomImporter = WindowsWebAppResource{}
 	_ sdk.ResourceWithCustomizeDiff  = WindowsWebAppResource{}
 	_ sdk.ResourceWithStateMigration = WindowsWebAppResource{}
+	_ sdk.ResourceWithIdentity       = WindowsWebAppResource{}
 )
 
 func (r WindowsWebAppResource) Arguments() map[string]*pluginsdk.Schema {
@@ -445,6 +449,9 @@ func (r WindowsWebAppResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
 
 			if currentStack != "" {
 				siteMetadata := webapps.StringDictionary{Properties: &map[string]string{
@@ -761,7 +768,7 @@ func (r WindowsWebAppResource) Read() sdk.ResourceFunc {
 				}
 			}
 
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, id)
 		},
 	}
 }
@@ -790,6 +797,10 @@ func (r WindowsWebAppResource) Delete() sdk.ResourceFunc {
 	}
 }
 
+func (r WindowsWebAppResource) Identity() resourceids.ResourceId {
+	return &commonids.AppServiceId{}
+}
+
 func (r WindowsWebAppResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return commonids.ValidateAppServiceID
 }
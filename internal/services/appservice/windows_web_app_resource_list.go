@@ -0,0 +1,33 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package appservice
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type WindowsWebAppResourceList struct{}
+
+var _ sdk.FrameworkListWrappedResourceWithConfig = new(WindowsWebAppResourceList)
+
+func (WindowsWebAppResourceList) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = WindowsWebAppResource{}.ResourceType()
+}
+
+func (WindowsWebAppResourceList) ResourceFunc() *pluginsdk.Resource {
+	return sdk.WrappedResource(WindowsWebAppResource{})
+}
+
+func (WindowsWebAppResourceList) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = appServiceListResourceConfigSchema()
+}
+
+func (WindowsWebAppResourceList) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata sdk.ResourceMetadata) {
+	listAppServiceSites(ctx, request, stream, metadata, WindowsWebAppResource{}, false, false)
+}
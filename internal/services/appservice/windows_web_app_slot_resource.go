@@ -1,6 +1,8 @@
 // Copyright IBM Corp. 2014, 2025
 // SPDX-License-Identifier: MPL-2.0
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name windows_web_app_slot -properties "name" -service-package-name appservice -compare-values "subscription_id:app_service_id,resource_group_name:app_service_id,site_name:app_service_id"
+
 package appservice
 
 import (
@@ -16,6 +18,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
@@ -73,6 +76,7 @@ var (
 	_ sdk.ResourceWithCustomizeDiff  = WindowsWebAppSlotResource{}
 	_ sdk.ResourceWithUpdate         = WindowsWebAppSlotResource{}
 	_ sdk.ResourceWithStateMigration = WindowsWebAppSlotResource{}
+	_ sdk.ResourceWithIdentity       = WindowsWebAppSlotResource{}
 )
 
 func (r WindowsWebAppSlotResource) ModelObject() interface{} {
@@ -83,6 +87,10 @@ func (r WindowsWebAppSlotResource) ResourceType() string {
 	return "azurerm_windows_web_app_slot"
 }
 
+func (r WindowsWebAppSlotResource) Identity() resourceids.ResourceId {
+	return &webapps.SlotId{}
+}
+
 func (r WindowsWebAppSlotResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return webapps.ValidateSlotID
 }
@@ -428,6 +436,9 @@ func (r WindowsWebAppSlotResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			if currentStack != "" {
 				siteMetadata := webapps.StringDictionary{Properties: &map[string]string{
@@ -732,7 +743,7 @@ func (r WindowsWebAppSlotResource) Read() sdk.ResourceFunc {
 				}
 			}
 
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, id)
 		},
 	}
 }
@@ -0,0 +1,81 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package appservice_test
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestWindowsWebAppSlot_listByAppServiceID(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_windows_web_app_slot", "test")
+	r := WindowsWebAppSlotResource{}
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.basic(data),
+			},
+			{
+				Query:  true,
+				Config: r.basicQuery(),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("azurerm_windows_web_app_slot.list", 1),
+					querycheck.ExpectIdentity(
+						"azurerm_windows_web_app_slot.list",
+						map[string]knownvalue.Check{
+							"name":                knownvalue.NotNull(),
+							"site_name":           knownvalue.StringRegexp(regexp.MustCompile(strconv.Itoa(data.RandomInteger))),
+							"resource_group_name": knownvalue.StringRegexp(regexp.MustCompile(strconv.Itoa(data.RandomInteger))),
+							"subscription_id":     knownvalue.StringExact(data.Subscriptions.Primary),
+						},
+					),
+				},
+			},
+			{
+				Query:  true,
+				Config: r.basicQueryByServicePlanID(),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("azurerm_windows_web_app_slot.list", 1),
+				},
+			},
+		},
+	})
+}
+
+func (r WindowsWebAppSlotResource) basicQuery() string {
+	return `
+list "azurerm_windows_web_app_slot" "list" {
+  provider = azurerm
+  config {
+    app_service_id = azurerm_windows_web_app.test.id
+  }
+}
+`
+}
+
+func (r WindowsWebAppSlotResource) basicQueryByServicePlanID() string {
+	return `
+list "azurerm_windows_web_app_slot" "list" {
+  provider = azurerm
+  config {
+    app_service_id  = azurerm_windows_web_app.test.id
+    service_plan_id = azurerm_service_plan.test.id
+  }
+}
+`
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resourceconnector/2022-10-27/appliances"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -23,7 +24,10 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name arc_resource_bridge_appliance -service-package-name arcresourcebridge -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 var _ sdk.Resource = ArcResourceBridgeApplianceResource{}
+var _ sdk.ResourceWithIdentity = ArcResourceBridgeApplianceResource{}
 
 type ArcResourceBridgeApplianceResource struct{}
 
@@ -151,7 +155,7 @@ func (r ArcResourceBridgeApplianceResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -250,6 +254,10 @@ func (r ArcResourceBridgeApplianceResource) Read() sdk.ResourceFunc {
 					}
 				}
 			}
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -277,3 +285,7 @@ func (r ArcResourceBridgeApplianceResource) Delete() sdk.ResourceFunc {
 func (r ArcResourceBridgeApplianceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return appliances.ValidateApplianceID
 }
+
+func (r ArcResourceBridgeApplianceResource) Identity() resourceids.ResourceId {
+	return &appliances.ApplianceId{}
+}
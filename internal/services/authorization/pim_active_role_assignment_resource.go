@@ -27,7 +27,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
-var _ sdk.Resource = PimActiveRoleAssignmentResource{}
+var (
+	_ sdk.Resource                   = PimActiveRoleAssignmentResource{}
+	_ sdk.ResourceWithCustomIdentity = PimActiveRoleAssignmentResource{}
+)
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name pim_active_role_assignment -service-package-name authorization -properties "scope,role_definition_id,principal_id" -no-subscription-id -test-name noExpiration -test-expect-non-empty
 
 type PimActiveRoleAssignmentResource struct{}
 
@@ -69,6 +74,14 @@ func (PimActiveRoleAssignmentResource) IDValidationFunc() pluginsdk.SchemaValida
 	return validate.PimRoleAssignmentID
 }
 
+func (PimActiveRoleAssignmentResource) IdentitySchema() map[string]*pluginsdk.Schema {
+	return pimRoleAssignmentIdentitySchema()
+}
+
+func (PimActiveRoleAssignmentResource) IdentityImporter() pluginsdk.IdentityImporterFunc {
+	return pimRoleAssignmentIdentityImporter
+}
+
 func (PimActiveRoleAssignmentResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"scope": {
@@ -458,36 +471,11 @@ func (r PimActiveRoleAssignmentResource) Read() sdk.ResourceFunc {
 				}
 			} else if props := schedule.Properties; props != nil {
 				// The request has likely expired, so populate from the schedule (not all fields will be available)
-				state.PrincipalId = pointer.From(props.PrincipalId)
-				state.PrincipalType = string(pointer.From(props.PrincipalType))
-				state.RoleDefinitionId = pointer.From(props.RoleDefinitionId)
-
-				if props.StartDateTime != nil {
-					if len(state.ScheduleInfo) == 0 {
-						state.ScheduleInfo = make([]PimActiveRoleAssignmentScheduleInfo, 1)
-					}
-
-					// Only set the StartDateTime if not already present in state, because the value returned by the server advances
-					// in short intervals until the request has been fully processed, causing unnecessary persistent diffs
-					if state.ScheduleInfo[0].StartDateTime == "" {
-						state.ScheduleInfo[0].StartDateTime = *props.StartDateTime
-					}
-				}
-
-				if props.EndDateTime != nil {
-					if len(state.ScheduleInfo) == 0 {
-						state.ScheduleInfo = make([]PimActiveRoleAssignmentScheduleInfo, 1)
-					}
-					if len(state.ScheduleInfo[0].Expiration) == 0 {
-						state.ScheduleInfo[0].Expiration = make([]PimActiveRoleAssignmentScheduleInfoExpiration, 1)
-					}
+				state.flattenSchedule(*props)
+			}
 
-					// Only set the EndDateTime if not already present in state, because the value returned by the server advances
-					// in short intervals until the request has been fully processed, causing unnecessary persistent diffs
-					if state.ScheduleInfo[0].Expiration[0].EndDateTime == "" {
-						state.ScheduleInfo[0].Expiration[0].EndDateTime = *props.EndDateTime
-					}
-				}
+			if err := setPimRoleAssignmentIdentityData(metadata.ResourceData, *id); err != nil {
+				return err
 			}
 
 			return metadata.Encode(&state)
@@ -630,6 +618,41 @@ func (PimActiveRoleAssignmentResource) Delete() sdk.ResourceFunc {
 	}
 }
 
+// flattenSchedule populates the model from a Schedule, which is used once the associated Request has expired and when
+// listing PIM Active Role Assignments - however not all fields are available from the Schedule.
+func (m *PimActiveRoleAssignmentModel) flattenSchedule(props roleassignmentschedules.RoleAssignmentScheduleProperties) {
+	m.PrincipalId = pointer.From(props.PrincipalId)
+	m.PrincipalType = string(pointer.From(props.PrincipalType))
+	m.RoleDefinitionId = pointer.From(props.RoleDefinitionId)
+
+	if props.StartDateTime != nil {
+		if len(m.ScheduleInfo) == 0 {
+			m.ScheduleInfo = make([]PimActiveRoleAssignmentScheduleInfo, 1)
+		}
+
+		// Only set the StartDateTime if not already present in state, because the value returned by the server advances
+		// in short intervals until the request has been fully processed, causing unnecessary persistent diffs
+		if m.ScheduleInfo[0].StartDateTime == "" {
+			m.ScheduleInfo[0].StartDateTime = *props.StartDateTime
+		}
+	}
+
+	if props.EndDateTime != nil {
+		if len(m.ScheduleInfo) == 0 {
+			m.ScheduleInfo = make([]PimActiveRoleAssignmentScheduleInfo, 1)
+		}
+		if len(m.ScheduleInfo[0].Expiration) == 0 {
+			m.ScheduleInfo[0].Expiration = make([]PimActiveRoleAssignmentScheduleInfoExpiration, 1)
+		}
+
+		// Only set the EndDateTime if not already present in state, because the value returned by the server advances
+		// in short intervals until the request has been fully processed, causing unnecessary persistent diffs
+		if m.ScheduleInfo[0].Expiration[0].EndDateTime == "" {
+			m.ScheduleInfo[0].Expiration[0].EndDateTime = *props.EndDateTime
+		}
+	}
+}
+
 func findRoleAssignmentSchedule(ctx context.Context, client *roleassignmentschedules.RoleAssignmentSchedulesClient, id parse.PimRoleAssignmentId) (*roleassignmentschedules.RoleAssignmentSchedule, error) {
 	scopeId, err := commonids.ParseScopeID(id.Scope)
 	if err != nil {
@@ -0,0 +1,38 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package authorization_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccPimActiveRoleAssignment_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_pim_active_role_assignment", "test")
+	r := PimActiveRoleAssignmentResource{}
+
+	checkedFields := map[string]struct{}{
+		"principal_id":       {},
+		"role_definition_id": {},
+		"scope":              {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.noExpiration(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_pim_active_role_assignment.test", checkedFields),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_pim_active_role_assignment.test", tfjsonpath.New("principal_id"), tfjsonpath.New("principal_id")),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_pim_active_role_assignment.test", tfjsonpath.New("role_definition_id"), tfjsonpath.New("role_definition_id")),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_pim_active_role_assignment.test", tfjsonpath.New("scope"), tfjsonpath.New("scope")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(true),
+		data.ImportBlockWithIDStep(true),
+	}, false)
+}
@@ -0,0 +1,121 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package authorization
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2020-10-01/roleassignmentschedules"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type PimActiveRoleAssignmentListResource struct{}
+
+var _ sdk.FrameworkListWrappedResourceWithConfig = new(PimActiveRoleAssignmentListResource)
+
+func (PimActiveRoleAssignmentListResource) ResourceFunc() *pluginsdk.Resource {
+	return sdk.WrappedResource(PimActiveRoleAssignmentResource{})
+}
+
+func (PimActiveRoleAssignmentListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = PimActiveRoleAssignmentResource{}.ResourceType()
+}
+
+func (PimActiveRoleAssignmentListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = roleAssignmentScopeListResourceConfigSchema()
+}
+
+func (PimActiveRoleAssignmentListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata sdk.ResourceMetadata) {
+	client := metadata.Client.Authorization.RoleAssignmentSchedulesClient
+
+	// retrieve the deadline from the supplied context
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		// This *should* never happen given the List Wrapper instantiates a context with a timeout
+		sdk.SetResponseErrorDiagnostic(stream, "internal-error", "context had no deadline")
+		return
+	}
+
+	var data RoleAssignmentScopeListModel
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	resource := PimActiveRoleAssignmentResource{}
+
+	scopeId := commonids.NewScopeID(data.Scope.ValueString())
+	resp, err := client.ListForScopeComplete(ctx, scopeId, roleassignmentschedules.ListForScopeOperationOptions{
+		Filter: data.filter(),
+	})
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", resource.ResourceType()), err)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		deadlineCtx, cancel := context.WithDeadline(context.Background(), deadline)
+		defer cancel()
+
+		for _, item := range resp.Items {
+			props := item.Properties
+			if props == nil {
+				continue
+			}
+
+			// Schedules for members of a Group which has been assigned the Role are returned too, however these
+			// aren't managed by this resource so are excluded
+			if pointer.From(props.MemberType) != roleassignmentschedules.MemberTypeDirect {
+				continue
+			}
+
+			if !data.matches(pointer.From(props.Scope), pointer.From(props.PrincipalId), pointer.From(props.RoleDefinitionId)) {
+				continue
+			}
+
+			id := parse.NewPimRoleAssignmentID(pointer.From(props.Scope), pointer.From(props.RoleDefinitionId), pointer.From(props.PrincipalId))
+
+			result := request.NewListResult(deadlineCtx)
+			result.DisplayName = pointer.From(item.Name)
+
+			meta := sdk.NewResourceMetaData(metadata.Client, resource)
+			meta.SetID(id)
+
+			// the associated Requests expire and are not required to identify the PIM Role Assignment, so only the
+			// information available from the Schedule is returned
+			state := PimActiveRoleAssignmentModel{
+				Scope: id.Scope,
+			}
+			state.flattenSchedule(*props)
+
+			if err := meta.Encode(&state); err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("encoding `%s` resource data", resource.ResourceType()), err)
+				return
+			}
+
+			if err := setPimRoleAssignmentIdentityData(meta.ResourceData, id); err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("setting `%s` resource identity", resource.ResourceType()), err)
+				return
+			}
+
+			sdk.EncodeListResult(deadlineCtx, meta.ResourceData, &result)
+			if result.Diagnostics.HasError() {
+				push(result)
+				return
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
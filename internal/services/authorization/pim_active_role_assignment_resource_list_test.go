@@ -0,0 +1,63 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package authorization_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestAccPimActiveRoleAssignment_list(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_pim_active_role_assignment", "testlist")
+	r := PimActiveRoleAssignmentResource{}
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"azuread": {
+				VersionConstraint: "=3.4.0",
+				Source:            "registry.terraform.io/hashicorp/azuread",
+			},
+		},
+		Steps: []resource.TestStep{
+			{Config: r.noExpiration(data)},
+			{
+				Query:  true,
+				Config: r.listQuery(),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("azurerm_pim_active_role_assignment.list", 1),
+					querycheck.ExpectIdentity("azurerm_pim_active_role_assignment.list", map[string]knownvalue.Check{
+						"scope":              knownvalue.StringExact(fmt.Sprintf("/subscriptions/%s", data.Subscriptions.Primary)),
+						"role_definition_id": knownvalue.NotNull(),
+						"principal_id":       knownvalue.NotNull(),
+					}),
+				},
+			},
+		},
+	})
+}
+
+func (r PimActiveRoleAssignmentResource) listQuery() string {
+	return `
+list "azurerm_pim_active_role_assignment" "list" {
+  provider = azurerm
+  config {
+    scope         = data.azurerm_subscription.primary.id
+    at_scope_only = true
+    principal_id  = azuread_user.test.object_id
+  }
+}
+`
+}
@@ -28,7 +28,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
-var _ sdk.Resource = PimEligibleRoleAssignmentResource{}
+var (
+	_ sdk.Resource                   = PimEligibleRoleAssignmentResource{}
+	_ sdk.ResourceWithCustomIdentity = PimEligibleRoleAssignmentResource{}
+)
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name pim_eligible_role_assignment -service-package-name authorization -properties "scope,role_definition_id,principal_id" -no-subscription-id -test-name noExpiration -test-expect-non-empty
 
 type PimEligibleRoleAssignmentResource struct{}
 
@@ -72,6 +77,14 @@ func (PimEligibleRoleAssignmentResource) IDValidationFunc() pluginsdk.SchemaVali
 	return validate.PimRoleAssignmentID
 }
 
+func (PimEligibleRoleAssignmentResource) IdentitySchema() map[string]*pluginsdk.Schema {
+	return pimRoleAssignmentIdentitySchema()
+}
+
+func (PimEligibleRoleAssignmentResource) IdentityImporter() pluginsdk.IdentityImporterFunc {
+	return pimRoleAssignmentIdentityImporter
+}
+
 func (PimEligibleRoleAssignmentResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"scope": {
@@ -491,36 +504,11 @@ func (r PimEligibleRoleAssignmentResource) Read() sdk.ResourceFunc {
 				}
 			} else if props := schedule.Properties; props != nil {
 				// The request has likely expired, so populate from the schedule (not all fields will be available)
-				state.PrincipalId = pointer.From(props.PrincipalId)
-				state.PrincipalType = string(pointer.From(props.PrincipalType))
-				state.RoleDefinitionId = pointer.From(props.RoleDefinitionId)
-
-				if props.StartDateTime != nil {
-					if len(state.ScheduleInfo) == 0 {
-						state.ScheduleInfo = make([]PimEligibleRoleAssignmentScheduleInfo, 1)
-					}
-
-					// Only set the StartDateTime if not already present in state, because the value returned by the server advances
-					// in short intervals until the request has been fully processed, causing unnecessary persistent diffs
-					if state.ScheduleInfo[0].StartDateTime == "" {
-						state.ScheduleInfo[0].StartDateTime = *props.StartDateTime
-					}
-				}
-
-				if props.EndDateTime != nil {
-					if len(state.ScheduleInfo) == 0 {
-						state.ScheduleInfo = make([]PimEligibleRoleAssignmentScheduleInfo, 1)
-					}
-					if len(state.ScheduleInfo[0].Expiration) == 0 {
-						state.ScheduleInfo[0].Expiration = make([]PimEligibleRoleAssignmentScheduleInfoExpiration, 1)
-					}
+				state.flattenSchedule(*props)
+			}
 
-					// Only set the EndDateTime if not already present in state, because the value returned by the server advances
-					// in short intervals until the request has been fully processed, causing unnecessary persistent diffs
-					if state.ScheduleInfo[0].Expiration[0].EndDateTime == "" {
-						state.ScheduleInfo[0].Expiration[0].EndDateTime = *props.EndDateTime
-					}
-				}
+			if err := setPimRoleAssignmentIdentityData(metadata.ResourceData, *id); err != nil {
+				return err
 			}
 
 			return metadata.Encode(&state)
@@ -678,6 +666,41 @@ func pollForRoleEligibilitySchedule(ctx context.Context, client *roleeligibility
 	}
 }
 
+// flattenSchedule populates the model from a Schedule, which is used once the associated Request has expired and when
+// listing PIM Eligible Role Assignments - however not all fields are available from the Schedule.
+func (m *PimEligibleRoleAssignmentModel) flattenSchedule(props roleeligibilityschedules.RoleEligibilityScheduleProperties) {
+	m.PrincipalId = pointer.From(props.PrincipalId)
+	m.PrincipalType = string(pointer.From(props.PrincipalType))
+	m.RoleDefinitionId = pointer.From(props.RoleDefinitionId)
+
+	if props.StartDateTime != nil {
+		if len(m.ScheduleInfo) == 0 {
+			m.ScheduleInfo = make([]PimEligibleRoleAssignmentScheduleInfo, 1)
+		}
+
+		// Only set the StartDateTime if not already present in state, because the value returned by the server advances
+		// in short intervals until the request has been fully processed, causing unnecessary persistent diffs
+		if m.ScheduleInfo[0].StartDateTime == "" {
+			m.ScheduleInfo[0].StartDateTime = *props.StartDateTime
+		}
+	}
+
+	if props.EndDateTime != nil {
+		if len(m.ScheduleInfo) == 0 {
+			m.ScheduleInfo = make([]PimEligibleRoleAssignmentScheduleInfo, 1)
+		}
+		if len(m.ScheduleInfo[0].Expiration) == 0 {
+			m.ScheduleInfo[0].Expiration = make([]PimEligibleRoleAssignmentScheduleInfoExpiration, 1)
+		}
+
+		// Only set the EndDateTime if not already present in state, because the value returned by the server advances
+		// in short intervals until the request has been fully processed, causing unnecessary persistent diffs
+		if m.ScheduleInfo[0].Expiration[0].EndDateTime == "" {
+			m.ScheduleInfo[0].Expiration[0].EndDateTime = *props.EndDateTime
+		}
+	}
+}
+
 func findRoleEligibilitySchedule(ctx context.Context, client *roleeligibilityschedules.RoleEligibilitySchedulesClient, id parse.PimRoleAssignmentId) (*roleeligibilityschedules.RoleEligibilitySchedule, error) {
 	scopeId, err := commonids.ParseScopeID(id.Scope)
 	if err != nil {
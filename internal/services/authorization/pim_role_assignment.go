@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package authorization
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// PIM Role Assignments use a composite ID (rather than a Resource ID) and as such define the Resource Identity schema
+// rather than generating this from a Resource ID type.
+
+func pimRoleAssignmentIdentitySchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"scope": {
+			Type:              pluginsdk.TypeString,
+			RequiredForImport: true,
+		},
+		"role_definition_id": {
+			Type:              pluginsdk.TypeString,
+			RequiredForImport: true,
+		},
+		"principal_id": {
+			Type:              pluginsdk.TypeString,
+			RequiredForImport: true,
+		},
+	}
+}
+
+func pimRoleAssignmentIdentityImporter(d *pluginsdk.ResourceData) error {
+	identity, err := d.Identity()
+	if err != nil {
+		return fmt.Errorf("getting identity: %+v", err)
+	}
+
+	values := make(map[string]string)
+	for name := range pimRoleAssignmentIdentitySchema() {
+		value, ok := identity.Get(name).(string)
+		if !ok {
+			return fmt.Errorf("converting %q to string", name)
+		}
+		if value == "" {
+			return fmt.Errorf("%q cannot be empty", name)
+		}
+		values[name] = value
+	}
+
+	id := parse.NewPimRoleAssignmentID(values["scope"], values["role_definition_id"], values["principal_id"])
+	d.SetId(id.ID())
+
+	return nil
+}
+
+func setPimRoleAssignmentIdentityData(d *pluginsdk.ResourceData, id parse.PimRoleAssignmentId) error {
+	identity, err := d.Identity()
+	if err != nil {
+		return fmt.Errorf("getting identity: %+v", err)
+	}
+
+	values := map[string]string{
+		"scope":              id.Scope,
+		"role_definition_id": id.RoleDefinitionId,
+		"principal_id":       id.PrincipalId,
+	}
+	for name, value := range values {
+		if err := identity.Set(name, value); err != nil {
+			return fmt.Errorf("setting `%s` in resource identity: %+v", name, err)
+		}
+	}
+
+	return nil
+}
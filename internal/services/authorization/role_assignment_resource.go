@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2024-04-03/applicationgroup"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-12-01/subscriptions"
 	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -33,16 +34,22 @@ import (
 
 // TODO: this wants splitting into virtual resources with Virtual IDs
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name role_assignment -service-package-name authorization -properties "name,scope" -no-subscription-id -test-name resourceGroupScoped
+
 func resourceArmRoleAssignment() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceArmRoleAssignmentCreate,
 		Read:   resourceArmRoleAssignmentRead,
 		Delete: resourceArmRoleAssignmentDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+		Importer: pluginsdk.ImporterValidatingResourceIdOrIdentity(func(id string) error {
 			_, err := parse.RoleAssignmentID(id)
 			return err
-		}),
+		}, pluginsdk.IdentityImporter(&roleassignments.ScopedRoleAssignmentId{})),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&roleassignments.ScopedRoleAssignmentId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -272,6 +279,9 @@ func resourceArmRoleAssignmentCreate(d *pluginsdk.ResourceData, meta interface{}
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id.ScopedId); err != nil {
+		return err
+	}
 
 	return resourceArmRoleAssignmentRead(d, meta)
 }
@@ -304,43 +314,72 @@ func resourceArmRoleAssignmentRead(d *pluginsdk.ResourceData, meta interface{})
 	}
 
 	if model := resp.Model; model != nil {
-		d.Set("name", model.Name)
-
+		roleDefinitionName := ""
 		if props := model.Properties; props != nil {
-			d.Set("scope", normalizeScopeValue(pointer.From(props.Scope)))
-			d.Set("role_definition_id", props.RoleDefinitionId)
-			d.Set("principal_id", props.PrincipalId)
-			d.Set("principal_type", pointer.From(props.PrincipalType))
-			d.Set("delegated_managed_identity_resource_id", props.DelegatedManagedIdentityResourceId)
-			d.Set("description", props.Description)
-			d.Set("condition", props.Condition)
-			d.Set("condition_version", props.ConditionVersion)
-
-			if roleDefResourceId := props.RoleDefinitionId; roleDefResourceId != "" {
-				// Workaround for https://github.com/hashicorp/pandora/issues/3257
-				// The role definition id returned does not contain scope when the role definition was on tenant level (management group or tenant).
-				// And adding tenant id as scope will cause 404 response, so just adding a slash to parse that.
-				if strings.HasPrefix(roleDefResourceId, "/providers") {
-					roleDefResourceId = fmt.Sprintf("/%s", roleDefResourceId)
-				}
-				parsedRoleDefId, err := roledefinitions.ParseScopedRoleDefinitionID(roleDefResourceId)
-				if err != nil {
-					return fmt.Errorf("parsing %q: %+v", roleDefResourceId, err)
-				}
-				roleResp, err := roleDefinitionsClient.Get(ctx, *parsedRoleDefId)
-				if err != nil {
-					return fmt.Errorf("retrieving Role Definition %q: %+v", roleDefResourceId, err)
-				}
-				if roleResp.Model != nil && roleResp.Model.Properties != nil {
-					d.Set("role_definition_name", pointer.From(roleResp.Model.Properties.RoleName))
-				}
+			roleDefinitionName, err = roleAssignmentRoleDefinitionName(ctx, roleDefinitionsClient, props.RoleDefinitionId)
+			if err != nil {
+				return err
 			}
 		}
+
+		if err := resourceArmRoleAssignmentFlatten(d, *model, roleDefinitionName); err != nil {
+			return err
+		}
+	}
+
+	return pluginsdk.SetResourceIdentityData(d, &id.ScopedId)
+}
+
+func resourceArmRoleAssignmentFlatten(d *pluginsdk.ResourceData, model roleassignments.RoleAssignment, roleDefinitionName string) error {
+	d.Set("name", model.Name)
+
+	if props := model.Properties; props != nil {
+		d.Set("scope", normalizeScopeValue(pointer.From(props.Scope)))
+		d.Set("role_definition_id", props.RoleDefinitionId)
+		d.Set("principal_id", props.PrincipalId)
+		d.Set("principal_type", pointer.From(props.PrincipalType))
+		d.Set("delegated_managed_identity_resource_id", props.DelegatedManagedIdentityResourceId)
+		d.Set("description", props.Description)
+		d.Set("condition", props.Condition)
+		d.Set("condition_version", props.ConditionVersion)
+
+		if roleDefinitionName != "" {
+			d.Set("role_definition_name", roleDefinitionName)
+		}
 	}
 
 	return nil
 }
 
+// roleAssignmentRoleDefinitionName retrieves the name of the Role Definition referenced by a Role Assignment
+func roleAssignmentRoleDefinitionName(ctx context.Context, client *roledefinitions.RoleDefinitionsClient, roleDefinitionId string) (string, error) {
+	if roleDefinitionId == "" {
+		return "", nil
+	}
+
+	// Workaround for https://github.com/hashicorp/pandora/issues/3257
+	// The role definition id returned does not contain scope when the role definition was on tenant level (management group or tenant).
+	// And adding tenant id as scope will cause 404 response, so just adding a slash to parse that.
+	if strings.HasPrefix(roleDefinitionId, "/providers") {
+		roleDefinitionId = fmt.Sprintf("/%s", roleDefinitionId)
+	}
+	parsedRoleDefId, err := roledefinitions.ParseScopedRoleDefinitionID(roleDefinitionId)
+	if err != nil {
+		return "", fmt.Errorf("parsing %q: %+v", roleDefinitionId, err)
+	}
+
+	roleResp, err := client.Get(ctx, *parsedRoleDefId)
+	if err != nil {
+		return "", fmt.Errorf("retrieving Role Definition %q: %+v", roleDefinitionId, err)
+	}
+
+	if roleResp.Model != nil && roleResp.Model.Properties != nil {
+		return pointer.From(roleResp.Model.Properties.RoleName), nil
+	}
+
+	return "", nil
+}
+
 func resourceArmRoleAssignmentDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Authorization.ScopedRoleAssignmentsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
@@ -0,0 +1,129 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2022-04-01/roleassignments"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type RoleAssignmentListResource struct{}
+
+var _ sdk.FrameworkListWrappedResourceWithConfig = new(RoleAssignmentListResource)
+
+func (RoleAssignmentListResource) ResourceFunc() *pluginsdk.Resource {
+	return resourceArmRoleAssignment()
+}
+
+func (RoleAssignmentListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "azurerm_role_assignment"
+}
+
+func (RoleAssignmentListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = roleAssignmentScopeListResourceConfigSchema()
+}
+
+func (RoleAssignmentListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata sdk.ResourceMetadata) {
+	client := metadata.Client.Authorization.ScopedRoleAssignmentsClient
+	roleDefinitionsClient := metadata.Client.Authorization.ScopedRoleDefinitionsClient
+
+	// retrieve the deadline from the supplied context
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		// This *should* never happen given the List Wrapper instantiates a context with a timeout
+		sdk.SetResponseErrorDiagnostic(stream, "internal-error", "context had no deadline")
+		return
+	}
+
+	var data RoleAssignmentScopeListModel
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	scopeId := commonids.NewScopeID(data.Scope.ValueString())
+	options := roleassignments.DefaultListForScopeOperationOptions()
+	options.Filter = data.filter()
+
+	resp, err := client.ListForScopeComplete(ctx, scopeId, options)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", "azurerm_role_assignment"), err)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		deadlineCtx, cancel := context.WithDeadline(context.Background(), deadline)
+		defer cancel()
+
+		// Role Assignments commonly reference the same Role Definitions, so their names are only retrieved once
+		roleDefinitionNames := make(map[string]string)
+
+		for _, item := range resp.Items {
+			props := item.Properties
+			if props == nil || !data.matches(pointer.From(props.Scope), props.PrincipalId, props.RoleDefinitionId) {
+				continue
+			}
+
+			result := request.NewListResult(deadlineCtx)
+			result.DisplayName = pointer.From(item.Name)
+
+			id, err := roleassignments.ParseScopedRoleAssignmentIDInsensitively(pointer.From(item.Id))
+			if err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, "parsing Role Assignment ID", err)
+				return
+			}
+
+			rd := resourceArmRoleAssignment().Data(&terraform.InstanceState{})
+			rd.SetId(id.ID())
+
+			// retrieving the name of the Role Definition requires an additional API call, so this is only done when
+			// the full resource has been requested
+			roleDefinitionName := ""
+			if request.IncludeResource {
+				key := strings.ToLower(props.RoleDefinitionId)
+				name, ok := roleDefinitionNames[key]
+				if !ok {
+					name, err = roleAssignmentRoleDefinitionName(deadlineCtx, roleDefinitionsClient, props.RoleDefinitionId)
+					if err != nil {
+						sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("retrieving Role Definition for %s", id), err)
+						return
+					}
+					roleDefinitionNames[key] = name
+				}
+				roleDefinitionName = name
+			}
+
+			if err := resourceArmRoleAssignmentFlatten(rd, item, roleDefinitionName); err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("encoding `%s` resource data", "azurerm_role_assignment"), err)
+				return
+			}
+
+			if err := pluginsdk.SetResourceIdentityData(rd, id); err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("setting `%s` resource identity", "azurerm_role_assignment"), err)
+				return
+			}
+
+			sdk.EncodeListResult(deadlineCtx, rd, &result)
+			if result.Diagnostics.HasError() {
+				push(result)
+				return
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
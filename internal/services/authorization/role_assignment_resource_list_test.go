@@ -0,0 +1,56 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package authorization_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestAccRoleAssignment_list(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_role_assignment", "testlist")
+	r := RoleAssignmentResource{}
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{Config: r.resourceGroupScoped(data)},
+			{
+				Query:  true,
+				Config: r.listQuery(),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("azurerm_role_assignment.list", 1),
+					querycheck.ExpectIdentity("azurerm_role_assignment.list", map[string]knownvalue.Check{
+						"name":  knownvalue.NotNull(),
+						"scope": knownvalue.StringExact(fmt.Sprintf("/subscriptions/%s/resourceGroups/acctestRG-fwpolicy-RCG-%d", data.Subscriptions.Primary, data.RandomInteger)),
+					}),
+				},
+			},
+		},
+	})
+}
+
+func (r RoleAssignmentResource) listQuery() string {
+	return `
+list "azurerm_role_assignment" "list" {
+  provider = azurerm
+  config {
+    scope         = azurerm_resource_group.test.id
+    at_scope_only = true
+    principal_id  = data.azurerm_client_config.test.object_id
+  }
+}
+`
+}
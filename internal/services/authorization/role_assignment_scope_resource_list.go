@@ -0,0 +1,111 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package authorization
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// RoleAssignmentScopeListModel is the configuration used to list Role Assignments and PIM Role Assignments at a Scope
+type RoleAssignmentScopeListModel struct {
+	Scope            types.String `tfsdk:"scope"`
+	AtScopeOnly      types.Bool   `tfsdk:"at_scope_only"`
+	PrincipalId      types.String `tfsdk:"principal_id"`
+	RoleDefinitionId types.String `tfsdk:"role_definition_id"`
+}
+
+func roleAssignmentScopeListResourceConfigSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"scope": schema.StringAttribute{
+				Required:    true,
+				Description: "The Scope to list Role Assignments at, which can be a Management Group, Subscription, Resource Group or Resource ID.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.Any(
+							commonids.ValidateManagementGroupID,
+							commonids.ValidateSubscriptionID,
+							commonids.ValidateResourceGroupID,
+							azure.ValidateResourceID,
+						),
+					},
+				},
+			},
+
+			"at_scope_only": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only return Role Assignments made at the `scope`, rather than at the `scope` and any Scopes beneath it. Defaults to `false`.",
+			},
+
+			"principal_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return Role Assignments for this Principal ID.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.IsUUID,
+					},
+				},
+			},
+
+			"role_definition_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return Role Assignments for this Role Definition ID.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+	}
+}
+
+// filter returns the filter used when listing Role Assignments at the Scope. The filters supported by the API differ
+// between the Role Assignment APIs, so only the Principal ID is filtered server-side - the remaining filters are applied
+// using `matches`.
+func (m RoleAssignmentScopeListModel) filter() *string {
+	if m.PrincipalId.IsNull() || m.PrincipalId.IsUnknown() {
+		return nil
+	}
+
+	return pointer.To(fmt.Sprintf("principalId eq '%s'", m.PrincipalId.ValueString()))
+}
+
+// matches returns whether a Role Assignment should be returned. The API returns Role Assignments made at Scopes above
+// the configured `scope` (which are inherited) and as such these are always excluded.
+func (m RoleAssignmentScopeListModel) matches(scope string, principalId string, roleDefinitionId string) bool {
+	if m.AtScopeOnly.ValueBool() {
+		if !strings.EqualFold(strings.TrimSuffix(scope, "/"), strings.TrimSuffix(m.Scope.ValueString(), "/")) {
+			return false
+		}
+	} else if !azure.ScopeIsWithin(scope, m.Scope.ValueString()) {
+		return false
+	}
+
+	if v := m.PrincipalId.ValueString(); v != "" && !strings.EqualFold(v, principalId) {
+		return false
+	}
+
+	// Role Definitions can be referenced at any Scope, so are compared using their name (which is a UUID)
+	if v := m.RoleDefinitionId.ValueString(); v != "" && !strings.EqualFold(roleDefinitionName(v), roleDefinitionName(roleDefinitionId)) {
+		return false
+	}
+
+	return true
+}
+
+func roleDefinitionName(roleDefinitionId string) string {
+	roleDefinitionId = strings.TrimSuffix(roleDefinitionId, "/")
+	return roleDefinitionId[strings.LastIndex(roleDefinitionId, "/")+1:]
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/automanage/2022-05-04/configurationprofileassignments"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/automanage/2022-05-04/configurationprofilehcrpassignments"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/automanage/2022-05-04/configurationprofiles"
@@ -18,6 +19,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name arc_machine_automanage_configuration_assignment -service-package-name automanage -compare-values "subscription_id:arc_machine_id,resource_group_name:arc_machine_id,machine_name:arc_machine_id" -known-values "name:default" -test-name complete
+
 type ArcMachineConfigurationAssignment struct {
 	ArcMachineId    string `tfschema:"arc_machine_id"`
 	ConfigurationId string `tfschema:"configuration_id"`
@@ -96,7 +99,7 @@ func (v ArcMachineConfigurationAssignment) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -136,6 +139,10 @@ func (v ArcMachineConfigurationAssignment) Read() sdk.ResourceFunc {
 				state.ArcMachineId = arcMachineId.ID()
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -165,4 +172,9 @@ func (v ArcMachineConfigurationAssignment) IDValidationFunc() pluginsdk.SchemaVa
 	return configurationprofilehcrpassignments.ValidateProviders2ConfigurationProfileAssignmentID
 }
 
+func (v ArcMachineConfigurationAssignment) Identity() resourceids.ResourceId {
+	return &configurationprofilehcrpassignments.Providers2ConfigurationProfileAssignmentId{}
+}
+
 var _ sdk.Resource = &ArcMachineConfigurationAssignment{}
+var _ sdk.ResourceWithIdentity = &ArcMachineConfigurationAssignment{}
@@ -0,0 +1,41 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package automanage_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccArcMachineAutomanageConfigurationAssignment_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_arc_machine_automanage_configuration_assignment", "test")
+	r := ArcMachineAutomanageConfigurationAssignmentResource{}
+
+	checkedFields := map[string]struct{}{
+		"name":                {},
+		"machine_name":        {},
+		"resource_group_name": {},
+		"subscription_id":     {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_arc_machine_automanage_configuration_assignment.test", checkedFields),
+				statecheck.ExpectIdentityValue("azurerm_arc_machine_automanage_configuration_assignment.test", tfjsonpath.New("name"), knownvalue.StringExact("default")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_arc_machine_automanage_configuration_assignment.test", tfjsonpath.New("machine_name"), tfjsonpath.New("arc_machine_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_arc_machine_automanage_configuration_assignment.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("arc_machine_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_arc_machine_automanage_configuration_assignment.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("arc_machine_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/automanage/2022-05-04/configurationprofileassignments"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/automanage/2022-05-04/configurationprofiles"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -17,6 +18,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name virtual_machine_automanage_configuration_assignment -service-package-name automanage -compare-values "subscription_id:virtual_machine_id,resource_group_name:virtual_machine_id,virtual_machine_name:virtual_machine_id" -known-values "name:default"
+
 type VirtualMachineConfigurationAssignment struct {
 	VirtualMachineId string `tfschema:"virtual_machine_id"`
 	ConfigurationId  string `tfschema:"configuration_id"`
@@ -97,7 +100,7 @@ func (v VirtualMachineConfigurationAssignment) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -137,6 +140,10 @@ func (v VirtualMachineConfigurationAssignment) Read() sdk.ResourceFunc {
 				state.VirtualMachineId = virtualMachineId.ID()
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -166,4 +173,9 @@ func (v VirtualMachineConfigurationAssignment) IDValidationFunc() pluginsdk.Sche
 	return configurationprofileassignments.ValidateVirtualMachineProviders2ConfigurationProfileAssignmentID
 }
 
+func (v VirtualMachineConfigurationAssignment) Identity() resourceids.ResourceId {
+	return &configurationprofileassignments.VirtualMachineProviders2ConfigurationProfileAssignmentId{}
+}
+
 var _ sdk.Resource = &VirtualMachineConfigurationAssignment{}
+var _ sdk.ResourceWithIdentity = &VirtualMachineConfigurationAssignment{}
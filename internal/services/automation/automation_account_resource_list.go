@@ -47,7 +47,7 @@ func (r AutomationAccountListResource) List(ctx context.Context, request list.Li
 
 	switch {
 	case !data.ResourceGroupName.IsNull():
-		resp, err := client.ListByResourceGroupComplete(ctx, commonids.NewResourceGroupID(subscriptionID, data.ResourceGroupName.ValueString()))
+		resp, err := client.ListByResourceGroupComplete(sdk.ListPageLimitContext(ctx, request), commonids.NewResourceGroupID(subscriptionID, data.ResourceGroupName.ValueString()))
 		if err != nil {
 			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", "azurerm_automation_account"), err)
 			return
@@ -55,7 +55,7 @@ func (r AutomationAccountListResource) List(ctx context.Context, request list.Li
 
 		results = resp.Items
 	default:
-		resp, err := client.ListComplete(ctx, commonids.NewSubscriptionID(subscriptionID))
+		resp, err := client.ListComplete(sdk.ListPageLimitContext(ctx, request), commonids.NewSubscriptionID(subscriptionID))
 		if err != nil {
 			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", "azurerm_automation_account"), err)
 			return
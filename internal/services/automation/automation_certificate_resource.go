@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2024-10-23/certificate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -19,6 +20,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name automation_certificate -service-package-name automation -properties "name,resource_group_name,automation_account_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 func resourceAutomationCertificate() *pluginsdk.Resource {
 	resource := &pluginsdk.Resource{
 		Create: resourceAutomationCertificateCreate,
@@ -26,10 +29,11 @@ func resourceAutomationCertificate() *pluginsdk.Resource {
 		Update: resourceAutomationCertificateUpdate,
 		Delete: resourceAutomationCertificateDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := certificate.ParseCertificateID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&certificate.CertificateId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&certificate.CertificateId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -119,6 +123,9 @@ func resourceAutomationCertificateCreate(d *pluginsdk.ResourceData, meta interfa
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 
 	return resourceAutomationCertificateRead(d, meta)
 }
@@ -198,7 +205,7 @@ func resourceAutomationCertificateRead(d *pluginsdk.ResourceData, meta interface
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceAutomationCertificateDelete(d *pluginsdk.ResourceData, meta interface{}) error {
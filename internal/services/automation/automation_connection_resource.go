@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2024-10-23/connection"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2024-10-23/connectiontype"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/validate"
@@ -22,6 +23,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name automation_connection -service-package-name automation -properties "name,resource_group_name,automation_account_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 func resourceAutomationConnection() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceAutomationConnectionCreate,
@@ -29,10 +32,11 @@ func resourceAutomationConnection() *pluginsdk.Resource {
 		Update: resourceAutomationConnectionUpdate,
 		Delete: resourceAutomationConnectionDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := connection.ParseConnectionID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&connection.ConnectionId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&connection.ConnectionId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -140,6 +144,9 @@ func resourceAutomationConnectionCreate(d *pluginsdk.ResourceData, meta interfac
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 
 	return resourceAutomationConnectionRead(d, meta)
 }
@@ -216,7 +223,7 @@ func resourceAutomationConnectionRead(d *pluginsdk.ResourceData, meta interface{
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceAutomationConnectionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2024-10-23/automationaccount"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2024-10-23/connectiontype"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -18,6 +19,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name automation_connection_type -service-package-name automation -properties "name,resource_group_name,automation_account_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 type Field struct {
 	Name        string `tfschema:"name"`
 	IsOptional  bool   `tfschema:"is_optional"`
@@ -36,6 +39,7 @@ type AutomationConnectionTypeModel struct {
 type AutomationConnectionTypeResource struct{}
 
 var _ sdk.Resource = (*AutomationConnectionTypeResource)(nil)
+var _ sdk.ResourceWithIdentity = (*AutomationConnectionTypeResource)(nil)
 
 func (m AutomationConnectionTypeResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
@@ -150,7 +154,7 @@ func (m AutomationConnectionTypeResource) Create() sdk.ResourceFunc {
 			}
 
 			meta.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(meta.ResourceData, &id)
 		},
 	}
 }
@@ -193,6 +197,10 @@ func (m AutomationConnectionTypeResource) Read() sdk.ResourceFunc {
 					}
 				}
 			}
+			if err := pluginsdk.SetResourceIdentityData(meta.ResourceData, id); err != nil {
+				return err
+			}
+
 			return meta.Encode(&output)
 		},
 	}
@@ -219,3 +227,7 @@ func (m AutomationConnectionTypeResource) Delete() sdk.ResourceFunc {
 func (m AutomationConnectionTypeResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return connectiontype.ValidateConnectionTypeID
 }
+
+func (m AutomationConnectionTypeResource) Identity() resourceids.ResourceId {
+	return &connectiontype.ConnectionTypeId{}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2024-10-23/dscnodeconfiguration"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/validate"
@@ -21,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name automation_dsc_nodeconfiguration -service-package-name automation -properties "name,resource_group_name,automation_account_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type AutomationDscNodeConfigurationResource
+
 func resourceAutomationDscNodeConfiguration() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceAutomationDscNodeConfigurationCreate,
@@ -28,10 +31,11 @@ func resourceAutomationDscNodeConfiguration() *pluginsdk.Resource {
 		Update: resourceAutomationDscNodeConfigurationUpdate,
 		Delete: resourceAutomationDscNodeConfigurationDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := dscnodeconfiguration.ParseNodeConfigurationID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&dscnodeconfiguration.NodeConfigurationId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&dscnodeconfiguration.NodeConfigurationId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -112,6 +116,9 @@ func resourceAutomationDscNodeConfigurationCreate(d *pluginsdk.ResourceData, met
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 
 	return resourceAutomationDscNodeConfigurationRead(d, meta)
 }
@@ -190,7 +197,7 @@ func resourceAutomationDscNodeConfigurationRead(d *pluginsdk.ResourceData, meta
 
 	// cannot read back content_embedded as not part of body nor exposed through method
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceAutomationDscNodeConfigurationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
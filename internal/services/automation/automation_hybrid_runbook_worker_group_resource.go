@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2024-10-23/hybridrunbookworkergroup"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2024-10-23/listallhybridrunbookworkergroupinautomationaccount"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -18,6 +19,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name automation_hybrid_runbook_worker_group -service-package-name automation -properties "name,resource_group_name,automation_account_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type HybridRunbookWorkerGroupResource
+
 type HybridRunbookWorkerGroupModel struct {
 	ResourceGroupName     string `tfschema:"resource_group_name"`
 	AutomationAccountName string `tfschema:"automation_account_name"`
@@ -28,6 +31,7 @@ type HybridRunbookWorkerGroupModel struct {
 type HybridRunbookWorkerGroupResource struct{}
 
 var _ sdk.Resource = (*HybridRunbookWorkerGroupResource)(nil)
+var _ sdk.ResourceWithIdentity = (*HybridRunbookWorkerGroupResource)(nil)
 
 func (m HybridRunbookWorkerGroupResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
@@ -102,7 +106,7 @@ func (m HybridRunbookWorkerGroupResource) Create() sdk.ResourceFunc {
 			}
 
 			meta.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(meta.ResourceData, &id)
 		},
 	}
 }
@@ -138,6 +142,10 @@ func (m HybridRunbookWorkerGroupResource) Read() sdk.ResourceFunc {
 				}
 			}
 			output.ResourceGroupName = id.ResourceGroupName
+			if err := pluginsdk.SetResourceIdentityData(meta.ResourceData, id); err != nil {
+				return err
+			}
+
 			return meta.Encode(&output)
 		},
 	}
@@ -209,3 +217,7 @@ func (m HybridRunbookWorkerGroupResource) Delete() sdk.ResourceFunc {
 func (m HybridRunbookWorkerGroupResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return hybridrunbookworkergroup.ValidateHybridRunbookWorkerGroupID
 }
+
+func (m HybridRunbookWorkerGroupResource) Identity() resourceids.ResourceId {
+	return &hybridrunbookworkergroup.HybridRunbookWorkerGroupId{}
+}
@@ -12,12 +12,15 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2024-10-23/hybridrunbookworker"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name automation_hybrid_runbook_worker -service-package-name automation -properties "resource_group_name,automation_account_name,hybrid_runbook_worker_group_name:worker_group_name,hybrid_runbook_worker_id:worker_id" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type HybridRunbookWorkerResource
+
 type HybridRunbookWorkerModel struct {
 	ResourceGroupName     string `tfschema:"resource_group_name"`
 	AutomationAccountName string `tfschema:"automation_account_name"`
@@ -34,6 +37,7 @@ type HybridRunbookWorkerModel struct {
 type HybridRunbookWorkerResource struct{}
 
 var _ sdk.Resource = (*HybridRunbookWorkerResource)(nil)
+var _ sdk.ResourceWithIdentity = (*HybridRunbookWorkerResource)(nil)
 
 func (m HybridRunbookWorkerResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
@@ -145,7 +149,7 @@ func (m HybridRunbookWorkerResource) Create() sdk.ResourceFunc {
 			_ = future
 
 			meta.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(meta.ResourceData, &id)
 		},
 	}
 }
@@ -185,6 +189,10 @@ func (m HybridRunbookWorkerResource) Read() sdk.ResourceFunc {
 				output.Ip = pointer.From(prop.IP)
 				output.WorkerName = pointer.From(prop.WorkerName)
 			}
+			if err := pluginsdk.SetResourceIdentityData(meta.ResourceData, id); err != nil {
+				return err
+			}
+
 			return meta.Encode(&output)
 		},
 	}
@@ -211,3 +219,7 @@ func (m HybridRunbookWorkerResource) Delete() sdk.ResourceFunc {
 func (m HybridRunbookWorkerResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return hybridrunbookworker.ValidateHybridRunbookWorkerID
 }
+
+func (m HybridRunbookWorkerResource) Identity() resourceids.ResourceId {
+	return &hybridrunbookworker.HybridRunbookWorkerId{}
+}
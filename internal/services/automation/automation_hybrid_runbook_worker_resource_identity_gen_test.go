@@ -0,0 +1,43 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package automation_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccAutomationHybridRunbookWorker_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_automation_hybrid_runbook_worker", "test")
+	r := HybridRunbookWorkerResource{}
+
+	checkedFields := map[string]struct{}{
+		"subscription_id":                  {},
+		"automation_account_name":          {},
+		"hybrid_runbook_worker_group_name": {},
+		"hybrid_runbook_worker_id":         {},
+		"resource_group_name":              {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_automation_hybrid_runbook_worker.test", checkedFields),
+				statecheck.ExpectIdentityValue("azurerm_automation_hybrid_runbook_worker.test", tfjsonpath.New("subscription_id"), knownvalue.StringExact(data.Subscriptions.Primary)),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_automation_hybrid_runbook_worker.test", tfjsonpath.New("automation_account_name"), tfjsonpath.New("automation_account_name")),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_automation_hybrid_runbook_worker.test", tfjsonpath.New("hybrid_runbook_worker_group_name"), tfjsonpath.New("worker_group_name")),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_automation_hybrid_runbook_worker.test", tfjsonpath.New("hybrid_runbook_worker_id"), tfjsonpath.New("worker_id")),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_automation_hybrid_runbook_worker.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("resource_group_name")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
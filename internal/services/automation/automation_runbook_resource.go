@@ -28,6 +28,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name automation_runbook -service-package-name automation -properties "name,resource_group_name,automation_account_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-name PSWorkflow
+
 func contentLinkSchema(isDraft bool) *pluginsdk.Schema {
 	ins := &pluginsdk.Schema{
 		Type:     pluginsdk.TypeList,
@@ -86,10 +88,11 @@ func resourceAutomationRunbook() *pluginsdk.Resource {
 		Update: resourceAutomationRunbookCreateUpdate,
 		Delete: resourceAutomationRunbookDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := runbook.ParseRunbookID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&runbook.RunbookId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&runbook.RunbookId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -361,6 +364,10 @@ func resourceAutomationRunbookCreateUpdate(d *pluginsdk.ResourceData, meta inter
 		}
 
 		d.SetId(id.ID())
+		if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+			return err
+		}
+
 	}
 
 	// **don't need** to list job schedules and delete all of them. update the runbook will recreate these job schedules automatically,
@@ -472,7 +479,7 @@ func resourceAutomationRunbookRead(d *pluginsdk.ResourceData, meta interface{})
 		return err
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceAutomationRunbookDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -0,0 +1,183 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package automation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-azure-helpers/framework/convert"
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2024-10-23/job"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2024-10-23/runbook"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/custompollers"
+)
+
+type AutomationRunbookStartAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &AutomationRunbookStartAction{}
+
+func newAutomationRunbookStartAction() action.Action {
+	return &AutomationRunbookStartAction{}
+}
+
+type AutomationRunbookStartActionModel struct {
+	RunbookId         types.String                         `tfsdk:"automation_runbook_id"`
+	Parameters        typehelpers.MapValueOf[types.String] `tfsdk:"parameters"`
+	RunOn             types.String                         `tfsdk:"run_on"`
+	WaitForCompletion types.Bool                           `tfsdk:"wait_for_completion"`
+	Timeout           types.String                         `tfsdk:"timeout"`
+}
+
+func (a *AutomationRunbookStartAction) Schema(ctx context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"automation_runbook_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Automation Runbook to start a job for.",
+				MarkdownDescription: "The ID of the Automation Runbook to start a job for.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: runbook.ValidateRunbookID,
+					},
+				},
+			},
+
+			"parameters": schema.MapAttribute{
+				CustomType:          typehelpers.NewMapTypeOf[types.String](ctx),
+				ElementType:         types.StringType,
+				Optional:            true,
+				Description:         "A map of parameters to pass to the Runbook.",
+				MarkdownDescription: "A map of parameters to pass to the Runbook.",
+			},
+
+			"run_on": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The name of the Hybrid Runbook Worker Group to run the job on. Defaults to running in Azure.",
+				MarkdownDescription: "The name of the Hybrid Runbook Worker Group to run the job on. Defaults to running in Azure.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			"wait_for_completion": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Whether to poll the job for completion, reporting its output streams as they become available. Defaults to `true`.",
+				MarkdownDescription: "Whether to poll the job for completion, reporting its output streams as they become available. Defaults to `true`.",
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the action to complete. Defaults to `30m`.",
+				MarkdownDescription: "Timeout duration for the action to complete. Defaults to `30m`.",
+			},
+		},
+	}
+}
+
+func (a *AutomationRunbookStartAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_automation_runbook_start"
+}
+
+func (a *AutomationRunbookStartAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := a.Client.Automation.Job
+	streamClient := a.Client.Automation.JobStream
+
+	model := AutomationRunbookStartActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTimeout := 30 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+
+		ctxTimeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	runbookId, err := runbook.ParseRunbookID(model.RunbookId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	id := job.NewJobID(runbookId.SubscriptionId, runbookId.ResourceGroupName, runbookId.AutomationAccountName, uuid.New().String())
+
+	payload := job.JobCreateParameters{
+		Properties: job.JobCreateProperties{
+			Runbook: &job.RunbookAssociationProperty{
+				Name: pointer.To(runbookId.RunbookName),
+			},
+		},
+	}
+
+	if len(model.Parameters.Elements()) > 0 {
+		parameters := make(map[string]string)
+		convert.Expand(ctx, model.Parameters, &parameters, &response.Diagnostics)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		payload.Properties.Parameters = pointer.To(parameters)
+	}
+
+	if v := model.RunOn.ValueString(); v != "" {
+		payload.Properties.RunOn = pointer.To(v)
+	}
+
+	if _, err := client.Create(ctx, id, payload, job.DefaultCreateOperationOptions()); err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("starting %s for %s: %+v", id, runbookId, err))
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("started job %s for runbook %s", id.JobName, runbookId.RunbookName),
+	})
+
+	if !model.WaitForCompletion.IsNull() && !model.WaitForCompletion.ValueBool() {
+		return
+	}
+
+	onStream := func(streamType string, text string) {
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("[%s] %s", streamType, text),
+		})
+	}
+
+	jobPoller := custompollers.NewAutomationJobPoller(client, streamClient, id, onStream)
+	poller := pollers.NewPoller(jobPoller, 10*time.Second, pollers.DefaultNumberOfDroppedConnectionsToAllow)
+	if err := poller.PollUntilDone(ctx); err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "waiting for completion", fmt.Sprintf("waiting for %s: %+v", id, err))
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("job %s for runbook %s completed", id.JobName, runbookId.RunbookName),
+	})
+}
+
+func (a *AutomationRunbookStartAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	a.Defaults(ctx, request, response)
+}
@@ -0,0 +1,127 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package automation_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type AutomationRunbookStartAction struct{}
+
+func TestAccAutomationRunbookStartAction_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_automation_runbook_start", "test")
+	a := AutomationRunbookStartAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.basic(data),
+				Check:  nil, // TODO - plugin-testing release?
+			},
+		},
+	})
+}
+
+func TestAccAutomationRunbookStartAction_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_automation_runbook_start", "test")
+	a := AutomationRunbookStartAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.complete(data),
+				Check:  nil, // TODO - plugin-testing release?
+			},
+		},
+	})
+}
+
+func (a *AutomationRunbookStartAction) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+action "azurerm_automation_runbook_start" "test" {
+  config {
+    automation_runbook_id = azurerm_automation_runbook.test.id
+  }
+}
+`, a.template(data))
+}
+
+func (a *AutomationRunbookStartAction) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+action "azurerm_automation_runbook_start" "test" {
+  config {
+    automation_runbook_id = azurerm_automation_runbook.test.id
+    parameters = {
+      name = "terraform"
+    }
+    wait_for_completion = true
+    timeout             = "20m"
+  }
+}
+`, a.template(data))
+}
+
+func (a *AutomationRunbookStartAction) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-auto-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_automation_account" "test" {
+  name                = "acctest-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku_name            = "Basic"
+}
+
+resource "azurerm_automation_runbook" "test" {
+  name                    = "Write-Greeting"
+  location                = azurerm_resource_group.test.location
+  resource_group_name     = azurerm_resource_group.test.name
+  automation_account_name = azurerm_automation_account.test.name
+  log_verbose             = true
+  log_progress            = true
+  runbook_type            = "PowerShell"
+
+  content = <<CONTENT
+param([string]$name = "world")
+Write-Output "hello $name"
+CONTENT
+}
+
+resource "terraform_data" "trigger" {
+  input = azurerm_automation_runbook.test.id
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_automation_runbook_start.test]
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
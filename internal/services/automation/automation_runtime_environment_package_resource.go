@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2024-10-23/packageresource"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -19,7 +20,10 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name automation_runtime_environment_package -service-package-name automation -properties "name" -compare-values "subscription_id:automation_runtime_environment_id,resource_group_name:automation_runtime_environment_id,automation_account_name:automation_runtime_environment_id,runtime_environment_name:automation_runtime_environment_id"
+
 var _ sdk.Resource = AutomationRuntimeEnvironmentPackageResource{}
+var _ sdk.ResourceWithIdentity = AutomationRuntimeEnvironmentPackageResource{}
 
 type AutomationRuntimeEnvironmentPackageModel struct {
 	Name                           string `tfschema:"name"`
@@ -117,6 +121,10 @@ func (r AutomationRuntimeEnvironmentPackageResource) IDValidationFunc() pluginsd
 	return packageresource.ValidatePackageID
 }
 
+func (r AutomationRuntimeEnvironmentPackageResource) Identity() resourceids.ResourceId {
+	return &packageresource.PackageId{}
+}
+
 func (r AutomationRuntimeEnvironmentPackageResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -174,7 +182,7 @@ func (r AutomationRuntimeEnvironmentPackageResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -238,6 +246,10 @@ func (r AutomationRuntimeEnvironmentPackageResource) Read() sdk.ResourceFunc {
 				output.Default = pointer.From(props.Default)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&output)
 		},
 	}
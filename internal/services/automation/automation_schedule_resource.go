@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2024-10-23/schedule"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	azvalidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -29,6 +30,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name automation_schedule -service-package-name automation -properties "name,resource_group_name,automation_account_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-name oneTime_basic
+
 func resourceAutomationSchedule() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceAutomationScheduleCreate,
@@ -36,10 +39,11 @@ func resourceAutomationSchedule() *pluginsdk.Resource {
 		Update: resourceAutomationScheduleUpdate,
 		Delete: resourceAutomationScheduleDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := schedule.ParseScheduleID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&schedule.ScheduleId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&schedule.ScheduleId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -288,6 +292,9 @@ func resourceAutomationScheduleCreate(d *pluginsdk.ResourceData, meta interface{
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 
 	return resourceAutomationScheduleRead(d, meta)
 }
@@ -435,7 +442,7 @@ func resourceAutomationScheduleRead(d *pluginsdk.ResourceData, meta interface{})
 			}
 		}
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceAutomationScheduleDelete(d *pluginsdk.ResourceData, meta interface{}) error {
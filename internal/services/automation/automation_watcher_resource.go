@@ -11,12 +11,15 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2020-01-13-preview/watcher"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name automation_watcher -service-package-name automation -properties "name" -compare-values "subscription_id:automation_account_id,resource_group_name:automation_account_id,automation_account_name:automation_account_id" -test-resource-type WatcherResource
+
 type WatcherModel struct {
 	AutomationAccountID         string                 `tfschema:"automation_account_id"`
 	Name                        string                 `tfschema:"name"`
@@ -34,6 +37,7 @@ type WatcherModel struct {
 type WatcherResource struct{}
 
 var _ sdk.Resource = (*WatcherResource)(nil)
+var _ sdk.ResourceWithIdentity = (*WatcherResource)(nil)
 
 func (m WatcherResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
@@ -158,7 +162,7 @@ func (m WatcherResource) Create() sdk.ResourceFunc {
 			}
 
 			meta.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(meta.ResourceData, &id)
 		},
 	}
 }
@@ -205,6 +209,10 @@ func (m WatcherResource) Read() sdk.ResourceFunc {
 
 			// tags, etag and location are not returned by response, so do NOT encode them
 
+			if err := pluginsdk.SetResourceIdentityData(meta.ResourceData, id); err != nil {
+				return err
+			}
+
 			return meta.Encode(&output)
 		},
 	}
@@ -261,3 +269,7 @@ func (m WatcherResource) Delete() sdk.ResourceFunc {
 func (m WatcherResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return watcher.ValidateWatcherID
 }
+
+func (m WatcherResource) Identity() resourceids.ResourceId {
+	return &watcher.WatcherId{}
+}
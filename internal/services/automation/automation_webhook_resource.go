@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2015-10-31/webhook"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/migration"
@@ -23,6 +24,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name automation_webhook -service-package-name automation -properties "name,resource_group_name,automation_account_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 func resourceAutomationWebhook() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceAutomationWebhookCreate,
@@ -30,10 +33,11 @@ func resourceAutomationWebhook() *pluginsdk.Resource {
 		Update: resourceAutomationWebhookUpdate,
 		Delete: resourceAutomationWebhookDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := webhook.ParseWebHookID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&webhook.WebHookId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&webhook.WebHookId{}),
+		},
 
 		SchemaVersion: 1,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
@@ -162,6 +166,10 @@ func resourceAutomationWebhookCreate(d *pluginsdk.ResourceData, meta interface{}
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
+
 	// URI is not present in the response from Azure, so it's set now, as there was no error returned
 	if uri != "" {
 		d.Set("uri", uri)
@@ -258,7 +266,7 @@ func resourceAutomationWebhookRead(d *pluginsdk.ResourceData, meta interface{})
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceAutomationWebhookDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -0,0 +1,131 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package custompollers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2024-10-23/job"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2024-10-23/jobstream"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+)
+
+var _ pollers.PollerType = &automationJobPoller{}
+
+// automationJobPoller polls an Automation Job until it reaches a terminal status, passing any
+// new entries from the job's output streams to the supplied callback as they become available.
+type automationJobPoller struct {
+	client       *job.JobClient
+	streamClient *jobstream.JobStreamClient
+	id           job.JobId
+	onStream     func(streamType string, text string)
+	seenStreams  map[string]struct{}
+}
+
+var (
+	pollingFailed = pollers.PollResult{
+		Status: pollers.PollingStatusFailed,
+	}
+
+	jobStatusToResult = map[job.JobStatus]pollers.PollResult{
+		job.JobStatusCompleted: pollingSuccess,
+
+		job.JobStatusActivating:   pollingInProgress,
+		job.JobStatusBlocked:      pollingInProgress,
+		job.JobStatusDisconnected: pollingInProgress,
+		job.JobStatusNew:          pollingInProgress,
+		job.JobStatusResuming:     pollingInProgress,
+		job.JobStatusRunning:      pollingInProgress,
+		job.JobStatusStopping:     pollingInProgress,
+		job.JobStatusSuspending:   pollingInProgress,
+
+		job.JobStatusFailed:    pollingFailed,
+		job.JobStatusRemoving:  pollingFailed,
+		job.JobStatusStopped:   pollingFailed,
+		job.JobStatusSuspended: pollingFailed,
+	}
+)
+
+func NewAutomationJobPoller(client *job.JobClient, streamClient *jobstream.JobStreamClient, id job.JobId, onStream func(streamType string, text string)) *automationJobPoller {
+	return &automationJobPoller{
+		client:       client,
+		streamClient: streamClient,
+		id:           id,
+		onStream:     onStream,
+		seenStreams:  make(map[string]struct{}),
+	}
+}
+
+func (p *automationJobPoller) Poll(ctx context.Context) (*pollers.PollResult, error) {
+	resp, err := p.client.Get(ctx, p.id, job.DefaultGetOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", p.id, err)
+	}
+
+	if resp.Model == nil || resp.Model.Properties == nil {
+		return nil, fmt.Errorf("polling for %s: `properties` was nil", p.id)
+	}
+	props := resp.Model.Properties
+
+	if err := p.flushStreams(ctx); err != nil {
+		return nil, err
+	}
+
+	status := pointer.From(props.Status)
+	result, ok := jobStatusToResult[status]
+	if !ok {
+		return nil, fmt.Errorf("polling for %s: unexpected status %q", p.id, status)
+	}
+
+	if result.Status == pollers.PollingStatusFailed {
+		message := fmt.Sprintf("job finished with status %q", status)
+		if v := pointer.From(props.Exception); v != "" {
+			message = fmt.Sprintf("%s: %s", message, v)
+		}
+
+		return nil, pollers.PollingFailedError{
+			Message: message,
+		}
+	}
+
+	return &result, nil
+}
+
+// flushStreams retrieves the job streams and passes any which have not been seen before to the callback.
+func (p *automationJobPoller) flushStreams(ctx context.Context) error {
+	if p.onStream == nil {
+		return nil
+	}
+
+	streamJobId := jobstream.NewJobID(p.id.SubscriptionId, p.id.ResourceGroupName, p.id.AutomationAccountName, p.id.JobName)
+	resp, err := p.streamClient.ListByJobComplete(ctx, streamJobId, jobstream.DefaultListByJobOperationOptions())
+	if err != nil {
+		return fmt.Errorf("listing streams for %s: %+v", p.id, err)
+	}
+
+	for _, item := range resp.Items {
+		props := item.Properties
+		if props == nil {
+			continue
+		}
+
+		streamId := pointer.From(props.JobStreamId)
+		if _, seen := p.seenStreams[streamId]; seen {
+			continue
+		}
+		p.seenStreams[streamId] = struct{}{}
+
+		text := pointer.From(props.StreamText)
+		if text == "" {
+			text = pointer.From(props.Summary)
+		}
+
+		p.onStream(string(pointer.From(props.StreamType)), strings.TrimSpace(text))
+	}
+
+	return nil
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2024-01-01/deploymentsettings"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2024-01-01/storagecontainers"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/extendedlocation/2021-08-15/customlocations"
@@ -26,7 +27,10 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name stack_hci_deployment_setting -service-package-name azurestackhci -compare-values "subscription_id:stack_hci_cluster_id,resource_group_name:stack_hci_cluster_id,cluster_name:stack_hci_cluster_id" -known-values "name:default" -test-resource-type StackHCIDeploymentSettingResource
+
 var _ sdk.Resource = StackHCIDeploymentSettingResource{}
+var _ sdk.ResourceWithIdentity = StackHCIDeploymentSettingResource{}
 
 type StackHCIDeploymentSettingResource struct{}
 
@@ -34,6 +38,10 @@ func (StackHCIDeploymentSettingResource) IDValidationFunc() pluginsdk.SchemaVali
 	return deploymentsettings.ValidateDeploymentSettingID
 }
 
+func (StackHCIDeploymentSettingResource) Identity() resourceids.ResourceId {
+	return &deploymentsettings.DeploymentSettingId{}
+}
+
 func (StackHCIDeploymentSettingResource) ResourceType() string {
 	return "azurerm_stack_hci_deployment_setting"
 }
@@ -755,6 +763,9 @@ func (r StackHCIDeploymentSettingResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			return nil
 		},
@@ -793,6 +804,10 @@ func (StackHCIDeploymentSettingResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&schema)
 		},
 	}
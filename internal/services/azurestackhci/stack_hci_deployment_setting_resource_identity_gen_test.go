@@ -0,0 +1,41 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package azurestackhci_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccStackHciDeploymentSetting_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_deployment_setting", "test")
+	r := StackHCIDeploymentSettingResource{}
+
+	checkedFields := map[string]struct{}{
+		"name":                {},
+		"cluster_name":        {},
+		"resource_group_name": {},
+		"subscription_id":     {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_stack_hci_deployment_setting.test", checkedFields),
+				statecheck.ExpectIdentityValue("azurerm_stack_hci_deployment_setting.test", tfjsonpath.New("name"), knownvalue.StringExact("default")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_stack_hci_deployment_setting.test", tfjsonpath.New("cluster_name"), tfjsonpath.New("stack_hci_cluster_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_stack_hci_deployment_setting.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("stack_hci_cluster_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_stack_hci_deployment_setting.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("stack_hci_cluster_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
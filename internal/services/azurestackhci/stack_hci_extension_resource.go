@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2024-01-01/extensions"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -17,9 +18,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name stack_hci_extension -service-package-name azurestackhci -properties "name" -compare-values "subscription_id:arc_setting_id,resource_group_name:arc_setting_id,cluster_name:arc_setting_id,arc_setting_name:arc_setting_id" -test-resource-type StackHCIExtensionResource
+
 var (
-	_ sdk.Resource           = StackHCIExtensionResource{}
-	_ sdk.ResourceWithUpdate = StackHCIExtensionResource{}
+	_ sdk.Resource             = StackHCIExtensionResource{}
+	_ sdk.ResourceWithUpdate   = StackHCIExtensionResource{}
+	_ sdk.ResourceWithIdentity = StackHCIExtensionResource{}
 )
 
 type StackHCIExtensionResource struct{}
@@ -36,6 +40,10 @@ func (r StackHCIExtensionResource) IDValidationFunc() pluginsdk.SchemaValidateFu
 	return extensions.ValidateExtensionID
 }
 
+func (r StackHCIExtensionResource) Identity() resourceids.ResourceId {
+	return &extensions.ExtensionId{}
+}
+
 type StackHCIExtensionResourceModel struct {
 	Name                           string `tfschema:"name"`
 	ArcSettingId                   string `tfschema:"arc_setting_id"`
@@ -203,7 +211,7 @@ func (r StackHCIExtensionResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -260,6 +268,10 @@ func (r StackHCIExtensionResource) Read() sdk.ResourceFunc {
 
 			metadata.SetID(id)
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&extension)
 		},
 	}
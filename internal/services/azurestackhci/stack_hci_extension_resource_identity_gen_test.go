@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package azurestackhci_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccStackHciExtension_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_stack_hci_extension", "test")
+	r := StackHCIExtensionResource{}
+
+	checkedFields := map[string]struct{}{
+		"name":                {},
+		"arc_setting_name":    {},
+		"cluster_name":        {},
+		"resource_group_name": {},
+		"subscription_id":     {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_stack_hci_extension.test", checkedFields),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_stack_hci_extension.test", tfjsonpath.New("name"), tfjsonpath.New("name")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_stack_hci_extension.test", tfjsonpath.New("arc_setting_name"), tfjsonpath.New("arc_setting_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_stack_hci_extension.test", tfjsonpath.New("cluster_name"), tfjsonpath.New("arc_setting_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_stack_hci_extension.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("arc_setting_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_stack_hci_extension.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("arc_setting_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
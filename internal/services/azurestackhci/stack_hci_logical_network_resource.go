@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2024-01-01/logicalnetworks"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/extendedlocation/2021-08-15/customlocations"
@@ -21,9 +22,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name stack_hci_logical_network -service-package-name azurestackhci -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type StackHCILogicalNetworkResource
+
 var (
-	_ sdk.Resource           = StackHCILogicalNetworkResource{}
-	_ sdk.ResourceWithUpdate = StackHCILogicalNetworkResource{}
+	_ sdk.Resource             = StackHCILogicalNetworkResource{}
+	_ sdk.ResourceWithUpdate   = StackHCILogicalNetworkResource{}
+	_ sdk.ResourceWithIdentity = StackHCILogicalNetworkResource{}
 )
 
 type StackHCILogicalNetworkResource struct{}
@@ -32,6 +36,10 @@ func (StackHCILogicalNetworkResource) IDValidationFunc() pluginsdk.SchemaValidat
 	return logicalnetworks.ValidateLogicalNetworkID
 }
 
+func (StackHCILogicalNetworkResource) Identity() resourceids.ResourceId {
+	return &logicalnetworks.LogicalNetworkId{}
+}
+
 func (StackHCILogicalNetworkResource) ResourceType() string {
 	return "azurerm_stack_hci_logical_network"
 }
@@ -249,6 +257,9 @@ func (r StackHCILogicalNetworkResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			return nil
 		},
@@ -303,6 +314,10 @@ func (r StackHCILogicalNetworkResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&schema)
 		},
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2024-01-01/logicalnetworks"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2024-01-01/networkinterfaces"
@@ -22,9 +23,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name stack_hci_network_interface -service-package-name azurestackhci -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type StackHCINetworkInterfaceResource
+
 var (
-	_ sdk.Resource           = StackHCINetworkInterfaceResource{}
-	_ sdk.ResourceWithUpdate = StackHCINetworkInterfaceResource{}
+	_ sdk.Resource             = StackHCINetworkInterfaceResource{}
+	_ sdk.ResourceWithUpdate   = StackHCINetworkInterfaceResource{}
+	_ sdk.ResourceWithIdentity = StackHCINetworkInterfaceResource{}
 )
 
 type StackHCINetworkInterfaceResource struct{}
@@ -33,6 +37,10 @@ func (StackHCINetworkInterfaceResource) IDValidationFunc() pluginsdk.SchemaValid
 	return networkinterfaces.ValidateNetworkInterfaceID
 }
 
+func (StackHCINetworkInterfaceResource) Identity() resourceids.ResourceId {
+	return &networkinterfaces.NetworkInterfaceId{}
+}
+
 func (StackHCINetworkInterfaceResource) ResourceType() string {
 	return "azurerm_stack_hci_network_interface"
 }
@@ -182,6 +190,9 @@ func (r StackHCINetworkInterfaceResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			return nil
 		},
@@ -241,6 +252,10 @@ func (r StackHCINetworkInterfaceResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&schema)
 		},
 	}
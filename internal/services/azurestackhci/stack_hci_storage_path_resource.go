@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2024-01-01/storagecontainers"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/extendedlocation/2021-08-15/customlocations"
@@ -21,9 +22,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name stack_hci_storage_path -service-package-name azurestackhci -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type StackHCIStoragePathResource
+
 var (
-	_ sdk.Resource           = StackHCIStoragePathResource{}
-	_ sdk.ResourceWithUpdate = StackHCIStoragePathResource{}
+	_ sdk.Resource             = StackHCIStoragePathResource{}
+	_ sdk.ResourceWithUpdate   = StackHCIStoragePathResource{}
+	_ sdk.ResourceWithIdentity = StackHCIStoragePathResource{}
 )
 
 type StackHCIStoragePathResource struct{}
@@ -32,6 +36,10 @@ func (StackHCIStoragePathResource) IDValidationFunc() pluginsdk.SchemaValidateFu
 	return storagecontainers.ValidateStorageContainerID
 }
 
+func (StackHCIStoragePathResource) Identity() resourceids.ResourceId {
+	return &storagecontainers.StorageContainerId{}
+}
+
 func (StackHCIStoragePathResource) ResourceType() string {
 	return "azurerm_stack_hci_storage_path"
 }
@@ -127,6 +135,9 @@ func (r StackHCIStoragePathResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			return nil
 		},
@@ -176,6 +187,10 @@ func (r StackHCIStoragePathResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&schema)
 		},
 	}
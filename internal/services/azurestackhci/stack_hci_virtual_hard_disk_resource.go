@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2024-01-01/storagecontainers"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2024-01-01/virtualharddisks"
@@ -22,9 +23,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name stack_hci_virtual_hard_disk -service-package-name azurestackhci -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type StackHCIVirtualHardDiskResource
+
 var (
-	_ sdk.Resource           = StackHCIVirtualHardDiskResource{}
-	_ sdk.ResourceWithUpdate = StackHCIVirtualHardDiskResource{}
+	_ sdk.Resource             = StackHCIVirtualHardDiskResource{}
+	_ sdk.ResourceWithUpdate   = StackHCIVirtualHardDiskResource{}
+	_ sdk.ResourceWithIdentity = StackHCIVirtualHardDiskResource{}
 )
 
 type StackHCIVirtualHardDiskResource struct{}
@@ -33,6 +37,10 @@ func (StackHCIVirtualHardDiskResource) IDValidationFunc() pluginsdk.SchemaValida
 	return virtualharddisks.ValidateVirtualHardDiskID
 }
 
+func (StackHCIVirtualHardDiskResource) Identity() resourceids.ResourceId {
+	return &virtualharddisks.VirtualHardDiskId{}
+}
+
 func (StackHCIVirtualHardDiskResource) ResourceType() string {
 	return "azurerm_stack_hci_virtual_hard_disk"
 }
@@ -210,6 +218,9 @@ func (r StackHCIVirtualHardDiskResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			return nil
 		},
@@ -266,6 +277,10 @@ func (r StackHCIVirtualHardDiskResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&schema)
 		},
 	}
@@ -12,13 +12,17 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/chaosstudio/2023-11-01/capabilities"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/chaosstudio/2023-11-01/capabilitytypes"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name chaos_studio_capability -service-package-name chaosstudio -properties "name:capability_type" -compare-values "scope:chaos_studio_target_id,target_name:chaos_studio_target_id" -no-subscription-id -test-resource-type ChaosStudioCapabilityTestResource
+
 var _ sdk.Resource = ChaosStudioCapabilityResource{}
+var _ sdk.ResourceWithIdentity = ChaosStudioCapabilityResource{}
 
 type ChaosStudioCapabilityResource struct{}
 
@@ -36,6 +40,10 @@ func (r ChaosStudioCapabilityResource) IDValidationFunc() pluginsdk.SchemaValida
 	return commonids.ValidateChaosStudioCapabilityID
 }
 
+func (r ChaosStudioCapabilityResource) Identity() resourceids.ResourceId {
+	return &commonids.ChaosStudioCapabilityId{}
+}
+
 func (r ChaosStudioCapabilityResource) ResourceType() string {
 	return "azurerm_chaos_studio_capability"
 }
@@ -139,7 +147,7 @@ func (r ChaosStudioCapabilityResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 		Timeout: 30 * time.Minute,
 	}
@@ -175,6 +183,10 @@ func (r ChaosStudioCapabilityResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&schema)
 		},
 		Timeout: 5 * time.Minute,
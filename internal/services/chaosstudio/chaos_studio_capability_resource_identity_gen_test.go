@@ -0,0 +1,38 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package chaosstudio_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccChaosStudioCapability_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_chaos_studio_capability", "test")
+	r := ChaosStudioCapabilityTestResource{}
+
+	checkedFields := map[string]struct{}{
+		"name":        {},
+		"scope":       {},
+		"target_name": {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_chaos_studio_capability.test", checkedFields),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_chaos_studio_capability.test", tfjsonpath.New("name"), tfjsonpath.New("capability_type")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_chaos_studio_capability.test", tfjsonpath.New("scope"), tfjsonpath.New("chaos_studio_target_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_chaos_studio_capability.test", tfjsonpath.New("target_name"), tfjsonpath.New("chaos_studio_target_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
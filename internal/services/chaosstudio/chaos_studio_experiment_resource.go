@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/chaosstudio/2023-11-01/experiments"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -22,9 +23,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name chaos_studio_experiment -service-package-name chaosstudio -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type ChaosStudioExperimentTestResource
+
 var (
-	_ sdk.Resource           = ChaosStudioExperimentResource{}
-	_ sdk.ResourceWithUpdate = ChaosStudioExperimentResource{}
+	_ sdk.Resource             = ChaosStudioExperimentResource{}
+	_ sdk.ResourceWithUpdate   = ChaosStudioExperimentResource{}
+	_ sdk.ResourceWithIdentity = ChaosStudioExperimentResource{}
 )
 
 const (
@@ -77,6 +81,10 @@ func (r ChaosStudioExperimentResource) IDValidationFunc() pluginsdk.SchemaValida
 	return experiments.ValidateExperimentID
 }
 
+func (r ChaosStudioExperimentResource) Identity() resourceids.ResourceId {
+	return &experiments.ExperimentId{}
+}
+
 func (r ChaosStudioExperimentResource) ResourceType() string {
 	return "azurerm_chaos_studio_experiment"
 }
@@ -243,7 +251,7 @@ func (r ChaosStudioExperimentResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -295,6 +303,10 @@ func (r ChaosStudioExperimentResource) Read() sdk.ResourceFunc {
 				schema.Identity = *flattenedIdentity
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&schema)
 		},
 	}
@@ -13,12 +13,15 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/codesigning/2024-09-30-preview/codesigningaccounts"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name trusted_signing_account -service-package-name codesigning -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 type TrustedSigningAccountModel struct {
 	Name              string            `tfschema:"name"`
 	Location          string            `tfschema:"location"`
@@ -31,6 +34,7 @@ type TrustedSigningAccountModel struct {
 type TrustedSigningAccountResource struct{}
 
 var _ sdk.Resource = (*TrustedSigningAccountResource)(nil)
+var _ sdk.ResourceWithIdentity = (*TrustedSigningAccountResource)(nil)
 
 func (m TrustedSigningAccountResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
@@ -118,7 +122,7 @@ func (m TrustedSigningAccountResource) Create() sdk.ResourceFunc {
 			}
 
 			meta.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(meta.ResourceData, &id)
 		},
 	}
 }
@@ -136,6 +140,9 @@ func (m TrustedSigningAccountResource) Read() sdk.ResourceFunc {
 
 			result, err := client.Get(ctx, *id)
 			if err != nil {
+				if response.WasNotFound(result.HttpResponse) {
+					return meta.MarkAsGone(id)
+				}
 				return fmt.Errorf("retrieving %s: %+v", id, err)
 			}
 
@@ -156,6 +163,10 @@ func (m TrustedSigningAccountResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(meta.ResourceData, id); err != nil {
+				return err
+			}
+
 			return meta.Encode(&output)
 		},
 	}
@@ -220,3 +231,7 @@ func (m TrustedSigningAccountResource) Delete() sdk.ResourceFunc {
 func (m TrustedSigningAccountResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return codesigningaccounts.ValidateCodeSigningAccountID
 }
+
+func (m TrustedSigningAccountResource) Identity() resourceids.ResourceId {
+	return &codesigningaccounts.CodeSigningAccountId{}
+}
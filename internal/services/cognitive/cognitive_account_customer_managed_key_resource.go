@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/keyvault"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2025-06-01/cognitiveservicesaccounts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
@@ -18,6 +19,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name cognitive_account_customer_managed_key -service-package-name cognitive -compare-values "subscription_id:cognitive_account_id,resource_group_name:cognitive_account_id,name:cognitive_account_id"
+
 func resourceCognitiveAccountCustomerManagedKey() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceCognitiveAccountCustomerManagedKeyCreateUpdate,
@@ -32,10 +35,11 @@ func resourceCognitiveAccountCustomerManagedKey() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := cognitiveservicesaccounts.ParseAccountID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&cognitiveservicesaccounts.AccountId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&cognitiveservicesaccounts.AccountId{}),
+		},
 
 		Schema: map[string]*pluginsdk.Schema{
 			"cognitive_account_id": {
@@ -126,6 +130,9 @@ func resourceCognitiveAccountCustomerManagedKeyCreateUpdate(d *pluginsdk.Resourc
 		return fmt.Errorf("waiting for update of %s: %+v", id, err)
 	}
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, id); err != nil {
+		return err
+	}
 
 	return resourceCognitiveAccountCustomerManagedKeyRead(d, meta)
 }
@@ -163,7 +170,7 @@ func resourceCognitiveAccountCustomerManagedKeyRead(d *pluginsdk.ResourceData, m
 		d.Set("identity_client_id", props.IdentityClientId)
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceCognitiveAccountCustomerManagedKeyDelete(d *pluginsdk.ResourceData, meta interface{}) error {
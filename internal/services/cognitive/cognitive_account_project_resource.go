@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2025-06-01/cognitiveservicesaccounts"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2025-06-01/cognitiveservicesprojects"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -19,6 +20,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name cognitive_account_project -service-package-name cognitive -properties "name" -compare-values "subscription_id:cognitive_account_id,resource_group_name:cognitive_account_id,account_name:cognitive_account_id"
+
 type CognitiveAccountProjectModel struct {
 	CognitiveAccountId string                                     `tfschema:"cognitive_account_id"`
 	Default            bool                                       `tfschema:"default"`
@@ -34,6 +37,7 @@ type CognitiveAccountProjectModel struct {
 var (
 	_ sdk.ResourceWithUpdate        = CognitiveAccountProjectResource{}
 	_ sdk.ResourceWithCustomizeDiff = CognitiveAccountProjectResource{}
+	_ sdk.ResourceWithIdentity      = CognitiveAccountProjectResource{}
 )
 
 type CognitiveAccountProjectResource struct{}
@@ -50,8 +54,13 @@ func (r CognitiveAccountProjectResource) IDValidationFunc() pluginsdk.SchemaVali
 	return cognitiveservicesprojects.ValidateProjectID
 }
 
+func (r CognitiveAccountProjectResource) Identity() resourceids.ResourceId {
+	return &cognitiveservicesprojects.ProjectId{}
+}
+
 func (r CognitiveAccountProjectResource) CustomizeDiff() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
 			if metadata.ResourceDiff == nil {
 				return nil
@@ -169,6 +178,9 @@ func (r CognitiveAccountProjectResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			return nil
 		},
@@ -279,6 +291,10 @@ func (r CognitiveAccountProjectResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
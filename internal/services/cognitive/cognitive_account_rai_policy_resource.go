@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2025-06-01/raipolicies"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -18,7 +19,10 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name cognitive_account_rai_policy -service-package-name cognitive -properties "name" -compare-values "subscription_id:cognitive_account_id,resource_group_name:cognitive_account_id,account_name:cognitive_account_id" -test-resource-type RaiPolicyTestResource
+
 var _ sdk.ResourceWithUpdate = &CognitiveAccountRaiPolicyResource{}
+var _ sdk.ResourceWithIdentity = &CognitiveAccountRaiPolicyResource{}
 
 type CognitiveAccountRaiPolicyResource struct{}
 
@@ -171,6 +175,9 @@ func (r CognitiveAccountRaiPolicyResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			return nil
 		},
@@ -213,6 +220,10 @@ func (r CognitiveAccountRaiPolicyResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -304,6 +315,10 @@ func (r CognitiveAccountRaiPolicyResource) IDValidationFunc() pluginsdk.SchemaVa
 	return raipolicies.ValidateRaiPolicyID
 }
 
+func (r CognitiveAccountRaiPolicyResource) Identity() resourceids.ResourceId {
+	return &raipolicies.RaiPolicyId{}
+}
+
 func expandRaiPolicyContentFilters(filters []AccountRaiPolicyContentFilter) *[]raipolicies.RaiPolicyContentFilter {
 	if filters == nil {
 		return nil
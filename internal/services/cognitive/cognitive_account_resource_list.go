@@ -48,7 +48,7 @@ func (r CognitiveAccountListResource) List(ctx context.Context, request list.Lis
 
 	switch {
 	case !data.ResourceGroupName.IsNull():
-		resp, err := client.AccountsListByResourceGroupComplete(ctx, commonids.NewResourceGroupID(subscriptionID, data.ResourceGroupName.ValueString()))
+		resp, err := client.AccountsListByResourceGroupComplete(sdk.ListPageLimitContext(ctx, request), commonids.NewResourceGroupID(subscriptionID, data.ResourceGroupName.ValueString()))
 		if err != nil {
 			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", azureCognitiveAccountResourceName), err)
 			return
@@ -56,7 +56,7 @@ func (r CognitiveAccountListResource) List(ctx context.Context, request list.Lis
 
 		results = resp.Items
 	default:
-		resp, err := client.AccountsListComplete(ctx, commonids.NewSubscriptionID(subscriptionID))
+		resp, err := client.AccountsListComplete(sdk.ListPageLimitContext(ctx, request), commonids.NewSubscriptionID(subscriptionID))
 		if err != nil {
 			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", azureCognitiveAccountResourceName), err)
 			return
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2025-06-01/cognitiveservicesaccounts"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2025-06-01/deployments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
@@ -18,6 +19,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name cognitive_deployment -service-package-name cognitive -properties "name" -compare-values "subscription_id:cognitive_account_id,resource_group_name:cognitive_account_id,account_name:cognitive_account_id" -test-resource-type CognitiveDeploymentTestResource
+
 type cognitiveDeploymentModel struct {
 	Name                     string                 `tfschema:"name"`
 	CognitiveAccountId       string                 `tfschema:"cognitive_account_id"`
@@ -45,6 +48,7 @@ type DeploymentSkuModel struct {
 type CognitiveDeploymentResource struct{}
 
 var _ sdk.Resource = CognitiveDeploymentResource{}
+var _ sdk.ResourceWithIdentity = CognitiveDeploymentResource{}
 
 func (r CognitiveDeploymentResource) ResourceType() string {
 	return "azurerm_cognitive_deployment"
@@ -58,6 +62,10 @@ func (r CognitiveDeploymentResource) IDValidationFunc() pluginsdk.SchemaValidate
 	return deployments.ValidateDeploymentID
 }
 
+func (r CognitiveDeploymentResource) Identity() resourceids.ResourceId {
+	return &deployments.DeploymentId{}
+}
+
 func (r CognitiveDeploymentResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -244,7 +252,7 @@ func (r CognitiveDeploymentResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -346,6 +354,10 @@ func (r CognitiveDeploymentResource) Read() sdk.ResourceFunc {
 			if sku := flattenDeploymentSkuModel(model.Sku); sku != nil {
 				state.Sku = sku
 			}
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -4,6 +4,7 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -23,6 +24,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2023-04-02/disks"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachines"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -36,16 +38,20 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name linux_virtual_machine -service-package-name compute -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-name "authPassword"
+
 func resourceLinuxVirtualMachine() *pluginsdk.Resource {
 	resource := &pluginsdk.Resource{
 		Create: resourceLinuxVirtualMachineCreate,
 		Read:   resourceLinuxVirtualMachineRead,
 		Update: resourceLinuxVirtualMachineUpdate,
 		Delete: resourceLinuxVirtualMachineDelete,
-		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
-			_, err := commonids.ParseVirtualMachineID(id)
-			return err
-		}, importVirtualMachine(virtualmachines.OperatingSystemTypesLinux, "azurerm_linux_virtual_machine")),
+
+		Importer: pluginsdk.ImporterValidatingIdentityThen(&virtualmachines.VirtualMachineId{}, importVirtualMachine(virtualmachines.OperatingSystemTypesLinux, "azurerm_linux_virtual_machine")),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&virtualmachines.VirtualMachineId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(45 * time.Minute),
@@ -874,14 +880,14 @@ func resourceLinuxVirtualMachineCreate(d *pluginsdk.ResourceData, meta interface
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 	return resourceLinuxVirtualMachineRead(d, meta)
 }
 
 func resourceLinuxVirtualMachineRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Compute.VirtualMachinesClient
-	disksClient := meta.(*clients.Client).Compute.DisksClient
-	networkInterfacesClient := meta.(*clients.Client).Network.NetworkInterfacesClient
-	publicIPAddressesClient := meta.(*clients.Client).Network.PublicIPAddresses
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -903,10 +909,18 @@ func resourceLinuxVirtualMachineRead(d *pluginsdk.ResourceData, meta interface{}
 		return fmt.Errorf("retrieving Linux %s: %+v", id, err)
 	}
 
+	return resourceLinuxVirtualMachineFlatten(ctx, meta.(*clients.Client), d, id, resp.Model, true)
+}
+
+func resourceLinuxVirtualMachineFlatten(ctx context.Context, metaClient *clients.Client, d *pluginsdk.ResourceData, id *virtualmachines.VirtualMachineId, model *virtualmachines.VirtualMachine, includeResource bool) error {
 	d.Set("name", id.VirtualMachineName)
 	d.Set("resource_group_name", id.ResourceGroupName)
 
-	if model := resp.Model; model != nil {
+	disksClient := metaClient.Compute.DisksClient
+	networkInterfacesClient := metaClient.Network.NetworkInterfacesClient
+	publicIPAddressesClient := metaClient.Network.PublicIPAddresses
+
+	if model != nil {
 		d.Set("location", location.Normalize(model.Location))
 		d.Set("edge_zone", flattenEdgeZone(model.ExtendedLocation))
 
@@ -1066,13 +1080,15 @@ func resourceLinuxVirtualMachineRead(d *pluginsdk.ResourceData, meta interface{}
 			if profile := props.StorageProfile; profile != nil {
 				d.Set("disk_controller_type", string(pointer.From(props.StorageProfile.DiskControllerType)))
 
-				// the storage_account_type isn't returned so we need to look it up
-				flattenedOSDisk, err := flattenVirtualMachineOSDisk(ctx, disksClient, profile.OsDisk)
-				if err != nil {
-					return fmt.Errorf("flattening `os_disk`: %+v", err)
-				}
-				if err := d.Set("os_disk", flattenedOSDisk); err != nil {
-					return fmt.Errorf("settings `os_disk`: %+v", err)
+				if includeResource {
+					// the storage_account_type isn't returned so we need to look it up
+					flattenedOSDisk, err := flattenVirtualMachineOSDisk(ctx, disksClient, profile.OsDisk)
+					if err != nil {
+						return fmt.Errorf("flattening `os_disk`: %+v", err)
+					}
+					if err := d.Set("os_disk", flattenedOSDisk); err != nil {
+						return fmt.Errorf("settings `os_disk`: %+v", err)
+					}
 				}
 				osManagedDiskId := ""
 				if profile.OsDisk != nil && profile.OsDisk.ManagedDisk != nil && profile.OsDisk.ManagedDisk.Id != nil {
@@ -1135,19 +1151,23 @@ func resourceLinuxVirtualMachineRead(d *pluginsdk.ResourceData, meta interface{}
 			d.Set("virtual_machine_id", props.VMId)
 			d.Set("user_data", props.UserData)
 
-			connectionInfo := retrieveConnectionInformation(ctx, networkInterfacesClient, publicIPAddressesClient, props)
-			d.Set("private_ip_address", connectionInfo.primaryPrivateAddress)
-			d.Set("private_ip_addresses", connectionInfo.privateAddresses)
-			d.Set("public_ip_address", connectionInfo.primaryPublicAddress)
-			d.Set("public_ip_addresses", connectionInfo.publicAddresses)
-			isWindows := false
-			setConnectionInformation(d, connectionInfo, isWindows)
+			// the connection information is looked up from the Network Interfaces and Public IP Addresses
+			if includeResource {
+				connectionInfo := retrieveConnectionInformation(ctx, networkInterfacesClient, publicIPAddressesClient, props)
+				d.Set("private_ip_address", connectionInfo.primaryPrivateAddress)
+				d.Set("private_ip_addresses", connectionInfo.privateAddresses)
+				d.Set("public_ip_address", connectionInfo.primaryPublicAddress)
+				d.Set("public_ip_addresses", connectionInfo.publicAddresses)
+				isWindows := false
+				setConnectionInformation(d, connectionInfo, isWindows)
+			}
 		}
 		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
 			return err
 		}
 	}
-	return nil
+
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceLinuxVirtualMachineUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
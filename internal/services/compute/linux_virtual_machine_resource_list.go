@@ -0,0 +1,124 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachines"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+const linuxVirtualMachineResourceName = "azurerm_linux_virtual_machine"
+
+type LinuxVirtualMachineListResource struct{}
+
+var _ sdk.FrameworkListWrappedResource = new(LinuxVirtualMachineListResource)
+
+func (r LinuxVirtualMachineListResource) ResourceFunc() *pluginsdk.Resource {
+	return resourceLinuxVirtualMachine()
+}
+
+func (r LinuxVirtualMachineListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = linuxVirtualMachineResourceName
+}
+
+func (r LinuxVirtualMachineListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata sdk.ResourceMetadata) {
+	client := metadata.Client.Compute.VirtualMachinesClient
+
+	// retrieve the deadline from the supplied context
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		// This *should* never happen given the List Wrapper instantiates a context with a timeout
+		sdk.SetResponseErrorDiagnostic(stream, "internal-error", "context had no deadline")
+		return
+	}
+
+	var data sdk.DefaultListModel
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	results := make([]virtualmachines.VirtualMachine, 0)
+	subscriptionID := metadata.SubscriptionId
+	if !data.SubscriptionId.IsNull() {
+		subscriptionID = data.SubscriptionId.ValueString()
+	}
+
+	switch {
+	case !data.ResourceGroupName.IsNull():
+		resp, err := client.ListComplete(ctx, commonids.NewResourceGroupID(subscriptionID, data.ResourceGroupName.ValueString()), virtualmachines.DefaultListOperationOptions())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", linuxVirtualMachineResourceName), err)
+			return
+		}
+
+		results = resp.Items
+	default:
+		resp, err := client.ListAllComplete(ctx, commonids.NewSubscriptionID(subscriptionID), virtualmachines.DefaultListAllOperationOptions())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", linuxVirtualMachineResourceName), err)
+			return
+		}
+
+		results = resp.Items
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		// flattening a Virtual Machine retrieves the associated Disks and Network Interfaces, so we need a context
+		// which outlives the List call
+		deadlineCtx, cancel := context.WithDeadline(context.Background(), deadline)
+		defer cancel()
+
+		for _, vm := range results {
+			// the Virtual Machines API returns both Linux and Windows machines, so only include those for this resource
+			if !virtualMachineMatchesOperatingSystem(vm, virtualmachines.OperatingSystemTypesLinux) {
+				continue
+			}
+
+			result := request.NewListResult(deadlineCtx)
+
+			result.DisplayName = pointer.From(vm.Name)
+
+			rd := resourceLinuxVirtualMachine().Data(&terraform.InstanceState{})
+
+			id, err := virtualmachines.ParseVirtualMachineIDInsensitively(pointer.From(vm.Id))
+			if err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, "parsing Linux Virtual Machine ID", err)
+				return
+			}
+
+			rd.SetId(id.ID())
+
+			// flattening retrieves the Disks and Network Interfaces of the Virtual Machine, which may fail for a single
+			// Virtual Machine (e.g. where these are in a Resource Group we've no access to) so this is reported against
+			// the result rather than ending the listing
+			if err := resourceLinuxVirtualMachineFlatten(deadlineCtx, metadata.Client, rd, id, &vm, request.IncludeResource); err != nil {
+				result.Diagnostics.Append(sdk.NewErrorDiagnostic(fmt.Sprintf("encoding `%s` resource data", linuxVirtualMachineResourceName), err))
+				if !push(result) {
+					return
+				}
+				continue
+			}
+
+			sdk.EncodeListResult(deadlineCtx, rd, &result)
+			if result.Diagnostics.HasError() {
+				push(result)
+				return
+			}
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
@@ -11,7 +11,6 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
-	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
@@ -34,6 +33,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name linux_virtual_machine_scale_set -service-package-name compute -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-name "authPassword"
+
 func resourceLinuxVirtualMachineScaleSet() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceLinuxVirtualMachineScaleSetCreate,
@@ -41,10 +42,11 @@ func resourceLinuxVirtualMachineScaleSet() *pluginsdk.Resource {
 		Update: resourceLinuxVirtualMachineScaleSetUpdate,
 		Delete: resourceLinuxVirtualMachineScaleSetDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
-			_, err := commonids.ParseVirtualMachineScaleSetID(id)
-			return err
-		}, importVirtualMachineScaleSet(virtualmachinescalesets.OperatingSystemTypesLinux, "azurerm_linux_virtual_machine_scale_set")),
+		Importer: pluginsdk.ImporterValidatingIdentityThen(&virtualmachinescalesets.VirtualMachineScaleSetId{}, importVirtualMachineScaleSet(virtualmachinescalesets.OperatingSystemTypesLinux, "azurerm_linux_virtual_machine_scale_set")),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&virtualmachinescalesets.VirtualMachineScaleSetId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(time.Minute * 60),
@@ -471,6 +473,9 @@ func resourceLinuxVirtualMachineScaleSetCreate(d *pluginsdk.ResourceData, meta i
 	log.Printf("[DEBUG] %s was created", id)
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 
 	return resourceLinuxVirtualMachineScaleSetRead(d, meta)
 }
@@ -863,10 +868,14 @@ func resourceLinuxVirtualMachineScaleSetRead(d *pluginsdk.ResourceData, meta int
 		return fmt.Errorf("retrieving Linux %s: %+v", id, err)
 	}
 
+	return resourceLinuxVirtualMachineScaleSetFlatten(d, id, resp.Model)
+}
+
+func resourceLinuxVirtualMachineScaleSetFlatten(d *pluginsdk.ResourceData, id *virtualmachinescalesets.VirtualMachineScaleSetId, model *virtualmachinescalesets.VirtualMachineScaleSet) error {
 	d.Set("name", id.VirtualMachineScaleSetName)
 	d.Set("resource_group_name", id.ResourceGroupName)
 
-	if model := resp.Model; model != nil {
+	if model != nil {
 		d.Set("location", location.Normalize(model.Location))
 		d.Set("edge_zone", flattenEdgeZone(model.ExtendedLocation))
 		d.Set("zones", zones.FlattenUntyped(model.Zones))
@@ -1086,7 +1095,8 @@ func resourceLinuxVirtualMachineScaleSetRead(d *pluginsdk.ResourceData, meta int
 			return err
 		}
 	}
-	return nil
+
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceLinuxVirtualMachineScaleSetDelete(d *pluginsdk.ResourceData, meta interface{}) error {
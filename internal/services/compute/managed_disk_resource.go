@@ -858,10 +858,14 @@ func resourceManagedDiskRead(d *pluginsdk.ResourceData, meta interface{}) error
 		return fmt.Errorf("making Read request on Azure Managed Disk %s (resource group %s): %s", id.DiskName, id.ResourceGroupName, err)
 	}
 
+	return resourceManagedDiskFlatten(d, id, resp.Model)
+}
+
+func resourceManagedDiskFlatten(d *pluginsdk.ResourceData, id *commonids.ManagedDiskId, model *disks.Disk) error {
 	d.Set("name", id.DiskName)
 	d.Set("resource_group_name", id.ResourceGroupName)
 
-	if model := resp.Model; model != nil {
+	if model != nil {
 		d.Set("location", location.NormalizeNilable(&model.Location))
 		d.Set("edge_zone", flattenManagedDiskEdgeZone(model.ExtendedLocation))
 
@@ -126,5 +126,12 @@ func (r Registration) EphemeralResources() []func() ephemeral.EphemeralResource
 }
 
 func (r Registration) ListResources() []sdk.FrameworkListWrappedResource {
-	return []sdk.FrameworkListWrappedResource{}
+	return []sdk.FrameworkListWrappedResource{
+		LinuxVirtualMachineListResource{},
+		LinuxVirtualMachineScaleSetListResource{},
+		ManagedDiskListResource{},
+		SnapshotListResource{},
+		WindowsVirtualMachineListResource{},
+		WindowsVirtualMachineScaleSetListResource{},
+	}
 }
@@ -13,18 +13,22 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/restorepointcollections"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name restore_point_collection -service-package-name compute -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 // RestorePointCollectionResource remove this in 4.0, the resource is renamed
 type RestorePointCollectionResource struct{}
 
 var (
 	_ sdk.ResourceWithUpdate                = RestorePointCollectionResource{}
 	_ sdk.ResourceWithDeprecationReplacedBy = RestorePointCollectionResource{}
+	_ sdk.ResourceWithIdentity              = RestorePointCollectionResource{}
 )
 
 func (r RestorePointCollectionResource) DeprecatedInFavourOfResource() string {
@@ -47,6 +51,10 @@ func (r RestorePointCollectionResource) IDValidationFunc() pluginsdk.SchemaValid
 	return restorepointcollections.ValidateRestorePointCollectionID
 }
 
+func (r RestorePointCollectionResource) Identity() resourceids.ResourceId {
+	return &restorepointcollections.RestorePointCollectionId{}
+}
+
 func (r RestorePointCollectionResource) ResourceType() string {
 	return "azurerm_restore_point_collection"
 }
@@ -117,7 +125,7 @@ func (r RestorePointCollectionResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -157,6 +165,10 @@ func (r RestorePointCollectionResource) Read() sdk.ResourceFunc {
 				schema.Tags = tags.Flatten(model.Tags)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&schema)
 		},
 	}
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-01/images"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2023-07-03/galleryimageversions"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachines"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
@@ -28,6 +29,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name shared_image_version -service-package-name compute -properties "name,resource_group_name,gallery_name,image_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-name imageVersion
+
 func resourceSharedImageVersion() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceSharedImageVersionCreate,
@@ -35,10 +38,11 @@ func resourceSharedImageVersion() *pluginsdk.Resource {
 		Update: resourceSharedImageVersionUpdate,
 		Delete: resourceSharedImageVersionDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := galleryimageversions.ParseImageVersionID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&galleryimageversions.ImageVersionId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&galleryimageversions.ImageVersionId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -283,6 +287,9 @@ func resourceSharedImageVersionCreate(d *pluginsdk.ResourceData, meta interface{
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 
 	return resourceSharedImageVersionRead(d, meta)
 }
@@ -443,7 +450,7 @@ func resourceSharedImageVersionRead(d *pluginsdk.ResourceData, meta interface{})
 			return err
 		}
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceSharedImageVersionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -62,3 +62,32 @@ func importVirtualMachine(osType virtualmachines.OperatingSystemTypes, resourceT
 		return []*pluginsdk.ResourceData{d}, nil
 	}
 }
+
+// virtualMachineMatchesOperatingSystem returns whether the Virtual Machine is a Managed Disk based machine of the
+// specified Operating System - which is used to determine which of the Linux/Windows resources it belongs to.
+func virtualMachineMatchesOperatingSystem(model virtualmachines.VirtualMachine, osType virtualmachines.OperatingSystemTypes) bool {
+	if model.Properties == nil || model.Properties.StorageProfile == nil || model.Properties.StorageProfile.OsDisk == nil {
+		return false
+	}
+
+	osDisk := model.Properties.StorageProfile.OsDisk
+	if osDisk.Vhd != nil {
+		return false
+	}
+
+	if profile := model.Properties.OsProfile; profile != nil {
+		switch osType {
+		case virtualmachines.OperatingSystemTypesLinux:
+			if profile.LinuxConfiguration != nil {
+				return true
+			}
+		case virtualmachines.OperatingSystemTypesWindows:
+			if profile.WindowsConfiguration != nil {
+				return true
+			}
+		}
+	}
+
+	// machines importing existing managed disks do not have an OSProfile
+	return osDisk.OsType != nil && *osDisk.OsType == osType
+}
@@ -13,15 +13,19 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/restorepointcollections"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name virtual_machine_restore_point_collection -service-package-name compute -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 type VirtualMachineRestorePointCollectionResource struct{}
 
 var _ sdk.ResourceWithUpdate = VirtualMachineRestorePointCollectionResource{}
+var _ sdk.ResourceWithIdentity = VirtualMachineRestorePointCollectionResource{}
 
 func (r VirtualMachineRestorePointCollectionResource) ModelObject() interface{} {
 	return &VirtualMachineRestorePointCollectionResourceModel{}
@@ -39,6 +43,10 @@ func (r VirtualMachineRestorePointCollectionResource) IDValidationFunc() plugins
 	return restorepointcollections.ValidateRestorePointCollectionID
 }
 
+func (r VirtualMachineRestorePointCollectionResource) Identity() resourceids.ResourceId {
+	return &restorepointcollections.RestorePointCollectionId{}
+}
+
 func (r VirtualMachineRestorePointCollectionResource) ResourceType() string {
 	return "azurerm_virtual_machine_restore_point_collection"
 }
@@ -109,7 +117,7 @@ func (r VirtualMachineRestorePointCollectionResource) Create() sdk.ResourceFunc
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -149,6 +157,10 @@ func (r VirtualMachineRestorePointCollectionResource) Read() sdk.ResourceFunc {
 				schema.Tags = tags.Flatten(model.Tags)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&schema)
 		},
 	}
@@ -12,15 +12,19 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/restorepointcollections"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/restorepoints"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name virtual_machine_restore_point -service-package-name compute -properties "name" -compare-values "subscription_id:virtual_machine_restore_point_collection_id,resource_group_name:virtual_machine_restore_point_collection_id,restore_point_collection_name:virtual_machine_restore_point_collection_id"
+
 type VirtualMachineRestorePointResource struct{}
 
 var _ sdk.Resource = VirtualMachineRestorePointResource{}
+var _ sdk.ResourceWithIdentity = VirtualMachineRestorePointResource{}
 
 func (r VirtualMachineRestorePointResource) ModelObject() interface{} {
 	return &VirtualMachineRestorePointResourceModel{}
@@ -37,6 +41,10 @@ func (r VirtualMachineRestorePointResource) IDValidationFunc() pluginsdk.SchemaV
 	return restorepoints.ValidateRestorePointID
 }
 
+func (r VirtualMachineRestorePointResource) Identity() resourceids.ResourceId {
+	return &restorepoints.RestorePointId{}
+}
+
 func (r VirtualMachineRestorePointResource) ResourceType() string {
 	return "azurerm_virtual_machine_restore_point"
 }
@@ -131,7 +139,7 @@ func (r VirtualMachineRestorePointResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -174,6 +182,10 @@ func (r VirtualMachineRestorePointResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&schema)
 		},
 	}
@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package compute_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccVirtualMachineRestorePoint_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_restore_point", "test")
+	r := VirtualMachineRestorePointResource{}
+
+	checkedFields := map[string]struct{}{
+		"name":                          {},
+		"resource_group_name":           {},
+		"restore_point_collection_name": {},
+		"subscription_id":               {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_virtual_machine_restore_point.test", checkedFields),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_virtual_machine_restore_point.test", tfjsonpath.New("name"), tfjsonpath.New("name")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_virtual_machine_restore_point.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("virtual_machine_restore_point_collection_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_virtual_machine_restore_point.test", tfjsonpath.New("restore_point_collection_name"), tfjsonpath.New("virtual_machine_restore_point_collection_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_virtual_machine_restore_point.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("virtual_machine_restore_point_collection_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2023-03-01/virtualmachineruncommands"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -22,9 +23,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name virtual_machine_run_command -service-package-name compute -properties "name" -compare-values "subscription_id:virtual_machine_id,resource_group_name:virtual_machine_id,virtual_machine_name:virtual_machine_id" -test-resource-type VirtualMachineRunCommandTestResource
+
 var (
-	_ sdk.Resource           = VirtualMachineRunCommandResource{}
-	_ sdk.ResourceWithUpdate = VirtualMachineRunCommandResource{}
+	_ sdk.Resource             = VirtualMachineRunCommandResource{}
+	_ sdk.ResourceWithUpdate   = VirtualMachineRunCommandResource{}
+	_ sdk.ResourceWithIdentity = VirtualMachineRunCommandResource{}
 )
 
 type VirtualMachineRunCommandResource struct{}
@@ -81,6 +85,10 @@ func (r VirtualMachineRunCommandResource) IDValidationFunc() pluginsdk.SchemaVal
 	return virtualmachineruncommands.ValidateVirtualMachineRunCommandID
 }
 
+func (r VirtualMachineRunCommandResource) Identity() resourceids.ResourceId {
+	return &virtualmachineruncommands.VirtualMachineRunCommandId{}
+}
+
 func (r VirtualMachineRunCommandResource) ResourceType() string {
 	return "azurerm_virtual_machine_run_command"
 }
@@ -408,6 +416,9 @@ func (r VirtualMachineRunCommandResource) Create() sdk.ResourceFunc {
 
 			// the resource still exists if polling fails
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			if err := result.Poller.PollUntilDone(ctx); err != nil {
 				return fmt.Errorf("running the command: %+v", err)
@@ -480,6 +491,10 @@ func (r VirtualMachineRunCommandResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&schema)
 		},
 	}
@@ -103,3 +103,30 @@ func importVirtualMachineScaleSet(osType virtualmachinescalesets.OperatingSystem
 		return []*pluginsdk.ResourceData{d}, nil
 	}
 }
+
+// virtualMachineScaleSetMatchesOperatingSystem returns whether the Virtual Machine Scale Set is a Uniform
+// Scale Set of the specified Operating System - which is used to determine which of the Linux/Windows
+// resources it belongs to.
+func virtualMachineScaleSetMatchesOperatingSystem(model virtualmachinescalesets.VirtualMachineScaleSet, osType virtualmachinescalesets.OperatingSystemTypes) bool {
+	if model.Properties == nil || model.Properties.VirtualMachineProfile == nil {
+		return false
+	}
+
+	if mode := model.Properties.OrchestrationMode; mode != nil && *mode == virtualmachinescalesets.OrchestrationModeFlexible {
+		return false
+	}
+
+	profile := model.Properties.VirtualMachineProfile.OsProfile
+	if profile == nil {
+		return false
+	}
+
+	switch osType {
+	case virtualmachinescalesets.OperatingSystemTypesLinux:
+		return profile.LinuxConfiguration != nil
+	case virtualmachinescalesets.OperatingSystemTypesWindows:
+		return profile.WindowsConfiguration != nil
+	}
+
+	return false
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-11-01/virtualmachinescalesets"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/standbypool/2025-03-01/standbyvirtualmachinepools"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -20,6 +21,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name virtual_machine_scale_set_standby_pool -service-package-name compute -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type StandbyPoolStandbyVirtualMachinePoolResource
+
 type VirtualMachineScaleSetStandbyPoolModel struct {
 	Name                             string                                                    `tfschema:"name"`
 	ResourceGroupName                string                                                    `tfschema:"resource_group_name"`
@@ -40,6 +43,7 @@ type VirtualMachineScaleSetStandbyPoolResource struct{}
 var (
 	_ sdk.ResourceWithUpdate        = VirtualMachineScaleSetStandbyPoolResource{}
 	_ sdk.ResourceWithCustomizeDiff = VirtualMachineScaleSetStandbyPoolResource{}
+	_ sdk.ResourceWithIdentity      = VirtualMachineScaleSetStandbyPoolResource{}
 )
 
 func (r VirtualMachineScaleSetStandbyPoolResource) ResourceType() string {
@@ -54,6 +58,10 @@ func (r VirtualMachineScaleSetStandbyPoolResource) IDValidationFunc() pluginsdk.
 	return standbyvirtualmachinepools.ValidateStandbyVirtualMachinePoolID
 }
 
+func (r VirtualMachineScaleSetStandbyPoolResource) Identity() resourceids.ResourceId {
+	return &standbyvirtualmachinepools.StandbyVirtualMachinePoolId{}
+}
+
 func (r VirtualMachineScaleSetStandbyPoolResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -166,7 +174,7 @@ func (r VirtualMachineScaleSetStandbyPoolResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -263,6 +271,10 @@ func (r VirtualMachineScaleSetStandbyPoolResource) Read() sdk.ResourceFunc {
 				state.Tags = pointer.From(model.Tags)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
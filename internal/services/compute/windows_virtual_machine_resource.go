@@ -4,6 +4,7 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -35,6 +36,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name windows_virtual_machine -service-package-name compute -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-name "authPassword"
+
 func resourceWindowsVirtualMachine() *pluginsdk.Resource {
 	resource := &pluginsdk.Resource{
 		Create: resourceWindowsVirtualMachineCreate,
@@ -42,10 +45,11 @@ func resourceWindowsVirtualMachine() *pluginsdk.Resource {
 		Update: resourceWindowsVirtualMachineUpdate,
 		Delete: resourceWindowsVirtualMachineDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
-			_, err := commonids.ParseVirtualMachineID(id)
-			return err
-		}, importVirtualMachine(virtualmachines.OperatingSystemTypesWindows, "azurerm_windows_virtual_machine")),
+		Importer: pluginsdk.ImporterValidatingIdentityThen(&virtualmachines.VirtualMachineId{}, importVirtualMachine(virtualmachines.OperatingSystemTypesWindows, "azurerm_windows_virtual_machine")),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&virtualmachines.VirtualMachineId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(45 * time.Minute),
@@ -935,14 +939,14 @@ func resourceWindowsVirtualMachineCreate(d *pluginsdk.ResourceData, meta interfa
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 	return resourceWindowsVirtualMachineRead(d, meta)
 }
 
 func resourceWindowsVirtualMachineRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Compute.VirtualMachinesClient
-	disksClient := meta.(*clients.Client).Compute.DisksClient
-	networkInterfacesClient := meta.(*clients.Client).Network.NetworkInterfacesClient
-	publicIPAddressesClient := meta.(*clients.Client).Network.PublicIPAddresses
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -964,10 +968,18 @@ func resourceWindowsVirtualMachineRead(d *pluginsdk.ResourceData, meta interface
 		return fmt.Errorf("retrieving Windows %s: %+v", id, err)
 	}
 
+	return resourceWindowsVirtualMachineFlatten(ctx, meta.(*clients.Client), d, id, resp.Model, true)
+}
+
+func resourceWindowsVirtualMachineFlatten(ctx context.Context, metaClient *clients.Client, d *pluginsdk.ResourceData, id *virtualmachines.VirtualMachineId, model *virtualmachines.VirtualMachine, includeResource bool) error {
 	d.Set("name", id.VirtualMachineName)
 	d.Set("resource_group_name", id.ResourceGroupName)
 
-	if model := resp.Model; model != nil {
+	disksClient := metaClient.Compute.DisksClient
+	networkInterfacesClient := metaClient.Network.NetworkInterfacesClient
+	publicIPAddressesClient := metaClient.Network.PublicIPAddresses
+
+	if model != nil {
 		d.Set("location", location.Normalize(model.Location))
 		d.Set("edge_zone", flattenEdgeZone(model.ExtendedLocation))
 
@@ -1131,14 +1143,16 @@ func resourceWindowsVirtualMachineRead(d *pluginsdk.ResourceData, meta interface
 			if profile := props.StorageProfile; profile != nil {
 				d.Set("disk_controller_type", pointer.From(props.StorageProfile.DiskControllerType))
 
-				// the storage_account_type isn't returned so we need to look it up
-				flattenedOSDisk, err := flattenVirtualMachineOSDisk(ctx, disksClient, profile.OsDisk)
-				if err != nil {
-					return fmt.Errorf("flattening `os_disk`: %+v", err)
-				}
+				if includeResource {
+					// the storage_account_type isn't returned so we need to look it up
+					flattenedOSDisk, err := flattenVirtualMachineOSDisk(ctx, disksClient, profile.OsDisk)
+					if err != nil {
+						return fmt.Errorf("flattening `os_disk`: %+v", err)
+					}
 
-				if err := d.Set("os_disk", flattenedOSDisk); err != nil {
-					return fmt.Errorf("settings `os_disk`: %+v", err)
+					if err := d.Set("os_disk", flattenedOSDisk); err != nil {
+						return fmt.Errorf("settings `os_disk`: %+v", err)
+					}
 				}
 				osManagedDiskId := ""
 				if profile.OsDisk != nil && profile.OsDisk.ManagedDisk != nil && profile.OsDisk.ManagedDisk.Id != nil {
@@ -1196,19 +1210,23 @@ func resourceWindowsVirtualMachineRead(d *pluginsdk.ResourceData, meta interface
 			d.Set("secure_boot_enabled", secureBootEnabled)
 			d.Set("user_data", props.UserData)
 
-			connectionInfo := retrieveConnectionInformation(ctx, networkInterfacesClient, publicIPAddressesClient, props)
-			d.Set("private_ip_address", connectionInfo.primaryPrivateAddress)
-			d.Set("private_ip_addresses", connectionInfo.privateAddresses)
-			d.Set("public_ip_address", connectionInfo.primaryPublicAddress)
-			d.Set("public_ip_addresses", connectionInfo.publicAddresses)
-			isWindows := false
-			setConnectionInformation(d, connectionInfo, isWindows)
+			// the connection information is looked up from the Network Interfaces and Public IP Addresses
+			if includeResource {
+				connectionInfo := retrieveConnectionInformation(ctx, networkInterfacesClient, publicIPAddressesClient, props)
+				d.Set("private_ip_address", connectionInfo.primaryPrivateAddress)
+				d.Set("private_ip_addresses", connectionInfo.privateAddresses)
+				d.Set("public_ip_address", connectionInfo.primaryPublicAddress)
+				d.Set("public_ip_addresses", connectionInfo.publicAddresses)
+				isWindows := false
+				setConnectionInformation(d, connectionInfo, isWindows)
+			}
 		}
 		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
 			return err
 		}
 	}
-	return nil
+
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceWindowsVirtualMachineUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
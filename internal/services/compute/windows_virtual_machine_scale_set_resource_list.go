@@ -0,0 +1,104 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-11-01/virtualmachinescalesets"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+const windowsVirtualMachineScaleSetResourceName = "azurerm_windows_virtual_machine_scale_set"
+
+type WindowsVirtualMachineScaleSetListResource struct{}
+
+var _ sdk.FrameworkListWrappedResource = new(WindowsVirtualMachineScaleSetListResource)
+
+func (r WindowsVirtualMachineScaleSetListResource) ResourceFunc() *pluginsdk.Resource {
+	return resourceWindowsVirtualMachineScaleSet()
+}
+
+func (r WindowsVirtualMachineScaleSetListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = windowsVirtualMachineScaleSetResourceName
+}
+
+func (r WindowsVirtualMachineScaleSetListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata sdk.ResourceMetadata) {
+	client := metadata.Client.Compute.VirtualMachineScaleSetsClient
+	var data sdk.DefaultListModel
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	results := make([]virtualmachinescalesets.VirtualMachineScaleSet, 0)
+	subscriptionID := metadata.SubscriptionId
+	if !data.SubscriptionId.IsNull() {
+		subscriptionID = data.SubscriptionId.ValueString()
+	}
+
+	switch {
+	case !data.ResourceGroupName.IsNull():
+		resp, err := client.ListComplete(ctx, commonids.NewResourceGroupID(subscriptionID, data.ResourceGroupName.ValueString()))
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", windowsVirtualMachineScaleSetResourceName), err)
+			return
+		}
+
+		results = resp.Items
+	default:
+		resp, err := client.ListAllComplete(ctx, commonids.NewSubscriptionID(subscriptionID))
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", windowsVirtualMachineScaleSetResourceName), err)
+			return
+		}
+
+		results = resp.Items
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, vmss := range results {
+			// the Virtual Machine Scale Sets API returns both Linux and Windows (and Flexible) Scale Sets, so only include those for this resource
+			if !virtualMachineScaleSetMatchesOperatingSystem(vmss, virtualmachinescalesets.OperatingSystemTypesWindows) {
+				continue
+			}
+
+			result := request.NewListResult(ctx)
+
+			result.DisplayName = pointer.From(vmss.Name)
+
+			rd := resourceWindowsVirtualMachineScaleSet().Data(&terraform.InstanceState{})
+
+			id, err := virtualmachinescalesets.ParseVirtualMachineScaleSetIDInsensitively(pointer.From(vmss.Id))
+			if err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, "parsing Windows Virtual Machine Scale Set ID", err)
+				return
+			}
+
+			rd.SetId(id.ID())
+
+			if err := resourceWindowsVirtualMachineScaleSetFlatten(rd, id, &vmss); err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("encoding `%s` resource data", windowsVirtualMachineScaleSetResourceName), err)
+				return
+			}
+
+			sdk.EncodeListResult(ctx, rd, &result)
+			if result.Diagnostics.HasError() {
+				push(result)
+				return
+			}
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/consumption/2019-10-01/budgets"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -18,6 +19,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name consumption_budget_management_group -service-package-name consumption -properties "name,scope:management_group_id" -no-subscription-id
+
 type ManagementGroupConsumptionBudget struct {
 	base consumptionBudgetBaseResource
 }
@@ -45,6 +48,7 @@ type ConsumptionBudgetMgmtNotificationModel struct {
 var (
 	_ sdk.Resource                   = ManagementGroupConsumptionBudget{}
 	_ sdk.ResourceWithCustomImporter = ManagementGroupConsumptionBudget{}
+	_ sdk.ResourceWithIdentity       = ManagementGroupConsumptionBudget{}
 )
 
 func (r ManagementGroupConsumptionBudget) Arguments() map[string]*pluginsdk.Schema {
@@ -131,6 +135,10 @@ func (r ManagementGroupConsumptionBudget) IDValidationFunc() pluginsdk.SchemaVal
 	return budgets.ValidateScopedBudgetID
 }
 
+func (r ManagementGroupConsumptionBudget) Identity() resourceids.ResourceId {
+	return &budgets.ScopedBudgetId{}
+}
+
 func (r ManagementGroupConsumptionBudget) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -181,7 +189,7 @@ func (r ManagementGroupConsumptionBudget) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -221,6 +229,10 @@ func (r ManagementGroupConsumptionBudget) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
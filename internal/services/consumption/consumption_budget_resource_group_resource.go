@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/consumption/2019-10-01/budgets"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -18,6 +19,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name consumption_budget_resource_group -service-package-name consumption -properties "name,scope:resource_group_id" -no-subscription-id
+
 type ResourceGroupConsumptionBudget struct {
 	base consumptionBudgetBaseResource
 }
@@ -36,6 +39,7 @@ type ResourceGroupConsumptionBudgetModel struct {
 var (
 	_ sdk.Resource                   = ResourceGroupConsumptionBudget{}
 	_ sdk.ResourceWithCustomImporter = ResourceGroupConsumptionBudget{}
+	_ sdk.ResourceWithIdentity       = ResourceGroupConsumptionBudget{}
 )
 
 func (r ResourceGroupConsumptionBudget) Arguments() map[string]*pluginsdk.Schema {
@@ -72,6 +76,10 @@ func (r ResourceGroupConsumptionBudget) IDValidationFunc() pluginsdk.SchemaValid
 	return budgets.ValidateScopedBudgetID
 }
 
+func (r ResourceGroupConsumptionBudget) Identity() resourceids.ResourceId {
+	return &budgets.ScopedBudgetId{}
+}
+
 func (r ResourceGroupConsumptionBudget) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -122,7 +130,7 @@ func (r ResourceGroupConsumptionBudget) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -162,6 +170,10 @@ func (r ResourceGroupConsumptionBudget) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
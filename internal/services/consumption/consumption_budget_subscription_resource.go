@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/consumption/2019-10-01/budgets"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -19,6 +20,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name consumption_budget_subscription -service-package-name consumption -properties "name,scope:subscription_id" -no-subscription-id
+
 type SubscriptionConsumptionBudget struct {
 	base consumptionBudgetBaseResource
 }
@@ -38,6 +41,7 @@ var (
 	_ sdk.Resource                   = SubscriptionConsumptionBudget{}
 	_ sdk.ResourceWithCustomImporter = SubscriptionConsumptionBudget{}
 	_ sdk.ResourceWithStateMigration = SubscriptionConsumptionBudget{}
+	_ sdk.ResourceWithIdentity       = SubscriptionConsumptionBudget{}
 )
 
 func (r SubscriptionConsumptionBudget) Arguments() map[string]*pluginsdk.Schema {
@@ -74,6 +78,10 @@ func (r SubscriptionConsumptionBudget) IDValidationFunc() pluginsdk.SchemaValida
 	return budgets.ValidateScopedBudgetID
 }
 
+func (r SubscriptionConsumptionBudget) Identity() resourceids.ResourceId {
+	return &budgets.ScopedBudgetId{}
+}
+
 func (r SubscriptionConsumptionBudget) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -124,7 +132,7 @@ func (r SubscriptionConsumptionBudget) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -164,6 +172,10 @@ func (r SubscriptionConsumptionBudget) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
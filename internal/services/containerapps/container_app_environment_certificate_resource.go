@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/keyvault"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/certificates"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/managedenvironments"
@@ -22,6 +23,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name container_app_environment_certificate -service-package-name containerapps -properties "name" -compare-values "subscription_id:container_app_environment_id,resource_group_name:container_app_environment_id,managed_environment_name:container_app_environment_id"
+
 type ContainerAppEnvironmentCertificateResource struct{}
 
 type CertificateKeyVaultModel struct {
@@ -49,6 +52,7 @@ type ContainerAppCertificateModel struct {
 }
 
 var _ sdk.ResourceWithUpdate = ContainerAppEnvironmentCertificateResource{}
+var _ sdk.ResourceWithIdentity = ContainerAppEnvironmentCertificateResource{}
 
 func (r ContainerAppEnvironmentCertificateResource) ModelObject() interface{} {
 	return &ContainerAppCertificateModel{}
@@ -62,6 +66,10 @@ func (r ContainerAppEnvironmentCertificateResource) IDValidationFunc() pluginsdk
 	return certificates.ValidateCertificateID
 }
 
+func (r ContainerAppEnvironmentCertificateResource) Identity() resourceids.ResourceId {
+	return &certificates.CertificateId{}
+}
+
 func (r ContainerAppEnvironmentCertificateResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -208,6 +216,9 @@ func (r ContainerAppEnvironmentCertificateResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			return nil
 		},
@@ -268,6 +279,10 @@ func (r ContainerAppEnvironmentCertificateResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
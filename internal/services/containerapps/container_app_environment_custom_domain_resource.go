@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/managedenvironments"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
@@ -19,6 +20,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name container_app_environment_custom_domain -service-package-name containerapps -compare-values "subscription_id:container_app_environment_id,resource_group_name:container_app_environment_id,name:container_app_environment_id"
+
 type ContainerAppEnvironmentCustomDomainResource struct{}
 
 type ContainerAppEnvironmentCustomDomainModel struct {
@@ -30,6 +33,7 @@ type ContainerAppEnvironmentCustomDomainModel struct {
 }
 
 var _ sdk.ResourceWithUpdate = ContainerAppEnvironmentCustomDomainResource{}
+var _ sdk.ResourceWithIdentity = ContainerAppEnvironmentCustomDomainResource{}
 
 func (r ContainerAppEnvironmentCustomDomainResource) ModelObject() interface{} {
 	return &ContainerAppEnvironmentCustomDomainModel{}
@@ -43,6 +47,10 @@ func (r ContainerAppEnvironmentCustomDomainResource) IDValidationFunc() pluginsd
 	return managedenvironments.ValidateManagedEnvironmentID
 }
 
+func (r ContainerAppEnvironmentCustomDomainResource) Identity() resourceids.ResourceId {
+	return &managedenvironments.ManagedEnvironmentId{}
+}
+
 func (r ContainerAppEnvironmentCustomDomainResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"container_app_environment_id": {
@@ -143,6 +151,9 @@ func (r ContainerAppEnvironmentCustomDomainResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
 
 			return nil
 		},
@@ -184,6 +195,10 @@ func (r ContainerAppEnvironmentCustomDomainResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/managedenvironments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -19,6 +20,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name container_app_environment_managed_certificate -service-package-name containerapps -properties "name" -compare-values "subscription_id:container_app_environment_id,resource_group_name:container_app_environment_id,managed_environment_name:container_app_environment_id"
+
 type ContainerAppEnvironmentManagedCertificateResource struct{}
 
 type ContainerAppEnvironmentManagedCertificateModel struct {
@@ -31,6 +34,7 @@ type ContainerAppEnvironmentManagedCertificateModel struct {
 }
 
 var _ sdk.ResourceWithUpdate = ContainerAppEnvironmentManagedCertificateResource{}
+var _ sdk.ResourceWithIdentity = ContainerAppEnvironmentManagedCertificateResource{}
 
 func (r ContainerAppEnvironmentManagedCertificateResource) ModelObject() interface{} {
 	return &ContainerAppEnvironmentManagedCertificateModel{}
@@ -44,6 +48,10 @@ func (r ContainerAppEnvironmentManagedCertificateResource) IDValidationFunc() pl
 	return managedenvironments.ValidateManagedCertificateID
 }
 
+func (r ContainerAppEnvironmentManagedCertificateResource) Identity() resourceids.ResourceId {
+	return &managedenvironments.ManagedCertificateId{}
+}
+
 func (r ContainerAppEnvironmentManagedCertificateResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -152,6 +160,9 @@ func (r ContainerAppEnvironmentManagedCertificateResource) Create() sdk.Resource
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			return nil
 		},
@@ -192,6 +203,10 @@ func (r ContainerAppEnvironmentManagedCertificateResource) Read() sdk.ResourceFu
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
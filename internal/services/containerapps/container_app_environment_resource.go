@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourcegroups"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/managedenvironments"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
@@ -35,6 +36,8 @@ const (
 	LogsDestinationNone         string = ""
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name container_app_environment -service-package-name containerapps -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 type ContainerAppEnvironmentResource struct{}
 
 type ContainerAppEnvironmentModel struct {
@@ -67,6 +70,12 @@ var _ sdk.ResourceWithUpdate = ContainerAppEnvironmentResource{}
 
 var _ sdk.ResourceWithCustomizeDiff = ContainerAppEnvironmentResource{}
 
+var _ sdk.ResourceWithIdentity = ContainerAppEnvironmentResource{}
+
+func (r ContainerAppEnvironmentResource) Identity() resourceids.ResourceId {
+	return &managedenvironments.ManagedEnvironmentId{}
+}
+
 func (r ContainerAppEnvironmentResource) ModelObject() interface{} {
 	return &ContainerAppEnvironmentModel{}
 }
@@ -337,6 +346,10 @@ func (r ContainerAppEnvironmentResource) Create() sdk.ResourceFunc {
 				metadata.ResourceData.Set("log_analytics_workspace_id", containerAppEnvironment.LogAnalyticsWorkspaceId)
 			}
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
+
 			return nil
 		},
 	}
@@ -352,11 +365,6 @@ func (r ContainerAppEnvironmentResource) Read() sdk.ResourceFunc {
 				return err
 			}
 
-			var existingState ContainerAppEnvironmentModel
-			if err := metadata.Decode(&existingState); err != nil {
-				return err
-			}
-
 			existing, err := client.Get(ctx, *id)
 			if err != nil {
 				if response.WasNotFound(existing.HttpResponse) {
@@ -365,73 +373,7 @@ func (r ContainerAppEnvironmentResource) Read() sdk.ResourceFunc {
 				return fmt.Errorf("reading %s: %+v", *id, err)
 			}
 
-			var state ContainerAppEnvironmentModel
-
-			if model := existing.Model; model != nil {
-				state.Name = id.ManagedEnvironmentName
-				state.ResourceGroup = id.ResourceGroupName
-				state.Location = location.Normalize(model.Location)
-				state.Tags = tags.Flatten(model.Tags)
-				if model.Identity != nil {
-					ident, err := identity.FlattenLegacySystemAndUserAssignedMapToModel(model.Identity)
-					if err != nil {
-						return fmt.Errorf("flattening identity: %+v", err)
-					}
-					state.Identity = ident
-				}
-
-				if props := model.Properties; props != nil {
-					if vnet := props.VnetConfiguration; vnet != nil {
-						state.InfrastructureSubnetId = pointer.From(vnet.InfrastructureSubnetId)
-						state.InternalLoadBalancerEnabled = pointer.From(vnet.Internal)
-						state.DockerBridgeCidr = pointer.From(vnet.DockerBridgeCidr)
-						state.PlatformReservedCidr = pointer.From(vnet.PlatformReservedCidr)
-						state.PlatformReservedDnsIP = pointer.From(vnet.PlatformReservedDnsIP)
-					}
-
-					if appLogsConfig := props.AppLogsConfiguration; appLogsConfig != nil {
-						state.LogsDestination = pointer.From(appLogsConfig.Destination)
-						if appLogsConfig.LogAnalyticsConfiguration != nil && appLogsConfig.LogAnalyticsConfiguration.CustomerId != nil {
-							workspaceId, err := findWorkspaceResourceIDFromCustomerID(ctx, metadata, *appLogsConfig.LogAnalyticsConfiguration.CustomerId)
-							// During refreshing stage, `GetRawConfig()` may return null value.
-
-							if err == nil {
-								if workspaceId != nil {
-									state.LogAnalyticsWorkspaceId = workspaceId.ID()
-								} else {
-									state.LogAnalyticsWorkspaceId = existingState.LogAnalyticsWorkspaceId
-								}
-							}
-						}
-					}
-
-					state.PublicNetworkAccess = pointer.FromEnum(props.PublicNetworkAccess)
-					state.ZoneRedundant = pointer.From(props.ZoneRedundant)
-					state.StaticIP = pointer.From(props.StaticIP)
-					state.DefaultDomain = pointer.From(props.DefaultDomain)
-					state.WorkloadProfiles = helpers.FlattenWorkloadProfiles(props.WorkloadProfiles)
-					state.InfrastructureResourceGroup = pointer.From(props.InfrastructureResourceGroup)
-
-					if props.CustomDomainConfiguration != nil {
-						state.CustomDomainVerificationId = pointer.From(props.CustomDomainConfiguration.CustomDomainVerificationId)
-					}
-
-					if props.PeerAuthentication != nil && props.PeerAuthentication.Mtls != nil {
-						state.Mtls = pointer.From(props.PeerAuthentication.Mtls.Enabled)
-					}
-				}
-			}
-
-			// `dapr_application_insights_connection_string` is sensitive and not returned by API
-			if v := metadata.ResourceData.Get("dapr_application_insights_connection_string").(string); v != "" {
-				state.DaprApplicationInsightsConnectionString = v
-			}
-
-			if err = metadata.Encode(&state); err != nil {
-				return fmt.Errorf("encoding: %+v", err)
-			}
-
-			return nil
+			return r.flatten(ctx, metadata, id, existing.Model, true)
 		},
 	}
 }
@@ -569,7 +511,7 @@ func (r ContainerAppEnvironmentResource) Update() sdk.ResourceFunc {
 
 func (r ContainerAppEnvironmentResource) CustomizeDiff() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
-		Timeout: 5,
+		Timeout: 5 * time.Minute,
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
 			if metadata.ResourceDiff == nil {
 				return nil
@@ -711,3 +653,84 @@ func getSharedKeyForWorkspace(ctx context.Context, meta sdk.ResourceMetaData, wo
 
 	return workspace.Model.Properties.CustomerId, keys.Model.PrimarySharedKey, nil
 }
+
+// flatten sets the state for the Container App Environment - when `fetchCompleteData` is false the ID of the Log
+// Analytics Workspace is not looked up, which is used when listing Container App Environments
+func (ContainerAppEnvironmentResource) flatten(ctx context.Context, metadata sdk.ResourceMetaData, id *managedenvironments.ManagedEnvironmentId, model *managedenvironments.ManagedEnvironment, fetchCompleteData bool) error {
+	var existingState ContainerAppEnvironmentModel
+	if err := metadata.Decode(&existingState); err != nil {
+		return err
+	}
+
+	var state ContainerAppEnvironmentModel
+
+	if model != nil {
+		state.Name = id.ManagedEnvironmentName
+		state.ResourceGroup = id.ResourceGroupName
+		state.Location = location.Normalize(model.Location)
+		state.Tags = tags.Flatten(model.Tags)
+		if model.Identity != nil {
+			ident, err := identity.FlattenLegacySystemAndUserAssignedMapToModel(model.Identity)
+			if err != nil {
+				return fmt.Errorf("flattening identity: %+v", err)
+			}
+			state.Identity = ident
+		}
+
+		if props := model.Properties; props != nil {
+			if vnet := props.VnetConfiguration; vnet != nil {
+				state.InfrastructureSubnetId = pointer.From(vnet.InfrastructureSubnetId)
+				state.InternalLoadBalancerEnabled = pointer.From(vnet.Internal)
+				state.DockerBridgeCidr = pointer.From(vnet.DockerBridgeCidr)
+				state.PlatformReservedCidr = pointer.From(vnet.PlatformReservedCidr)
+				state.PlatformReservedDnsIP = pointer.From(vnet.PlatformReservedDnsIP)
+			}
+
+			if appLogsConfig := props.AppLogsConfiguration; appLogsConfig != nil {
+				state.LogsDestination = pointer.From(appLogsConfig.Destination)
+				if fetchCompleteData && appLogsConfig.LogAnalyticsConfiguration != nil && appLogsConfig.LogAnalyticsConfiguration.CustomerId != nil {
+					workspaceId, err := findWorkspaceResourceIDFromCustomerID(ctx, metadata, *appLogsConfig.LogAnalyticsConfiguration.CustomerId)
+					// During refreshing stage, `GetRawConfig()` may return null value.
+
+					if err == nil {
+						if workspaceId != nil {
+							state.LogAnalyticsWorkspaceId = workspaceId.ID()
+						} else {
+							state.LogAnalyticsWorkspaceId = existingState.LogAnalyticsWorkspaceId
+						}
+					}
+				}
+			}
+
+			state.PublicNetworkAccess = pointer.FromEnum(props.PublicNetworkAccess)
+			state.ZoneRedundant = pointer.From(props.ZoneRedundant)
+			state.StaticIP = pointer.From(props.StaticIP)
+			state.DefaultDomain = pointer.From(props.DefaultDomain)
+			state.WorkloadProfiles = helpers.FlattenWorkloadProfiles(props.WorkloadProfiles)
+			state.InfrastructureResourceGroup = pointer.From(props.InfrastructureResourceGroup)
+
+			if props.CustomDomainConfiguration != nil {
+				state.CustomDomainVerificationId = pointer.From(props.CustomDomainConfiguration.CustomDomainVerificationId)
+			}
+
+			if props.PeerAuthentication != nil && props.PeerAuthentication.Mtls != nil {
+				state.Mtls = pointer.From(props.PeerAuthentication.Mtls.Enabled)
+			}
+		}
+	}
+
+	// `dapr_application_insights_connection_string` is sensitive and not returned by API
+	if v := metadata.ResourceData.Get("dapr_application_insights_connection_string").(string); v != "" {
+		state.DaprApplicationInsightsConnectionString = v
+	}
+
+	if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+		return err
+	}
+
+	if err := metadata.Encode(&state); err != nil {
+		return fmt.Errorf("encoding: %+v", err)
+	}
+
+	return nil
+}
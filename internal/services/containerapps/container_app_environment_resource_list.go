@@ -0,0 +1,112 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package containerapps
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/managedenvironments"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ContainerAppEnvironmentListResource struct{}
+
+var _ sdk.FrameworkListWrappedResource = new(ContainerAppEnvironmentListResource)
+
+func (ContainerAppEnvironmentListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = ContainerAppEnvironmentResource{}.ResourceType()
+}
+
+func (ContainerAppEnvironmentListResource) ResourceFunc() *pluginsdk.Resource {
+	return sdk.WrappedResource(ContainerAppEnvironmentResource{})
+}
+
+func (ContainerAppEnvironmentListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata sdk.ResourceMetadata) {
+	client := metadata.Client.ContainerApps.ManagedEnvironmentClient
+
+	// retrieve the deadline from the supplied context
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		// This *should* never happen given the List Wrapper instantiates a context with a timeout
+		sdk.SetResponseErrorDiagnostic(stream, "internal-error", "context had no deadline")
+		return
+	}
+
+	var data sdk.DefaultListModel
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	var results []managedenvironments.ManagedEnvironment
+
+	subscriptionID := metadata.SubscriptionId
+	if !data.SubscriptionId.IsNull() {
+		subscriptionID = data.SubscriptionId.ValueString()
+	}
+
+	r := ContainerAppEnvironmentResource{}
+
+	switch {
+	case !data.ResourceGroupName.IsNull():
+		resp, err := client.ListByResourceGroupComplete(sdk.ListPageLimitContext(ctx, request), commonids.NewResourceGroupID(subscriptionID, data.ResourceGroupName.ValueString()))
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", r.ResourceType()), err)
+			return
+		}
+
+		results = resp.Items
+	default:
+		resp, err := client.ListBySubscriptionComplete(sdk.ListPageLimitContext(ctx, request), commonids.NewSubscriptionID(subscriptionID))
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", r.ResourceType()), err)
+			return
+		}
+
+		results = resp.Items
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		deadlineCtx, cancel := context.WithDeadline(context.Background(), deadline)
+		defer cancel()
+
+		for _, environment := range results {
+			result := request.NewListResult(deadlineCtx)
+			result.DisplayName = pointer.From(environment.Name)
+
+			id, err := managedenvironments.ParseManagedEnvironmentIDInsensitively(pointer.From(environment.Id))
+			if err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, "parsing Container App Environment ID", err)
+				return
+			}
+
+			meta := sdk.NewResourceMetaData(metadata.Client, r)
+			meta.SetID(id)
+
+			// looking up the Log Analytics Workspace requires listing the Workspaces in the Subscription, so is only
+			// done when the full resource has been requested
+			if err := r.flatten(deadlineCtx, meta, id, &environment, request.IncludeResource); err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("encoding `%s` resource data", pointer.From(environment.Name)), err)
+				return
+			}
+
+			sdk.EncodeListResult(deadlineCtx, meta.ResourceData, &result)
+			if result.Diagnostics.HasError() {
+				push(result)
+				return
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
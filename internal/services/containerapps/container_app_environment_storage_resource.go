@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/managedenvironmentsstorages"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/validate"
@@ -18,6 +19,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name container_app_environment_storage -service-package-name containerapps -properties "name" -compare-values "subscription_id:container_app_environment_id,resource_group_name:container_app_environment_id,managed_environment_name:container_app_environment_id"
+
 type ContainerAppEnvironmentStorageResource struct{}
 
 type ContainerAppEnvironmentStorageModel struct {
@@ -31,6 +34,7 @@ type ContainerAppEnvironmentStorageModel struct {
 }
 
 var _ sdk.ResourceWithUpdate = ContainerAppEnvironmentStorageResource{}
+var _ sdk.ResourceWithIdentity = ContainerAppEnvironmentStorageResource{}
 
 func (r ContainerAppEnvironmentStorageResource) ModelObject() interface{} {
 	return &ContainerAppEnvironmentStorageModel{}
@@ -44,6 +48,10 @@ func (r ContainerAppEnvironmentStorageResource) IDValidationFunc() pluginsdk.Sch
 	return managedenvironmentsstorages.ValidateStorageID
 }
 
+func (r ContainerAppEnvironmentStorageResource) Identity() resourceids.ResourceId {
+	return &managedenvironmentsstorages.StorageId{}
+}
+
 func (r ContainerAppEnvironmentStorageResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -171,6 +179,9 @@ func (r ContainerAppEnvironmentStorageResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			return nil
 		},
@@ -222,6 +233,10 @@ func (r ContainerAppEnvironmentStorageResource) Read() sdk.ResourceFunc {
 				state.AccessKey = keyFromConfig.(string)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
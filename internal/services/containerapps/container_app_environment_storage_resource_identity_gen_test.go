@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package containerapps_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccContainerAppEnvironmentStorage_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_environment_storage", "test")
+	r := ContainerAppEnvironmentStorageResource{}
+
+	checkedFields := map[string]struct{}{
+		"name":                     {},
+		"managed_environment_name": {},
+		"resource_group_name":      {},
+		"subscription_id":          {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_container_app_environment_storage.test", checkedFields),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_container_app_environment_storage.test", tfjsonpath.New("name"), tfjsonpath.New("name")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_container_app_environment_storage.test", tfjsonpath.New("managed_environment_name"), tfjsonpath.New("container_app_environment_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_container_app_environment_storage.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("container_app_environment_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_container_app_environment_storage.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("container_app_environment_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
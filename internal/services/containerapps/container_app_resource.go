@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/containerapps"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2025-07-01/managedenvironments"
@@ -24,6 +25,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name container_app -service-package-name containerapps -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 type ContainerAppResource struct{}
 
 type ContainerAppModel struct {
@@ -54,6 +57,12 @@ var _ sdk.ResourceWithUpdate = ContainerAppResource{}
 
 var _ sdk.ResourceWithCustomizeDiff = ContainerAppResource{}
 
+var _ sdk.ResourceWithIdentity = ContainerAppResource{}
+
+func (r ContainerAppResource) Identity() resourceids.ResourceId {
+	return &containerapps.ContainerAppId{}
+}
+
 func (r ContainerAppResource) ModelObject() interface{} {
 	return &ContainerAppModel{}
 }
@@ -232,6 +241,9 @@ func (r ContainerAppResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			return nil
 		},
@@ -257,54 +269,7 @@ func (r ContainerAppResource) Read() sdk.ResourceFunc {
 				return fmt.Errorf("reading %s: %+v", *id, err)
 			}
 
-			var state ContainerAppModel
-
-			state.Name = id.ContainerAppName
-			state.ResourceGroup = id.ResourceGroupName
-
-			if model := existing.Model; model != nil {
-				state.Location = location.Normalize(model.Location)
-				state.Tags = tags.Flatten(model.Tags)
-				if model.Identity != nil {
-					ident, err := identity.FlattenSystemAndUserAssignedMapToModel(pointer.To(identity.SystemAndUserAssignedMap(*model.Identity)))
-					if err != nil {
-						return err
-					}
-					state.Identity = pointer.From(ident)
-				}
-
-				if props := model.Properties; props != nil {
-					envId, err := managedenvironments.ParseManagedEnvironmentIDInsensitively(pointer.From(props.ManagedEnvironmentId))
-					if err != nil {
-						return err
-					}
-					state.ManagedEnvironmentId = envId.ID()
-					state.Template = helpers.FlattenContainerAppTemplate(props.Template)
-					if config := props.Configuration; config != nil {
-						if config.ActiveRevisionsMode != nil {
-							state.RevisionMode = string(pointer.From(config.ActiveRevisionsMode))
-						}
-						state.Ingress = helpers.FlattenContainerAppIngress(config.Ingress, id.ContainerAppName)
-						state.Registries = helpers.FlattenContainerAppRegistries(config.Registries)
-						state.Dapr = helpers.FlattenContainerAppDapr(config.Dapr)
-						state.MaxInactiveRevisions = pointer.From(config.MaxInactiveRevisions)
-					}
-					state.LatestRevisionName = pointer.From(props.LatestRevisionName)
-					state.LatestRevisionFqdn = pointer.From(props.LatestRevisionFqdn)
-					state.CustomDomainVerificationId = pointer.From(props.CustomDomainVerificationId)
-					state.OutboundIpAddresses = pointer.From(props.OutboundIPAddresses)
-					state.WorkloadProfileName = pointer.From(props.WorkloadProfileName)
-				}
-			}
-
-			secretsResp, err := client.ListSecrets(ctx, *id)
-			if err != nil {
-				return fmt.Errorf("retrieving secrets for %s: %+v", *id, err)
-			}
-
-			state.Secrets = helpers.FlattenContainerAppSecrets(secretsResp.Model)
-
-			return metadata.Encode(&state)
+			return r.flatten(ctx, metadata, id, existing.Model, true)
 		},
 	}
 }
@@ -440,6 +405,7 @@ func (r ContainerAppResource) Update() sdk.ResourceFunc {
 
 func (r ContainerAppResource) CustomizeDiff() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
 			if metadata.ResourceDiff == nil {
 				return nil
@@ -471,3 +437,62 @@ func (r ContainerAppResource) CustomizeDiff() sdk.ResourceFunc {
 		},
 	}
 }
+
+// flatten sets the state for the Container App - when `fetchCompleteData` is false the Secrets are not retrieved,
+// which is used when listing Container Apps
+func (ContainerAppResource) flatten(ctx context.Context, metadata sdk.ResourceMetaData, id *containerapps.ContainerAppId, model *containerapps.ContainerApp, fetchCompleteData bool) error {
+	var state ContainerAppModel
+
+	state.Name = id.ContainerAppName
+	state.ResourceGroup = id.ResourceGroupName
+
+	if model != nil {
+		state.Location = location.Normalize(model.Location)
+		state.Tags = tags.Flatten(model.Tags)
+		if model.Identity != nil {
+			ident, err := identity.FlattenSystemAndUserAssignedMapToModel(pointer.To(identity.SystemAndUserAssignedMap(*model.Identity)))
+			if err != nil {
+				return err
+			}
+			state.Identity = pointer.From(ident)
+		}
+
+		if props := model.Properties; props != nil {
+			envId, err := managedenvironments.ParseManagedEnvironmentIDInsensitively(pointer.From(props.ManagedEnvironmentId))
+			if err != nil {
+				return err
+			}
+			state.ManagedEnvironmentId = envId.ID()
+			state.Template = helpers.FlattenContainerAppTemplate(props.Template)
+			if config := props.Configuration; config != nil {
+				if config.ActiveRevisionsMode != nil {
+					state.RevisionMode = string(pointer.From(config.ActiveRevisionsMode))
+				}
+				state.Ingress = helpers.FlattenContainerAppIngress(config.Ingress, id.ContainerAppName)
+				state.Registries = helpers.FlattenContainerAppRegistries(config.Registries)
+				state.Dapr = helpers.FlattenContainerAppDapr(config.Dapr)
+				state.MaxInactiveRevisions = pointer.From(config.MaxInactiveRevisions)
+			}
+			state.LatestRevisionName = pointer.From(props.LatestRevisionName)
+			state.LatestRevisionFqdn = pointer.From(props.LatestRevisionFqdn)
+			state.CustomDomainVerificationId = pointer.From(props.CustomDomainVerificationId)
+			state.OutboundIpAddresses = pointer.From(props.OutboundIPAddresses)
+			state.WorkloadProfileName = pointer.From(props.WorkloadProfileName)
+		}
+	}
+
+	if fetchCompleteData {
+		secretsResp, err := metadata.Client.ContainerApps.ContainerAppClient.ListSecrets(ctx, *id)
+		if err != nil {
+			return fmt.Errorf("retrieving secrets for %s: %+v", *id, err)
+		}
+
+		state.Secrets = helpers.FlattenContainerAppSecrets(secretsResp.Model)
+	}
+
+	if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+		return err
+	}
+
+	return metadata.Encode(&state)
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2025-11-01/connectedregistries"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2025-11-01/registries"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2025-11-01/tokens"
@@ -21,9 +22,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name container_connected_registry -service-package-name containers -properties "name" -compare-values "subscription_id:container_registry_id,resource_group_name:container_registry_id,registry_name:container_registry_id"
+
 type ContainerConnectedRegistryResource struct{}
 
 var _ sdk.ResourceWithUpdate = ContainerConnectedRegistryResource{}
+var _ sdk.ResourceWithIdentity = ContainerConnectedRegistryResource{}
 
 type ContainerConnectedRegistryModel struct {
 	Name                string                   `tfschema:"name"`
@@ -195,6 +199,10 @@ func (r ContainerConnectedRegistryResource) IDValidationFunc() pluginsdk.SchemaV
 	return connectedregistries.ValidateConnectedRegistryID
 }
 
+func (r ContainerConnectedRegistryResource) Identity() resourceids.ResourceId {
+	return &connectedregistries.ConnectedRegistryId{}
+}
+
 func (r ContainerConnectedRegistryResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -264,7 +272,7 @@ func (r ContainerConnectedRegistryResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -364,6 +372,10 @@ func (r ContainerConnectedRegistryResource) Read() sdk.ResourceFunc {
 				AuditLogEnabled:     auditLogEnabled,
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&model)
 		},
 	}
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2019-06-01-preview/agentpools"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	validate2 "github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
@@ -23,6 +24,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name container_registry_agent_pool -service-package-name containers -properties "name,resource_group_name,registry_name:container_registry_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 func resourceContainerRegistryAgentPool() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceContainerRegistryAgentPoolCreate,
@@ -30,10 +33,11 @@ func resourceContainerRegistryAgentPool() *pluginsdk.Resource {
 		Update: resourceContainerRegistryAgentPoolUpdate,
 		Delete: resourceContainerRegistryAgentPoolDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := agentpools.ParseAgentPoolID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&agentpools.AgentPoolId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&agentpools.AgentPoolId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -135,6 +139,9 @@ func resourceContainerRegistryAgentPoolCreate(d *pluginsdk.ResourceData, meta in
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 
 	return resourceContainerRegistryAgentPoolRead(d, meta)
 }
@@ -216,7 +223,7 @@ func resourceContainerRegistryAgentPoolRead(d *pluginsdk.ResourceData, meta inte
 			return err
 		}
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceContainerRegistryAgentPoolDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/keyvault"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-07-01/credentialsets"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2025-11-01/registries"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -22,7 +23,10 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name container_registry_credential_set -service-package-name containers -properties "name" -compare-values "subscription_id:container_registry_id,resource_group_name:container_registry_id,registry_name:container_registry_id"
+
 var _ sdk.Resource = ContainerRegistryCredentialSetResource{}
+var _ sdk.ResourceWithIdentity = ContainerRegistryCredentialSetResource{}
 
 type ContainerRegistryCredentialSetResource struct{}
 
@@ -154,7 +158,7 @@ func (r ContainerRegistryCredentialSetResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -235,6 +239,10 @@ func (ContainerRegistryCredentialSetResource) Read() sdk.ResourceFunc {
 					config.AuthenticationCredential = flattenAuthCredentials(props.AuthCredentials)
 				}
 			}
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&config)
 		},
 	}
@@ -262,6 +270,10 @@ func (ContainerRegistryCredentialSetResource) IDValidationFunc() pluginsdk.Schem
 	return credentialsets.ValidateCredentialSetID
 }
 
+func (ContainerRegistryCredentialSetResource) Identity() resourceids.ResourceId {
+	return &credentialsets.CredentialSetId{}
+}
+
 func expandAuthCredentials(input []AuthenticationCredential) *[]credentialsets.AuthCredential {
 	output := make([]credentialsets.AuthCredential, 0)
 	if len(input) == 0 {
@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package containers_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccContainerRegistryCredentialSet_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_credential_set", "test")
+	r := ContainerRegistryCredentialSetResource{}
+
+	checkedFields := map[string]struct{}{
+		"name":                {},
+		"registry_name":       {},
+		"resource_group_name": {},
+		"subscription_id":     {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_container_registry_credential_set.test", checkedFields),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_container_registry_credential_set.test", tfjsonpath.New("name"), tfjsonpath.New("name")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_container_registry_credential_set.test", tfjsonpath.New("registry_name"), tfjsonpath.New("container_registry_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_container_registry_credential_set.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("container_registry_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_container_registry_credential_set.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("container_registry_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
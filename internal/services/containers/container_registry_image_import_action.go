@@ -0,0 +1,258 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/convert"
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2025-11-01/registries"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type ContainerRegistryImageImportAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &ContainerRegistryImageImportAction{}
+
+func newContainerRegistryImageImportAction() action.Action {
+	return &ContainerRegistryImageImportAction{}
+}
+
+type ContainerRegistryImageImportActionModel struct {
+	ContainerRegistryId        types.String                          `tfsdk:"container_registry_id"`
+	SourceImage                types.String                          `tfsdk:"source_image"`
+	SourceRegistryId           types.String                          `tfsdk:"source_registry_id"`
+	SourceRegistryUri          types.String                          `tfsdk:"source_registry_uri"`
+	SourceUsername             types.String                          `tfsdk:"source_username"`
+	SourcePassword             types.String                          `tfsdk:"source_password"`
+	TargetTags                 typehelpers.ListValueOf[types.String] `tfsdk:"target_tags"`
+	UntaggedTargetRepositories typehelpers.ListValueOf[types.String] `tfsdk:"untagged_target_repositories"`
+	Force                      types.Bool                            `tfsdk:"force"`
+	Timeout                    types.String                          `tfsdk:"timeout"`
+}
+
+func (a *ContainerRegistryImageImportAction) Schema(ctx context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"container_registry_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Container Registry to import the image into.",
+				MarkdownDescription: "The ID of the Container Registry to import the image into.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: registries.ValidateRegistryID,
+					},
+				},
+			},
+
+			"source_image": schema.StringAttribute{
+				Required:            true,
+				Description:         "The repository and tag or digest of the image to import, for example `library/hello-world:latest`.",
+				MarkdownDescription: "The repository and tag or digest of the image to import, for example `library/hello-world:latest`.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			"source_registry_id": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The ID of the Container Registry to import the image from.",
+				MarkdownDescription: "The ID of the Container Registry to import the image from.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: registries.ValidateRegistryID,
+					},
+					stringvalidator.ExactlyOneOf(path.MatchRoot("source_registry_id"), path.MatchRoot("source_registry_uri")),
+				},
+			},
+
+			"source_registry_uri": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The address of the registry to import the image from, for example `docker.io`.",
+				MarkdownDescription: "The address of the registry to import the image from, for example `docker.io`.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ExactlyOneOf(path.MatchRoot("source_registry_id"), path.MatchRoot("source_registry_uri")),
+				},
+			},
+
+			"source_username": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The username used to authenticate with the source registry.",
+				MarkdownDescription: "The username used to authenticate with the source registry.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("source_password")),
+				},
+			},
+
+			"source_password": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The password or token used to authenticate with the source registry. This is not redacted from Terraform's output, so should be sourced from an ephemeral value such as a Key Vault Secret.",
+				MarkdownDescription: "The password or token used to authenticate with the source registry. This is not redacted from Terraform's output, so should be sourced from an ephemeral value such as a Key Vault Secret.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			"target_tags": schema.ListAttribute{
+				CustomType:          typehelpers.NewListTypeOf[types.String](ctx),
+				ElementType:         types.StringType,
+				Optional:            true,
+				Description:         "The list of `repository:tag` values to apply to the imported image in the target Container Registry.",
+				MarkdownDescription: "The list of `repository:tag` values to apply to the imported image in the target Container Registry.",
+				Validators: []validator.List{
+					listvalidator.All(
+						listvalidator.NoNullValues(),
+						listvalidator.ValueStringsAre(
+							stringvalidator.LengthAtLeast(1),
+						),
+					),
+				},
+			},
+
+			"untagged_target_repositories": schema.ListAttribute{
+				CustomType:          typehelpers.NewListTypeOf[types.String](ctx),
+				ElementType:         types.StringType,
+				Optional:            true,
+				Description:         "The list of repositories in the target Container Registry to import the image into by digest only.",
+				MarkdownDescription: "The list of repositories in the target Container Registry to import the image into by digest only.",
+				Validators: []validator.List{
+					listvalidator.All(
+						listvalidator.NoNullValues(),
+						listvalidator.ValueStringsAre(
+							stringvalidator.LengthAtLeast(1),
+						),
+					),
+				},
+			},
+
+			"force": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Whether existing tags in the target Container Registry should be overwritten. Defaults to `false`.",
+				MarkdownDescription: "Whether existing tags in the target Container Registry should be overwritten. Defaults to `false`.",
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the action to complete. Defaults to `30m`.",
+				MarkdownDescription: "Timeout duration for the action to complete. Defaults to `30m`.",
+			},
+		},
+	}
+}
+
+func (a *ContainerRegistryImageImportAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_container_registry_image_import"
+}
+
+func (a *ContainerRegistryImageImportAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := a.Client.Containers.ContainerRegistryClient.Registries
+
+	model := ContainerRegistryImageImportActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTimeout := 30 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+
+		ctxTimeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	id, err := registries.ParseRegistryID(model.ContainerRegistryId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	payload := registries.ImportImageParameters{
+		Mode: pointer.To(registries.ImportModeNoForce),
+		Source: registries.ImportSource{
+			SourceImage: model.SourceImage.ValueString(),
+		},
+	}
+
+	if model.Force.ValueBool() {
+		payload.Mode = pointer.To(registries.ImportModeForce)
+	}
+
+	if v := model.SourceRegistryId.ValueString(); v != "" {
+		payload.Source.ResourceId = pointer.To(v)
+	}
+
+	if v := model.SourceRegistryUri.ValueString(); v != "" {
+		payload.Source.RegistryUri = pointer.To(v)
+	}
+
+	if v := model.SourcePassword.ValueString(); v != "" {
+		payload.Source.Credentials = &registries.ImportSourceCredentials{
+			Password: v,
+		}
+
+		if u := model.SourceUsername.ValueString(); u != "" {
+			payload.Source.Credentials.Username = pointer.To(u)
+		}
+	}
+
+	if len(model.TargetTags.Elements()) > 0 {
+		targetTags := make([]string, 0)
+		convert.Expand(ctx, model.TargetTags, &targetTags, &response.Diagnostics)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		payload.TargetTags = pointer.To(targetTags)
+	}
+
+	if len(model.UntaggedTargetRepositories.Elements()) > 0 {
+		repositories := make([]string, 0)
+		convert.Expand(ctx, model.UntaggedTargetRepositories, &repositories, &response.Diagnostics)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		payload.UntaggedTargetRepositories = pointer.To(repositories)
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("importing image %q into %s", model.SourceImage.ValueString(), id.RegistryName),
+	})
+
+	if err := client.ImportImageThenPoll(ctx, *id, payload); err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("importing image %q into %s: %+v", model.SourceImage.ValueString(), id, err))
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("imported image %q into %s", model.SourceImage.ValueString(), id.RegistryName),
+	})
+}
+
+func (a *ContainerRegistryImageImportAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	a.Defaults(ctx, request, response)
+}
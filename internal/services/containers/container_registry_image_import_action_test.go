@@ -0,0 +1,121 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type ContainerRegistryImageImportAction struct{}
+
+func TestAccContainerRegistryImageImportAction_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_image_import", "test")
+	a := ContainerRegistryImageImportAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.basic(data),
+				Check:  nil, // TODO - plugin-testing release?
+			},
+		},
+	})
+}
+
+func TestAccContainerRegistryImageImportAction_fromRegistry(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_image_import", "test")
+	a := ContainerRegistryImageImportAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.fromRegistry(data),
+				Check:  nil, // TODO - plugin-testing release?
+			},
+		},
+	})
+}
+
+func (a *ContainerRegistryImageImportAction) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "terraform_data" "trigger" {
+  input = azurerm_container_registry.test.id
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_container_registry_image_import.test]
+    }
+  }
+}
+
+action "azurerm_container_registry_image_import" "test" {
+  config {
+    container_registry_id = azurerm_container_registry.test.id
+    source_registry_uri   = "mcr.microsoft.com"
+    source_image          = "hello-world:latest"
+    target_tags           = ["hello-world:latest"]
+  }
+}
+`, ContainerRegistryResource{}.basic(data))
+}
+
+func (a *ContainerRegistryImageImportAction) fromRegistry(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry" "target" {
+  name                = "testacccrtarget%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "Basic"
+}
+
+resource "terraform_data" "trigger" {
+  input = azurerm_container_registry.target.id
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_container_registry_image_import.source, action.azurerm_container_registry_image_import.test]
+    }
+  }
+}
+
+action "azurerm_container_registry_image_import" "source" {
+  config {
+    container_registry_id = azurerm_container_registry.test.id
+    source_registry_uri   = "mcr.microsoft.com"
+    source_image          = "hello-world:latest"
+  }
+}
+
+action "azurerm_container_registry_image_import" "test" {
+  config {
+    container_registry_id        = azurerm_container_registry.target.id
+    source_registry_id           = azurerm_container_registry.test.id
+    source_image                 = "hello-world:latest"
+    target_tags                  = ["promoted/hello-world:v1"]
+    untagged_target_repositories = ["promoted/hello-world-untagged"]
+    force                        = true
+    timeout                      = "10m"
+  }
+}
+`, ContainerRegistryResource{}.basic(data), data.RandomInteger)
+}
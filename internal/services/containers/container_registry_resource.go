@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2025-11-01/registries"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2025-11-01/replications"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -33,6 +34,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name container_registry -service-package-name containers -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 func resourceContainerRegistry() *pluginsdk.Resource {
 	r := &pluginsdk.Resource{
 		Create: resourceContainerRegistryCreate,
@@ -46,10 +49,11 @@ func resourceContainerRegistry() *pluginsdk.Resource {
 			1: migration.RegistryV1ToV2{},
 		}),
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := registries.ParseRegistryID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&registries.RegistryId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&registries.RegistryId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -471,6 +475,9 @@ func resourceContainerRegistryCreate(d *pluginsdk.ResourceData, meta interface{}
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 
 	return resourceContainerRegistryRead(d, meta)
 }
@@ -800,7 +807,6 @@ func applyGeoReplicationLocations(ctx context.Context, meta interface{}, registr
 
 func resourceContainerRegistryRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Containers.ContainerRegistryClient.Registries
-	replicationClient := meta.(*clients.Client).Containers.ContainerRegistryClient.Replications
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -820,13 +826,22 @@ func resourceContainerRegistryRead(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
+	return resourceContainerRegistryFlatten(ctx, meta.(*clients.Client), d, id, resp.Model, true)
+}
+
+// resourceContainerRegistryFlatten sets the state for the Container Registry - when `fetchCompleteData` is false the
+// Admin Credentials and Geo Replications are not retrieved, which is used when listing Container Registries
+func resourceContainerRegistryFlatten(ctx context.Context, metaClient *clients.Client, d *pluginsdk.ResourceData, id *registries.RegistryId, model *registries.Registry, fetchCompleteData bool) error {
+	client := metaClient.Containers.ContainerRegistryClient.Registries
+	replicationClient := metaClient.Containers.ContainerRegistryClient.Replications
+
 	d.Set("name", id.RegistryName)
 	d.Set("resource_group_name", id.ResourceGroupName)
 
 	// this must be set to filter out the georeplication for the container registry's current location
 	loc := ""
 
-	if model := resp.Model; model != nil {
+	if model != nil {
 		loc = location.Normalize(model.Location)
 		d.Set("location", loc)
 
@@ -874,7 +889,7 @@ func resourceContainerRegistryRead(d *pluginsdk.ResourceData, meta interface{})
 				d.Set("export_policy_enabled", flattenExportPolicy(props.Policies))
 			}
 
-			if *props.AdminUserEnabled {
+			if fetchCompleteData && pointer.From(props.AdminUserEnabled) {
 				credsResp, errList := client.ListCredentials(ctx, *id)
 				if errList != nil {
 					return fmt.Errorf("retrieving credentials for %s: %s", *id, errList)
@@ -898,6 +913,10 @@ func resourceContainerRegistryRead(d *pluginsdk.ResourceData, meta interface{})
 		}
 	}
 
+	if !fetchCompleteData {
+		return pluginsdk.SetResourceIdentityData(d, id)
+	}
+
 	rId, err := replications.ParseRegistryID(id.ID())
 	if err != nil {
 		return err
@@ -929,7 +948,7 @@ func resourceContainerRegistryRead(d *pluginsdk.ResourceData, meta interface{})
 
 	d.Set("georeplications", geoReplications)
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceContainerRegistryDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2025-11-01/scopemaps"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
@@ -21,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name container_registry_scope_map -service-package-name containers -properties "name,resource_group_name,registry_name:container_registry_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 func resourceContainerRegistryScopeMap() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceContainerRegistryScopeMapCreate,
@@ -28,10 +31,11 @@ func resourceContainerRegistryScopeMap() *pluginsdk.Resource {
 		Update: resourceContainerRegistryScopeMapUpdate,
 		Delete: resourceContainerRegistryScopeMapDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := scopemaps.ParseScopeMapID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&scopemaps.ScopeMapId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&scopemaps.ScopeMapId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -109,6 +113,9 @@ func resourceContainerRegistryScopeMapCreate(d *pluginsdk.ResourceData, meta int
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 
 	return resourceContainerRegistryScopeMapRead(d, meta)
 }
@@ -175,7 +182,7 @@ func resourceContainerRegistryScopeMapRead(d *pluginsdk.ResourceData, meta inter
 			d.Set("actions", utils.FlattenStringSlice(&props.Actions))
 		}
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceContainerRegistryScopeMapDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -616,6 +616,7 @@ func (r ContainerRegistryTaskResource) Arguments() map[string]*pluginsdk.Schema
 
 func (r ContainerRegistryTaskResource) CustomizeDiff() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
 			rd := metadata.ResourceDiff
 			isSystemTask := rd.Get("is_system_task").(bool)
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2025-11-01/scopemaps"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2025-11-01/tokens"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
@@ -21,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name container_registry_token -service-package-name containers -properties "name,resource_group_name,registry_name:container_registry_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 func resourceContainerRegistryToken() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceContainerRegistryTokenCreate,
@@ -28,10 +31,11 @@ func resourceContainerRegistryToken() *pluginsdk.Resource {
 		Update: resourceContainerRegistryTokenUpdate,
 		Delete: resourceContainerRegistryTokenDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := tokens.ParseTokenID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&tokens.TokenId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&tokens.TokenId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -116,6 +120,9 @@ func resourceContainerRegistryTokenCreate(d *pluginsdk.ResourceData, meta interf
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 
 	return resourceContainerRegistryTokenRead(d, meta)
 }
@@ -199,7 +206,7 @@ func resourceContainerRegistryTokenRead(d *pluginsdk.ResourceData, meta interfac
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceContainerRegistryTokenDelete(d *pluginsdk.ResourceData, meta interface{}) error {
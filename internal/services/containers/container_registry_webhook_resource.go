@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2025-11-01/webhooks"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/migration"
@@ -23,6 +24,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name container_registry_webhook -service-package-name containers -properties "name,resource_group_name,registry_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 func resourceContainerRegistryWebhook() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceContainerRegistryWebhookCreate,
@@ -30,10 +33,11 @@ func resourceContainerRegistryWebhook() *pluginsdk.Resource {
 		Update: resourceContainerRegistryWebhookUpdate,
 		Delete: resourceContainerRegistryWebhookDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := webhooks.ParseWebHookID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&webhooks.WebHookId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&webhooks.WebHookId{}),
+		},
 
 		SchemaVersion: 1,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
@@ -150,6 +154,9 @@ func resourceContainerRegistryWebhookCreate(d *pluginsdk.ResourceData, meta inte
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 
 	return resourceContainerRegistryWebhookRead(d, meta)
 }
@@ -249,7 +256,7 @@ func resourceContainerRegistryWebhookRead(d *pluginsdk.ResourceData, meta interf
 			d.Set("custom_headers", customHeaders)
 		}
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceContainerRegistryWebhookDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -0,0 +1,121 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2025-10-01/agentpools"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type KubernetesClusterNodePoolListResource struct{}
+
+type KubernetesClusterNodePoolListModel struct {
+	KubernetesClusterId types.String `tfsdk:"kubernetes_cluster_id"`
+}
+
+var _ sdk.FrameworkListWrappedResourceWithConfig = new(KubernetesClusterNodePoolListResource)
+
+func (KubernetesClusterNodePoolListResource) ResourceFunc() *pluginsdk.Resource {
+	return resourceKubernetesClusterNodePool()
+}
+
+func (KubernetesClusterNodePoolListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "azurerm_kubernetes_cluster_node_pool"
+}
+
+func (KubernetesClusterNodePoolListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"kubernetes_cluster_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: commonids.ValidateKubernetesClusterID,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (KubernetesClusterNodePoolListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata sdk.ResourceMetadata) {
+	client := metadata.Client.Containers.AgentPoolsClient
+
+	var data KubernetesClusterNodePoolListModel
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	clusterId, err := commonids.ParseKubernetesClusterIDInsensitively(data.KubernetesClusterId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("parsing Kubernetes Cluster ID for `%s`", "azurerm_kubernetes_cluster_node_pool"), err)
+		return
+	}
+
+	resp, err := client.ListComplete(ctx, *clusterId)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", "azurerm_kubernetes_cluster_node_pool"), err)
+		return
+	}
+
+	results := make([]agentpools.AgentPool, 0)
+
+	// the Default Node Pool is managed by the `default_node_pool` block within the `azurerm_kubernetes_cluster` resource
+	// rather than as a separate Node Pool - which (as in `findDefaultNodePool`) is the first System Node Pool
+	defaultNodePoolFound := false
+	for _, pool := range resp.Items {
+		if !defaultNodePoolFound && pool.Properties != nil && pointer.From(pool.Properties.Mode) == agentpools.AgentPoolModeSystem {
+			defaultNodePoolFound = true
+			continue
+		}
+
+		results = append(results, pool)
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, pool := range results {
+			result := request.NewListResult(ctx)
+			result.DisplayName = pointer.From(pool.Name)
+
+			rd := resourceKubernetesClusterNodePool().Data(&terraform.InstanceState{})
+
+			id, err := agentpools.ParseAgentPoolIDInsensitively(pointer.From(pool.Id))
+			if err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, "parsing Kubernetes Cluster Node Pool ID", err)
+				return
+			}
+			rd.SetId(id.ID())
+
+			if err := resourceKubernetesClusterNodePoolFlatten(rd, id, &pool); err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("encoding `%s` resource data", "azurerm_kubernetes_cluster_node_pool"), err)
+				return
+			}
+
+			sdk.EncodeListResult(ctx, rd, &result)
+			if result.Diagnostics.HasError() {
+				push(result)
+				return
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package containers_test
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestAccKubernetesClusterNodePool_listByKubernetesClusterId(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_node_pool", "testlist")
+	r := KubernetesClusterNodePoolResource{}
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.manualScaleConfig(data),
+			},
+			{
+				Query:  true,
+				Config: r.basicListQueryByKubernetesClusterId(),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("azurerm_kubernetes_cluster_node_pool.list", 1), // the default node pool is excluded
+					querycheck.ExpectIdentity(
+						"azurerm_kubernetes_cluster_node_pool.list",
+						map[string]knownvalue.Check{
+							"name":                 knownvalue.StringExact("internal"),
+							"managed_cluster_name": knownvalue.StringRegexp(regexp.MustCompile(strconv.Itoa(data.RandomInteger))),
+							"resource_group_name":  knownvalue.StringRegexp(regexp.MustCompile(strconv.Itoa(data.RandomInteger))),
+							"subscription_id":      knownvalue.StringExact(data.Subscriptions.Primary),
+						},
+					),
+				},
+			},
+		},
+	})
+}
+
+func (KubernetesClusterNodePoolResource) basicListQueryByKubernetesClusterId() string {
+	return `
+list "azurerm_kubernetes_cluster_node_pool" "list" {
+  provider = azurerm
+  config {
+    kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+  }
+}
+`
+}
@@ -45,6 +45,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name kubernetes_cluster -service-package-name containers -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 func resourceKubernetesCluster() *pluginsdk.Resource {
 	resource := &pluginsdk.Resource{
 		Create: resourceKubernetesClusterCreate,
@@ -52,11 +54,11 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 		Update: resourceKubernetesClusterUpdate,
 		Delete: resourceKubernetesClusterDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(
-			func(id string) error {
-				_, err := commonids.ParseKubernetesClusterID(id)
-				return err
-			}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&commonids.KubernetesClusterId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&commonids.KubernetesClusterId{}),
+		},
 
 		CustomizeDiff: pluginsdk.CustomDiffInSequence(
 			// The behaviour of the API requires this, but this could be removed when https://github.com/Azure/azure-rest-api-specs/issues/27373 has been addressed
@@ -2099,6 +2101,10 @@ func resourceKubernetesClusterCreate(d *pluginsdk.ResourceData, meta interface{}
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
+
 	return resourceKubernetesClusterRead(d, meta)
 }
 
@@ -2907,18 +2913,18 @@ func resourceKubernetesClusterRead(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
-	credentials, err := client.ListClusterUserCredentials(ctx, *id, managedclusters.ListClusterUserCredentialsOperationOptions{})
-	if err != nil {
-		return fmt.Errorf("retrieving User Credentials for %s: %+v", id, err)
-	}
-	if credentials.Model == nil {
-		return fmt.Errorf("retrieving User Credentials for %s: payload is empty", id)
-	}
+	return resourceKubernetesClusterFlatten(ctx, meta.(*clients.Client), d, id, resp.Model, true)
+}
+
+// resourceKubernetesClusterFlatten sets the state for the Kubernetes Cluster - when `fetchCompleteData` is false the
+// Cluster Credentials and Maintenance Configurations are not retrieved, which is used when listing Clusters
+func resourceKubernetesClusterFlatten(ctx context.Context, metaClient *clients.Client, d *pluginsdk.ResourceData, id *commonids.KubernetesClusterId, model *managedclusters.ManagedCluster, fetchCompleteData bool) error {
+	client := metaClient.Containers.KubernetesClustersClient
 
 	d.Set("name", id.ManagedClusterName)
 	d.Set("resource_group_name", id.ResourceGroupName)
 
-	if model := resp.Model; model != nil {
+	if model != nil {
 		d.Set("edge_zone", flattenEdgeZone(model.ExtendedLocation))
 		d.Set("location", location.Normalize(model.Location))
 
@@ -3162,7 +3168,7 @@ func resourceKubernetesClusterRead(d *pluginsdk.ResourceData, meta interface{})
 			// adminProfile is only available for RBAC enabled clusters with AAD and local account is not disabled
 			var adminKubeConfigRaw *string
 			adminKubeConfig := make([]interface{}, 0)
-			if props.AadProfile != nil && (props.DisableLocalAccounts == nil || !*props.DisableLocalAccounts) {
+			if fetchCompleteData && props.AadProfile != nil && (props.DisableLocalAccounts == nil || !*props.DisableLocalAccounts) {
 				adminCredentials, err := client.ListClusterAdminCredentials(ctx, *id, managedclusters.ListClusterAdminCredentialsOperationOptions{})
 				if err != nil {
 					return fmt.Errorf("retrieving Admin Credentials for %s: %+v", id, err)
@@ -3194,43 +3200,53 @@ func resourceKubernetesClusterRead(d *pluginsdk.ResourceData, meta interface{})
 			return fmt.Errorf("setting `identity`: %+v", err)
 		}
 
-		kubeConfigRaw, kubeConfig := flattenKubernetesClusterCredentials(credentials.Model, "clusterUser")
-		d.Set("kube_config_raw", kubeConfigRaw)
-		if err := d.Set("kube_config", kubeConfig); err != nil {
-			return fmt.Errorf("setting `kube_config`: %+v", err)
-		}
+		if fetchCompleteData {
+			credentials, err := client.ListClusterUserCredentials(ctx, *id, managedclusters.ListClusterUserCredentialsOperationOptions{})
+			if err != nil {
+				return fmt.Errorf("retrieving User Credentials for %s: %+v", id, err)
+			}
+			if credentials.Model == nil {
+				return fmt.Errorf("retrieving User Credentials for %s: payload is empty", id)
+			}
 
-		var maintenanceWindow interface{}
-		maintenanceConfigurationsClient := meta.(*clients.Client).Containers.MaintenanceConfigurationsClient
-		maintenanceId := maintenanceconfigurations.NewMaintenanceConfigurationID(id.SubscriptionId, id.ResourceGroupName, id.ManagedClusterName, "default")
-		configResp, _ := maintenanceConfigurationsClient.Get(ctx, maintenanceId)
-		if configurationBody := configResp.Model; configurationBody != nil && configurationBody.Properties != nil {
-			maintenanceWindow = flattenKubernetesClusterMaintenanceConfigurationDefault(configurationBody.Properties)
-		}
-		d.Set("maintenance_window", maintenanceWindow)
+			kubeConfigRaw, kubeConfig := flattenKubernetesClusterCredentials(credentials.Model, "clusterUser")
+			d.Set("kube_config_raw", kubeConfigRaw)
+			if err := d.Set("kube_config", kubeConfig); err != nil {
+				return fmt.Errorf("setting `kube_config`: %+v", err)
+			}
 
-		var maintenanceWindowAutoUpgrade interface{}
-		maintenanceId = maintenanceconfigurations.NewMaintenanceConfigurationID(id.SubscriptionId, id.ResourceGroupName, id.ManagedClusterName, "aksManagedAutoUpgradeSchedule")
-		configResp, _ = maintenanceConfigurationsClient.Get(ctx, maintenanceId)
-		if configurationBody := configResp.Model; configurationBody != nil && configurationBody.Properties != nil && configurationBody.Properties.MaintenanceWindow != nil {
-			maintenanceWindowAutoUpgrade = flattenKubernetesClusterMaintenanceConfiguration(configurationBody.Properties.MaintenanceWindow)
-		}
-		d.Set("maintenance_window_auto_upgrade", maintenanceWindowAutoUpgrade)
+			var maintenanceWindow interface{}
+			maintenanceConfigurationsClient := metaClient.Containers.MaintenanceConfigurationsClient
+			maintenanceId := maintenanceconfigurations.NewMaintenanceConfigurationID(id.SubscriptionId, id.ResourceGroupName, id.ManagedClusterName, "default")
+			configResp, _ := maintenanceConfigurationsClient.Get(ctx, maintenanceId)
+			if configurationBody := configResp.Model; configurationBody != nil && configurationBody.Properties != nil {
+				maintenanceWindow = flattenKubernetesClusterMaintenanceConfigurationDefault(configurationBody.Properties)
+			}
+			d.Set("maintenance_window", maintenanceWindow)
+
+			var maintenanceWindowAutoUpgrade interface{}
+			maintenanceId = maintenanceconfigurations.NewMaintenanceConfigurationID(id.SubscriptionId, id.ResourceGroupName, id.ManagedClusterName, "aksManagedAutoUpgradeSchedule")
+			configResp, _ = maintenanceConfigurationsClient.Get(ctx, maintenanceId)
+			if configurationBody := configResp.Model; configurationBody != nil && configurationBody.Properties != nil && configurationBody.Properties.MaintenanceWindow != nil {
+				maintenanceWindowAutoUpgrade = flattenKubernetesClusterMaintenanceConfiguration(configurationBody.Properties.MaintenanceWindow)
+			}
+			d.Set("maintenance_window_auto_upgrade", maintenanceWindowAutoUpgrade)
 
-		var maintenanceWindowNodeOS interface{}
-		maintenanceId = maintenanceconfigurations.NewMaintenanceConfigurationID(id.SubscriptionId, id.ResourceGroupName, id.ManagedClusterName, "aksManagedNodeOSUpgradeSchedule")
-		configResp, _ = maintenanceConfigurationsClient.Get(ctx, maintenanceId)
-		if configurationBody := configResp.Model; configurationBody != nil && configurationBody.Properties != nil && configurationBody.Properties.MaintenanceWindow != nil {
-			maintenanceWindowNodeOS = flattenKubernetesClusterMaintenanceConfiguration(configurationBody.Properties.MaintenanceWindow)
+			var maintenanceWindowNodeOS interface{}
+			maintenanceId = maintenanceconfigurations.NewMaintenanceConfigurationID(id.SubscriptionId, id.ResourceGroupName, id.ManagedClusterName, "aksManagedNodeOSUpgradeSchedule")
+			configResp, _ = maintenanceConfigurationsClient.Get(ctx, maintenanceId)
+			if configurationBody := configResp.Model; configurationBody != nil && configurationBody.Properties != nil && configurationBody.Properties.MaintenanceWindow != nil {
+				maintenanceWindowNodeOS = flattenKubernetesClusterMaintenanceConfiguration(configurationBody.Properties.MaintenanceWindow)
+			}
+			d.Set("maintenance_window_node_os", maintenanceWindowNodeOS)
 		}
-		d.Set("maintenance_window_node_os", maintenanceWindowNodeOS)
 
 		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
 			return fmt.Errorf("setting `tags`: %+v", err)
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceKubernetesClusterDelete(d *pluginsdk.ResourceData, meta interface{}) error {
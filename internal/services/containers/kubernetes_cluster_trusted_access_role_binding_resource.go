@@ -10,14 +10,18 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2025-10-01/trustedaccess"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name kubernetes_cluster_trusted_access_role_binding -service-package-name containers -properties "name" -compare-values "subscription_id:kubernetes_cluster_id,resource_group_name:kubernetes_cluster_id,managed_cluster_name:kubernetes_cluster_id" -test-resource-type KubernetesClusterTrustedAccessRoleBindingTestResource
+
 var (
-	_ sdk.Resource           = KubernetesClusterTrustedAccessRoleBindingResource{}
-	_ sdk.ResourceWithUpdate = KubernetesClusterTrustedAccessRoleBindingResource{}
+	_ sdk.Resource             = KubernetesClusterTrustedAccessRoleBindingResource{}
+	_ sdk.ResourceWithUpdate   = KubernetesClusterTrustedAccessRoleBindingResource{}
+	_ sdk.ResourceWithIdentity = KubernetesClusterTrustedAccessRoleBindingResource{}
 )
 
 type KubernetesClusterTrustedAccessRoleBindingResource struct{}
@@ -37,6 +41,10 @@ func (r KubernetesClusterTrustedAccessRoleBindingResource) IDValidationFunc() pl
 	return trustedaccess.ValidateTrustedAccessRoleBindingID
 }
 
+func (r KubernetesClusterTrustedAccessRoleBindingResource) Identity() resourceids.ResourceId {
+	return &trustedaccess.TrustedAccessRoleBindingId{}
+}
+
 func (r KubernetesClusterTrustedAccessRoleBindingResource) ResourceType() string {
 	return "azurerm_kubernetes_cluster_trusted_access_role_binding"
 }
@@ -110,7 +118,7 @@ func (r KubernetesClusterTrustedAccessRoleBindingResource) Create() sdk.Resource
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -143,6 +151,10 @@ func (r KubernetesClusterTrustedAccessRoleBindingResource) Read() sdk.ResourceFu
 				r.mapTrustedAccessRoleBindingToKubernetesClusterTrustedAccessRoleBindingResourceSchema(*model, &schema)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&schema)
 		},
 	}
@@ -11,15 +11,19 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2024-04-01/fleets"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name kubernetes_fleet_manager -service-package-name containers -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type KubernetesFleetManagerTestResource
+
 var (
-	_ sdk.Resource           = KubernetesFleetManagerResource{}
-	_ sdk.ResourceWithUpdate = KubernetesFleetManagerResource{}
+	_ sdk.Resource             = KubernetesFleetManagerResource{}
+	_ sdk.ResourceWithUpdate   = KubernetesFleetManagerResource{}
+	_ sdk.ResourceWithIdentity = KubernetesFleetManagerResource{}
 )
 
 type KubernetesFleetManagerResource struct{}
@@ -39,6 +43,10 @@ func (r KubernetesFleetManagerResource) IDValidationFunc() pluginsdk.SchemaValid
 	return fleets.ValidateFleetID
 }
 
+func (r KubernetesFleetManagerResource) Identity() resourceids.ResourceId {
+	return &fleets.FleetId{}
+}
+
 func (r KubernetesFleetManagerResource) ResourceType() string {
 	return "azurerm_kubernetes_fleet_manager"
 }
@@ -116,7 +124,7 @@ func (r KubernetesFleetManagerResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -147,6 +155,10 @@ func (r KubernetesFleetManagerResource) Read() sdk.ResourceFunc {
 				r.mapFleetToKubernetesFleetManagerResourceSchema(*model, &schema)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&schema)
 		},
 	}
@@ -11,14 +11,18 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2024-04-01/fleetmembers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name kubernetes_fleet_member -service-package-name containers -properties "name" -compare-values "subscription_id:kubernetes_fleet_id,resource_group_name:kubernetes_fleet_id,fleet_name:kubernetes_fleet_id" -test-resource-type KubernetesFleetMemberTestResource
+
 var (
-	_ sdk.Resource           = KubernetesFleetMemberResource{}
-	_ sdk.ResourceWithUpdate = KubernetesFleetMemberResource{}
+	_ sdk.Resource             = KubernetesFleetMemberResource{}
+	_ sdk.ResourceWithUpdate   = KubernetesFleetMemberResource{}
+	_ sdk.ResourceWithIdentity = KubernetesFleetMemberResource{}
 )
 
 type KubernetesFleetMemberResource struct{}
@@ -38,6 +42,10 @@ func (r KubernetesFleetMemberResource) IDValidationFunc() pluginsdk.SchemaValida
 	return fleetmembers.ValidateMemberID
 }
 
+func (r KubernetesFleetMemberResource) Identity() resourceids.ResourceId {
+	return &fleetmembers.MemberId{}
+}
+
 func (r KubernetesFleetMemberResource) ResourceType() string {
 	return "azurerm_kubernetes_fleet_member"
 }
@@ -110,7 +118,7 @@ func (r KubernetesFleetMemberResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -145,6 +153,10 @@ func (r KubernetesFleetMemberResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&schema)
 		},
 	}
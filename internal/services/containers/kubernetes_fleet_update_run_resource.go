@@ -12,15 +12,19 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2025-03-01/updateruns"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name kubernetes_fleet_update_run -service-package-name containers -properties "name" -compare-values "subscription_id:kubernetes_fleet_manager_id,resource_group_name:kubernetes_fleet_manager_id,fleet_name:kubernetes_fleet_manager_id" -test-resource-type KubernetesFleetUpdateRunTestResource
+
 var (
-	_ sdk.Resource           = KubernetesFleetUpdateRunResource{}
-	_ sdk.ResourceWithUpdate = KubernetesFleetUpdateRunResource{}
+	_ sdk.Resource             = KubernetesFleetUpdateRunResource{}
+	_ sdk.ResourceWithUpdate   = KubernetesFleetUpdateRunResource{}
+	_ sdk.ResourceWithIdentity = KubernetesFleetUpdateRunResource{}
 )
 
 type KubernetesFleetUpdateRunResource struct{}
@@ -65,6 +69,10 @@ func (r KubernetesFleetUpdateRunResource) IDValidationFunc() pluginsdk.SchemaVal
 	return updateruns.ValidateUpdateRunID
 }
 
+func (r KubernetesFleetUpdateRunResource) Identity() resourceids.ResourceId {
+	return &updateruns.UpdateRunId{}
+}
+
 func (r KubernetesFleetUpdateRunResource) ResourceType() string {
 	return "azurerm_kubernetes_fleet_update_run"
 }
@@ -218,7 +226,7 @@ func (r KubernetesFleetUpdateRunResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -316,6 +324,10 @@ func (r KubernetesFleetUpdateRunResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&schema)
 		},
 	}
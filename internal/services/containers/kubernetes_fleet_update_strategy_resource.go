@@ -12,15 +12,19 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2025-03-01/fleetupdatestrategies"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name kubernetes_fleet_update_strategy -service-package-name containers -properties "name" -compare-values "subscription_id:kubernetes_fleet_manager_id,resource_group_name:kubernetes_fleet_manager_id,fleet_name:kubernetes_fleet_manager_id" -test-resource-type KubernetesFleetUpdateStrategyTestResource
+
 var (
-	_ sdk.Resource           = KubernetesFleetUpdateStrategyResource{}
-	_ sdk.ResourceWithUpdate = KubernetesFleetUpdateStrategyResource{}
+	_ sdk.Resource             = KubernetesFleetUpdateStrategyResource{}
+	_ sdk.ResourceWithUpdate   = KubernetesFleetUpdateStrategyResource{}
+	_ sdk.ResourceWithIdentity = KubernetesFleetUpdateStrategyResource{}
 )
 
 type KubernetesFleetUpdateStrategyResource struct{}
@@ -49,6 +53,10 @@ func (r KubernetesFleetUpdateStrategyResource) IDValidationFunc() pluginsdk.Sche
 	return fleetupdatestrategies.ValidateUpdateStrategyID
 }
 
+func (r KubernetesFleetUpdateStrategyResource) Identity() resourceids.ResourceId {
+	return &fleetupdatestrategies.UpdateStrategyId{}
+}
+
 func (r KubernetesFleetUpdateStrategyResource) ResourceType() string {
 	return "azurerm_kubernetes_fleet_update_strategy"
 }
@@ -144,7 +152,7 @@ func (r KubernetesFleetUpdateStrategyResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -215,6 +223,10 @@ func (r KubernetesFleetUpdateStrategyResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&schema)
 		},
 	}
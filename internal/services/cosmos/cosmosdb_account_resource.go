@@ -30,7 +30,6 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/migration"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -38,6 +37,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name cosmosdb_account -service-package-name cosmos -properties "name,resource_group_name" -test-params "GlobalDocumentDB,Eventual" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type CosmosDBAccountResource
+
 var CosmosDbAccountResourceName = "azurerm_cosmosdb_account"
 
 var connStringPropertyMap = map[string]string{
@@ -182,10 +183,11 @@ func resourceCosmosDbAccount() *pluginsdk.Resource {
 			}),
 		),
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.DatabaseAccountID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&cosmosdb.DatabaseAccountId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&cosmosdb.DatabaseAccountId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(180 * time.Minute),
@@ -975,6 +977,9 @@ func resourceCosmosDbAccountCreate(d *pluginsdk.ResourceData, meta interface{})
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 
 	return resourceCosmosDbAccountRead(d, meta)
 }
@@ -1317,10 +1322,16 @@ func resourceCosmosDbAccountRead(d *pluginsdk.ResourceData, meta interface{}) er
 		return fmt.Errorf("retrieving %s: %+v", id, err)
 	}
 
+	return resourceCosmosDbAccountFlatten(ctx, d, meta, id, existing.Model, true)
+}
+
+// resourceCosmosDbAccountFlatten sets the Resource Data from the model, the keys and connection strings require
+// additional API calls and are only retrieved when includeKeys is true
+func resourceCosmosDbAccountFlatten(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}, id *cosmosdb.DatabaseAccountId, model *cosmosdb.DatabaseAccountGetResults, includeKeys bool) error {
 	d.Set("name", id.DatabaseAccountName)
 	d.Set("resource_group_name", id.ResourceGroupName)
 
-	if model := existing.Model; model != nil {
+	if model != nil {
 		d.Set("location", location.NormalizeNilable(model.Location))
 		d.Set("kind", pointer.From(model.Kind))
 		d.Set("tags", model.Tags)
@@ -1428,64 +1439,69 @@ func resourceCosmosDbAccountRead(d *pluginsdk.ResourceData, meta interface{}) er
 				return fmt.Errorf("setting `write_endpoints`: %s", err)
 			}
 
-			// ListKeys returns a data structure containing a DatabaseAccountListReadOnlyKeysResult pointer
-			// implying that it also returns the read only keys, however this appears to not be the case
-			keys, err := client.DatabaseAccountsListKeys(ctx, *id)
-			if err != nil {
-				if response.WasNotFound(keys.HttpResponse) {
-					log.Printf("[DEBUG] Keys were not found for CosmosDB Account %q (Resource Group %q) - removing from state!", id.DatabaseAccountName, id.ResourceGroupName)
-					d.SetId("")
-					return nil
-				}
+			// the keys and connection strings require additional API calls
+			if includeKeys {
+				client := meta.(*clients.Client).Cosmos.CosmosDBClient
 
-				return fmt.Errorf("listing keys for %s: %w", id, err)
-			}
+				// ListKeys returns a data structure containing a DatabaseAccountListReadOnlyKeysResult pointer
+				// implying that it also returns the read only keys, however this appears to not be the case
+				keys, err := client.DatabaseAccountsListKeys(ctx, *id)
+				if err != nil {
+					if response.WasNotFound(keys.HttpResponse) {
+						log.Printf("[DEBUG] Keys were not found for CosmosDB Account %q (Resource Group %q) - removing from state!", id.DatabaseAccountName, id.ResourceGroupName)
+						d.SetId("")
+						return nil
+					}
 
-			if keys.Model != nil {
-				d.Set("primary_key", keys.Model.PrimaryMasterKey)
-				d.Set("secondary_key", keys.Model.SecondaryMasterKey)
-			}
+					return fmt.Errorf("listing keys for %s: %w", id, err)
+				}
 
-			readonlyKeys, err := client.DatabaseAccountsListReadOnlyKeys(ctx, *id)
-			if err != nil {
-				if response.WasNotFound(keys.HttpResponse) {
-					log.Printf("[DEBUG] Read Only Keys were not found for CosmosDB Account %q (Resource Group %q) - removing from state!", id.DatabaseAccountName, id.ResourceGroupName)
-					d.SetId("")
-					return nil
+				if keys.Model != nil {
+					d.Set("primary_key", keys.Model.PrimaryMasterKey)
+					d.Set("secondary_key", keys.Model.SecondaryMasterKey)
 				}
 
-				return fmt.Errorf("listing read-only keys for %s: %w", id, err)
-			}
-			if readonlyKeys.Model != nil {
-				d.Set("primary_readonly_key", readonlyKeys.Model.PrimaryReadonlyMasterKey)
-				d.Set("secondary_readonly_key", readonlyKeys.Model.SecondaryReadonlyMasterKey)
-			}
+				readonlyKeys, err := client.DatabaseAccountsListReadOnlyKeys(ctx, *id)
+				if err != nil {
+					if response.WasNotFound(keys.HttpResponse) {
+						log.Printf("[DEBUG] Read Only Keys were not found for CosmosDB Account %q (Resource Group %q) - removing from state!", id.DatabaseAccountName, id.ResourceGroupName)
+						d.SetId("")
+						return nil
+					}
 
-			connStringResp, err := client.DatabaseAccountsListConnectionStrings(ctx, *id)
-			if err != nil {
-				if response.WasNotFound(keys.HttpResponse) {
-					log.Printf("[DEBUG] Connection Strings were not found for CosmosDB Account %q (Resource Group %q) - removing from state!", id.ResourceGroupName, id.ResourceGroupName)
-					d.SetId("")
-					return nil
+					return fmt.Errorf("listing read-only keys for %s: %w", id, err)
+				}
+				if readonlyKeys.Model != nil {
+					d.Set("primary_readonly_key", readonlyKeys.Model.PrimaryReadonlyMasterKey)
+					d.Set("secondary_readonly_key", readonlyKeys.Model.SecondaryReadonlyMasterKey)
 				}
 
-				return fmt.Errorf("listing connection strings for %s: %w", id, err)
-			}
+				connStringResp, err := client.DatabaseAccountsListConnectionStrings(ctx, *id)
+				if err != nil {
+					if response.WasNotFound(keys.HttpResponse) {
+						log.Printf("[DEBUG] Connection Strings were not found for CosmosDB Account %q (Resource Group %q) - removing from state!", id.ResourceGroupName, id.ResourceGroupName)
+						d.SetId("")
+						return nil
+					}
 
-			var connStrings []string
-			if connStringResp.Model.ConnectionStrings != nil {
-				connStrings = make([]string, len(*connStringResp.Model.ConnectionStrings))
-				for i, v := range *connStringResp.Model.ConnectionStrings {
-					connStrings[i] = *v.ConnectionString
-					if propertyName, propertyExists := connStringPropertyMap[*v.Description]; propertyExists {
-						d.Set(propertyName, v.ConnectionString) // lintignore:R001
+					return fmt.Errorf("listing connection strings for %s: %w", id, err)
+				}
+
+				var connStrings []string
+				if connStringResp.Model.ConnectionStrings != nil {
+					connStrings = make([]string, len(*connStringResp.Model.ConnectionStrings))
+					for i, v := range *connStringResp.Model.ConnectionStrings {
+						connStrings[i] = *v.ConnectionString
+						if propertyName, propertyExists := connStringPropertyMap[*v.Description]; propertyExists {
+							d.Set(propertyName, v.ConnectionString) // lintignore:R001
+						}
 					}
 				}
 			}
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceCosmosDbAccountDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -0,0 +1,112 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package cosmos
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2024-08-15/cosmosdb"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type CosmosDbAccountListResource struct{}
+
+var _ sdk.FrameworkListWrappedResource = new(CosmosDbAccountListResource)
+
+func (r CosmosDbAccountListResource) ResourceFunc() *pluginsdk.Resource {
+	return resourceCosmosDbAccount()
+}
+
+func (r CosmosDbAccountListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = CosmosDbAccountResourceName
+}
+
+func (r CosmosDbAccountListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata sdk.ResourceMetadata) {
+	client := metadata.Client.Cosmos.CosmosDBClient
+
+	var data sdk.DefaultListModel
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	results := make([]cosmosdb.DatabaseAccountGetResults, 0)
+
+	subscriptionID := metadata.SubscriptionId
+	if !data.SubscriptionId.IsNull() {
+		subscriptionID = data.SubscriptionId.ValueString()
+	}
+
+	switch {
+	case !data.ResourceGroupName.IsNull():
+		resp, err := client.DatabaseAccountsListByResourceGroup(ctx, commonids.NewResourceGroupID(subscriptionID, data.ResourceGroupName.ValueString()))
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", CosmosDbAccountResourceName), err)
+			return
+		}
+
+		if model := resp.Model; model != nil {
+			results = pointer.From(model.Value)
+		}
+	default:
+		resp, err := client.DatabaseAccountsList(ctx, commonids.NewSubscriptionID(subscriptionID))
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", CosmosDbAccountResourceName), err)
+			return
+		}
+
+		if model := resp.Model; model != nil {
+			results = pointer.From(model.Value)
+		}
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		sdk.SetResponseErrorDiagnostic(stream, "internal-error", "context had no deadline")
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		ctx, cancel := context.WithDeadline(context.Background(), deadline)
+		defer cancel()
+
+		for _, account := range results {
+			result := request.NewListResult(ctx)
+			result.DisplayName = pointer.From(account.Name)
+
+			id, err := cosmosdb.ParseDatabaseAccountIDInsensitively(pointer.From(account.Id))
+			if err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, "parsing CosmosDB Account ID", err)
+				return
+			}
+
+			rd := resourceCosmosDbAccount().Data(&terraform.InstanceState{})
+			rd.SetId(id.ID())
+
+			// the keys and connection strings require additional API calls per Account, so are only retrieved when the full resource is requested
+			if err := resourceCosmosDbAccountFlatten(ctx, rd, metadata.Client, id, &account, request.IncludeResource); err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("encoding `%s` resource data", CosmosDbAccountResourceName), err)
+				return
+			}
+
+			sdk.EncodeListResult(ctx, rd, &result)
+			if result.Diagnostics.HasError() {
+				push(result)
+				return
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/keyvault"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2023-04-15/managedcassandras"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -23,6 +24,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name cosmosdb_cassandra_datacenter -service-package-name cosmos -properties "name" -compare-values "subscription_id:cassandra_cluster_id,resource_group_name:cassandra_cluster_id,cassandra_cluster_name:cassandra_cluster_id" -test-resource-type CassandraDatacenterResource
+
 func resourceCassandraDatacenter() *pluginsdk.Resource {
 	resource := &pluginsdk.Resource{
 		Create: resourceCassandraDatacenterCreate,
@@ -30,10 +33,11 @@ func resourceCassandraDatacenter() *pluginsdk.Resource {
 		Update: resourceCassandraDatacenterUpdate,
 		Delete: resourceCassandraDatacenterDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := managedcassandras.ParseDataCenterID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&managedcassandras.DataCenterId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&managedcassandras.DataCenterId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
@@ -183,6 +187,9 @@ func resourceCassandraDatacenterCreate(d *pluginsdk.ResourceData, meta interface
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 
 	return resourceCassandraDatacenterRead(d, meta)
 }
@@ -228,7 +235,7 @@ func resourceCassandraDatacenterRead(d *pluginsdk.ResourceData, meta interface{}
 			}
 		}
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceCassandraDatacenterUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package cosmos_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccCosmosdbCassandraDatacenter_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_cassandra_datacenter", "test")
+	r := CassandraDatacenterResource{}
+
+	checkedFields := map[string]struct{}{
+		"name":                   {},
+		"cassandra_cluster_name": {},
+		"resource_group_name":    {},
+		"subscription_id":        {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_cosmosdb_cassandra_datacenter.test", checkedFields),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_cosmosdb_cassandra_datacenter.test", tfjsonpath.New("name"), tfjsonpath.New("name")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_cosmosdb_cassandra_datacenter.test", tfjsonpath.New("cassandra_cluster_name"), tfjsonpath.New("cassandra_cluster_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_cosmosdb_cassandra_datacenter.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("cassandra_cluster_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_cosmosdb_cassandra_datacenter.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("cassandra_cluster_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
@@ -13,11 +13,11 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2024-08-15/cosmosdb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/migration"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -25,6 +25,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name cosmosdb_sql_container -service-package-name cosmos -properties "name,resource_group_name,database_account_name:account_name,sql_database_name:database_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type CosmosSqlContainerResource
+
 func resourceCosmosDbSQLContainer() *pluginsdk.Resource {
 	resource := &pluginsdk.Resource{
 		Create: resourceCosmosDbSQLContainerCreate,
@@ -32,10 +34,11 @@ func resourceCosmosDbSQLContainer() *pluginsdk.Resource {
 		Update: resourceCosmosDbSQLContainerUpdate,
 		Delete: resourceCosmosDbSQLContainerDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.SqlContainerID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&cosmosdb.ContainerId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&cosmosdb.ContainerId{}),
+		},
 
 		SchemaVersion: 1,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
@@ -238,6 +241,9 @@ func resourceCosmosDbSQLContainerCreate(d *pluginsdk.ResourceData, meta interfac
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 
 	return resourceCosmosDbSQLContainerRead(d, meta)
 }
@@ -318,7 +324,6 @@ func resourceCosmosDbSQLContainerUpdate(d *pluginsdk.ResourceData, meta interfac
 
 func resourceCosmosDbSQLContainerRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Cosmos.CosmosDBClient
-	accountClient := meta.(*clients.Client).Cosmos.DatabaseClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -338,12 +343,20 @@ func resourceCosmosDbSQLContainerRead(d *pluginsdk.ResourceData, meta interface{
 		return fmt.Errorf("reading %q: %+v", id, err)
 	}
 
+	if err := resourceCosmosDbSQLContainerFlatten(d, id, resp.Model); err != nil {
+		return err
+	}
+
+	return resourceCosmosDbSQLContainerFlattenThroughput(ctx, d, meta, id)
+}
+
+func resourceCosmosDbSQLContainerFlatten(d *pluginsdk.ResourceData, id *cosmosdb.ContainerId, model *cosmosdb.SqlContainerGetResults) error {
 	d.Set("name", id.ContainerName)
 	d.Set("resource_group_name", id.ResourceGroupName)
 	d.Set("account_name", id.DatabaseAccountName)
 	d.Set("database_name", id.SqlDatabaseName)
 
-	if model := resp.Model; model != nil {
+	if model != nil {
 		if props := model.Properties; props != nil {
 			if res := props.Resource; res != nil {
 				if pk := res.PartitionKey; pk != nil {
@@ -383,6 +396,14 @@ func resourceCosmosDbSQLContainerRead(d *pluginsdk.ResourceData, meta interface{
 		}
 	}
 
+	return pluginsdk.SetResourceIdentityData(d, id)
+}
+
+// resourceCosmosDbSQLContainerFlattenThroughput sets the throughput of the SQL Container, which requires additional API calls
+func resourceCosmosDbSQLContainerFlattenThroughput(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}, id *cosmosdb.ContainerId) error {
+	client := meta.(*clients.Client).Cosmos.CosmosDBClient
+	accountClient := meta.(*clients.Client).Cosmos.DatabaseClient
+
 	accResp, err := accountClient.Get(ctx, id.ResourceGroupName, id.DatabaseAccountName)
 	if err != nil {
 		return fmt.Errorf("reading CosmosDB Account %q (Resource Group %q): %+v", id.DatabaseAccountName, id.ResourceGroupName, err)
@@ -0,0 +1,127 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package cosmos
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2024-08-15/cosmosdb"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type CosmosDbSqlContainerListResource struct{}
+
+type CosmosDbSqlContainerListModel struct {
+	SqlDatabaseId types.String `tfsdk:"sql_database_id"`
+}
+
+var _ sdk.FrameworkListWrappedResourceWithConfig = new(CosmosDbSqlContainerListResource)
+
+func (r CosmosDbSqlContainerListResource) ResourceFunc() *pluginsdk.Resource {
+	return resourceCosmosDbSQLContainer()
+}
+
+func (r CosmosDbSqlContainerListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = `azurerm_cosmosdb_sql_container`
+}
+
+func (r CosmosDbSqlContainerListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"sql_database_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: cosmosdb.ValidateSqlDatabaseID,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r CosmosDbSqlContainerListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata sdk.ResourceMetadata) {
+	client := metadata.Client.Cosmos.CosmosDBClient
+
+	var data CosmosDbSqlContainerListModel
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	sqlDatabaseId, err := cosmosdb.ParseSqlDatabaseID(data.SqlDatabaseId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, "parsing CosmosDB SQL Database ID for `azurerm_cosmosdb_sql_container`", err)
+		return
+	}
+
+	results := make([]cosmosdb.SqlContainerGetResults, 0)
+	resp, err := client.SqlResourcesListSqlContainers(ctx, pointer.From(sqlDatabaseId))
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, "listing `azurerm_cosmosdb_sql_container`", err)
+		return
+	}
+	if model := resp.Model; model != nil {
+		results = pointer.From(model.Value)
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		sdk.SetResponseErrorDiagnostic(stream, "internal-error", "context had no deadline")
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		ctx, cancel := context.WithDeadline(context.Background(), deadline)
+		defer cancel()
+
+		for _, container := range results {
+			result := request.NewListResult(ctx)
+			result.DisplayName = pointer.From(container.Name)
+
+			id, err := cosmosdb.ParseContainerIDInsensitively(pointer.From(container.Id))
+			if err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, "parsing CosmosDB SQL Container ID", err)
+				return
+			}
+
+			rd := resourceCosmosDbSQLContainer().Data(&terraform.InstanceState{})
+			rd.SetId(id.ID())
+
+			if err := resourceCosmosDbSQLContainerFlatten(rd, id, &container); err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, "encoding `azurerm_cosmosdb_sql_container` resource data", err)
+				return
+			}
+
+			// the throughput requires additional API calls per SQL Container, so is only retrieved when the full resource is requested
+			if request.IncludeResource {
+				if err := resourceCosmosDbSQLContainerFlattenThroughput(ctx, rd, metadata.Client, id); err != nil {
+					sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("retrieving throughput for %s", id), err)
+					return
+				}
+			}
+
+			sdk.EncodeListResult(ctx, rd, &result)
+			if result.Diagnostics.HasError() {
+				push(result)
+				return
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
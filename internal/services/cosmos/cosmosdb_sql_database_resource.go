@@ -4,13 +4,17 @@
 package cosmos
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2021-10-15/documentdb" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2024-08-15/cosmosdb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/common"
@@ -22,6 +26,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name cosmosdb_sql_database -service-package-name cosmos -properties "name,resource_group_name,database_account_name:account_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type CosmosSqlDatabaseResource
+
 func resourceCosmosDbSQLDatabase() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceCosmosDbSQLDatabaseCreate,
@@ -29,10 +35,11 @@ func resourceCosmosDbSQLDatabase() *pluginsdk.Resource {
 		Update: resourceCosmosDbSQLDatabaseUpdate,
 		Delete: resourceCosmosDbSQLDatabaseDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := parse.SqlDatabaseID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&cosmosdb.SqlDatabaseId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&cosmosdb.SqlDatabaseId{}),
+		},
 
 		SchemaVersion: 1,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
@@ -125,6 +132,9 @@ func resourceCosmosDbSQLDatabaseCreate(d *pluginsdk.ResourceData, meta interface
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, pointer.To(cosmosdb.NewSqlDatabaseID(id.SubscriptionId, id.ResourceGroup, id.DatabaseAccountName, id.Name))); err != nil {
+		return err
+	}
 
 	return resourceCosmosDbSQLDatabaseRead(d, meta)
 }
@@ -182,7 +192,6 @@ func resourceCosmosDbSQLDatabaseUpdate(d *pluginsdk.ResourceData, meta interface
 
 func resourceCosmosDbSQLDatabaseRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Cosmos.SqlClient
-	accountClient := meta.(*clients.Client).Cosmos.DatabaseClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -202,14 +211,30 @@ func resourceCosmosDbSQLDatabaseRead(d *pluginsdk.ResourceData, meta interface{}
 		return fmt.Errorf("reading Cosmos SQL Database %q (Account: %q): %+v", id.Name, id.DatabaseAccountName, err)
 	}
 
+	if err := resourceCosmosDbSQLDatabaseFlatten(d, id, resp.SQLDatabaseGetProperties); err != nil {
+		return err
+	}
+
+	return resourceCosmosDbSQLDatabaseFlattenThroughput(ctx, d, meta, id)
+}
+
+func resourceCosmosDbSQLDatabaseFlatten(d *pluginsdk.ResourceData, id *parse.SqlDatabaseId, props *documentdb.SQLDatabaseGetProperties) error {
 	d.Set("resource_group_name", id.ResourceGroup)
 	d.Set("account_name", id.DatabaseAccountName)
-	if props := resp.SQLDatabaseGetProperties; props != nil {
+	if props != nil {
 		if res := props.Resource; res != nil {
 			d.Set("name", res.ID)
 		}
 	}
 
+	return pluginsdk.SetResourceIdentityData(d, pointer.To(cosmosdb.NewSqlDatabaseID(id.SubscriptionId, id.ResourceGroup, id.DatabaseAccountName, id.Name)))
+}
+
+// resourceCosmosDbSQLDatabaseFlattenThroughput sets the throughput of the SQL Database, which requires additional API calls
+func resourceCosmosDbSQLDatabaseFlattenThroughput(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}, id *parse.SqlDatabaseId) error {
+	client := meta.(*clients.Client).Cosmos.SqlClient
+	accountClient := meta.(*clients.Client).Cosmos.DatabaseClient
+
 	accResp, err := accountClient.Get(ctx, id.ResourceGroup, id.DatabaseAccountName)
 	if err != nil {
 		return fmt.Errorf("reading CosmosDB Account %q (Resource Group %q): %+v", id.DatabaseAccountName, id.ResourceGroup, err)
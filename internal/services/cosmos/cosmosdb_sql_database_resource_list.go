@@ -0,0 +1,125 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package cosmos
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2024-08-15/cosmosdb"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type CosmosDbSqlDatabaseListResource struct{}
+
+type CosmosDbSqlDatabaseListModel struct {
+	AccountId types.String `tfsdk:"account_id"`
+}
+
+var _ sdk.FrameworkListWrappedResourceWithConfig = new(CosmosDbSqlDatabaseListResource)
+
+func (r CosmosDbSqlDatabaseListResource) ResourceFunc() *pluginsdk.Resource {
+	return resourceCosmosDbSQLDatabase()
+}
+
+func (r CosmosDbSqlDatabaseListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = `azurerm_cosmosdb_sql_database`
+}
+
+func (r CosmosDbSqlDatabaseListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: cosmosdb.ValidateDatabaseAccountID,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r CosmosDbSqlDatabaseListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata sdk.ResourceMetadata) {
+	client := metadata.Client.Cosmos.SqlClient
+
+	var data CosmosDbSqlDatabaseListModel
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	accountId, err := cosmosdb.ParseDatabaseAccountID(data.AccountId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, "parsing CosmosDB Account ID for `azurerm_cosmosdb_sql_database`", err)
+		return
+	}
+
+	resp, err := client.ListSQLDatabases(ctx, accountId.ResourceGroupName, accountId.DatabaseAccountName)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, "listing `azurerm_cosmosdb_sql_database`", err)
+		return
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		sdk.SetResponseErrorDiagnostic(stream, "internal-error", "context had no deadline")
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		ctx, cancel := context.WithDeadline(context.Background(), deadline)
+		defer cancel()
+
+		for _, database := range pointer.From(resp.Value) {
+			result := request.NewListResult(ctx)
+			result.DisplayName = pointer.From(database.Name)
+
+			sqlDatabaseId, err := cosmosdb.ParseSqlDatabaseIDInsensitively(pointer.From(database.ID))
+			if err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, "parsing CosmosDB SQL Database ID", err)
+				return
+			}
+			id := parse.NewSqlDatabaseID(sqlDatabaseId.SubscriptionId, sqlDatabaseId.ResourceGroupName, sqlDatabaseId.DatabaseAccountName, sqlDatabaseId.SqlDatabaseName)
+
+			rd := resourceCosmosDbSQLDatabase().Data(&terraform.InstanceState{})
+			rd.SetId(id.ID())
+
+			if err := resourceCosmosDbSQLDatabaseFlatten(rd, &id, database.SQLDatabaseGetProperties); err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, "encoding `azurerm_cosmosdb_sql_database` resource data", err)
+				return
+			}
+
+			// the throughput requires additional API calls per SQL Database, so is only retrieved when the full resource is requested
+			if request.IncludeResource {
+				if err := resourceCosmosDbSQLDatabaseFlattenThroughput(ctx, rd, metadata.Client, &id); err != nil {
+					sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("retrieving throughput for %s", id), err)
+					return
+				}
+			}
+
+			sdk.EncodeListResult(ctx, rd, &result)
+			if result.Diagnostics.HasError() {
+				push(result)
+				return
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
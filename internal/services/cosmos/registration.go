@@ -104,5 +104,9 @@ func (r Registration) EphemeralResources() []func() ephemeral.EphemeralResource
 }
 
 func (r Registration) ListResources() []sdk.FrameworkListWrappedResource {
-	return []sdk.FrameworkListWrappedResource{}
+	return []sdk.FrameworkListWrappedResource{
+		CosmosDbAccountListResource{},
+		CosmosDbSqlContainerListResource{},
+		CosmosDbSqlDatabaseListResource{},
+	}
 }
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/credentials"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -17,11 +18,14 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name data_factory_credential_service_principal -service-package-name datafactory -properties "name" -compare-values "subscription_id:data_factory_id,resource_group_name:data_factory_id,factory_name:data_factory_id" -test-resource-type CredentialServicePrincipalResource
+
 type DataFactoryCredentialServicePrincipalResource struct{}
 
 var (
-	_ sdk.Resource           = DataFactoryCredentialServicePrincipalResource{}
-	_ sdk.ResourceWithUpdate = DataFactoryCredentialServicePrincipalResource{}
+	_ sdk.Resource             = DataFactoryCredentialServicePrincipalResource{}
+	_ sdk.ResourceWithUpdate   = DataFactoryCredentialServicePrincipalResource{}
+	_ sdk.ResourceWithIdentity = DataFactoryCredentialServicePrincipalResource{}
 )
 
 func (DataFactoryCredentialServicePrincipalResource) ResourceType() string {
@@ -133,6 +137,10 @@ func (DataFactoryCredentialServicePrincipalResource) IDValidationFunc() pluginsd
 	return credentials.ValidateCredentialID
 }
 
+func (DataFactoryCredentialServicePrincipalResource) Identity() resourceids.ResourceId {
+	return &credentials.CredentialId{}
+}
+
 func (r DataFactoryCredentialServicePrincipalResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 5 * time.Minute,
@@ -185,6 +193,9 @@ func (r DataFactoryCredentialServicePrincipalResource) Create() sdk.ResourceFunc
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			return nil
 		},
@@ -232,6 +243,10 @@ func (DataFactoryCredentialServicePrincipalResource) Read() sdk.ResourceFunc {
 				state.ServicePrincipalKey = flattenDataFactoryCredentialKeyVaultSecretReference(props.TypeProperties.ServicePrincipalKey)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package datafactory_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccDataFactoryCredentialServicePrincipal_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_credential_service_principal", "test")
+	r := CredentialServicePrincipalResource{}
+
+	checkedFields := map[string]struct{}{
+		"name":                {},
+		"factory_name":        {},
+		"resource_group_name": {},
+		"subscription_id":     {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_data_factory_credential_service_principal.test", checkedFields),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_data_factory_credential_service_principal.test", tfjsonpath.New("name"), tfjsonpath.New("name")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_data_factory_credential_service_principal.test", tfjsonpath.New("factory_name"), tfjsonpath.New("data_factory_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_data_factory_credential_service_principal.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("data_factory_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_data_factory_credential_service_principal.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("data_factory_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
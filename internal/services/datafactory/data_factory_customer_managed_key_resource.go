@@ -13,12 +13,15 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/keyvault"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/factories"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name data_factory_customer_managed_key -service-package-name datafactory -compare-values "subscription_id:data_factory_id,resource_group_name:data_factory_id,name:data_factory_id" -test-name systemAssignedKey -test-resource-type DataFactoryCustomerManagedKeyTestResource
+
 type DataFactoryCustomerManagedKeyResource struct{}
 
 type DataFactoryCustomerManagedKeyModel struct {
@@ -28,6 +31,7 @@ type DataFactoryCustomerManagedKeyModel struct {
 }
 
 var _ sdk.ResourceWithUpdate = DataFactoryCustomerManagedKeyResource{}
+var _ sdk.ResourceWithIdentity = DataFactoryCustomerManagedKeyResource{}
 
 func (r DataFactoryCustomerManagedKeyResource) ModelObject() interface{} {
 	return &DataFactoryCustomerManagedKeyModel{}
@@ -41,6 +45,10 @@ func (r DataFactoryCustomerManagedKeyResource) IDValidationFunc() pluginsdk.Sche
 	return factories.ValidateFactoryID
 }
 
+func (r DataFactoryCustomerManagedKeyResource) Identity() resourceids.ResourceId {
+	return &factories.FactoryId{}
+}
+
 func (r DataFactoryCustomerManagedKeyResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"data_factory_id": {
@@ -124,6 +132,9 @@ func (r DataFactoryCustomerManagedKeyResource) Create() sdk.ResourceFunc {
 				return fmt.Errorf("creating %s: %+v", id, err)
 			}
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
 
 			return nil
 		},
@@ -181,6 +192,10 @@ func (r DataFactoryCustomerManagedKeyResource) Read() sdk.ResourceFunc {
 				UserAssignedIdentityID: customerManagedKeyIdentityId,
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
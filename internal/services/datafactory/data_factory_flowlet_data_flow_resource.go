@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/dataflows"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/factories"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/helper"
@@ -20,6 +21,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name data_factory_flowlet_data_flow -service-package-name datafactory -properties "name" -compare-values "subscription_id:data_factory_id,resource_group_name:data_factory_id,factory_name:data_factory_id" -test-resource-type FlowletDataFlowResource
+
 func resourceDataFactoryFlowletDataFlow() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceDataFactoryFlowletDataFlowCreateUpdate,
@@ -27,10 +30,11 @@ func resourceDataFactoryFlowletDataFlow() *pluginsdk.Resource {
 		Update: resourceDataFactoryFlowletDataFlowCreateUpdate,
 		Delete: resourceDataFactoryFlowletDataFlowDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := dataflows.ParseDataflowID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&dataflows.DataflowId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&dataflows.DataflowId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -158,6 +162,9 @@ func resourceDataFactoryFlowletDataFlowCreateUpdate(d *pluginsdk.ResourceData, m
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 
 	return resourceDataFactoryFlowletDataFlowRead(d, meta)
 }
@@ -218,7 +225,7 @@ func resourceDataFactoryFlowletDataFlowRead(d *pluginsdk.ResourceData, meta inte
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceDataFactoryFlowletDataFlowDelete(d *pluginsdk.ResourceData, meta interface{}) error {
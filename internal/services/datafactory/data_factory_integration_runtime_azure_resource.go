@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/factories"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/integrationruntimes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/migration"
@@ -21,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name data_factory_integration_runtime_azure -service-package-name datafactory -properties "name" -compare-values "subscription_id:data_factory_id,resource_group_name:data_factory_id,factory_name:data_factory_id" -test-resource-type IntegrationRuntimeAzureResource
+
 func resourceDataFactoryIntegrationRuntimeAzure() *pluginsdk.Resource {
 	resource := &pluginsdk.Resource{
 		Create: resourceDataFactoryIntegrationRuntimeAzureCreate,
@@ -33,10 +36,11 @@ func resourceDataFactoryIntegrationRuntimeAzure() *pluginsdk.Resource {
 			0: migration.DataFactoryIntegrationRuntimeAzureV0ToV1{},
 		}),
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := integrationruntimes.ParseIntegrationRuntimeID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&integrationruntimes.IntegrationRuntimeId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&integrationruntimes.IntegrationRuntimeId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -186,6 +190,9 @@ func resourceDataFactoryIntegrationRuntimeAzureCreate(d *pluginsdk.ResourceData,
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 
 	return resourceDataFactoryIntegrationRuntimeAzureRead(d, meta)
 }
@@ -300,7 +307,7 @@ func resourceDataFactoryIntegrationRuntimeAzureRead(d *pluginsdk.ResourceData, m
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceDataFactoryIntegrationRuntimeAzureDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/factories"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/linkedservices"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
@@ -20,6 +21,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name data_factory_linked_service_sql_managed_instance -service-package-name datafactory -properties "name" -compare-values "subscription_id:data_factory_id,resource_group_name:data_factory_id,factory_name:data_factory_id" -test-resource-type LinkedServiceSQLManagedInstanceResource
+
 type LinkedServiceSqlManagedInstanceResource struct{}
 
 type LinkedServiceSqlManagedInstanceModel struct {
@@ -48,6 +51,7 @@ type KeyVaultPasswordConfig struct {
 }
 
 var _ sdk.ResourceWithUpdate = LinkedServiceSqlManagedInstanceResource{}
+var _ sdk.ResourceWithIdentity = LinkedServiceSqlManagedInstanceResource{}
 
 func (r LinkedServiceSqlManagedInstanceResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
@@ -248,7 +252,7 @@ func (r LinkedServiceSqlManagedInstanceResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -326,6 +330,10 @@ func (r LinkedServiceSqlManagedInstanceResource) Read() sdk.ResourceFunc {
 				state.IntegrationRuntimeName = connectVia.ReferenceName
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -446,6 +454,10 @@ func (r LinkedServiceSqlManagedInstanceResource) IDValidationFunc() pluginsdk.Sc
 	return linkedservices.ValidateLinkedServiceID
 }
 
+func (r LinkedServiceSqlManagedInstanceResource) Identity() resourceids.ResourceId {
+	return &linkedservices.LinkedServiceId{}
+}
+
 func expandLinkedServiceSqlManagedInstanceKeyVaultConnectionString(input []KeyVaultConnectionStringConfig) interface{} {
 	if len(input) == 0 {
 		return nil
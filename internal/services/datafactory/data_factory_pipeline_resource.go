@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/factories"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/pipelines"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/validate"
@@ -22,6 +23,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name data_factory_pipeline -service-package-name datafactory -properties "name" -compare-values "subscription_id:data_factory_id,resource_group_name:data_factory_id,factory_name:data_factory_id" -test-resource-type PipelineResource
+
 func resourceDataFactoryPipeline() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceDataFactoryPipelineCreateUpdate,
@@ -29,10 +32,11 @@ func resourceDataFactoryPipeline() *pluginsdk.Resource {
 		Update: resourceDataFactoryPipelineCreateUpdate,
 		Delete: resourceDataFactoryPipelineDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := pipelines.ParsePipelineID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&pipelines.PipelineId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&pipelines.PipelineId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -206,6 +210,9 @@ func resourceDataFactoryPipelineCreateUpdate(d *pluginsdk.ResourceData, meta int
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 
 	return resourceDataFactoryPipelineRead(d, meta)
 }
@@ -281,7 +288,7 @@ func resourceDataFactoryPipelineRead(d *pluginsdk.ResourceData, meta interface{}
 		d.Set("activities_json", activitiesJson)
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceDataFactoryPipelineDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/factories"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/datafactory/2018-06-01/managedvirtualnetworks"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/purview/2021-07-01/account"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/migration"
@@ -29,6 +30,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name data_factory -service-package-name datafactory -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 func resourceDataFactory() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceDataFactoryCreateUpdate,
@@ -42,10 +45,11 @@ func resourceDataFactory() *pluginsdk.Resource {
 			1: migration.DataFactoryV1ToV2{},
 		}),
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := factories.ParseFactoryID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&factories.FactoryId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&factories.FactoryId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -295,6 +299,9 @@ func resourceDataFactoryCreateUpdate(d *pluginsdk.ResourceData, meta interface{}
 		return fmt.Errorf("creating/updating %s: %+v", id, err)
 	}
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 
 	githubConfiguration := expandGitHubRepoConfiguration(d.Get("github_configuration").([]interface{}))
 	if githubConfiguration != nil {
@@ -437,7 +444,7 @@ func resourceDataFactoryRead(d *pluginsdk.ResourceData, meta interface{}) error
 	}
 	d.Set("managed_virtual_network_enabled", managedVirtualNetworkName != nil)
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceDataFactoryDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2025-07-01/backupinstanceresources"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2025-07-01/backupvaultresources"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2025-07-01/basebackuppolicyresources"
@@ -21,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name data_protection_backup_instance_mysql_flexible_server -service-package-name dataprotection -properties "name" -compare-values "subscription_id:vault_id,resource_group_name:vault_id,backup_vault_name:vault_id"
+
 type BackupInstanceMySQLFlexibleServerModel struct {
 	Name            string `tfschema:"name"`
 	Location        string `tfschema:"location"`
@@ -33,6 +36,7 @@ type BackupInstanceMySQLFlexibleServerModel struct {
 type DataProtectionBackupInstanceMySQLFlexibleServerResource struct{}
 
 var _ sdk.Resource = DataProtectionBackupInstanceMySQLFlexibleServerResource{}
+var _ sdk.ResourceWithIdentity = DataProtectionBackupInstanceMySQLFlexibleServerResource{}
 
 func (r DataProtectionBackupInstanceMySQLFlexibleServerResource) ResourceType() string {
 	return "azurerm_data_protection_backup_instance_mysql_flexible_server"
@@ -46,6 +50,10 @@ func (r DataProtectionBackupInstanceMySQLFlexibleServerResource) IDValidationFun
 	return backupinstanceresources.ValidateBackupInstanceID
 }
 
+func (r DataProtectionBackupInstanceMySQLFlexibleServerResource) Identity() resourceids.ResourceId {
+	return &backupinstanceresources.BackupInstanceId{}
+}
+
 func (r DataProtectionBackupInstanceMySQLFlexibleServerResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -164,7 +172,7 @@ func (r DataProtectionBackupInstanceMySQLFlexibleServerResource) Create() sdk.Re
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -216,6 +224,10 @@ func (r DataProtectionBackupInstanceMySQLFlexibleServerResource) Read() sdk.Reso
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
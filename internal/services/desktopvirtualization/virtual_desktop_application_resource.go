@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2024-04-03/application"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2024-04-03/applicationgroup"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
@@ -21,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name virtual_desktop_application -service-package-name desktopvirtualization -properties "name" -compare-values "subscription_id:application_group_id,resource_group_name:application_group_id,application_group_name:application_group_id"
+
 var applicationType = "azurerm_virtual_desktop_application"
 
 func resourceVirtualDesktopApplication() *pluginsdk.Resource {
@@ -37,10 +40,11 @@ func resourceVirtualDesktopApplication() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
 		},
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := application.ParseApplicationID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&application.ApplicationId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&application.ApplicationId{}),
+		},
 
 		SchemaVersion: 0,
 
@@ -165,6 +169,10 @@ func resourceVirtualDesktopApplicationCreateUpdate(d *pluginsdk.ResourceData, me
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
+
 	return resourceVirtualDesktopApplicationRead(d, meta)
 }
 
@@ -205,7 +213,7 @@ func resourceVirtualDesktopApplicationRead(d *pluginsdk.ResourceData, meta inter
 		d.Set("icon_index", props.IconIndex)
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceVirtualDesktopApplicationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package desktopvirtualization_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccVirtualDesktopApplication_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_desktop_application", "test")
+	r := VirtualDesktopApplicationResource{}
+
+	checkedFields := map[string]struct{}{
+		"name":                   {},
+		"application_group_name": {},
+		"resource_group_name":    {},
+		"subscription_id":        {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_virtual_desktop_application.test", checkedFields),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_virtual_desktop_application.test", tfjsonpath.New("name"), tfjsonpath.New("name")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_virtual_desktop_application.test", tfjsonpath.New("application_group_name"), tfjsonpath.New("application_group_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_virtual_desktop_application.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("application_group_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_virtual_desktop_application.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("application_group_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
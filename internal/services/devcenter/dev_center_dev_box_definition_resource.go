@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2025-02-01/devboxdefinitions"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2025-02-01/images"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -20,9 +21,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name dev_center_dev_box_definition -service-package-name devcenter -properties "name" -compare-values "subscription_id:dev_center_id,resource_group_name:dev_center_id,dev_center_name:dev_center_id" -test-resource-type DevCenterDevBoxDefinitionTestResource
+
 var (
-	_ sdk.Resource           = DevCenterDevBoxDefinitionResource{}
-	_ sdk.ResourceWithUpdate = DevCenterDevBoxDefinitionResource{}
+	_ sdk.Resource             = DevCenterDevBoxDefinitionResource{}
+	_ sdk.ResourceWithUpdate   = DevCenterDevBoxDefinitionResource{}
+	_ sdk.ResourceWithIdentity = DevCenterDevBoxDefinitionResource{}
 )
 
 type DevCenterDevBoxDefinitionResource struct{}
@@ -45,6 +49,10 @@ func (r DevCenterDevBoxDefinitionResource) IDValidationFunc() pluginsdk.SchemaVa
 	return devboxdefinitions.ValidateDevCenterDevBoxDefinitionID
 }
 
+func (r DevCenterDevBoxDefinitionResource) Identity() resourceids.ResourceId {
+	return &devboxdefinitions.DevCenterDevBoxDefinitionId{}
+}
+
 func (r DevCenterDevBoxDefinitionResource) ResourceType() string {
 	return "azurerm_dev_center_dev_box_definition"
 }
@@ -136,7 +144,7 @@ func (r DevCenterDevBoxDefinitionResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -182,6 +190,10 @@ func (r DevCenterDevBoxDefinitionResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
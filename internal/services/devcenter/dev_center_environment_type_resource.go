@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2025-02-01/devcenters"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2025-02-01/environmenttypes"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -18,9 +19,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name dev_center_environment_type -service-package-name devcenter -properties "name" -compare-values "subscription_id:dev_center_id,resource_group_name:dev_center_id,dev_center_name:dev_center_id" -test-resource-type DevCenterEnvironmentTypeTestResource
+
 var (
-	_ sdk.Resource           = DevCenterEnvironmentTypeResource{}
-	_ sdk.ResourceWithUpdate = DevCenterEnvironmentTypeResource{}
+	_ sdk.Resource             = DevCenterEnvironmentTypeResource{}
+	_ sdk.ResourceWithUpdate   = DevCenterEnvironmentTypeResource{}
+	_ sdk.ResourceWithIdentity = DevCenterEnvironmentTypeResource{}
 )
 
 type DevCenterEnvironmentTypeResource struct{}
@@ -39,6 +43,10 @@ func (r DevCenterEnvironmentTypeResource) IDValidationFunc() pluginsdk.SchemaVal
 	return environmenttypes.ValidateDevCenterEnvironmentTypeID
 }
 
+func (r DevCenterEnvironmentTypeResource) Identity() resourceids.ResourceId {
+	return &environmenttypes.DevCenterEnvironmentTypeId{}
+}
+
 func (r DevCenterEnvironmentTypeResource) ResourceType() string {
 	return "azurerm_dev_center_environment_type"
 }
@@ -101,7 +109,7 @@ func (r DevCenterEnvironmentTypeResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -134,6 +142,10 @@ func (r DevCenterEnvironmentTypeResource) Read() sdk.ResourceFunc {
 				state.Tags = pointer.From(model.Tags)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
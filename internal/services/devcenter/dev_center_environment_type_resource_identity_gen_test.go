@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package devcenter_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccDevCenterEnvironmentType_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dev_center_environment_type", "test")
+	r := DevCenterEnvironmentTypeTestResource{}
+
+	checkedFields := map[string]struct{}{
+		"name":                {},
+		"dev_center_name":     {},
+		"resource_group_name": {},
+		"subscription_id":     {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_dev_center_environment_type.test", checkedFields),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_dev_center_environment_type.test", tfjsonpath.New("name"), tfjsonpath.New("name")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_dev_center_environment_type.test", tfjsonpath.New("dev_center_name"), tfjsonpath.New("dev_center_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_dev_center_environment_type.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("dev_center_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_dev_center_environment_type.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("dev_center_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
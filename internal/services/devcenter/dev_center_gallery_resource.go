@@ -9,12 +9,16 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2025-02-01/galleries"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name dev_center_gallery -service-package-name devcenter -properties "name" -compare-values "subscription_id:dev_center_id,resource_group_name:dev_center_id,dev_center_name:dev_center_id" -test-resource-type DevCenterGalleryTestResource
+
 var _ sdk.Resource = DevCenterGalleryResource{}
+var _ sdk.ResourceWithIdentity = DevCenterGalleryResource{}
 
 type DevCenterGalleryResource struct{}
 
@@ -32,6 +36,10 @@ func (r DevCenterGalleryResource) IDValidationFunc() pluginsdk.SchemaValidateFun
 	return galleries.ValidateGalleryID
 }
 
+func (r DevCenterGalleryResource) Identity() resourceids.ResourceId {
+	return &galleries.GalleryId{}
+}
+
 func (r DevCenterGalleryResource) ResourceType() string {
 	return "azurerm_dev_center_gallery"
 }
@@ -100,7 +108,7 @@ func (r DevCenterGalleryResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -136,6 +144,10 @@ func (r DevCenterGalleryResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&schema)
 		},
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2025-02-01/networkconnections"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/devcenter/validate"
@@ -20,9 +21,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name dev_center_network_connection -service-package-name devcenter -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type DevCenterNetworkConnectionTestResource
+
 var (
-	_ sdk.Resource           = DevCenterNetworkConnectionResource{}
-	_ sdk.ResourceWithUpdate = DevCenterNetworkConnectionResource{}
+	_ sdk.Resource             = DevCenterNetworkConnectionResource{}
+	_ sdk.ResourceWithUpdate   = DevCenterNetworkConnectionResource{}
+	_ sdk.ResourceWithIdentity = DevCenterNetworkConnectionResource{}
 )
 
 type DevCenterNetworkConnectionResource struct{}
@@ -48,6 +52,10 @@ func (r DevCenterNetworkConnectionResource) IDValidationFunc() pluginsdk.SchemaV
 	return networkconnections.ValidateNetworkConnectionID
 }
 
+func (r DevCenterNetworkConnectionResource) Identity() resourceids.ResourceId {
+	return &networkconnections.NetworkConnectionId{}
+}
+
 func (r DevCenterNetworkConnectionResource) ResourceType() string {
 	return "azurerm_dev_center_network_connection"
 }
@@ -162,7 +170,7 @@ func (r DevCenterNetworkConnectionResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -208,6 +216,10 @@ func (r DevCenterNetworkConnectionResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
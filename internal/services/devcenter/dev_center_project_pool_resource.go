@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2025-02-01/pools"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2025-02-01/projects"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -20,9 +21,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name dev_center_project_pool -service-package-name devcenter -properties "name" -compare-values "subscription_id:dev_center_project_id,resource_group_name:dev_center_project_id,project_name:dev_center_project_id" -test-resource-type DevCenterProjectPoolTestResource
+
 var (
-	_ sdk.Resource           = DevCenterProjectPoolResource{}
-	_ sdk.ResourceWithUpdate = DevCenterProjectPoolResource{}
+	_ sdk.Resource             = DevCenterProjectPoolResource{}
+	_ sdk.ResourceWithUpdate   = DevCenterProjectPoolResource{}
+	_ sdk.ResourceWithIdentity = DevCenterProjectPoolResource{}
 )
 
 type DevCenterProjectPoolResource struct{}
@@ -48,6 +52,10 @@ func (r DevCenterProjectPoolResource) IDValidationFunc() pluginsdk.SchemaValidat
 	return pools.ValidatePoolID
 }
 
+func (r DevCenterProjectPoolResource) Identity() resourceids.ResourceId {
+	return &pools.PoolId{}
+}
+
 func (r DevCenterProjectPoolResource) ResourceType() string {
 	return "azurerm_dev_center_project_pool"
 }
@@ -176,7 +184,7 @@ func (r DevCenterProjectPoolResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -219,6 +227,10 @@ func (r DevCenterProjectPoolResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
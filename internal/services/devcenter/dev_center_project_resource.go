@@ -13,15 +13,19 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2025-02-01/projects"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name dev_center_project -service-package-name devcenter -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type DevCenterProjectTestResource
+
 var (
-	_ sdk.Resource           = DevCenterProjectResource{}
-	_ sdk.ResourceWithUpdate = DevCenterProjectResource{}
+	_ sdk.Resource             = DevCenterProjectResource{}
+	_ sdk.ResourceWithUpdate   = DevCenterProjectResource{}
+	_ sdk.ResourceWithIdentity = DevCenterProjectResource{}
 )
 
 type DevCenterProjectResource struct{}
@@ -46,6 +50,10 @@ func (r DevCenterProjectResource) IDValidationFunc() pluginsdk.SchemaValidateFun
 	return projects.ValidateProjectID
 }
 
+func (r DevCenterProjectResource) Identity() resourceids.ResourceId {
+	return &projects.ProjectId{}
+}
+
 func (r DevCenterProjectResource) ResourceType() string {
 	return "azurerm_dev_center_project"
 }
@@ -136,7 +144,7 @@ func (r DevCenterProjectResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -181,6 +189,10 @@ func (r DevCenterProjectResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&schema)
 		},
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/devtestlab/2018-09-15/policies"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -23,16 +24,20 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name dev_test_policy -service-package-name devtestlabs -properties "name,resource_group_name,lab_name,policy_set_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 func resourceArmDevTestPolicy() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceArmDevTestPolicyCreateUpdate,
 		Read:   resourceArmDevTestPolicyRead,
 		Update: resourceArmDevTestPolicyCreateUpdate,
 		Delete: resourceArmDevTestPolicyDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := policies.ParsePolicyID(id)
-			return err
-		}),
+
+		Importer: pluginsdk.ImporterValidatingIdentity(&policies.PolicyId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&policies.PolicyId{}),
+		},
 
 		SchemaVersion: 1,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
@@ -157,6 +162,9 @@ func resourceArmDevTestPolicyCreateUpdate(d *pluginsdk.ResourceData, meta interf
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 
 	return resourceArmDevTestPolicyRead(d, meta)
 }
@@ -198,7 +206,7 @@ func resourceArmDevTestPolicyRead(d *pluginsdk.ResourceData, meta interface{}) e
 			return err
 		}
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceArmDevTestPolicyDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/devtestlab/2018-09-15/schedules"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -25,16 +26,20 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name dev_test_schedule -service-package-name devtestlabs -properties "name,resource_group_name,lab_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-name autoShutdownBasic -test-resource-type DevTestLabScheduleResource
+
 func resourceDevTestLabSchedules() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceDevTestLabSchedulesCreateUpdate,
 		Read:   resourceDevTestLabSchedulesRead,
 		Update: resourceDevTestLabSchedulesCreateUpdate,
 		Delete: resourceDevTestLabSchedulesDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := schedules.ParseLabScheduleID(id)
-			return err
-		}),
+
+		Importer: pluginsdk.ImporterValidatingIdentity(&schedules.LabScheduleId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&schedules.LabScheduleId{}),
+		},
 
 		SchemaVersion: 1,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
@@ -261,6 +266,9 @@ func resourceDevTestLabSchedulesCreateUpdate(d *pluginsdk.ResourceData, meta int
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 
 	return resourceDevTestLabSchedulesRead(d, meta)
 }
@@ -317,7 +325,7 @@ func resourceDevTestLabSchedulesRead(d *pluginsdk.ResourceData, meta interface{}
 			return err
 		}
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceDevTestLabSchedulesDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/digitaltwins/2023-01-31/timeseriesdatabaseconnections"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-11-01/eventhubs"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -21,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name digital_twins_time_series_database_connection -service-package-name digitaltwins -properties "name" -compare-values "subscription_id:digital_twins_id,resource_group_name:digital_twins_id,digital_twins_instance_name:digital_twins_id" -test-resource-type TimeSeriesDatabaseConnectionResource
+
 type TimeSeriesDatabaseConnectionModel struct {
 	Name                         string `tfschema:"name"`
 	DigitalTwinsId               string `tfschema:"digital_twins_id"`
@@ -36,6 +39,8 @@ type TimeSeriesDatabaseConnectionModel struct {
 
 type TimeSeriesDatabaseConnectionResource struct{}
 
+var _ sdk.ResourceWithIdentity = TimeSeriesDatabaseConnectionResource{}
+
 func (m TimeSeriesDatabaseConnectionResource) Arguments() map[string]*pluginsdk.Schema {
 	resource := map[string]*pluginsdk.Schema{
 		"name": {
@@ -130,6 +135,10 @@ func (m TimeSeriesDatabaseConnectionResource) IDValidationFunc() pluginsdk.Schem
 	return timeseriesdatabaseconnections.ValidateTimeSeriesDatabaseConnectionID
 }
 
+func (m TimeSeriesDatabaseConnectionResource) Identity() resourceids.ResourceId {
+	return &timeseriesdatabaseconnections.TimeSeriesDatabaseConnectionId{}
+}
+
 func (m TimeSeriesDatabaseConnectionResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -182,7 +191,7 @@ func (m TimeSeriesDatabaseConnectionResource) Create() sdk.ResourceFunc {
 			}
 
 			meta.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(meta.ResourceData, &id)
 		},
 	}
 }
@@ -240,6 +249,10 @@ func (m TimeSeriesDatabaseConnectionResource) Read() sdk.ResourceFunc {
 				output.KustoTableName = kustoTableName
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(meta.ResourceData, id); err != nil {
+				return err
+			}
+
 			return meta.Encode(&output)
 		},
 	}
@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package digitaltwins_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccDigitalTwinsTimeSeriesDatabaseConnection_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_digital_twins_time_series_database_connection", "test")
+	r := TimeSeriesDatabaseConnectionResource{}
+
+	checkedFields := map[string]struct{}{
+		"name":                        {},
+		"digital_twins_instance_name": {},
+		"resource_group_name":         {},
+		"subscription_id":             {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_digital_twins_time_series_database_connection.test", checkedFields),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_digital_twins_time_series_database_connection.test", tfjsonpath.New("name"), tfjsonpath.New("name")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_digital_twins_time_series_database_connection.test", tfjsonpath.New("digital_twins_instance_name"), tfjsonpath.New("digital_twins_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_digital_twins_time_series_database_connection.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("digital_twins_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_digital_twins_time_series_database_connection.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("digital_twins_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
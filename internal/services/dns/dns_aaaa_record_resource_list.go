@@ -0,0 +1,34 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package dns
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/recordsets"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type DnsAAAARecordListResource struct{}
+
+var _ sdk.FrameworkListWrappedResourceWithConfig = new(DnsAAAARecordListResource)
+
+func (r DnsAAAARecordListResource) ResourceFunc() *pluginsdk.Resource {
+	return resourceDnsAAAARecord()
+}
+
+func (r DnsAAAARecordListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "azurerm_dns_aaaa_record"
+}
+
+func (r DnsAAAARecordListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = dnsRecordListResourceConfigSchema()
+}
+
+func (r DnsAAAARecordListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata sdk.ResourceMetadata) {
+	listDnsRecords(ctx, request, stream, metadata, "azurerm_dns_aaaa_record", recordsets.RecordTypeAAAA, resourceDnsAAAARecord, resourceDnsAaaaRecordFlatten)
+}
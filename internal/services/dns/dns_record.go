@@ -0,0 +1,28 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package dns
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/recordsets"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// dnsRecordImporter validates the Resource ID or Resource Identity provided at import time is a DNS Record Set of the
+// type supported by the resource
+func dnsRecordImporter(recordType recordsets.RecordType) *schema.ResourceImporter {
+	return pluginsdk.ImporterValidatingIdentityThen(&recordsets.RecordTypeId{}, func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+		id, err := recordsets.ParseRecordTypeID(d.Id())
+		if err != nil {
+			return []*pluginsdk.ResourceData{d}, err
+		}
+		if id.RecordType != recordType {
+			return []*pluginsdk.ResourceData{d}, fmt.Errorf("this resource only supports '%s' records", recordType)
+		}
+		return []*pluginsdk.ResourceData{d}, nil
+	})
+}
@@ -0,0 +1,113 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package dns
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/recordsets"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/zones"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type DnsRecordListModel struct {
+	DnsZoneId types.String `tfsdk:"dns_zone_id"`
+}
+
+type dnsRecordFlattenFunc = func(d *pluginsdk.ResourceData, id *recordsets.RecordTypeId, model *recordsets.RecordSet) error
+
+func dnsRecordListResourceConfigSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"dns_zone_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: zones.ValidateDnsZoneID,
+					},
+				},
+			},
+		},
+	}
+}
+
+// listDnsRecords streams the DNS Record Sets of the specified type within the configured DNS Zone
+func listDnsRecords(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata sdk.ResourceMetadata, resourceType string, recordType recordsets.RecordType, resourceFunc func() *pluginsdk.Resource, flatten dnsRecordFlattenFunc) {
+	client := metadata.Client.Dns.RecordSets
+
+	var data DnsRecordListModel
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	dnsZoneId, err := zones.ParseDnsZoneID(data.DnsZoneId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("parsing DNS Zone ID for `%s`", resourceType), err)
+		return
+	}
+
+	zoneId := recordsets.NewZoneID(dnsZoneId.SubscriptionId, dnsZoneId.ResourceGroupName, dnsZoneId.DnsZoneName, recordType)
+	resp, err := client.ListByTypeComplete(sdk.ListPageLimitContext(ctx, request), zoneId, recordsets.DefaultListByTypeOperationOptions())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", resourceType), err)
+		return
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		sdk.SetResponseErrorDiagnostic(stream, "internal-error", "context had no deadline")
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		ctx, cancel := context.WithDeadline(context.Background(), deadline)
+		defer cancel()
+
+		for _, record := range resp.Items {
+			// the NS Record Set at the apex of the zone is managed by Azure, so can't be managed as a resource
+			if recordType == recordsets.RecordTypeNS && pointer.From(record.Name) == "@" {
+				continue
+			}
+
+			result := request.NewListResult(ctx)
+			result.DisplayName = pointer.From(record.Name)
+
+			// the API returns the `dnszones` segment lower-cased
+			id, err := recordsets.ParseRecordTypeIDInsensitively(pointer.From(record.Id))
+			if err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("parsing DNS %s Record ID", recordType), err)
+				return
+			}
+
+			rd := resourceFunc().Data(&terraform.InstanceState{})
+			rd.SetId(id.ID())
+
+			if err := flatten(rd, id, &record); err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("encoding `%s` resource data", resourceType), err)
+				return
+			}
+
+			sdk.EncodeListResult(ctx, rd, &result)
+			if result.Diagnostics.HasError() {
+				push(result)
+				return
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
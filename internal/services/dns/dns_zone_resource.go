@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/recordsets"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/zones"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -23,13 +24,20 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name dns_zone -service-package-name dns -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 var (
+	_ sdk.ResourceWithIdentity       = DnsZoneResource{}
 	_ sdk.ResourceWithUpdate         = DnsZoneResource{}
 	_ sdk.ResourceWithStateMigration = DnsZoneResource{}
 )
 
 type DnsZoneResource struct{}
 
+func (DnsZoneResource) Identity() resourceids.ResourceId {
+	return &zones.DnsZoneId{}
+}
+
 func (DnsZoneResource) ModelObject() interface{} {
 	return &DnsZoneResourceModel{}
 }
@@ -249,27 +257,27 @@ func (r DnsZoneResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			return nil
 		},
 	}
 }
 
-func (DnsZoneResource) Read() sdk.ResourceFunc {
+func (r DnsZoneResource) Read() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 5 * time.Minute,
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
-			zonesClient := metadata.Client.Dns.Zones
-			recordSetsClient := metadata.Client.Dns.RecordSets
-
-			state := DnsZoneResourceModel{}
+			client := metadata.Client.Dns.Zones
 
 			id, err := zones.ParseDnsZoneID(metadata.ResourceData.Id())
 			if err != nil {
 				return err
 			}
 
-			resp, err := zonesClient.Get(ctx, *id)
+			resp, err := client.Get(ctx, *id)
 			if err != nil {
 				if response.WasNotFound(resp.HttpResponse) {
 					return metadata.MarkAsGone(id)
@@ -277,29 +285,43 @@ func (DnsZoneResource) Read() sdk.ResourceFunc {
 				return fmt.Errorf("retrieving %s: %+v", *id, err)
 			}
 
-			soaRecord := recordsets.NewRecordTypeID(id.SubscriptionId, id.ResourceGroupName, id.DnsZoneName, recordsets.RecordTypeSOA, "@")
-			soaRecordResp, err := recordSetsClient.Get(ctx, soaRecord)
-			if err != nil {
-				return fmt.Errorf("retrieving %s: %+v", id, err)
-			}
+			return r.flatten(ctx, metadata, id, resp.Model, true)
+		},
+	}
+}
 
-			state.SoaRecord = flattenDNSZoneSOARecord(soaRecordResp.Model)
+// flatten sets the state for the DNS Zone, the SOA Record is only retrieved when `includeSoaRecord` is set since this
+// requires an additional API call
+func (r DnsZoneResource) flatten(ctx context.Context, metadata sdk.ResourceMetaData, id *zones.DnsZoneId, model *zones.Zone, includeSoaRecord bool) error {
+	state := DnsZoneResourceModel{
+		Name:              id.DnsZoneName,
+		ResourceGroupName: id.ResourceGroupName,
+	}
 
-			state.Name = id.DnsZoneName
-			state.ResourceGroupName = id.ResourceGroupName
+	if includeSoaRecord {
+		soaRecord := recordsets.NewRecordTypeID(id.SubscriptionId, id.ResourceGroupName, id.DnsZoneName, recordsets.RecordTypeSOA, "@")
+		soaRecordResp, err := metadata.Client.Dns.RecordSets.Get(ctx, soaRecord)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", id, err)
+		}
 
-			if model := resp.Model; model != nil {
-				if props := model.Properties; props != nil {
-					state.NumberOfRecordSets = pointer.From(props.NumberOfRecordSets)
-					state.MaxNumberOfRecordSets = pointer.From(props.MaxNumberOfRecordSets)
-					state.NameServers = pointer.From(props.NameServers)
-				}
-				state.Tags = pointer.From(model.Tags)
-			}
+		state.SoaRecord = flattenDNSZoneSOARecord(soaRecordResp.Model)
+	}
 
-			return metadata.Encode(&state)
-		},
+	if model != nil {
+		if props := model.Properties; props != nil {
+			state.NumberOfRecordSets = pointer.From(props.NumberOfRecordSets)
+			state.MaxNumberOfRecordSets = pointer.From(props.MaxNumberOfRecordSets)
+			state.NameServers = pointer.From(props.NameServers)
+		}
+		state.Tags = pointer.From(model.Tags)
 	}
+
+	if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+		return err
+	}
+
+	return metadata.Encode(&state)
 }
 
 func (r DnsZoneResource) Update() sdk.ResourceFunc {
@@ -96,5 +96,16 @@ func (r Registration) EphemeralResources() []func() ephemeral.EphemeralResource
 }
 
 func (r Registration) ListResources() []sdk.FrameworkListWrappedResource {
-	return []sdk.FrameworkListWrappedResource{}
+	return []sdk.FrameworkListWrappedResource{
+		DnsARecordListResource{},
+		DnsAAAARecordListResource{},
+		DnsCaaRecordListResource{},
+		DnsCNameRecordListResource{},
+		DnsMxRecordListResource{},
+		DnsNsRecordListResource{},
+		DnsPtrRecordListResource{},
+		DnsSrvRecordListResource{},
+		DnsTxtRecordListResource{},
+		DnsZoneListResource{},
+	}
 }
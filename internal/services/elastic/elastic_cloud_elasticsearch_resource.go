@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/elastic/2023-06-01/monitorsresource"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/elastic/2023-06-01/rules"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/elastic/validate"
@@ -23,6 +24,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name elastic_cloud_elasticsearch -service-package-name elastic -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type ElasticsearchResource
+
 func resourceElasticsearch() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceElasticsearchCreate,
@@ -37,10 +40,11 @@ func resourceElasticsearch() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
 		},
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := monitorsresource.ParseMonitorID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&monitorsresource.MonitorId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&monitorsresource.MonitorId{}),
+		},
 
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
@@ -199,6 +203,9 @@ func resourceElasticsearchCreate(d *pluginsdk.ResourceData, meta interface{}) er
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 
 	if v, ok := d.GetOk("logs"); ok {
 		tagRulesClient := meta.(*clients.Client).Elastic.TagRuleClient
@@ -291,7 +298,7 @@ func resourceElasticsearchRead(d *pluginsdk.ResourceData, meta interface{}) erro
 		return fmt.Errorf("setting `logs`: %+v", err)
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceElasticsearchUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
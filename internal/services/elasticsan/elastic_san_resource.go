@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/zones"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/elasticsan/2023-01-01/elasticsans"
@@ -21,10 +22,13 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name elastic_san -service-package-name elasticsan -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type ElasticSANTestResource
+
 var (
 	_ sdk.Resource                  = ElasticSANResource{}
 	_ sdk.ResourceWithUpdate        = ElasticSANResource{}
 	_ sdk.ResourceWithCustomizeDiff = ElasticSANResource{}
+	_ sdk.ResourceWithIdentity      = ElasticSANResource{}
 )
 
 type ElasticSANResource struct{}
@@ -58,6 +62,10 @@ func (r ElasticSANResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return elasticsans.ValidateElasticSanID
 }
 
+func (r ElasticSANResource) Identity() resourceids.ResourceId {
+	return &elasticsans.ElasticSanId{}
+}
+
 func (r ElasticSANResource) ResourceType() string {
 	return "azurerm_elastic_san"
 }
@@ -222,7 +230,7 @@ func (r ElasticSANResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -267,6 +275,10 @@ func (r ElasticSANResource) Read() sdk.ResourceFunc {
 				state.VolumeGroupCount = pointer.From(prop.VolumeGroupCount)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/keyvault"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/elasticsan/2023-01-01/volumegroups"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/elasticsan/validate"
@@ -21,10 +22,13 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name elastic_san_volume_group -service-package-name elasticsan -properties "name" -compare-values "subscription_id:elastic_san_id,resource_group_name:elastic_san_id,elastic_san_name:elastic_san_id" -test-resource-type ElasticSANVolumeGroupTestResource
+
 var (
 	_ sdk.Resource                  = ElasticSANVolumeGroupResource{}
 	_ sdk.ResourceWithUpdate        = ElasticSANVolumeGroupResource{}
 	_ sdk.ResourceWithCustomizeDiff = ElasticSANVolumeGroupResource{}
+	_ sdk.ResourceWithIdentity      = ElasticSANVolumeGroupResource{}
 )
 
 type ElasticSANVolumeGroupResource struct{}
@@ -33,6 +37,10 @@ func (r ElasticSANVolumeGroupResource) IDValidationFunc() pluginsdk.SchemaValida
 	return volumegroups.ValidateVolumeGroupID
 }
 
+func (r ElasticSANVolumeGroupResource) Identity() resourceids.ResourceId {
+	return &volumegroups.VolumeGroupId{}
+}
+
 func (r ElasticSANVolumeGroupResource) ResourceType() string {
 	return "azurerm_elastic_san_volume_group"
 }
@@ -230,7 +238,7 @@ func (r ElasticSANVolumeGroupResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -282,6 +290,10 @@ func (r ElasticSANVolumeGroupResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&schema)
 		},
 	}
@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticsan_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccElasticSanVolumeGroup_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_elastic_san_volume_group", "test")
+	r := ElasticSANVolumeGroupTestResource{}
+
+	checkedFields := map[string]struct{}{
+		"name":                {},
+		"elastic_san_name":    {},
+		"resource_group_name": {},
+		"subscription_id":     {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_elastic_san_volume_group.test", checkedFields),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_elastic_san_volume_group.test", tfjsonpath.New("name"), tfjsonpath.New("name")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_elastic_san_volume_group.test", tfjsonpath.New("elastic_san_name"), tfjsonpath.New("elastic_san_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_elastic_san_volume_group.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("elastic_san_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_elastic_san_volume_group.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("elastic_san_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
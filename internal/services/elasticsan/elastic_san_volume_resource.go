@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	diskSnapshots "github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-02/snapshots"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2023-03-01/restorepoints"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/elasticsan/2023-01-01/snapshots"
@@ -22,10 +23,13 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name elastic_san_volume -service-package-name elasticsan -properties "name" -compare-values "subscription_id:volume_group_id,resource_group_name:volume_group_id,elastic_san_name:volume_group_id,volume_group_name:volume_group_id" -test-resource-type ElasticSANVolumeTestResource
+
 var (
 	_ sdk.Resource                  = ElasticSANVolumeResource{}
 	_ sdk.ResourceWithUpdate        = ElasticSANVolumeResource{}
 	_ sdk.ResourceWithCustomizeDiff = ElasticSANVolumeResource{}
+	_ sdk.ResourceWithIdentity      = ElasticSANVolumeResource{}
 )
 
 type ElasticSANVolumeResource struct{}
@@ -54,6 +58,10 @@ func (r ElasticSANVolumeResource) IDValidationFunc() pluginsdk.SchemaValidateFun
 	return volumes.ValidateVolumeID
 }
 
+func (r ElasticSANVolumeResource) Identity() resourceids.ResourceId {
+	return &volumes.VolumeId{}
+}
+
 func (r ElasticSANVolumeResource) ResourceType() string {
 	return "azurerm_elastic_san_volume"
 }
@@ -191,7 +199,7 @@ func (r ElasticSANVolumeResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -236,6 +244,10 @@ func (r ElasticSANVolumeResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&schema)
 		},
 	}
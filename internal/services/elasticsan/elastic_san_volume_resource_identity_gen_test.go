@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticsan_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccElasticSanVolume_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_elastic_san_volume", "test")
+	r := ElasticSANVolumeTestResource{}
+
+	checkedFields := map[string]struct{}{
+		"name":                {},
+		"elastic_san_name":    {},
+		"resource_group_name": {},
+		"subscription_id":     {},
+		"volume_group_name":   {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_elastic_san_volume.test", checkedFields),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_elastic_san_volume.test", tfjsonpath.New("name"), tfjsonpath.New("name")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_elastic_san_volume.test", tfjsonpath.New("elastic_san_name"), tfjsonpath.New("volume_group_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_elastic_san_volume.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("volume_group_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_elastic_san_volume.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("volume_group_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_elastic_san_volume.test", tfjsonpath.New("volume_group_name"), tfjsonpath.New("volume_group_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
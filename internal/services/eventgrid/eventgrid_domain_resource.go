@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2025-02-15/domains"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -23,6 +24,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name eventgrid_domain -service-package-name eventgrid -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type EventGridDomainResource
+
 func resourceEventGridDomain() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceEventGridDomainCreate,
@@ -37,10 +40,11 @@ func resourceEventGridDomain() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := domains.ParseDomainID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&domains.DomainId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&domains.DomainId{}),
+		},
 
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
@@ -260,6 +264,10 @@ func resourceEventGridDomainCreate(d *pluginsdk.ResourceData, meta interface{})
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
+
 	return resourceEventGridDomainRead(d, meta)
 }
 
@@ -424,7 +432,7 @@ func resourceEventGridDomainRead(d *pluginsdk.ResourceData, meta interface{}) er
 		d.Set("secondary_access_key", model.Key2)
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceEventGridDomainDelete(d *pluginsdk.ResourceData, meta interface{}) error {
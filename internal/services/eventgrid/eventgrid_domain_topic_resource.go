@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2025-02-15/domaintopics"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -19,6 +20,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name eventgrid_domain_topic -service-package-name eventgrid -properties "name,resource_group_name,domain_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type EventGridDomainTopicResource
+
 func resourceEventGridDomainTopic() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceEventGridDomainTopicCreate,
@@ -31,10 +34,11 @@ func resourceEventGridDomainTopic() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := domaintopics.ParseDomainTopicID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&domaintopics.DomainTopicId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&domaintopics.DomainTopicId{}),
+		},
 
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
@@ -94,6 +98,10 @@ func resourceEventGridDomainTopicCreate(d *pluginsdk.ResourceData, meta interfac
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
+
 	return resourceEventGridDomainTopicRead(d, meta)
 }
 
@@ -122,7 +130,7 @@ func resourceEventGridDomainTopicRead(d *pluginsdk.ResourceData, meta interface{
 	d.Set("domain_name", id.DomainName)
 	d.Set("resource_group_name", id.ResourceGroupName)
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceEventGridDomainTopicDelete(d *pluginsdk.ResourceData, meta interface{}) error {
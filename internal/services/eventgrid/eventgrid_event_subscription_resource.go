@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2025-02-15/eventsubscriptions"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -22,6 +23,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name eventgrid_event_subscription -service-package-name eventgrid -properties "name,scope" -no-subscription-id -test-resource-type EventGridEventSubscriptionResource
+
 func possibleEventSubscriptionEndpointTypes() []string {
 	return []string{
 		string(AzureFunctionEndpoint),
@@ -69,10 +72,11 @@ func resourceEventGridEventSubscription() *pluginsdk.Resource {
 			return nil
 		}),
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := eventsubscriptions.ParseScopedEventSubscriptionID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&eventsubscriptions.ScopedEventSubscriptionId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&eventsubscriptions.ScopedEventSubscriptionId{}),
+		},
 
 		Schema: map[string]*pluginsdk.Schema{
 			"name": eventSubscriptionSchemaEventSubscriptionName(),
@@ -249,6 +253,10 @@ func resourceEventGridEventSubscriptionCreateUpdate(d *pluginsdk.ResourceData, m
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
+
 	return resourceEventGridEventSubscriptionRead(d, meta)
 }
 
@@ -368,7 +376,7 @@ func resourceEventGridEventSubscriptionRead(d *pluginsdk.ResourceData, meta inte
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceEventGridEventSubscriptionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
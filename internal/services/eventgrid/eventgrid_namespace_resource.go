@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2023-12-15-preview/namespaces"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2025-02-15/topics"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
@@ -21,7 +22,10 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name eventgrid_namespace -service-package-name eventgrid -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type EventGridNamespaceResource
+
 var _ sdk.ResourceWithUpdate = EventGridNamespaceResource{}
+var _ sdk.ResourceWithIdentity = EventGridNamespaceResource{}
 
 type EventGridNamespaceResource struct{}
 
@@ -267,6 +271,9 @@ func (r EventGridNamespaceResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			return nil
 		},
@@ -383,6 +390,10 @@ func (r EventGridNamespaceResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -412,6 +423,10 @@ func (r EventGridNamespaceResource) IDValidationFunc() pluginsdk.SchemaValidateF
 	return namespaces.ValidateNamespaceID
 }
 
+func (r EventGridNamespaceResource) Identity() resourceids.ResourceId {
+	return &namespaces.NamespaceId{}
+}
+
 func expandInboundIPRules(input []InboundIpRuleModel) *[]namespaces.InboundIPRule {
 	if len(input) == 0 {
 		return nil
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2025-02-15/partnerconfigurations"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -19,7 +20,10 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name eventgrid_partner_configuration -service-package-name eventgrid -properties "name:resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type EventGridPartnerConfigurationsTestResource
+
 var _ sdk.Resource = EventGridPartnerConfigurationResource{}
+var _ sdk.ResourceWithIdentity = EventGridPartnerConfigurationResource{}
 
 type EventGridPartnerConfigurationResource struct{}
 
@@ -125,7 +129,7 @@ func (r EventGridPartnerConfigurationResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -218,6 +222,10 @@ func (r EventGridPartnerConfigurationResource) Read() sdk.ResourceFunc {
 					state.Tags = pointer.From(model.Tags)
 				}
 			}
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -246,6 +254,10 @@ func (EventGridPartnerConfigurationResource) IDValidationFunc() pluginsdk.Schema
 	return commonids.ValidateResourceGroupID
 }
 
+func (EventGridPartnerConfigurationResource) Identity() resourceids.ResourceId {
+	return &commonids.ResourceGroupId{}
+}
+
 func expandAuthorizedPartnersList(partnerAuthorization []PartnerAuthorization) *[]partnerconfigurations.Partner {
 	if len(partnerAuthorization) == 0 {
 		return nil
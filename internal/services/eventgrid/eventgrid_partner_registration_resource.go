@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2025-02-15/partnerregistrations"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -19,7 +20,10 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name eventgrid_partner_registration -service-package-name eventgrid -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type EventGridPartnerRegistrationTestResource
+
 var _ sdk.Resource = EventGridPartnerRegistrationResource{}
+var _ sdk.ResourceWithIdentity = EventGridPartnerRegistrationResource{}
 
 type EventGridPartnerRegistrationResource struct{}
 
@@ -99,7 +103,7 @@ func (r EventGridPartnerRegistrationResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -172,6 +176,10 @@ func (r EventGridPartnerRegistrationResource) Read() sdk.ResourceFunc {
 					state.PartnerRegistrationID = pointer.From(props.PartnerRegistrationImmutableId)
 				}
 			}
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -200,3 +208,7 @@ func (r EventGridPartnerRegistrationResource) Delete() sdk.ResourceFunc {
 func (EventGridPartnerRegistrationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return partnerregistrations.ValidatePartnerRegistrationID
 }
+
+func (EventGridPartnerRegistrationResource) Identity() resourceids.ResourceId {
+	return &partnerregistrations.PartnerRegistrationId{}
+}
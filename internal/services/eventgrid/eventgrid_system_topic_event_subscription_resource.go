@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2025-02-15/eventsubscriptions"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -22,6 +23,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name eventgrid_system_topic_event_subscription -service-package-name eventgrid -properties "name,resource_group_name,system_topic_name:system_topic" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type EventGridSystemTopicEventSubscriptionResource
+
 func possibleSystemTopicEventSubscriptionEndpointTypes() []string {
 	return []string{
 		string(AzureFunctionEndpoint),
@@ -48,10 +51,11 @@ func resourceEventGridSystemTopicEventSubscription() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := eventsubscriptions.ParseSystemTopicEventSubscriptionID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&eventsubscriptions.SystemTopicEventSubscriptionId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&eventsubscriptions.SystemTopicEventSubscriptionId{}),
+		},
 
 		Schema: map[string]*pluginsdk.Schema{
 			"name": eventSubscriptionSchemaEventSubscriptionName(),
@@ -232,6 +236,10 @@ func resourceEventGridSystemTopicEventSubscriptionCreateUpdate(d *pluginsdk.Reso
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
+
 	return resourceEventGridSystemTopicEventSubscriptionRead(d, meta)
 }
 
@@ -347,7 +355,7 @@ func resourceEventGridSystemTopicEventSubscriptionRead(d *pluginsdk.ResourceData
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceEventGridSystemTopicEventSubscriptionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2025-02-15/systemtopics"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -27,6 +28,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name eventgrid_system_topic -service-package-name eventgrid -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type EventGridSystemTopicResource
+
 func resourceEventGridSystemTopic() *pluginsdk.Resource {
 	resource := &pluginsdk.Resource{
 		Create: resourceEventGridSystemTopicCreateUpdate,
@@ -41,10 +44,11 @@ func resourceEventGridSystemTopic() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := systemtopics.ParseSystemTopicID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&systemtopics.SystemTopicId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&systemtopics.SystemTopicId{}),
+		},
 
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
@@ -176,6 +180,10 @@ func resourceEventGridSystemTopicCreateUpdate(d *pluginsdk.ResourceData, meta in
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
+
 	return resourceEventGridSystemTopicRead(d, meta)
 }
 
@@ -229,7 +237,7 @@ func resourceEventGridSystemTopicRead(d *pluginsdk.ResourceData, meta interface{
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceEventGridSystemTopicDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2024-01-01/consumergroups"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/migration"
@@ -19,6 +20,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name eventhub_consumer_group -service-package-name eventhub -properties "name,resource_group_name,namespace_name,eventhub_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type EventHubConsumerGroupResource
+
 type ConsumerGroupObject struct {
 	Name              string `tfschema:"name"`
 	NamespaceName     string `tfschema:"namespace_name"`
@@ -31,6 +34,7 @@ var (
 	_ sdk.Resource                   = ConsumerGroupResource{}
 	_ sdk.ResourceWithUpdate         = ConsumerGroupResource{}
 	_ sdk.ResourceWithStateMigration = ConsumerGroupResource{}
+	_ sdk.ResourceWithIdentity       = ConsumerGroupResource{}
 )
 
 type ConsumerGroupResource struct{}
@@ -110,7 +114,7 @@ func (r ConsumerGroupResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 		Timeout: 30 * time.Minute,
 	}
@@ -179,6 +183,10 @@ func (r ConsumerGroupResource) Read() sdk.ResourceFunc {
 				state.UserMetadata = pointer.From(model.Properties.UserMetadata)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 		Timeout: 5 * time.Minute,
@@ -215,6 +223,10 @@ func (r ConsumerGroupResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return consumergroups.ValidateConsumerGroupID
 }
 
+func (r ConsumerGroupResource) Identity() resourceids.ResourceId {
+	return &consumergroups.ConsumerGroupId{}
+}
+
 func (r ConsumerGroupResource) StateUpgraders() sdk.StateUpgradeData {
 	return sdk.StateUpgradeData{
 		SchemaVersion: 1,
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2024-01-01/checknameavailabilitydisasterrecoveryconfigs"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2024-01-01/disasterrecoveryconfigs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -24,6 +25,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name eventhub_namespace_disaster_recovery_config -service-package-name eventhub -properties "name,resource_group_name,namespace_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type EventHubNamespaceDisasterRecoveryConfigResource
+
 func resourceEventHubNamespaceDisasterRecoveryConfig() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceEventHubNamespaceDisasterRecoveryConfigCreate,
@@ -31,10 +34,11 @@ func resourceEventHubNamespaceDisasterRecoveryConfig() *pluginsdk.Resource {
 		Update: resourceEventHubNamespaceDisasterRecoveryConfigUpdate,
 		Delete: resourceEventHubNamespaceDisasterRecoveryConfigDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := disasterrecoveryconfigs.ParseDisasterRecoveryConfigID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&disasterrecoveryconfigs.DisasterRecoveryConfigId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&disasterrecoveryconfigs.DisasterRecoveryConfigId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -110,6 +114,10 @@ func resourceEventHubNamespaceDisasterRecoveryConfigCreate(d *pluginsdk.Resource
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
+
 	return resourceEventHubNamespaceDisasterRecoveryConfigRead(d, meta)
 }
 
@@ -196,7 +204,7 @@ func resourceEventHubNamespaceDisasterRecoveryConfigRead(d *pluginsdk.ResourceDa
 		d.Set("partner_namespace_id", model.Properties.PartnerNamespace)
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceEventHubNamespaceDisasterRecoveryConfigDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2024-01-01/eventhubsclusters"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2024-01-01/namespaces"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2024-01-01/networkrulesets"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
@@ -33,6 +34,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name eventhub_namespace -service-package-name eventhub -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type EventHubNamespaceResource
+
 // Default Authorization Rule/Policy created by Azure, used to populate the
 // default connection strings and keys
 var (
@@ -47,10 +50,11 @@ func resourceEventHubNamespace() *pluginsdk.Resource {
 		Update: resourceEventHubNamespaceUpdate,
 		Delete: resourceEventHubNamespaceDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := namespaces.ParseNamespaceID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&namespaces.NamespaceId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&namespaces.NamespaceId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -355,6 +359,9 @@ func resourceEventHubNamespaceCreate(d *pluginsdk.ResourceData, meta interface{}
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 
 	ruleSets, hasRuleSets := d.GetOk("network_rulesets")
 	if hasRuleSets {
@@ -496,8 +503,6 @@ func resourceEventHubNamespaceUpdate(d *pluginsdk.ResourceData, meta interface{}
 
 func resourceEventHubNamespaceRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Eventhub.NamespacesClient
-	authorizationKeysClient := meta.(*clients.Client).Eventhub.NamespaceAuthorizationRulesClient
-	ruleSetsClient := meta.(*clients.Client).Eventhub.NetworkRuleSetsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -515,10 +520,16 @@ func resourceEventHubNamespaceRead(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
+	return resourceEventHubNamespaceFlatten(ctx, d, meta, id, resp.Model, true)
+}
+
+// resourceEventHubNamespaceFlatten sets the Resource Data from the model, the Network Rule Set and the default
+// Authorization Rule keys require additional API calls and are only retrieved when includeAdditional is true
+func resourceEventHubNamespaceFlatten(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}, id *namespaces.NamespaceId, model *namespaces.EHNamespace, includeAdditional bool) error {
 	d.Set("name", id.NamespaceName)
 	d.Set("resource_group_name", id.ResourceGroupName)
 
-	if model := resp.Model; model != nil {
+	if model != nil {
 		d.Set("location", location.NormalizeNilable(model.Location))
 
 		if sku := model.Sku; sku != nil {
@@ -559,36 +570,41 @@ func resourceEventHubNamespaceRead(d *pluginsdk.ResourceData, meta interface{})
 		}
 	}
 
-	namespaceId := networkrulesets.NewNamespaceID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName)
-	ruleset, err := ruleSetsClient.NamespacesGetNetworkRuleSet(ctx, namespaceId)
-	if err != nil {
-		return fmt.Errorf("retrieving Network Rule Sets for %s: %+v", *id, err)
-	}
+	if includeAdditional {
+		authorizationKeysClient := meta.(*clients.Client).Eventhub.NamespaceAuthorizationRulesClient
+		ruleSetsClient := meta.(*clients.Client).Eventhub.NetworkRuleSetsClient
 
-	networkRuleSets, err := flattenEventHubNamespaceNetworkRuleset(ruleset)
-	if err != nil {
-		return fmt.Errorf("flattening `network_rule` for %s: %+v", id, err)
-	}
-	if err := d.Set("network_rulesets", networkRuleSets); err != nil {
-		return fmt.Errorf("setting `network_ruleset` for Evenhub Namespace %s: %v", id.NamespaceName, err)
-	}
+		namespaceId := networkrulesets.NewNamespaceID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName)
+		ruleset, err := ruleSetsClient.NamespacesGetNetworkRuleSet(ctx, namespaceId)
+		if err != nil {
+			return fmt.Errorf("retrieving Network Rule Sets for %s: %+v", *id, err)
+		}
 
-	authorizationRuleId := authorizationrulesnamespaces.NewAuthorizationRuleID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName, eventHubNamespaceDefaultAuthorizationRule)
-	keys, err := authorizationKeysClient.NamespacesListKeys(ctx, authorizationRuleId)
-	if err != nil {
-		log.Printf("[WARN] Unable to List default keys for EventHub Namespace %q: %+v", id.NamespaceName, err)
-	}
+		networkRuleSets, err := flattenEventHubNamespaceNetworkRuleset(ruleset)
+		if err != nil {
+			return fmt.Errorf("flattening `network_rule` for %s: %+v", id, err)
+		}
+		if err := d.Set("network_rulesets", networkRuleSets); err != nil {
+			return fmt.Errorf("setting `network_ruleset` for Evenhub Namespace %s: %v", id.NamespaceName, err)
+		}
+
+		authorizationRuleId := authorizationrulesnamespaces.NewAuthorizationRuleID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName, eventHubNamespaceDefaultAuthorizationRule)
+		keys, err := authorizationKeysClient.NamespacesListKeys(ctx, authorizationRuleId)
+		if err != nil {
+			log.Printf("[WARN] Unable to List default keys for EventHub Namespace %q: %+v", id.NamespaceName, err)
+		}
 
-	if model := keys.Model; model != nil {
-		d.Set("default_primary_connection_string_alias", model.AliasPrimaryConnectionString)
-		d.Set("default_secondary_connection_string_alias", model.AliasSecondaryConnectionString)
-		d.Set("default_primary_connection_string", model.PrimaryConnectionString)
-		d.Set("default_secondary_connection_string", model.SecondaryConnectionString)
-		d.Set("default_primary_key", model.PrimaryKey)
-		d.Set("default_secondary_key", model.SecondaryKey)
+		if model := keys.Model; model != nil {
+			d.Set("default_primary_connection_string_alias", model.AliasPrimaryConnectionString)
+			d.Set("default_secondary_connection_string_alias", model.AliasSecondaryConnectionString)
+			d.Set("default_primary_connection_string", model.PrimaryConnectionString)
+			d.Set("default_secondary_connection_string", model.SecondaryConnectionString)
+			d.Set("default_primary_key", model.PrimaryKey)
+			d.Set("default_secondary_key", model.SecondaryKey)
+		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceEventHubNamespaceDelete(d *pluginsdk.ResourceData, meta interface{}) error {
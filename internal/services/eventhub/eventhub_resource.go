@@ -24,6 +24,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name eventhub -service-package-name eventhub -properties "name" -test-params "2" -compare-values "subscription_id:namespace_id,resource_group_name:namespace_id,namespace_name:namespace_id" -test-resource-type EventHubResource
+
 var eventHubResourceName = "azurerm_eventhub"
 
 func resourceEventHub() *pluginsdk.Resource {
@@ -33,10 +35,11 @@ func resourceEventHub() *pluginsdk.Resource {
 		Update: resourceEventHubUpdate,
 		Delete: resourceEventHubDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := eventhubs.ParseEventhubID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&eventhubs.EventhubId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&eventhubs.EventhubId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -293,6 +296,9 @@ func resourceEventHubCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 
 	return resourceEventHubRead(d, meta)
 }
@@ -375,6 +381,10 @@ func resourceEventHubRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		return fmt.Errorf("making Read request on %s: %+v", id, err)
 	}
 
+	return resourceEventHubFlatten(d, id, resp.Model)
+}
+
+func resourceEventHubFlatten(d *pluginsdk.ResourceData, id *eventhubs.EventhubId, model *eventhubs.Eventhub) error {
 	d.Set("name", id.EventhubName)
 
 	if !features.FivePointOh() {
@@ -385,7 +395,7 @@ func resourceEventHubRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	namespaceId := namespaces.NewNamespaceID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName)
 	d.Set("namespace_id", namespaceId.ID())
 
-	if model := resp.Model; model != nil {
+	if model != nil {
 		if props := model.Properties; props != nil {
 			d.Set("partition_count", props.PartitionCount)
 			d.Set("partition_ids", props.PartitionIds)
@@ -407,7 +417,7 @@ func resourceEventHubRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceEventHubDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -0,0 +1,106 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package eventhub
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2024-01-01/eventhubs"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type EventHubListResource struct{}
+
+type EventHubListModel struct {
+	NamespaceId types.String `tfsdk:"namespace_id"`
+}
+
+var _ sdk.FrameworkListWrappedResourceWithConfig = new(EventHubListResource)
+
+func (r EventHubListResource) ResourceFunc() *pluginsdk.Resource {
+	return resourceEventHub()
+}
+
+func (r EventHubListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = eventHubResourceName
+}
+
+func (r EventHubListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"namespace_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: eventhubs.ValidateNamespaceID,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r EventHubListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata sdk.ResourceMetadata) {
+	client := metadata.Client.Eventhub.EventHubsClient
+
+	var data EventHubListModel
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	namespaceId, err := eventhubs.ParseNamespaceID(data.NamespaceId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("parsing EventHub Namespace ID for `%s`", eventHubResourceName), err)
+		return
+	}
+
+	resp, err := client.ListByNamespaceComplete(sdk.ListPageLimitContext(ctx, request), pointer.From(namespaceId), eventhubs.DefaultListByNamespaceOperationOptions())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", eventHubResourceName), err)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, eventHub := range resp.Items {
+			result := request.NewListResult(ctx)
+			result.DisplayName = pointer.From(eventHub.Name)
+
+			id, err := eventhubs.ParseEventhubIDInsensitively(pointer.From(eventHub.Id))
+			if err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, "parsing EventHub ID", err)
+				return
+			}
+
+			rd := resourceEventHub().Data(&terraform.InstanceState{})
+			rd.SetId(id.ID())
+
+			if err := resourceEventHubFlatten(rd, id, &eventHub); err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("encoding `%s` resource data", eventHubResourceName), err)
+				return
+			}
+
+			sdk.EncodeListResult(ctx, rd, &result)
+			if result.Diagnostics.HasError() {
+				push(result)
+				return
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
@@ -48,7 +48,7 @@ func (r FirewallPolicyListResource) List(ctx context.Context, request list.ListR
 
 	switch {
 	case !data.ResourceGroupName.IsNull():
-		resp, err := client.ListComplete(ctx, commonids.NewResourceGroupID(subscriptionID, data.ResourceGroupName.ValueString()))
+		resp, err := client.ListComplete(sdk.ListPageLimitContext(ctx, request), commonids.NewResourceGroupID(subscriptionID, data.ResourceGroupName.ValueString()))
 		if err != nil {
 			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", `azurerm_firewall_policy`), err)
 			return
@@ -56,7 +56,7 @@ func (r FirewallPolicyListResource) List(ctx context.Context, request list.ListR
 
 		results = resp.Items
 	default:
-		resp, err := client.ListAllComplete(ctx, commonids.NewSubscriptionID(subscriptionID))
+		resp, err := client.ListAllComplete(sdk.ListPageLimitContext(ctx, request), commonids.NewSubscriptionID(subscriptionID))
 		if err != nil {
 			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", `azurerm_firewall_policy`), err)
 			return
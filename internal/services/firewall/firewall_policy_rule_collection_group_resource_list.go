@@ -70,7 +70,7 @@ func (r FirewallPolicyRuleCollectionGroupListResource) List(ctx context.Context,
 			return
 		}
 
-		resp, err := client.ListComplete(ctx, *firewallPolicyId)
+		resp, err := client.ListComplete(sdk.ListPageLimitContext(ctx, request), *firewallPolicyId)
 		if err != nil {
 			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", "azurerm_firewall_policy_rule_collection_group"), err)
 			return
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/keyvault"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/fluidrelay/2022-05-26/fluidrelayservers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -23,6 +24,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name fluid_relay_server -service-package-name fluidrelay -properties "name,resource_group:resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type FluidRelayResource
+
 type ServerModel struct {
 	Name               string                                     `tfschema:"name"`
 	ResourceGroup      string                                     `tfschema:"resource_group_name"`
@@ -71,6 +74,7 @@ func (s *ServerModel) flattenIdentity(input *identity.SystemAndUserAssignedMap)
 type Server struct{}
 
 var _ sdk.ResourceWithUpdate = (*Server)(nil)
+var _ sdk.ResourceWithIdentity = (*Server)(nil)
 
 func (s Server) Arguments() map[string]*pluginsdk.Schema {
 	args := map[string]*pluginsdk.Schema{
@@ -219,6 +223,9 @@ func (s Server) Create() sdk.ResourceFunc {
 				return fmt.Errorf("creating %v err: %+v", id, err)
 			}
 			meta.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(meta.ResourceData, &id); err != nil {
+				return err
+			}
 
 			return nil
 		},
@@ -334,6 +341,10 @@ func (s Server) Read() sdk.ResourceFunc {
 				output.SecondaryKey = pointer.From(model.Key2)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(meta.ResourceData, id); err != nil {
+				return err
+			}
+
 			return meta.Encode(output)
 		},
 	}
@@ -363,6 +374,10 @@ func (s Server) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return fluidrelayservers.ValidateFluidRelayServerID
 }
 
+func (s Server) Identity() resourceids.ResourceId {
+	return &fluidrelayservers.FluidRelayServerId{}
+}
+
 func expandFluidRelayServerCustomerManagedKey(input []CustomerManagedKey) *fluidrelayservers.EncryptionProperties {
 	if len(input) == 0 {
 		return nil
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/frontdoor/2020-05-01/frontdoors"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -27,6 +28,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name frontdoor -service-package-name frontdoor -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type FrontDoorResource
+
 func resourceFrontDoor() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceFrontDoorCreate,
@@ -34,10 +37,11 @@ func resourceFrontDoor() *pluginsdk.Resource {
 		Update: resourceFrontDoorUpdate,
 		Delete: resourceFrontDoorDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := frontdoors.ParseFrontDoorID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&frontdoors.FrontDoorId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&frontdoors.FrontDoorId{}),
+		},
 
 		SchemaVersion: 2,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
@@ -122,6 +126,10 @@ func resourceFrontDoorCreate(d *pluginsdk.ResourceData, meta interface{}) error
 	d.Set("explicit_resource_order", flattenExplicitResourceOrder(backendPools, frontendEndpoints, routingRules, loadBalancingSettings, healthProbeSettings, id))
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
+
 	return resourceFrontDoorRead(d, meta)
 }
 
@@ -412,7 +420,7 @@ func resourceFrontDoorRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceFrontDoorDelete(d *pluginsdk.ResourceData, meta interface{}) error {
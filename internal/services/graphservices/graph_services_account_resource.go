@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/graphservices/2023-04-13/graphservicesprods"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -19,9 +20,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name graph_services_account -service-package-name graphservices -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type AccountTestResource
+
 var (
-	_ sdk.Resource           = AccountResource{}
-	_ sdk.ResourceWithUpdate = AccountResource{}
+	_ sdk.Resource             = AccountResource{}
+	_ sdk.ResourceWithUpdate   = AccountResource{}
+	_ sdk.ResourceWithIdentity = AccountResource{}
 )
 
 type AccountResource struct{}
@@ -42,6 +46,10 @@ func (r AccountResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return graphservicesprods.ValidateAccountID
 }
 
+func (r AccountResource) Identity() resourceids.ResourceId {
+	return &graphservicesprods.AccountId{}
+}
+
 func (r AccountResource) ResourceType() string {
 	return "azurerm_graph_services_account"
 }
@@ -111,7 +119,7 @@ func (r AccountResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -150,6 +158,10 @@ func (r AccountResource) Read() sdk.ResourceFunc {
 				schema.BillingPlanId = pointer.From(model.Properties.BillingPlanId)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&schema)
 		},
 	}
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/healthcareapis/2022-12-01/fhirservices"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/healthcareapis/2022-12-01/iotconnectors"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -26,6 +27,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name healthcare_medtech_service_fhir_destination -service-package-name healthcare -properties "name" -compare-values "subscription_id:medtech_service_id,resource_group_name:medtech_service_id,workspace_name:medtech_service_id,iot_connector_name:medtech_service_id" -test-resource-type HealthCareMedTechServiceFhirDestinationResource
+
 func resourceHealthcareApisMedTechServiceFhirDestination() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceHealthcareApisMedTechServiceFhirDestinationCreate,
@@ -40,10 +43,11 @@ func resourceHealthcareApisMedTechServiceFhirDestination() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(90 * time.Minute),
 		},
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := iotconnectors.ParseFhirDestinationID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&iotconnectors.FhirDestinationId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&iotconnectors.FhirDestinationId{}),
+		},
 
 		SchemaVersion: 1,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
@@ -137,6 +141,9 @@ func resourceHealthcareApisMedTechServiceFhirDestinationCreate(d *pluginsdk.Reso
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 
 	return resourceHealthcareApisMedTechServiceFhirDestinationRead(d, meta)
 }
@@ -194,7 +201,7 @@ func resourceHealthcareApisMedTechServiceFhirDestinationRead(d *pluginsdk.Resour
 		d.Set("destination_identity_resolution_type", props.ResourceIdentityResolutionType)
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceHealthcareApisMedTechServiceFhirDestinationUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
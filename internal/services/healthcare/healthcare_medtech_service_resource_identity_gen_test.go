@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package healthcare_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccHealthcareMedtechService_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_healthcare_medtech_service", "test")
+	r := HealthCareWorkspaceMedTechServiceResource{}
+
+	checkedFields := map[string]struct{}{
+		"name":                {},
+		"resource_group_name": {},
+		"subscription_id":     {},
+		"workspace_name":      {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_healthcare_medtech_service.test", checkedFields),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_healthcare_medtech_service.test", tfjsonpath.New("name"), tfjsonpath.New("name")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_healthcare_medtech_service.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("workspace_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_healthcare_medtech_service.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("workspace_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_healthcare_medtech_service.test", tfjsonpath.New("workspace_name"), tfjsonpath.New("workspace_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
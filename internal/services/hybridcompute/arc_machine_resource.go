@@ -13,12 +13,15 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2024-07-10/machines"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name arc_machine -service-package-name hybridcompute -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 type ArcMachineResourceModel struct {
 	Name              string                         `tfschema:"name"`
 	ResourceGroupName string                         `tfschema:"resource_group_name"`
@@ -30,6 +33,8 @@ type ArcMachineResourceModel struct {
 
 type ArcMachineResource struct{}
 
+var _ sdk.ResourceWithIdentity = ArcMachineResource{}
+
 func (r ArcMachineResource) ResourceType() string {
 	return "azurerm_arc_machine"
 }
@@ -42,6 +47,10 @@ func (r ArcMachineResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return machines.ValidateMachineID
 }
 
+func (r ArcMachineResource) Identity() resourceids.ResourceId {
+	return &machines.MachineId{}
+}
+
 func (r ArcMachineResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -112,7 +121,7 @@ func (r ArcMachineResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -147,6 +156,10 @@ func (r ArcMachineResource) Read() sdk.ResourceFunc {
 				state.Tags = pointer.From(model.Tags)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
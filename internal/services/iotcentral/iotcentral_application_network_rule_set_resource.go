@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/iotcentral/2021-11-01-preview/apps"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
@@ -20,9 +21,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name iotcentral_application_network_rule_set -service-package-name iotcentral -compare-values "subscription_id:iotcentral_application_id,resource_group_name:iotcentral_application_id,name:iotcentral_application_id" -test-resource-type IoTCentralApplicationNetworkRuleSetResource
+
 type IotCentralApplicationNetworkRuleSetResource struct{}
 
 var _ sdk.ResourceWithUpdate = IotCentralApplicationNetworkRuleSetResource{}
+var _ sdk.ResourceWithIdentity = IotCentralApplicationNetworkRuleSetResource{}
 
 type IotCentralApplicationNetworkRuleSetModel struct {
 	IotCentralApplicationId string             `tfschema:"iotcentral_application_id"`
@@ -98,6 +102,10 @@ func (r IotCentralApplicationNetworkRuleSetResource) IDValidationFunc() pluginsd
 	return apps.ValidateIotAppID
 }
 
+func (r IotCentralApplicationNetworkRuleSetResource) Identity() resourceids.ResourceId {
+	return &apps.IotAppId{}
+}
+
 func (r IotCentralApplicationNetworkRuleSetResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
@@ -149,7 +157,7 @@ func (r IotCentralApplicationNetworkRuleSetResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, id)
 		},
 		Timeout: 30 * time.Minute,
 	}
@@ -195,6 +203,10 @@ func (r IotCentralApplicationNetworkRuleSetResource) Read() sdk.ResourceFunc {
 			}
 			state.DefaultAction = defaultAction
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 		Timeout: 5 * time.Minute,
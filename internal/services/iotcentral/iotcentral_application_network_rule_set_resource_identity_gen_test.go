@@ -0,0 +1,38 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package iotcentral_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccIotcentralApplicationNetworkRuleSet_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_iotcentral_application_network_rule_set", "test")
+	r := IoTCentralApplicationNetworkRuleSetResource{}
+
+	checkedFields := map[string]struct{}{
+		"name":                {},
+		"resource_group_name": {},
+		"subscription_id":     {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_iotcentral_application_network_rule_set.test", checkedFields),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_iotcentral_application_network_rule_set.test", tfjsonpath.New("name"), tfjsonpath.New("iotcentral_application_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_iotcentral_application_network_rule_set.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("iotcentral_application_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_iotcentral_application_network_rule_set.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("iotcentral_application_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
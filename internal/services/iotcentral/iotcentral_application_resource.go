@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/iotcentral/2021-11-01-preview/apps"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iotcentral/migration"
@@ -24,6 +25,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name iotcentral_application -service-package-name iotcentral -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type IoTCentralApplicationResource
+
 func resourceIotCentralApplication() *pluginsdk.Resource {
 	resource := &pluginsdk.Resource{
 		Create: resourceIotCentralAppCreate,
@@ -31,10 +34,11 @@ func resourceIotCentralApplication() *pluginsdk.Resource {
 		Update: resourceIotCentralAppUpdate,
 		Delete: resourceIotCentralAppDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := apps.ParseIotAppID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&apps.IotAppId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&apps.IotAppId{}),
+		},
 
 		SchemaVersion: 2,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
@@ -181,6 +185,10 @@ func resourceIotCentralAppCreate(d *pluginsdk.ResourceData, meta interface{}) er
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
+
 	return resourceIotCentralAppRead(d, meta)
 }
 
@@ -296,7 +304,7 @@ func resourceIotCentralAppRead(d *pluginsdk.ResourceData, meta interface{}) erro
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceIotCentralAppDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package iothub_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccIothubDeviceUpdateInstance_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_iothub_device_update_instance", "test")
+	r := IotHubDeviceUpdateInstanceResource{}
+
+	checkedFields := map[string]struct{}{
+		"name":                {},
+		"account_name":        {},
+		"resource_group_name": {},
+		"subscription_id":     {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_iothub_device_update_instance.test", checkedFields),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_iothub_device_update_instance.test", tfjsonpath.New("name"), tfjsonpath.New("name")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_iothub_device_update_instance.test", tfjsonpath.New("account_name"), tfjsonpath.New("device_update_account_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_iothub_device_update_instance.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("device_update_account_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_iothub_device_update_instance.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("device_update_account_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
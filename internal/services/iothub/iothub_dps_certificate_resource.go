@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/deviceprovisioningservices/2022-02-05/dpscertificate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iothub/validate"
@@ -19,6 +20,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name iothub_dps_certificate -service-package-name iothub -properties "name,resource_group_name,provisioning_service_name:iot_dps_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type IotHubDPSCertificateResource
+
 func resourceIotHubDPSCertificate() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceIotHubDPSCertificateCreate,
@@ -26,10 +29,11 @@ func resourceIotHubDPSCertificate() *pluginsdk.Resource {
 		Update: resourceIotHubDPSCertificateUpdate,
 		Delete: resourceIotHubDPSCertificateDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := dpscertificate.ParseCertificateID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&dpscertificate.CertificateId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&dpscertificate.CertificateId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -105,6 +109,9 @@ func resourceIotHubDPSCertificateCreate(d *pluginsdk.ResourceData, meta interfac
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 
 	return resourceIotHubDPSCertificateRead(d, meta)
 }
@@ -142,7 +149,7 @@ func resourceIotHubDPSCertificateRead(d *pluginsdk.ResourceData, meta interface{
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceIotHubDPSCertificateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/deviceprovisioningservices/2022-02-05/iotdpsresource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -28,6 +29,8 @@ import (
 	devices "github.com/jackofallops/kermit/sdk/iothub/2022-04-30-preview/iothub"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name iothub_dps -service-package-name iothub -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type IotHubDPSResource
+
 func resourceIotHubDPS() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceIotHubDPSCreate,
@@ -35,10 +38,11 @@ func resourceIotHubDPS() *pluginsdk.Resource {
 		Update: resourceIotHubDPSUpdate,
 		Delete: resourceIotHubDPSDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := commonids.ParseProvisioningServiceID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&commonids.ProvisioningServiceId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&commonids.ProvisioningServiceId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -248,6 +252,9 @@ func resourceIotHubDPSCreate(d *pluginsdk.ResourceData, meta interface{}) error
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 
 	return resourceIotHubDPSRead(d, meta)
 }
@@ -317,7 +324,7 @@ func resourceIotHubDPSRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		d.Set("tags", flattenTags(model.Tags))
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceIotHubDPSUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/deviceprovisioningservices/2022-02-05/iotdpsresource"
 	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
@@ -23,6 +24,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name iothub_dps_shared_access_policy -service-package-name iothub -properties "name,resource_group_name,provisioning_service_name:iothub_dps_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type IotHubDpsSharedAccessPolicyResource
+
 func resourceIotHubDPSSharedAccessPolicy() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceIotHubDPSSharedAccessPolicyCreateUpdate,
@@ -30,10 +33,11 @@ func resourceIotHubDPSSharedAccessPolicy() *pluginsdk.Resource {
 		Update: resourceIotHubDPSSharedAccessPolicyCreateUpdate,
 		Delete: resourceIotHubDPSSharedAccessPolicyDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := iotdpsresource.ParseKeyID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&iotdpsresource.KeyId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&iotdpsresource.KeyId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -191,6 +195,9 @@ func resourceIotHubDPSSharedAccessPolicyCreateUpdate(d *pluginsdk.ResourceData,
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 
 	return resourceIotHubDPSSharedAccessPolicyRead(d, meta)
 }
@@ -254,7 +261,7 @@ func resourceIotHubDPSSharedAccessPolicyRead(d *pluginsdk.ResourceData, meta int
 		d.Set("service_config", rights.serviceConfig)
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceIotHubDPSSharedAccessPolicyDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -0,0 +1,41 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package iothub_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccIothubDpsSharedAccessPolicy_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_iothub_dps_shared_access_policy", "test")
+	r := IotHubDpsSharedAccessPolicyResource{}
+
+	checkedFields := map[string]struct{}{
+		"subscription_id":           {},
+		"name":                      {},
+		"provisioning_service_name": {},
+		"resource_group_name":       {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_iothub_dps_shared_access_policy.test", checkedFields),
+				statecheck.ExpectIdentityValue("azurerm_iothub_dps_shared_access_policy.test", tfjsonpath.New("subscription_id"), knownvalue.StringExact(data.Subscriptions.Primary)),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_iothub_dps_shared_access_policy.test", tfjsonpath.New("name"), tfjsonpath.New("name")),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_iothub_dps_shared_access_policy.test", tfjsonpath.New("provisioning_service_name"), tfjsonpath.New("iothub_dps_name")),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_iothub_dps_shared_access_policy.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("resource_group_name")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
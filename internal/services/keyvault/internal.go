@@ -13,6 +13,8 @@ import (
 
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -153,3 +155,57 @@ func nestedItemResourceImporter(ctx context.Context, d *pluginsdk.ResourceData,
 
 	return []*pluginsdk.ResourceData{d}, nil
 }
+
+type nestedItemLatestVersionIdFunc func(ctx context.Context, meta interface{}, keyVaultBaseUrl string, name string) (*string, error)
+
+// nestedItemImporterValidatingIdentity validates either the versioned Nested Item ID or the Resource Identity data
+// provided at import time. Since the Resource Identity of a Nested Item is versionless, when importing using the
+// Resource Identity the ID of the latest version of the Nested Item is retrieved using `latestVersionIdFunc`.
+func nestedItemImporterValidatingIdentity(identity resourceids.ResourceId, latestVersionIdFunc nestedItemLatestVersionIdFunc) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		StateContext: func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+			if _, ok := ctx.Deadline(); !ok {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, d.Timeout(schema.TimeoutRead))
+				defer cancel()
+			}
+
+			if d.Id() == "" {
+				if err := pluginsdk.ValidateResourceIdentityData(d, identity); err != nil {
+					return nil, err
+				}
+
+				versionlessId, err := parse.ParseOptionallyVersionedNestedItemID(d.Id())
+				if err != nil {
+					return nil, err
+				}
+
+				latestVersionId, err := latestVersionIdFunc(ctx, meta, versionlessId.KeyVaultBaseUrl, versionlessId.Name)
+				if err != nil {
+					return nil, fmt.Errorf("retrieving the latest version of %q: %+v", d.Id(), err)
+				}
+				if latestVersionId == nil {
+					return nil, fmt.Errorf("retrieving the latest version of %q: `id` was nil", d.Id())
+				}
+
+				d.SetId(*latestVersionId)
+			}
+
+			if _, err := parse.ParseNestedItemID(d.Id()); err != nil {
+				// NOTE: we're intentionally not wrapping this error, since it's prefixed with `parsing %q:`
+				return []*pluginsdk.ResourceData{d}, err
+			}
+
+			return nestedItemResourceImporter(ctx, d, meta)
+		},
+	}
+}
+
+// nestedItemUnixTimeToRFC3339 formats the Unix timestamps returned in the attributes of a Nested Item from the list APIs
+func nestedItemUnixTimeToRFC3339(input *int64) string {
+	if input == nil {
+		return ""
+	}
+
+	return time.Unix(*input, 0).UTC().Format(time.RFC3339)
+}
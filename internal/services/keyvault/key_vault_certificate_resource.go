@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/data-plane/keyvault/7-4/certificates"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -34,6 +35,8 @@ import (
 	"github.com/jackofallops/kermit/sdk/keyvault/7.4/keyvault"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name key_vault_certificate -service-package-name keyvault -properties "name" -compare-values "base_uri:versionless_id" -no-subscription-id -test-name basicGenerate
+
 func resourceKeyVaultCertificate() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		// TODO: support Updating additional properties once we have more information about what can be updated
@@ -42,10 +45,11 @@ func resourceKeyVaultCertificate() *pluginsdk.Resource {
 		Delete: resourceKeyVaultCertificateDelete,
 		Update: resourceKeyVaultCertificateUpdate,
 
-		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
-			_, err := parse.ParseNestedItemID(id)
-			return err
-		}, nestedItemResourceImporter),
+		Importer: nestedItemImporterValidatingIdentity(&certificates.CertificateId{}, keyVaultCertificateLatestVersionId),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&certificates.CertificateId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
@@ -550,6 +554,9 @@ func resourceKeyVaultCertificateCreate(d *pluginsdk.ResourceData, meta interface
 		return err
 	}
 	d.SetId(certificateId.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, pointer.To(certificates.NewCertificateID(certificateId.KeyVaultBaseUrl, certificateId.Name))); err != nil {
+		return err
+	}
 
 	return resourceKeyVaultCertificateRead(d, meta)
 }
@@ -806,7 +813,11 @@ func resourceKeyVaultCertificateRead(d *pluginsdk.ResourceData, meta interface{}
 	}
 	d.Set("thumbprint", thumbprint)
 
-	return tags.FlattenAndSet(d, cert.Tags)
+	if err := tags.FlattenAndSet(d, cert.Tags); err != nil {
+		return err
+	}
+
+	return pluginsdk.SetResourceIdentityData(d, pointer.To(certificates.NewCertificateID(id.KeyVaultBaseUrl, id.Name)))
 }
 
 func resourceKeyVaultCertificateDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -1204,3 +1215,12 @@ func expandKeyVaultCertificate(v interface{}) KeyVaultCertificateImportParameter
 		CertificatePassword: cert["password"].(string),
 	}
 }
+
+func keyVaultCertificateLatestVersionId(ctx context.Context, meta interface{}, keyVaultBaseUrl string, name string) (*string, error) {
+	resp, err := meta.(*clients.Client).KeyVault.ManagementClient.GetCertificate(ctx, keyVaultBaseUrl, name, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.ID, nil
+}
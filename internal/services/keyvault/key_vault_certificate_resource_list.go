@@ -0,0 +1,136 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/data-plane/keyvault/7-4/certificates"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type KeyVaultCertificateListResource struct{}
+
+var _ sdk.FrameworkListWrappedResourceWithConfig = new(KeyVaultCertificateListResource)
+
+func (KeyVaultCertificateListResource) ResourceFunc() *pluginsdk.Resource {
+	return resourceKeyVaultCertificate()
+}
+
+func (KeyVaultCertificateListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "azurerm_key_vault_certificate"
+}
+
+func (KeyVaultCertificateListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = keyVaultNestedItemListResourceConfigSchema()
+}
+
+func (KeyVaultCertificateListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata sdk.ResourceMetadata) {
+	var data KeyVaultNestedItemListModel
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	keyVaultId, keyVaultBaseUri, err := keyVaultNestedItemListBaseUri(ctx, metadata, data)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", "azurerm_key_vault_certificate"), err)
+		return
+	}
+
+	// the Complete method follows the `nextLink` returned for each page of results until all Certificates have been retrieved
+	client := metadata.Client.KeyVault.DataPlaneKeyVaultClient.Certificates.Clone(*keyVaultBaseUri)
+	resp, err := client.GetCertificatesComplete(sdk.ListPageLimitContext(ctx, request), certificates.DefaultGetCertificatesOperationOptions())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", "azurerm_key_vault_certificate"), err)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, item := range resp.Items {
+			result := request.NewListResult(ctx)
+
+			id, err := parse.ParseOptionallyVersionedNestedItemID(pointer.From(item.Id))
+			if err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, "parsing Key Vault Certificate ID", err)
+				return
+			}
+			result.DisplayName = id.Name
+
+			rd := resourceKeyVaultCertificate().Data(&terraform.InstanceState{})
+			// the ID is required to encode the Resource Data, but isn't included in the List Result - see encodeNestedItemListResult
+			rd.SetId(id.ID())
+
+			if err := resourceKeyVaultCertificateFlattenListItem(rd, *keyVaultId, *id, item); err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("encoding `%s` resource data", "azurerm_key_vault_certificate"), err)
+				return
+			}
+
+			encodeNestedItemListResult(ctx, rd, &result)
+			if result.Diagnostics.HasError() {
+				push(result)
+				return
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// resourceKeyVaultCertificateFlattenListItem sets the metadata returned when listing Certificates - the List API doesn't
+// return the contents or policy of the Certificate, which are only retrieved when the Certificate is read.
+func resourceKeyVaultCertificateFlattenListItem(d *pluginsdk.ResourceData, keyVaultId commonids.KeyVaultId, id parse.NestedItemId, item certificates.CertificateItem) error {
+	d.Set("name", id.Name)
+	d.Set("key_vault_id", keyVaultId.ID())
+	d.Set("versionless_id", id.VersionlessID())
+	d.Set("resource_manager_versionless_id", parse.NewCertificateVersionlessID(keyVaultId.SubscriptionId, keyVaultId.ResourceGroupName, keyVaultId.VaultName, id.Name).ID())
+
+	thumbprint := ""
+	if v := item.X5t; v != nil {
+		x509Thumbprint, err := base64.RawURLEncoding.DecodeString(*v)
+		if err != nil {
+			return err
+		}
+
+		thumbprint = strings.ToUpper(hex.EncodeToString(x509Thumbprint))
+	}
+	d.Set("thumbprint", thumbprint)
+
+	if attributes := item.Attributes; attributes != nil {
+		certificateAttribute := []interface{}{
+			map[string]interface{}{
+				"created":        nestedItemUnixTimeToRFC3339(attributes.Created),
+				"enabled":        pointer.From(attributes.Enabled),
+				"expires":        nestedItemUnixTimeToRFC3339(attributes.Exp),
+				"not_before":     nestedItemUnixTimeToRFC3339(attributes.Nbf),
+				"recovery_level": string(pointer.From(attributes.RecoveryLevel)),
+				"updated":        nestedItemUnixTimeToRFC3339(attributes.Updated),
+			},
+		}
+		if err := d.Set("certificate_attribute", certificateAttribute); err != nil {
+			return fmt.Errorf("setting `certificate_attribute`: %+v", err)
+		}
+	}
+
+	if err := tags.FlattenAndSet(d, tags.FromTypedObject(pointer.From(item.Tags))); err != nil {
+		return err
+	}
+
+	return pluginsdk.SetResourceIdentityData(d, pointer.To(certificates.NewCertificateID(id.KeyVaultBaseUrl, id.Name)))
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/data-plane/keyvault/7-4/deletedkeys"
 	"github.com/hashicorp/go-azure-sdk/data-plane/keyvault/7-4/keys"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -37,6 +38,8 @@ import (
 	"golang.org/x/crypto/ssh"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name key_vault_key -service-package-name keyvault -properties "name" -compare-values "base_uri:versionless_id" -no-subscription-id -test-name basicRSA
+
 func resourceKeyVaultKey() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceKeyVaultKeyCreate,
@@ -44,10 +47,11 @@ func resourceKeyVaultKey() *pluginsdk.Resource {
 		Update: resourceKeyVaultKeyUpdate,
 		Delete: resourceKeyVaultKeyDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
-			_, err := parse.ParseNestedItemID(id)
-			return err
-		}, nestedItemResourceImporter),
+		Importer: nestedItemImporterValidatingIdentity(&keys.KeyId{}, keyVaultKeyLatestVersionId),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&keys.KeyId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -403,6 +407,9 @@ func resourceKeyVaultKeyCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 		return err
 	}
 	d.SetId(itemId.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, pointer.To(keys.NewKeyID(itemId.KeyVaultBaseUrl, itemId.Name))); err != nil {
+		return err
+	}
 
 	return resourceKeyVaultKeyRead(d, meta)
 }
@@ -525,6 +532,10 @@ func resourceKeyVaultKeyRead(d *pluginsdk.ResourceData, meta interface{}) error
 
 	d.Set("name", id.Name)
 
+	if err := pluginsdk.SetResourceIdentityData(d, pointer.To(keys.NewKeyID(id.KeyVaultBaseUrl, id.Name))); err != nil {
+		return err
+	}
+
 	if resp.Model != nil {
 		if key := resp.Model.Key; key != nil {
 			d.Set("key_type", string(pointer.From(key.Kty)))
@@ -876,3 +887,17 @@ func readPublicKey(d *pluginsdk.ResourceData, pubKey interface{}) error {
 	}
 	return nil
 }
+
+func keyVaultKeyLatestVersionId(ctx context.Context, meta interface{}, keyVaultBaseUrl string, name string) (*string, error) {
+	client := meta.(*clients.Client).KeyVault.DataPlaneKeyVaultClient.Keys.Clone(keyVaultBaseUrl)
+	resp, err := client.GetKey(ctx, keys.NewKeyversionID(keyVaultBaseUrl, name, ""))
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Model == nil || resp.Model.Key == nil {
+		return nil, nil
+	}
+
+	return resp.Model.Key.Kid, nil
+}
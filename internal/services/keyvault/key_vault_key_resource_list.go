@@ -0,0 +1,116 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/data-plane/keyvault/7-4/keys"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type KeyVaultKeyListResource struct{}
+
+var _ sdk.FrameworkListWrappedResourceWithConfig = new(KeyVaultKeyListResource)
+
+func (KeyVaultKeyListResource) ResourceFunc() *pluginsdk.Resource {
+	return resourceKeyVaultKey()
+}
+
+func (KeyVaultKeyListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "azurerm_key_vault_key"
+}
+
+func (KeyVaultKeyListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = keyVaultNestedItemListResourceConfigSchema()
+}
+
+func (KeyVaultKeyListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata sdk.ResourceMetadata) {
+	var data KeyVaultNestedItemListModel
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	keyVaultId, keyVaultBaseUri, err := keyVaultNestedItemListBaseUri(ctx, metadata, data)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", "azurerm_key_vault_key"), err)
+		return
+	}
+
+	// the Complete method follows the `nextLink` returned for each page of results until all Keys have been retrieved
+	client := metadata.Client.KeyVault.DataPlaneKeyVaultClient.Keys.Clone(*keyVaultBaseUri)
+	resp, err := client.GetKeysComplete(ctx, keys.DefaultGetKeysOperationOptions())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", "azurerm_key_vault_key"), err)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, item := range resp.Items {
+			// the Key backing a Certificate is managed by the `azurerm_key_vault_certificate` resource
+			if pointer.From(item.Managed) {
+				continue
+			}
+
+			result := request.NewListResult(ctx)
+
+			id, err := parse.ParseOptionallyVersionedNestedItemID(pointer.From(item.Kid))
+			if err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, "parsing Key Vault Key ID", err)
+				return
+			}
+			result.DisplayName = id.Name
+
+			rd := resourceKeyVaultKey().Data(&terraform.InstanceState{})
+			// the ID is required to encode the Resource Data, but isn't included in the List Result - see encodeNestedItemListResult
+			rd.SetId(id.ID())
+
+			if err := resourceKeyVaultKeyFlattenListItem(rd, *keyVaultId, *id, item); err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("encoding `%s` resource data", "azurerm_key_vault_key"), err)
+				return
+			}
+
+			encodeNestedItemListResult(ctx, rd, &result)
+			if result.Diagnostics.HasError() {
+				push(result)
+				return
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// resourceKeyVaultKeyFlattenListItem sets the metadata returned when listing Keys - the List API doesn't return the
+// key material (or version) of the Key, which is only retrieved when the Key is read.
+func resourceKeyVaultKeyFlattenListItem(d *pluginsdk.ResourceData, keyVaultId commonids.KeyVaultId, id parse.NestedItemId, item keys.KeyItem) error {
+	d.Set("name", id.Name)
+	d.Set("key_vault_id", keyVaultId.ID())
+	d.Set("versionless_id", id.VersionlessID())
+	d.Set("resource_versionless_id", parse.NewKeyVersionlessID(keyVaultId.SubscriptionId, keyVaultId.ResourceGroupName, keyVaultId.VaultName, id.Name).ID())
+
+	if attributes := item.Attributes; attributes != nil {
+		d.Set("not_before_date", nestedItemUnixTimeToRFC3339(attributes.Nbf))
+		d.Set("expiration_date", nestedItemUnixTimeToRFC3339(attributes.Exp))
+	}
+
+	if err := tags.FlattenAndSet(d, tags.FromTypedObject(pointer.From(item.Tags))); err != nil {
+		return err
+	}
+
+	return pluginsdk.SetResourceIdentityData(d, pointer.To(keys.NewKeyID(id.KeyVaultBaseUrl, id.Name)))
+}
@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestAccKeyVaultKey_listByKeyVaultId(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_key", "testlist")
+	r := KeyVaultKeyResource{}
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.basicRSA(data),
+			},
+			{
+				Query:  true,
+				Config: r.basicListQueryByKeyVaultId(),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("azurerm_key_vault_key.list", 1),
+					querycheck.ExpectIdentity(
+						"azurerm_key_vault_key.list",
+						map[string]knownvalue.Check{
+							"name":     knownvalue.StringExact("key-" + data.RandomString),
+							"base_uri": knownvalue.StringRegexp(regexp.MustCompile(data.RandomString)),
+						},
+					),
+				},
+			},
+		},
+	})
+}
+
+func (KeyVaultKeyResource) basicListQueryByKeyVaultId() string {
+	return `
+list "azurerm_key_vault_key" "list" {
+  provider = azurerm
+  config {
+    key_vault_id = azurerm_key_vault.test.id
+  }
+}
+`
+}
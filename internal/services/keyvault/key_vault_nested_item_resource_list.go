@@ -0,0 +1,72 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// KeyVaultNestedItemListModel is the List Resource configuration shared by the Key Vault Nested Items
+// (Certificates, Keys and Secrets), which are listed from the Data Plane of a single Key Vault.
+type KeyVaultNestedItemListModel struct {
+	KeyVaultId types.String `tfsdk:"key_vault_id"`
+}
+
+func keyVaultNestedItemListResourceConfigSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"key_vault_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: commonids.ValidateKeyVaultID,
+					},
+				},
+			},
+		},
+	}
+}
+
+// keyVaultNestedItemListBaseUri parses the configured Key Vault ID and retrieves the Data Plane URI for the Key Vault
+func keyVaultNestedItemListBaseUri(ctx context.Context, metadata sdk.ResourceMetadata, data KeyVaultNestedItemListModel) (*commonids.KeyVaultId, *string, error) {
+	keyVaultId, err := commonids.ParseKeyVaultID(data.KeyVaultId.ValueString())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	baseUri, err := metadata.Client.KeyVault.BaseUriForKeyVault(ctx, *keyVaultId)
+	if err != nil {
+		return nil, nil, fmt.Errorf("looking up the Data Plane URI for %s: %+v", *keyVaultId, err)
+	}
+	if baseUri == nil {
+		return nil, nil, fmt.Errorf("looking up the Data Plane URI for %s: `baseUri` was nil", *keyVaultId)
+	}
+
+	return keyVaultId, baseUri, nil
+}
+
+// encodeNestedItemListResult encodes the Resource Data into the List Result. The ID of the Nested Items is versioned,
+// however the List APIs only return the versionless ID - as such the `id` is left unset and the Nested Item is instead
+// identified by its Resource Identity, from which the latest version is retrieved when it's imported.
+func encodeNestedItemListResult(ctx context.Context, rd *pluginsdk.ResourceData, result *list.ListResult) {
+	sdk.EncodeListResult(ctx, rd, result)
+	if result.Diagnostics.HasError() {
+		return
+	}
+
+	if diags := result.Resource.SetAttribute(ctx, path.Root("id"), types.StringNull()); diags.HasError() {
+		sdk.AppendResponseErrorDiagnostic(result, diags)
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/keyvault/2023-02-01/vaults"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	commonValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -35,6 +36,8 @@ import (
 	dataplane "github.com/jackofallops/kermit/sdk/keyvault/7.4/keyvault"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name key_vault -service-package-name keyvault -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 var keyVaultResourceName = "azurerm_key_vault"
 
 func resourceKeyVault() *pluginsdk.Resource {
@@ -44,10 +47,11 @@ func resourceKeyVault() *pluginsdk.Resource {
 		Update: resourceKeyVaultUpdate,
 		Delete: resourceKeyVaultDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := commonids.ParseKeyVaultID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&commonids.KeyVaultId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&commonids.KeyVaultId{}),
+		},
 
 		SchemaVersion: 2,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
@@ -420,6 +424,9 @@ func resourceKeyVaultCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 
 	meta.(*clients.Client).KeyVault.AddToCache(id, vaultUri)
 
@@ -682,7 +689,6 @@ func resourceKeyVaultUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 
 func resourceKeyVaultRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).KeyVault.VaultsClient
-	managementClient := meta.(*clients.Client).KeyVault.ManagementClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -701,15 +707,21 @@ func resourceKeyVaultRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
+	return resourceKeyVaultFlatten(ctx, meta.(*clients.Client), d, id, resp.Model, true)
+}
+
+// resourceKeyVaultFlatten sets the Key Vault attributes from the model into the resource data. The Certificate
+// Contacts are retrieved from the data plane only when `fetchCompleteData` is true.
+func resourceKeyVaultFlatten(ctx context.Context, metaClient *clients.Client, d *pluginsdk.ResourceData, id *commonids.KeyVaultId, model *vaults.Vault, fetchCompleteData bool) error {
 	vaultUri := ""
-	if model := resp.Model; model != nil {
+	if model != nil {
 		if model.Properties.VaultUri != nil {
 			vaultUri = *model.Properties.VaultUri
 		}
 	}
 
 	if vaultUri != "" {
-		meta.(*clients.Client).KeyVault.AddToCache(*id, vaultUri)
+		metaClient.KeyVault.AddToCache(*id, vaultUri)
 	}
 
 	d.Set("name", id.VaultName)
@@ -718,7 +730,7 @@ func resourceKeyVaultRead(d *pluginsdk.ResourceData, meta interface{}) error {
 
 	publicNetworkAccessEnabled := true
 
-	if model := resp.Model; model != nil {
+	if model != nil {
 		d.Set("location", location.NormalizeNilable(model.Location))
 		d.Set("tenant_id", model.Properties.TenantId)
 		d.Set("enabled_for_deployment", model.Properties.EnabledForDeployment)
@@ -780,20 +792,22 @@ func resourceKeyVaultRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	//
 	// We don't know if the private endpoint has been created yet, so we need
 	// to ignore the error if the data plane call fails.
-	contacts, err := managementClient.GetCertificateContacts(ctx, vaultUri)
-	if err != nil {
-		if publicNetworkAccessEnabled && (!utils.ResponseWasForbidden(contacts.Response) && !utils.ResponseWasNotFound(contacts.Response)) {
-			return fmt.Errorf("retrieving `contact` for KeyVault: %+v", err)
+	if fetchCompleteData {
+		contacts, err := metaClient.KeyVault.ManagementClient.GetCertificateContacts(ctx, vaultUri)
+		if err != nil {
+			if publicNetworkAccessEnabled && (!utils.ResponseWasForbidden(contacts.Response) && !utils.ResponseWasNotFound(contacts.Response)) {
+				return fmt.Errorf("retrieving `contact` for KeyVault: %+v", err)
+			}
 		}
-	}
 
-	if !features.FivePointOh() {
-		if err := d.Set("contact", flattenKeyVaultCertificateContactList(&contacts)); err != nil {
-			return fmt.Errorf("setting `contact` for KeyVault: %+v", err)
+		if !features.FivePointOh() {
+			if err := d.Set("contact", flattenKeyVaultCertificateContactList(&contacts)); err != nil {
+				return fmt.Errorf("setting `contact` for KeyVault: %+v", err)
+			}
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceKeyVaultDelete(d *pluginsdk.ResourceData, meta interface{}) error {
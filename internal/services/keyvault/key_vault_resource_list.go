@@ -0,0 +1,118 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/keyvault/2023-02-01/vaults"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type KeyVaultListResource struct{}
+
+var (
+	_ sdk.FrameworkListWrappedResource                  = new(KeyVaultListResource)
+	_ sdk.FrameworkListWrappedResourceWithResourceGraph = new(KeyVaultListResource)
+)
+
+func (KeyVaultListResource) ResourceFunc() *pluginsdk.Resource {
+	return resourceKeyVault()
+}
+
+func (KeyVaultListResource) ResourceGraphResourceType() string {
+	return "Microsoft.KeyVault/vaults"
+}
+
+func (KeyVaultListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = keyVaultResourceName
+}
+
+func (KeyVaultListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata sdk.ResourceMetadata) {
+	client := metadata.Client.KeyVault.VaultsClient
+
+	// retrieve the deadline from the supplied context
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		// This *should* never happen given the List Wrapper instantiates a context with a timeout
+		sdk.SetResponseErrorDiagnostic(stream, "internal-error", "context had no deadline")
+		return
+	}
+
+	var data sdk.DefaultListModel
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	results := make([]vaults.Vault, 0)
+
+	subscriptionID := metadata.SubscriptionId
+	if !data.SubscriptionId.IsNull() {
+		subscriptionID = data.SubscriptionId.ValueString()
+	}
+
+	switch {
+	case !data.ResourceGroupName.IsNull():
+		resp, err := client.ListByResourceGroupComplete(sdk.ListPageLimitContext(ctx, request), commonids.NewResourceGroupID(subscriptionID, data.ResourceGroupName.ValueString()), vaults.DefaultListByResourceGroupOperationOptions())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", keyVaultResourceName), err)
+			return
+		}
+
+		results = resp.Items
+	default:
+		resp, err := client.ListBySubscriptionComplete(sdk.ListPageLimitContext(ctx, request), commonids.NewSubscriptionID(subscriptionID), vaults.DefaultListBySubscriptionOperationOptions())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", keyVaultResourceName), err)
+			return
+		}
+
+		results = resp.Items
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		deadlineCtx, cancel := context.WithDeadline(context.Background(), deadline)
+		defer cancel()
+
+		for _, vault := range results {
+			result := request.NewListResult(deadlineCtx)
+			result.DisplayName = pointer.From(vault.Name)
+
+			rd := resourceKeyVault().Data(&terraform.InstanceState{})
+
+			id, err := commonids.ParseKeyVaultIDInsensitively(pointer.From(vault.Id))
+			if err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, "parsing Key Vault ID", err)
+				return
+			}
+			rd.SetId(id.ID())
+
+			// the Certificate Contacts are retrieved from the Data Plane, which requires an additional API call per
+			// Key Vault (and network access to the Key Vault), so are only retrieved when the full resource has been requested
+			if err := resourceKeyVaultFlatten(deadlineCtx, metadata.Client, rd, id, &vault, request.IncludeResource); err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("encoding `%s` resource data", keyVaultResourceName), err)
+				return
+			}
+
+			sdk.EncodeListResult(deadlineCtx, rd, &result)
+			if result.Diagnostics.HasError() {
+				push(result)
+				return
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
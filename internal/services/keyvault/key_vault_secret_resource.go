@@ -15,7 +15,9 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/data-plane/keyvault/7-4/secrets"
 	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
@@ -28,16 +30,20 @@ import (
 	"github.com/jackofallops/kermit/sdk/keyvault/7.4/keyvault"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name key_vault_secret -service-package-name keyvault -properties "name" -compare-values "base_uri:versionless_id" -no-subscription-id
+
 func resourceKeyVaultSecret() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceKeyVaultSecretCreate,
 		Read:   resourceKeyVaultSecretRead,
 		Update: resourceKeyVaultSecretUpdate,
 		Delete: resourceKeyVaultSecretDelete,
-		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
-			_, err := parse.ParseNestedItemID(id)
-			return err
-		}, nestedItemResourceImporter),
+
+		Importer: nestedItemImporterValidatingIdentity(&secrets.SecretId{}, keyVaultSecretLatestVersionId),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&secrets.SecretId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -235,6 +241,9 @@ func resourceKeyVaultSecretCreate(d *pluginsdk.ResourceData, meta interface{}) e
 	}
 
 	d.SetId(secretId.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, pointer.To(secrets.NewSecretID(secretId.KeyVaultBaseUrl, secretId.Name))); err != nil {
+		return err
+	}
 
 	return resourceKeyVaultSecretRead(d, meta)
 }
@@ -416,7 +425,11 @@ func resourceKeyVaultSecretRead(d *pluginsdk.ResourceData, meta interface{}) err
 	d.Set("resource_id", parse.NewSecretID(keyVaultId.SubscriptionId, keyVaultId.ResourceGroupName, keyVaultId.VaultName, id.Name, id.Version).ID())
 	d.Set("resource_versionless_id", parse.NewSecretVersionlessID(keyVaultId.SubscriptionId, keyVaultId.ResourceGroupName, keyVaultId.VaultName, id.Name).ID())
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	if err := tags.FlattenAndSet(d, resp.Tags); err != nil {
+		return err
+	}
+
+	return pluginsdk.SetResourceIdentityData(d, pointer.To(secrets.NewSecretID(id.KeyVaultBaseUrl, id.Name)))
 }
 
 func resourceKeyVaultSecretDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -499,3 +512,12 @@ func (d deleteAndPurgeSecret) NestedItemHasBeenPurged(ctx context.Context) (auto
 	resp, err := d.client.GetDeletedSecret(ctx, d.keyVaultUri, d.name)
 	return resp.Response, err
 }
+
+func keyVaultSecretLatestVersionId(ctx context.Context, meta interface{}, keyVaultBaseUrl string, name string) (*string, error) {
+	resp, err := meta.(*clients.Client).KeyVault.ManagementClient.GetSecret(ctx, keyVaultBaseUrl, name, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.ID, nil
+}
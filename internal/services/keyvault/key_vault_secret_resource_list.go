@@ -0,0 +1,117 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/data-plane/keyvault/7-4/secrets"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type KeyVaultSecretListResource struct{}
+
+var _ sdk.FrameworkListWrappedResourceWithConfig = new(KeyVaultSecretListResource)
+
+func (KeyVaultSecretListResource) ResourceFunc() *pluginsdk.Resource {
+	return resourceKeyVaultSecret()
+}
+
+func (KeyVaultSecretListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "azurerm_key_vault_secret"
+}
+
+func (KeyVaultSecretListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = keyVaultNestedItemListResourceConfigSchema()
+}
+
+func (KeyVaultSecretListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata sdk.ResourceMetadata) {
+	var data KeyVaultNestedItemListModel
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	keyVaultId, keyVaultBaseUri, err := keyVaultNestedItemListBaseUri(ctx, metadata, data)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", "azurerm_key_vault_secret"), err)
+		return
+	}
+
+	// the Complete method follows the `nextLink` returned for each page of results until all Secrets have been retrieved
+	client := metadata.Client.KeyVault.DataPlaneKeyVaultClient.Secrets.Clone(*keyVaultBaseUri)
+	resp, err := client.GetSecretsComplete(ctx, secrets.DefaultGetSecretsOperationOptions())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", "azurerm_key_vault_secret"), err)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, item := range resp.Items {
+			// the Secret backing a Certificate is managed by the `azurerm_key_vault_certificate` resource
+			if pointer.From(item.Managed) {
+				continue
+			}
+
+			result := request.NewListResult(ctx)
+
+			id, err := parse.ParseOptionallyVersionedNestedItemID(pointer.From(item.Id))
+			if err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, "parsing Key Vault Secret ID", err)
+				return
+			}
+			result.DisplayName = id.Name
+
+			rd := resourceKeyVaultSecret().Data(&terraform.InstanceState{})
+			// the ID is required to encode the Resource Data, but isn't included in the List Result - see encodeNestedItemListResult
+			rd.SetId(id.ID())
+
+			if err := resourceKeyVaultSecretFlattenListItem(rd, *keyVaultId, *id, item); err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("encoding `%s` resource data", "azurerm_key_vault_secret"), err)
+				return
+			}
+
+			encodeNestedItemListResult(ctx, rd, &result)
+			if result.Diagnostics.HasError() {
+				push(result)
+				return
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// resourceKeyVaultSecretFlattenListItem sets the metadata returned when listing Secrets - the List API intentionally
+// doesn't return the value (or version) of the Secret, and it's not retrieved here, so that values aren't exposed.
+func resourceKeyVaultSecretFlattenListItem(d *pluginsdk.ResourceData, keyVaultId commonids.KeyVaultId, id parse.NestedItemId, item secrets.SecretItem) error {
+	d.Set("name", id.Name)
+	d.Set("key_vault_id", keyVaultId.ID())
+	d.Set("content_type", pointer.From(item.ContentType))
+	d.Set("versionless_id", id.VersionlessID())
+	d.Set("resource_versionless_id", parse.NewSecretVersionlessID(keyVaultId.SubscriptionId, keyVaultId.ResourceGroupName, keyVaultId.VaultName, id.Name).ID())
+
+	if attributes := item.Attributes; attributes != nil {
+		d.Set("not_before_date", nestedItemUnixTimeToRFC3339(attributes.Nbf))
+		d.Set("expiration_date", nestedItemUnixTimeToRFC3339(attributes.Exp))
+	}
+
+	if err := tags.FlattenAndSet(d, tags.FromTypedObject(pointer.From(item.Tags))); err != nil {
+		return err
+	}
+
+	return pluginsdk.SetResourceIdentityData(d, pointer.To(secrets.NewSecretID(id.KeyVaultBaseUrl, id.Name)))
+}
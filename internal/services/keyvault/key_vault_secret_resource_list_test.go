@@ -0,0 +1,100 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package keyvault_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestAccKeyVaultSecret_listByKeyVaultId(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_secret", "testlist")
+	r := KeyVaultSecretResource{}
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.basic(data),
+			},
+			{
+				Query:  true,
+				Config: r.basicListQueryByKeyVaultId(),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("azurerm_key_vault_secret.list", 1),
+					querycheck.ExpectIdentity(
+						"azurerm_key_vault_secret.list",
+						map[string]knownvalue.Check{
+							"name":     knownvalue.StringExact("secret-" + data.RandomString),
+							"base_uri": knownvalue.StringRegexp(regexp.MustCompile(data.RandomString)),
+						},
+					),
+				},
+			},
+			{
+				Query:  true,
+				Config: r.basicListQueryIncludeResource(),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("azurerm_key_vault_secret.list", 1),
+					querycheck.ExpectResourceKnownValues(
+						"azurerm_key_vault_secret.list",
+						queryfilter.ByDisplayName(knownvalue.StringExact("secret-"+data.RandomString)),
+						[]querycheck.KnownValueCheck{
+							// the value of the Secret must never be retrieved when listing
+							{
+								Path:       tfjsonpath.New("value"),
+								KnownValue: knownvalue.Null(),
+							},
+							// the ID of the Secret is versioned, which isn't returned when listing
+							{
+								Path:       tfjsonpath.New("id"),
+								KnownValue: knownvalue.Null(),
+							},
+							{
+								Path:       tfjsonpath.New("name"),
+								KnownValue: knownvalue.StringExact("secret-" + data.RandomString),
+							},
+						},
+					),
+				},
+			},
+		},
+	})
+}
+
+func (KeyVaultSecretResource) basicListQueryByKeyVaultId() string {
+	return `
+list "azurerm_key_vault_secret" "list" {
+  provider = azurerm
+  config {
+    key_vault_id = azurerm_key_vault.test.id
+  }
+}
+`
+}
+
+func (KeyVaultSecretResource) basicListQueryIncludeResource() string {
+	return `
+list "azurerm_key_vault_secret" "list" {
+  provider         = azurerm
+  include_resource = true
+  config {
+    key_vault_id = azurerm_key_vault.test.id
+  }
+}
+`
+}
@@ -22,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name kusto_cluster_managed_private_endpoint -service-package-name kusto -properties "name,resource_group_name,cluster_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 func resourceKustoClusterManagedPrivateEndpoint() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceKustoClusterManagedPrivateEndpointCreateUpdate,
@@ -35,10 +37,11 @@ func resourceKustoClusterManagedPrivateEndpoint() *pluginsdk.Resource {
 			1: migration.KustoManagedPrivateEndpointV1ToV2{},
 		}),
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := managedprivateendpoints.ParseManagedPrivateEndpointID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&managedprivateendpoints.ManagedPrivateEndpointId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&managedprivateendpoints.ManagedPrivateEndpointId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
@@ -135,6 +138,10 @@ func resourceKustoClusterManagedPrivateEndpointCreateUpdate(d *schema.ResourceDa
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
+
 	return resourceKustoClusterManagedPrivateEndpointRead(d, meta)
 }
 
@@ -177,7 +184,7 @@ func resourceKustoClusterManagedPrivateEndpointRead(d *schema.ResourceData, meta
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceKustoClusterManagedPrivateEndpointDelete(d *schema.ResourceData, meta interface{}) error {
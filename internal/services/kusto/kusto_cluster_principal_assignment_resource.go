@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/kusto/2024-04-13/clusterprincipalassignments"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/kusto/migration"
@@ -20,6 +21,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name kusto_cluster_principal_assignment -service-package-name kusto -properties "name,resource_group_name,cluster_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 func resourceKustoClusterPrincipalAssignment() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceKustoClusterPrincipalAssignmentCreate,
@@ -31,10 +34,11 @@ func resourceKustoClusterPrincipalAssignment() *pluginsdk.Resource {
 			0: migration.KustoAttachedClusterPrincipalAssignmentV0ToV1{},
 		}),
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := clusterprincipalassignments.ParsePrincipalAssignmentID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&clusterprincipalassignments.PrincipalAssignmentId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&clusterprincipalassignments.PrincipalAssignmentId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
@@ -140,6 +144,10 @@ func resourceKustoClusterPrincipalAssignmentCreate(d *pluginsdk.ResourceData, me
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
+
 	return resourceKustoClusterPrincipalAssignmentRead(d, meta)
 }
 
@@ -195,7 +203,7 @@ func resourceKustoClusterPrincipalAssignmentRead(d *pluginsdk.ResourceData, meta
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceKustoClusterPrincipalAssignmentDelete(d *pluginsdk.ResourceData, meta interface{}) error {
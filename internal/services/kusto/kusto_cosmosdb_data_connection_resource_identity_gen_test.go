@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package kusto_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccKustoCosmosdbDataConnection_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kusto_cosmosdb_data_connection", "test")
+	r := KustoCosmosDBDataConnectionResource{}
+
+	checkedFields := map[string]struct{}{
+		"name":                {},
+		"cluster_name":        {},
+		"database_name":       {},
+		"resource_group_name": {},
+		"subscription_id":     {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_kusto_cosmosdb_data_connection.test", checkedFields),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_kusto_cosmosdb_data_connection.test", tfjsonpath.New("name"), tfjsonpath.New("name")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_kusto_cosmosdb_data_connection.test", tfjsonpath.New("cluster_name"), tfjsonpath.New("kusto_database_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_kusto_cosmosdb_data_connection.test", tfjsonpath.New("database_name"), tfjsonpath.New("kusto_database_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_kusto_cosmosdb_data_connection.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("kusto_database_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_kusto_cosmosdb_data_connection.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("kusto_database_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
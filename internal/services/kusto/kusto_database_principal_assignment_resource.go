@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/kusto/2024-04-13/databaseprincipalassignments"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/kusto/migration"
@@ -20,6 +21,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name kusto_database_principal_assignment -service-package-name kusto -properties "name,resource_group_name,cluster_name,database_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 func resourceKustoDatabasePrincipalAssignment() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceKustoDatabasePrincipalAssignmentCreate,
@@ -31,10 +34,11 @@ func resourceKustoDatabasePrincipalAssignment() *pluginsdk.Resource {
 			0: migration.KustoDatabasePrincipalAssignmentV0ToV1{},
 		}),
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := databaseprincipalassignments.ParseDatabasePrincipalAssignmentID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&databaseprincipalassignments.DatabasePrincipalAssignmentId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&databaseprincipalassignments.DatabasePrincipalAssignmentId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
@@ -140,6 +144,10 @@ func resourceKustoDatabasePrincipalAssignmentCreate(d *pluginsdk.ResourceData, m
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
+
 	return resourceKustoDatabasePrincipalAssignmentRead(d, meta)
 }
 
@@ -180,7 +188,7 @@ func resourceKustoDatabasePrincipalAssignmentRead(d *pluginsdk.ResourceData, met
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceKustoDatabasePrincipalAssignmentDelete(d *pluginsdk.ResourceData, meta interface{}) error {
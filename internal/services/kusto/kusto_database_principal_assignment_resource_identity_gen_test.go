@@ -0,0 +1,43 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package kusto_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccKustoDatabasePrincipalAssignment_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kusto_database_principal_assignment", "test")
+	r := KustoDatabasePrincipalAssignmentResource{}
+
+	checkedFields := map[string]struct{}{
+		"subscription_id":     {},
+		"cluster_name":        {},
+		"database_name":       {},
+		"name":                {},
+		"resource_group_name": {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_kusto_database_principal_assignment.test", checkedFields),
+				statecheck.ExpectIdentityValue("azurerm_kusto_database_principal_assignment.test", tfjsonpath.New("subscription_id"), knownvalue.StringExact(data.Subscriptions.Primary)),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_kusto_database_principal_assignment.test", tfjsonpath.New("cluster_name"), tfjsonpath.New("cluster_name")),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_kusto_database_principal_assignment.test", tfjsonpath.New("database_name"), tfjsonpath.New("database_name")),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_kusto_database_principal_assignment.test", tfjsonpath.New("name"), tfjsonpath.New("name")),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_kusto_database_principal_assignment.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("resource_group_name")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/kusto/2024-04-13/scripts"
 	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
@@ -22,6 +23,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name kusto_script -service-package-name kusto -properties "name" -compare-values "subscription_id:database_id,resource_group_name:database_id,cluster_name:database_id,database_name:database_id"
+
 func resourceKustoDatabaseScript() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceKustoDatabaseScriptCreateUpdate,
@@ -41,10 +44,11 @@ func resourceKustoDatabaseScript() *pluginsdk.Resource {
 			0: migration.KustoDatabaseScriptV0ToV1{},
 		}),
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := scripts.ParseScriptID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&scripts.ScriptId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&scripts.ScriptId{}),
+		},
 
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
@@ -180,6 +184,10 @@ func resourceKustoDatabaseScriptCreateUpdate(d *pluginsdk.ResourceData, meta int
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
+
 	return resourceKustoDatabaseScriptRead(d, meta)
 }
 
@@ -218,7 +226,7 @@ func resourceKustoDatabaseScriptRead(d *pluginsdk.ResourceData, meta interface{}
 			}
 		}
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceKustoDatabaseScriptDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/loadtestservice/2022-12-01/loadtests"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -22,9 +23,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name load_test -service-package-name loadtestservice -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type LoadTestTestResource
+
 var (
 	_ sdk.ResourceWithUpdate        = LoadTestResource{}
 	_ sdk.ResourceWithCustomizeDiff = LoadTestResource{}
+	_ sdk.ResourceWithIdentity      = LoadTestResource{}
 )
 
 type LoadTestResource struct{}
@@ -58,6 +62,10 @@ func (r LoadTestResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return loadtests.ValidateLoadTestID
 }
 
+func (r LoadTestResource) Identity() resourceids.ResourceId {
+	return &loadtests.LoadTestId{}
+}
+
 func (r LoadTestResource) ResourceType() string {
 	return "azurerm_load_test"
 }
@@ -180,7 +188,7 @@ func (r LoadTestResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -216,6 +224,10 @@ func (r LoadTestResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&schema)
 		},
 	}
@@ -0,0 +1,38 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package loganalytics_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccLogAnalyticsClusterCustomerManagedKey_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_log_analytics_cluster_customer_managed_key", "test")
+	r := LogAnalyticsClusterCustomerManagedKeyResource{}
+
+	checkedFields := map[string]struct{}{
+		"name":                {},
+		"resource_group_name": {},
+		"subscription_id":     {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_log_analytics_cluster_customer_managed_key.test", checkedFields),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_log_analytics_cluster_customer_managed_key.test", tfjsonpath.New("name"), tfjsonpath.New("log_analytics_cluster_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_log_analytics_cluster_customer_managed_key.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("log_analytics_cluster_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_log_analytics_cluster_customer_managed_key.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("log_analytics_cluster_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
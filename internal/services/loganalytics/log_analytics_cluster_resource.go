@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/clusters"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
@@ -23,6 +24,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name log_analytics_cluster -service-package-name loganalytics -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 type LogAnalyticsClusterResource struct{}
 
 type LogAnalyticsClusterModel struct {
@@ -36,6 +39,7 @@ type LogAnalyticsClusterModel struct {
 }
 
 var _ sdk.ResourceWithUpdate = LogAnalyticsClusterResource{}
+var _ sdk.ResourceWithIdentity = LogAnalyticsClusterResource{}
 
 func (l LogAnalyticsClusterResource) Arguments() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
@@ -84,6 +88,10 @@ func (r LogAnalyticsClusterResource) IDValidationFunc() pluginsdk.SchemaValidate
 	return clusters.ValidateClusterID
 }
 
+func (r LogAnalyticsClusterResource) Identity() resourceids.ResourceId {
+	return &clusters.ClusterId{}
+}
+
 func (r LogAnalyticsClusterResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 6 * time.Hour,
@@ -133,7 +141,7 @@ func (r LogAnalyticsClusterResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -185,6 +193,10 @@ func (r LogAnalyticsClusterResource) Read() sdk.ResourceFunc {
 				state.Tags = pointer.From(model.Tags)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2022-01-01-preview/namespaces"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/dataexport"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -28,6 +29,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name log_analytics_data_export_rule -service-package-name loganalytics -properties "name,resource_group_name" -compare-values "subscription_id:workspace_resource_id,workspace_name:workspace_resource_id"
+
 func resourceLogAnalyticsDataExport() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceOperationalinsightsDataExportCreateUpdate,
@@ -35,10 +38,11 @@ func resourceLogAnalyticsDataExport() *pluginsdk.Resource {
 		Update: resourceOperationalinsightsDataExportCreateUpdate,
 		Delete: resourceOperationalinsightsDataExportDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := dataexport.ParseDataExportID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&dataexport.DataExportId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&dataexport.DataExportId{}),
+		},
 
 		SchemaVersion: 1,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
@@ -178,6 +182,10 @@ func resourceOperationalinsightsDataExportCreateUpdate(d *pluginsdk.ResourceData
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
+
 	return resourceOperationalinsightsDataExportRead(d, meta)
 }
 
@@ -226,7 +234,7 @@ func resourceOperationalinsightsDataExportRead(d *pluginsdk.ResourceData, meta i
 			d.Set("table_names", props.TableNames)
 		}
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceOperationalinsightsDataExportDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/automation/2022-08-08/automationaccount"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/linkedservices"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/clusters"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -22,16 +23,20 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name log_analytics_linked_service -service-package-name loganalytics -properties "name,resource_group_name" -compare-values "subscription_id:workspace_id,workspace_name:workspace_id"
+
 func resourceLogAnalyticsLinkedService() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceLogAnalyticsLinkedServiceCreateUpdate,
 		Read:   resourceLogAnalyticsLinkedServiceRead,
 		Update: resourceLogAnalyticsLinkedServiceCreateUpdate,
 		Delete: resourceLogAnalyticsLinkedServiceDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := linkedservices.ParseLinkedServiceID(id)
-			return err
-		}),
+
+		Importer: pluginsdk.ImporterValidatingIdentity(&linkedservices.LinkedServiceId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&linkedservices.LinkedServiceId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -128,6 +133,9 @@ func resourceLogAnalyticsLinkedServiceCreateUpdate(d *pluginsdk.ResourceData, me
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 
 	return resourceLogAnalyticsLinkedServiceRead(d, meta)
 }
@@ -172,7 +180,7 @@ func resourceLogAnalyticsLinkedServiceRead(d *pluginsdk.ResourceData, meta inter
 		d.Set("write_access_id", writeAccessId)
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceLogAnalyticsLinkedServiceDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -13,12 +13,15 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2019-09-01/querypacks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name log_analytics_query_pack -service-package-name loganalytics -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 type LogAnalyticsQueryPackModel struct {
 	Name              string            `tfschema:"name"`
 	ResourceGroupName string            `tfschema:"resource_group_name"`
@@ -29,6 +32,7 @@ type LogAnalyticsQueryPackModel struct {
 type LogAnalyticsQueryPackResource struct{}
 
 var _ sdk.ResourceWithUpdate = LogAnalyticsQueryPackResource{}
+var _ sdk.ResourceWithIdentity = LogAnalyticsQueryPackResource{}
 
 func (r LogAnalyticsQueryPackResource) ResourceType() string {
 	return "azurerm_log_analytics_query_pack"
@@ -42,6 +46,10 @@ func (r LogAnalyticsQueryPackResource) IDValidationFunc() pluginsdk.SchemaValida
 	return querypacks.ValidateQueryPackID
 }
 
+func (r LogAnalyticsQueryPackResource) Identity() resourceids.ResourceId {
+	return &querypacks.QueryPackId{}
+}
+
 func (r LogAnalyticsQueryPackResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -100,7 +108,7 @@ func (r LogAnalyticsQueryPackResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -182,6 +190,10 @@ func (r LogAnalyticsQueryPackResource) Read() sdk.ResourceFunc {
 				state.Tags = *model.Tags
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
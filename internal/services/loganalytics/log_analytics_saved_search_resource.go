@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/savedsearches"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/loganalytics/migration"
@@ -21,16 +22,19 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name log_analytics_saved_search -service-package-name loganalytics -properties "saved_search_id:name" -compare-values "subscription_id:log_analytics_workspace_id,resource_group_name:log_analytics_workspace_id,workspace_name:log_analytics_workspace_id"
+
 func resourceLogAnalyticsSavedSearch() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceLogAnalyticsSavedSearchCreate,
 		Read:   resourceLogAnalyticsSavedSearchRead,
 		Delete: resourceLogAnalyticsSavedSearchDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := savedsearches.ParseSavedSearchID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&savedsearches.SavedSearchId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&savedsearches.SavedSearchId{}),
+		},
 
 		SchemaVersion: 1,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
@@ -154,6 +158,10 @@ func resourceLogAnalyticsSavedSearchCreate(d *pluginsdk.ResourceData, meta inter
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
+
 	return resourceLogAnalyticsSavedSearchRead(d, meta)
 }
 
@@ -203,7 +211,7 @@ func resourceLogAnalyticsSavedSearchRead(d *pluginsdk.ResourceData, meta interfa
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceLogAnalyticsSavedSearchDelete(d *pluginsdk.ResourceData, meta interface{}) error {
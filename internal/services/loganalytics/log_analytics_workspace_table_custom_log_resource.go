@@ -12,6 +12,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/tables"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -19,9 +20,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name log_analytics_workspace_table_custom_log -service-package-name loganalytics -properties "name" -compare-values "subscription_id:workspace_id,resource_group_name:workspace_id,workspace_name:workspace_id"
+
 var (
 	_ sdk.ResourceWithUpdate        = WorkspaceTableCustomLogResource{}
 	_ sdk.ResourceWithCustomizeDiff = WorkspaceTableCustomLogResource{}
+	_ sdk.ResourceWithIdentity      = WorkspaceTableCustomLogResource{}
 )
 
 type WorkspaceTableCustomLogResource struct{}
@@ -149,6 +153,10 @@ func (r WorkspaceTableCustomLogResource) IDValidationFunc() pluginsdk.SchemaVali
 	return tables.ValidateTableID
 }
 
+func (r WorkspaceTableCustomLogResource) Identity() resourceids.ResourceId {
+	return &tables.TableId{}
+}
+
 func (r WorkspaceTableCustomLogResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -202,7 +210,7 @@ func (r WorkspaceTableCustomLogResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -252,6 +260,10 @@ func (r WorkspaceTableCustomLogResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/tables"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -18,11 +19,14 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name log_analytics_workspace_table -service-package-name loganalytics -properties "name" -compare-values "subscription_id:workspace_id,resource_group_name:workspace_id,workspace_name:workspace_id" -test-name updateRetention
+
 type LogAnalyticsWorkspaceTableResource struct{}
 
 var (
 	_ sdk.ResourceWithUpdate        = LogAnalyticsWorkspaceTableResource{}
 	_ sdk.ResourceWithCustomizeDiff = LogAnalyticsWorkspaceTableResource{}
+	_ sdk.ResourceWithIdentity      = LogAnalyticsWorkspaceTableResource{}
 )
 
 type LogAnalyticsWorkspaceTableResourceModel struct {
@@ -103,6 +107,10 @@ func (r LogAnalyticsWorkspaceTableResource) IDValidationFunc() pluginsdk.SchemaV
 	return tables.ValidateTableID
 }
 
+func (r LogAnalyticsWorkspaceTableResource) Identity() resourceids.ResourceId {
+	return &tables.TableId{}
+}
+
 func (r LogAnalyticsWorkspaceTableResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 5 * time.Minute,
@@ -147,7 +155,7 @@ func (r LogAnalyticsWorkspaceTableResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -251,6 +259,10 @@ func (r LogAnalyticsWorkspaceTableResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/integrationaccountschemas"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/integrationaccountsessions"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/integrationserviceenvironments"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/workflowruns"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/workflows"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/workflowtriggers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
@@ -33,6 +34,7 @@ type Client struct {
 	IntegrationAccountSessionClient            *integrationaccountsessions.IntegrationAccountSessionsClient
 	IntegrationServiceEnvironmentClient        *integrationserviceenvironments.IntegrationServiceEnvironmentsClient
 	WorkflowClient                             *workflows.WorkflowsClient
+	WorkflowRunsClient                         *workflowruns.WorkflowRunsClient
 	TriggersClient                             *workflowtriggers.WorkflowTriggersClient
 }
 
@@ -103,6 +105,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		return nil, fmt.Errorf("building WorkflowClient client: %+v", err)
 	}
 
+	workflowRunsClient, err := workflowruns.NewWorkflowRunsClientWithBaseURI(o.Environment.ResourceManager)
+	o.Configure(workflowRunsClient.Client, o.Authorizers.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building WorkflowRunsClient client: %+v", err)
+	}
+
 	triggersClient, err := workflowtriggers.NewWorkflowTriggersClientWithBaseURI(o.Environment.ResourceManager)
 	o.Configure(triggersClient.Client, o.Authorizers.ResourceManager)
 	if err != nil {
@@ -121,6 +129,7 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		IntegrationAccountSessionClient:            integrationAccountSessionClient,
 		IntegrationServiceEnvironmentClient:        integrationServiceEnvironmentClient,
 		WorkflowClient:                             workflowClient,
+		WorkflowRunsClient:                         workflowRunsClient,
 		TriggersClient:                             triggersClient,
 	}, nil
 }
@@ -0,0 +1,92 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package custompollers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/workflowruns"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+)
+
+var _ pollers.PollerType = &logicAppWorkflowRunPoller{}
+
+type logicAppWorkflowRunPoller struct {
+	client *workflowruns.WorkflowRunsClient
+	id     workflowruns.RunId
+}
+
+var (
+	pollingSuccess = pollers.PollResult{
+		Status: pollers.PollingStatusSucceeded,
+	}
+
+	pollingInProgress = pollers.PollResult{
+		Status:       pollers.PollingStatusInProgress,
+		PollInterval: 10 * time.Second,
+	}
+
+	pollingFailed = pollers.PollResult{
+		Status: pollers.PollingStatusFailed,
+	}
+
+	workflowStatusToResult = map[workflowruns.WorkflowStatus]pollers.PollResult{
+		workflowruns.WorkflowStatusSkipped:   pollingSuccess,
+		workflowruns.WorkflowStatusSucceeded: pollingSuccess,
+
+		workflowruns.WorkflowStatusNotSpecified: pollingInProgress,
+		workflowruns.WorkflowStatusPaused:       pollingInProgress,
+		workflowruns.WorkflowStatusRunning:      pollingInProgress,
+		workflowruns.WorkflowStatusWaiting:      pollingInProgress,
+
+		workflowruns.WorkflowStatusAborted:   pollingFailed,
+		workflowruns.WorkflowStatusCancelled: pollingFailed,
+		workflowruns.WorkflowStatusFailed:    pollingFailed,
+		workflowruns.WorkflowStatusFaulted:   pollingFailed,
+		workflowruns.WorkflowStatusIgnored:   pollingFailed,
+		workflowruns.WorkflowStatusSuspended: pollingFailed,
+		workflowruns.WorkflowStatusTimedOut:  pollingFailed,
+	}
+)
+
+func NewLogicAppWorkflowRunPoller(client *workflowruns.WorkflowRunsClient, id workflowruns.RunId) *logicAppWorkflowRunPoller {
+	return &logicAppWorkflowRunPoller{
+		client: client,
+		id:     id,
+	}
+}
+
+func (p logicAppWorkflowRunPoller) Poll(ctx context.Context) (*pollers.PollResult, error) {
+	resp, err := p.client.Get(ctx, p.id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", p.id, err)
+	}
+
+	if resp.Model == nil || resp.Model.Properties == nil {
+		return nil, fmt.Errorf("polling for %s: `properties` was nil", p.id)
+	}
+	props := resp.Model.Properties
+
+	status := pointer.From(props.Status)
+	result, ok := workflowStatusToResult[status]
+	if !ok {
+		return nil, fmt.Errorf("polling for %s: unexpected status %q", p.id, status)
+	}
+
+	if result.Status == pollers.PollingStatusFailed {
+		message := fmt.Sprintf("workflow run finished with status %q", status)
+		if v := pointer.From(props.Code); v != "" {
+			message = fmt.Sprintf("%s (code %q)", message, v)
+		}
+
+		return nil, pollers.PollingFailedError{
+			Message: message,
+		}
+	}
+
+	return &result, nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/integrationaccountagreements"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/logic/validate"
@@ -20,6 +21,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name logic_app_integration_account_agreement -service-package-name logic -properties "name,resource_group_name,integration_account_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 func resourceLogicAppIntegrationAccountAgreement() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceLogicAppIntegrationAccountAgreementCreateUpdate,
@@ -34,10 +37,11 @@ func resourceLogicAppIntegrationAccountAgreement() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := integrationaccountagreements.ParseAgreementID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&integrationaccountagreements.AgreementId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&integrationaccountagreements.AgreementId{}),
+		},
 
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
@@ -185,6 +189,10 @@ func resourceLogicAppIntegrationAccountAgreementCreateUpdate(d *pluginsdk.Resour
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
+
 	return resourceLogicAppIntegrationAccountAgreementRead(d, meta)
 }
 
@@ -238,7 +246,7 @@ func resourceLogicAppIntegrationAccountAgreementRead(d *pluginsdk.ResourceData,
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceLogicAppIntegrationAccountAgreementDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/integrationaccountassemblies"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/logic/validate"
@@ -20,6 +21,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name logic_app_integration_account_assembly -service-package-name logic -properties "name,resource_group_name,integration_account_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 func resourceLogicAppIntegrationAccountAssembly() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceLogicAppIntegrationAccountAssemblyCreateUpdate,
@@ -34,10 +37,11 @@ func resourceLogicAppIntegrationAccountAssembly() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := integrationaccountassemblies.ParseAssemblyID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&integrationaccountassemblies.AssemblyId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&integrationaccountassemblies.AssemblyId{}),
+		},
 
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
@@ -142,6 +146,10 @@ func resourceLogicAppIntegrationAccountAssemblyCreateUpdate(d *pluginsdk.Resourc
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
+
 	return resourceLogicAppIntegrationAccountAssemblyRead(d, meta)
 }
 
@@ -181,7 +189,7 @@ func resourceLogicAppIntegrationAccountAssemblyRead(d *pluginsdk.ResourceData, m
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceLogicAppIntegrationAccountAssemblyDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -0,0 +1,41 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package logic_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccLogicAppIntegrationAccountBatchConfiguration_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_logic_app_integration_account_batch_configuration", "test")
+	r := LogicAppIntegrationAccountBatchConfigurationResource{}
+
+	checkedFields := map[string]struct{}{
+		"subscription_id":          {},
+		"integration_account_name": {},
+		"name":                     {},
+		"resource_group_name":      {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_logic_app_integration_account_batch_configuration.test", checkedFields),
+				statecheck.ExpectIdentityValue("azurerm_logic_app_integration_account_batch_configuration.test", tfjsonpath.New("subscription_id"), knownvalue.StringExact(data.Subscriptions.Primary)),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_logic_app_integration_account_batch_configuration.test", tfjsonpath.New("integration_account_name"), tfjsonpath.New("integration_account_name")),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_logic_app_integration_account_batch_configuration.test", tfjsonpath.New("name"), tfjsonpath.New("name")),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_logic_app_integration_account_batch_configuration.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("resource_group_name")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
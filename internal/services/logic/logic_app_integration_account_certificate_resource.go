@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/keyvault"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/integrationaccountcertificates"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/logic/validate"
@@ -22,6 +23,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name logic_app_integration_account_certificate -service-package-name logic -properties "name,resource_group_name,integration_account_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 func resourceLogicAppIntegrationAccountCertificate() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceLogicAppIntegrationAccountCertificateCreateUpdate,
@@ -36,10 +39,11 @@ func resourceLogicAppIntegrationAccountCertificate() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := integrationaccountcertificates.ParseCertificateID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&integrationaccountcertificates.CertificateId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&integrationaccountcertificates.CertificateId{}),
+		},
 
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
@@ -140,6 +144,10 @@ func resourceLogicAppIntegrationAccountCertificateCreateUpdate(d *pluginsdk.Reso
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
+
 	return resourceLogicAppIntegrationAccountCertificateRead(d, meta)
 }
 
@@ -180,7 +188,7 @@ func resourceLogicAppIntegrationAccountCertificateRead(d *pluginsdk.ResourceData
 		d.Set("public_certificate", props.PublicCertificate)
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceLogicAppIntegrationAccountCertificateDelete(d *pluginsdk.ResourceData, meta interface{}) error {
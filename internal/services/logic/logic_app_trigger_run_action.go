@@ -0,0 +1,156 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package logic
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/workflowruns"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/logic/2019-05-01/workflowtriggers"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/logic/custompollers"
+)
+
+// the ID of the Workflow Run started by a Trigger is returned in this header
+const logicAppWorkflowRunIdHeader = "x-ms-workflow-run-id"
+
+type LogicAppTriggerRunAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &LogicAppTriggerRunAction{}
+
+func newLogicAppTriggerRunAction() action.Action {
+	return &LogicAppTriggerRunAction{}
+}
+
+type LogicAppTriggerRunActionModel struct {
+	TriggerId         types.String `tfsdk:"logic_app_trigger_id"`
+	WaitForCompletion types.Bool   `tfsdk:"wait_for_completion"`
+	Timeout           types.String `tfsdk:"timeout"`
+}
+
+func (a *LogicAppTriggerRunAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"logic_app_trigger_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Logic App Workflow Trigger to run.",
+				MarkdownDescription: "The ID of the Logic App Workflow Trigger to run.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: workflowtriggers.ValidateTriggerID,
+					},
+				},
+			},
+
+			"wait_for_completion": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Whether to poll the Workflow Run started by the Trigger for completion. Defaults to `false`.",
+				MarkdownDescription: "Whether to poll the Workflow Run started by the Trigger for completion. Defaults to `false`.",
+			},
+
+			"timeout": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Timeout duration for the action to complete. Defaults to `15m`.",
+				MarkdownDescription: "Timeout duration for the action to complete. Defaults to `15m`.",
+			},
+		},
+	}
+}
+
+func (a *LogicAppTriggerRunAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_logic_app_trigger_run"
+}
+
+func (a *LogicAppTriggerRunAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := a.Client.Logic.TriggersClient
+	runsClient := a.Client.Logic.WorkflowRunsClient
+
+	model := LogicAppTriggerRunActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	ctxTimeout := 15 * time.Minute
+	if t := model.Timeout; !t.IsNull() {
+		duration, err := time.ParseDuration(t.ValueString())
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `timeout`", err)
+			return
+		}
+
+		ctxTimeout = duration
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ctxTimeout)
+	defer cancel()
+
+	id, err := workflowtriggers.ParseTriggerID(model.TriggerId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	resp, err := client.Run(ctx, *id)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("running %s: %+v", id, err))
+		return
+	}
+
+	runName := ""
+	if resp.HttpResponse != nil {
+		runName = resp.HttpResponse.Header.Get(logicAppWorkflowRunIdHeader)
+	}
+
+	if runName == "" {
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("triggered %s on workflow %s", id.TriggerName, id.WorkflowName),
+		})
+	} else {
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("triggered %s on workflow %s, started run %s", id.TriggerName, id.WorkflowName, runName),
+		})
+	}
+
+	if !model.WaitForCompletion.ValueBool() {
+		return
+	}
+
+	if runName == "" {
+		sdk.SetResponseErrorDiagnostic(response, "waiting for completion", fmt.Sprintf("the `%s` header was not returned when running %s", logicAppWorkflowRunIdHeader, id))
+		return
+	}
+
+	runId := workflowruns.NewRunID(id.SubscriptionId, id.ResourceGroupName, id.WorkflowName, runName)
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("waiting for completion of run %s on workflow %s", runName, id.WorkflowName),
+	})
+
+	runPoller := custompollers.NewLogicAppWorkflowRunPoller(runsClient, runId)
+	poller := pollers.NewPoller(runPoller, 10*time.Second, pollers.DefaultNumberOfDroppedConnectionsToAllow)
+	if err := poller.PollUntilDone(ctx); err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "waiting for completion", fmt.Sprintf("waiting for %s: %+v", runId, err))
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("run %s on workflow %s completed", runName, id.WorkflowName),
+	})
+}
+
+func (a *LogicAppTriggerRunAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	a.Defaults(ctx, request, response)
+}
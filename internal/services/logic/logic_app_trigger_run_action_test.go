@@ -0,0 +1,95 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package logic_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type LogicAppTriggerRunAction struct{}
+
+func TestAccLogicAppTriggerRunAction_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_logic_app_trigger_run", "test")
+	a := LogicAppTriggerRunAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.basic(data),
+				Check:  nil, // TODO - plugin-testing release?
+			},
+		},
+	})
+}
+
+func TestAccLogicAppTriggerRunAction_waitForCompletion(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_logic_app_trigger_run", "test")
+	a := LogicAppTriggerRunAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.waitForCompletion(data),
+				Check:  nil, // TODO - plugin-testing release?
+			},
+		},
+	})
+}
+
+func (a *LogicAppTriggerRunAction) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+action "azurerm_logic_app_trigger_run" "test" {
+  config {
+    logic_app_trigger_id = azurerm_logic_app_trigger_recurrence.test.id
+  }
+}
+`, a.template(data))
+}
+
+func (a *LogicAppTriggerRunAction) waitForCompletion(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+action "azurerm_logic_app_trigger_run" "test" {
+  config {
+    logic_app_trigger_id = azurerm_logic_app_trigger_recurrence.test.id
+    wait_for_completion  = true
+    timeout              = "10m"
+  }
+}
+`, a.template(data))
+}
+
+func (a *LogicAppTriggerRunAction) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "terraform_data" "trigger" {
+  input = azurerm_logic_app_trigger_recurrence.test.id
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_logic_app_trigger_run.test]
+    }
+  }
+}
+`, LogicAppTriggerRecurrenceResource{}.basic(data, "Day", 1))
+}
@@ -0,0 +1,38 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package machinelearning_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccAiFoundryProject_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_ai_foundry_project", "test")
+	r := AIFoundryProject{}
+
+	checkedFields := map[string]struct{}{
+		"name":                {},
+		"resource_group_name": {},
+		"subscription_id":     {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_ai_foundry_project.test", checkedFields),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_ai_foundry_project.test", tfjsonpath.New("name"), tfjsonpath.New("name")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_ai_foundry_project.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("ai_services_hub_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_ai_foundry_project.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("ai_services_hub_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/keyvault"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	components "github.com/hashicorp/go-azure-sdk/resource-manager/applicationinsights/2020-02-02/componentsapis"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2025-11-01/registries"
@@ -29,6 +30,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name ai_foundry -service-package-name machinelearning -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type AIFoundry
+
 type AIFoundry struct{}
 
 type AIFoundryModel struct {
@@ -74,6 +77,10 @@ func (r AIFoundry) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return workspaces.ValidateWorkspaceID
 }
 
+func (r AIFoundry) Identity() resourceids.ResourceId {
+	return &workspaces.WorkspaceId{}
+}
+
 func (r AIFoundry) CustomImporter() sdk.ResourceRunFunc {
 	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
 		id, err := workspaces.ParseWorkspaceID(metadata.ResourceData.Id())
@@ -98,6 +105,7 @@ func (r AIFoundry) CustomImporter() sdk.ResourceRunFunc {
 var _ sdk.ResourceWithUpdate = AIFoundry{}
 
 var _ sdk.ResourceWithCustomImporter = AIFoundry{}
+var _ sdk.ResourceWithIdentity = AIFoundry{}
 
 func (r AIFoundry) Arguments() map[string]*pluginsdk.Schema {
 	args := map[string]*pluginsdk.Schema{
@@ -340,7 +348,7 @@ func (r AIFoundry) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -525,6 +533,10 @@ func (r AIFoundry) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&hub)
 		},
 	}
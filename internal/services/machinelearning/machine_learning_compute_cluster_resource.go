@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/machinelearningcomputes"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/workspaces"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/validate"
@@ -22,6 +23,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name machine_learning_compute_cluster -service-package-name machinelearning -properties "name" -compare-values "subscription_id:machine_learning_workspace_id,resource_group_name:machine_learning_workspace_id,workspace_name:machine_learning_workspace_id" -test-resource-type ComputeClusterResource
+
 func resourceComputeCluster() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceComputeClusterCreate,
@@ -29,10 +32,11 @@ func resourceComputeCluster() *pluginsdk.Resource {
 		Update: resourceComputeClusterUpdate,
 		Delete: resourceComputeClusterDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := machinelearningcomputes.ParseComputeID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&machinelearningcomputes.ComputeId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&machinelearningcomputes.ComputeId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -258,6 +262,9 @@ func resourceComputeClusterCreate(d *pluginsdk.ResourceData, meta interface{}) e
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 
 	return resourceComputeClusterRead(d, meta)
 }
@@ -329,7 +336,11 @@ func resourceComputeClusterRead(d *pluginsdk.ResourceData, meta interface{}) err
 		return fmt.Errorf("setting `identity`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, computeResource.Model.Tags)
+	if err := tags.FlattenAndSet(d, computeResource.Model.Tags); err != nil {
+		return err
+	}
+
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceComputeClusterUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/datastore"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/workspaces"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -23,6 +24,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name machine_learning_datastore_blobstorage -service-package-name machinelearning -properties "name" -compare-values "subscription_id:workspace_id,resource_group_name:workspace_id,workspace_name:workspace_id" -test-name blobStorageAccountKey -test-resource-type MachineLearningDataStoreBlobStorage
+
 type MachineLearningDataStoreBlobStorage struct{}
 
 type MachineLearningDataStoreBlobStorageModel struct {
@@ -53,9 +56,14 @@ func (r MachineLearningDataStoreBlobStorage) IDValidationFunc() pluginsdk.Schema
 	return datastore.ValidateDataStoreID
 }
 
+func (r MachineLearningDataStoreBlobStorage) Identity() resourceids.ResourceId {
+	return &datastore.DataStoreId{}
+}
+
 var (
 	_ sdk.ResourceWithUpdate        = MachineLearningDataStoreBlobStorage{}
 	_ sdk.ResourceWithCustomizeDiff = MachineLearningDataStoreBlobStorage{}
+	_ sdk.ResourceWithIdentity      = MachineLearningDataStoreBlobStorage{}
 )
 
 func (r MachineLearningDataStoreBlobStorage) Arguments() map[string]*pluginsdk.Schema {
@@ -226,7 +234,7 @@ func (r MachineLearningDataStoreBlobStorage) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -387,6 +395,10 @@ func (r MachineLearningDataStoreBlobStorage) Read() sdk.ResourceFunc {
 				model.Tags = *data.Tags
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&model)
 		},
 	}
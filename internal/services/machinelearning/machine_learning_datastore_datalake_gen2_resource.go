@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/datastore"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/workspaces"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -24,6 +25,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name machine_learning_datastore_datalake_gen2 -service-package-name machinelearning -properties "name" -compare-values "subscription_id:workspace_id,resource_group_name:workspace_id,workspace_name:workspace_id" -test-name dataLakeGen2Basic -test-resource-type MachineLearningDataStoreDataLakeGen2
+
 type MachineLearningDataStoreDataLakeGen2 struct{}
 
 type MachineLearningDataStoreDataLakeGen2Model struct {
@@ -61,7 +64,12 @@ func (r MachineLearningDataStoreDataLakeGen2) IDValidationFunc() pluginsdk.Schem
 	return datastore.ValidateDataStoreID
 }
 
+func (r MachineLearningDataStoreDataLakeGen2) Identity() resourceids.ResourceId {
+	return &datastore.DataStoreId{}
+}
+
 var _ sdk.ResourceWithUpdate = MachineLearningDataStoreDataLakeGen2{}
+var _ sdk.ResourceWithIdentity = MachineLearningDataStoreDataLakeGen2{}
 
 func (r MachineLearningDataStoreDataLakeGen2) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
@@ -211,7 +219,7 @@ func (r MachineLearningDataStoreDataLakeGen2) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -363,6 +371,10 @@ func (r MachineLearningDataStoreDataLakeGen2) Read() sdk.ResourceFunc {
 				model.Tags = *data.Tags
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&model)
 		},
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/datastore"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/workspaces"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -24,6 +25,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name machine_learning_datastore_fileshare -service-package-name machinelearning -properties "name" -compare-values "subscription_id:workspace_id,resource_group_name:workspace_id,workspace_name:workspace_id" -test-name fileShareAccountKey -test-resource-type MachineLearningDataStoreFileShare
+
 type MachineLearningDataStoreFileShare struct{}
 
 type MachineLearningDataStoreFileShareModel struct {
@@ -50,7 +53,12 @@ func (r MachineLearningDataStoreFileShare) IDValidationFunc() pluginsdk.SchemaVa
 	return datastore.ValidateDataStoreID
 }
 
+func (r MachineLearningDataStoreFileShare) Identity() resourceids.ResourceId {
+	return &datastore.DataStoreId{}
+}
+
 var _ sdk.ResourceWithUpdate = MachineLearningDataStoreFileShare{}
+var _ sdk.ResourceWithIdentity = MachineLearningDataStoreFileShare{}
 
 func (r MachineLearningDataStoreFileShare) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
@@ -194,7 +202,7 @@ func (r MachineLearningDataStoreFileShare) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -343,6 +351,10 @@ func (r MachineLearningDataStoreFileShare) Read() sdk.ResourceFunc {
 				model.Tags = *data.Tags
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&model)
 		},
 	}
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2025-10-01/managedclusters"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/machinelearningcomputes"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/workspaces"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -24,16 +25,19 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name machine_learning_inference_cluster -service-package-name machinelearning -properties "name" -compare-values "subscription_id:machine_learning_workspace_id,resource_group_name:machine_learning_workspace_id,workspace_name:machine_learning_workspace_id" -test-resource-type InferenceClusterResource
+
 func resourceAksInferenceCluster() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceAksInferenceClusterCreate,
 		Read:   resourceAksInferenceClusterRead,
 		Delete: resourceAksInferenceClusterDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := machinelearningcomputes.ParseComputeID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&machinelearningcomputes.ComputeId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&machinelearningcomputes.ComputeId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -199,6 +203,9 @@ func resourceAksInferenceClusterCreate(d *pluginsdk.ResourceData, meta interface
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	id := machinelearningcomputes.NewComputeID(subscriptionId, workspaceID.ResourceGroupName, workspaceID.WorkspaceName, name)
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 
 	return resourceAksInferenceClusterRead(d, meta)
 }
@@ -260,7 +267,11 @@ func resourceAksInferenceClusterRead(d *pluginsdk.ResourceData, meta interface{}
 		return fmt.Errorf("setting `identity`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, computeResource.Model.Tags)
+	if err := tags.FlattenAndSet(d, computeResource.Model.Tags); err != nil {
+		return err
+	}
+
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceAksInferenceClusterDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package machinelearning_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccMachineLearningInferenceCluster_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_inference_cluster", "test")
+	r := InferenceClusterResource{}
+
+	checkedFields := map[string]struct{}{
+		"name":                {},
+		"resource_group_name": {},
+		"subscription_id":     {},
+		"workspace_name":      {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_machine_learning_inference_cluster.test", checkedFields),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_machine_learning_inference_cluster.test", tfjsonpath.New("name"), tfjsonpath.New("name")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_machine_learning_inference_cluster.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("machine_learning_workspace_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_machine_learning_inference_cluster.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("machine_learning_workspace_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_machine_learning_inference_cluster.test", tfjsonpath.New("workspace_name"), tfjsonpath.New("machine_learning_workspace_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/machinelearningcomputes"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/workspaces"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	synapseValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/synapse/validate"
@@ -24,16 +25,19 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name machine_learning_synapse_spark -service-package-name machinelearning -properties "name" -compare-values "subscription_id:machine_learning_workspace_id,resource_group_name:machine_learning_workspace_id,workspace_name:machine_learning_workspace_id" -test-resource-type SynapseSparkResource
+
 func resourceSynapseSpark() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceSynapseSparkCreate,
 		Read:   resourceSynapseSparkRead,
 		Delete: resourceSynapseSparkDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := machinelearningcomputes.ParseComputeID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&machinelearningcomputes.ComputeId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&machinelearningcomputes.ComputeId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -135,6 +139,9 @@ func resourceSynapseSparkCreate(d *pluginsdk.ResourceData, meta interface{}) err
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 
 	return resourceSynapseSparkRead(d, meta)
 }
@@ -183,7 +190,11 @@ func resourceSynapseSparkRead(d *pluginsdk.ResourceData, meta interface{}) error
 	d.Set("description", props.Description)
 	d.Set("synapse_spark_pool_id", props.ResourceId)
 
-	return tags.FlattenAndSet(d, resp.Model.Tags)
+	if err := tags.FlattenAndSet(d, resp.Model.Tags); err != nil {
+		return err
+	}
+
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceSynapseSparkDelete(d *pluginsdk.ResourceData, meta interface{}) error {
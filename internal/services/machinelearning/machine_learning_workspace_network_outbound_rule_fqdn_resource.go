@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/managednetwork"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/workspaces"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
@@ -18,6 +19,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name machine_learning_workspace_network_outbound_rule_fqdn -service-package-name machinelearning -properties "name" -compare-values "subscription_id:workspace_id,resource_group_name:workspace_id,workspace_name:workspace_id" -test-resource-type WorkspaceNetworkOutboundRuleFqdnResource
+
 var _ sdk.ResourceWithUpdate = WorkspaceNetworkOutboundRuleFqdn{}
 
 type machineLearningWorkspaceOutboundRuleFqdnModel struct {
@@ -29,6 +32,7 @@ type machineLearningWorkspaceOutboundRuleFqdnModel struct {
 type WorkspaceNetworkOutboundRuleFqdn struct{}
 
 var _ sdk.Resource = WorkspaceNetworkOutboundRuleFqdn{}
+var _ sdk.ResourceWithIdentity = WorkspaceNetworkOutboundRuleFqdn{}
 
 func (r WorkspaceNetworkOutboundRuleFqdn) ResourceType() string {
 	return "azurerm_machine_learning_workspace_network_outbound_rule_fqdn"
@@ -42,6 +46,10 @@ func (r WorkspaceNetworkOutboundRuleFqdn) IDValidationFunc() pluginsdk.SchemaVal
 	return managednetwork.ValidateOutboundRuleID
 }
 
+func (r WorkspaceNetworkOutboundRuleFqdn) Identity() resourceids.ResourceId {
+	return &managednetwork.OutboundRuleId{}
+}
+
 func (r WorkspaceNetworkOutboundRuleFqdn) Arguments() map[string]*pluginsdk.Schema {
 	arguments := map[string]*pluginsdk.Schema{
 		"name": {
@@ -112,7 +120,7 @@ func (r WorkspaceNetworkOutboundRuleFqdn) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -200,6 +208,10 @@ func (r WorkspaceNetworkOutboundRuleFqdn) Read() sdk.ResourceFunc {
 
 			state.WorkspaceId = workspaces.NewWorkspaceID(metadata.Client.Account.SubscriptionId, id.ResourceGroupName, id.WorkspaceName).ID()
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -19,6 +19,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name machine_learning_workspace_network_outbound_rule_private_endpoint -service-package-name machinelearning -properties "name" -compare-values "subscription_id:workspace_id,resource_group_name:workspace_id,workspace_name:workspace_id" -test-name onlyApprovedOutbound -test-resource-type WorkspaceNetworkOutboundPrivateEndpointResource
+
 var resourceTypeSupportSubResType = map[string][]string{
 	"Microsoft.KeyVault":                {"vault"},
 	"Microsoft.Cache":                   {"redisCache"},
@@ -37,6 +39,7 @@ type machineLearningWorkspaceOutboundRulePrivateEndpointModel struct {
 type WorkspaceNetworkOutboundRulePrivateEndpoint struct{}
 
 var _ sdk.Resource = WorkspaceNetworkOutboundRulePrivateEndpoint{}
+var _ sdk.ResourceWithIdentity = WorkspaceNetworkOutboundRulePrivateEndpoint{}
 
 func (r WorkspaceNetworkOutboundRulePrivateEndpoint) ResourceType() string {
 	return "azurerm_machine_learning_workspace_network_outbound_rule_private_endpoint"
@@ -50,6 +53,10 @@ func (r WorkspaceNetworkOutboundRulePrivateEndpoint) IDValidationFunc() pluginsd
 	return managednetwork.ValidateOutboundRuleID
 }
 
+func (r WorkspaceNetworkOutboundRulePrivateEndpoint) Identity() resourceids.ResourceId {
+	return &managednetwork.OutboundRuleId{}
+}
+
 func (r WorkspaceNetworkOutboundRulePrivateEndpoint) Arguments() map[string]*pluginsdk.Schema {
 	arguments := map[string]*pluginsdk.Schema{
 		"name": {
@@ -170,7 +177,7 @@ func (r WorkspaceNetworkOutboundRulePrivateEndpoint) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -209,6 +216,10 @@ func (r WorkspaceNetworkOutboundRulePrivateEndpoint) Read() sdk.ResourceFunc {
 					}
 				}
 			}
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -28,6 +28,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name maintenance_configuration -service-package-name maintenance -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 func resourceMaintenanceConfiguration() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceMaintenanceConfigurationCreate,
@@ -47,10 +49,14 @@ func resourceMaintenanceConfiguration() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+		Importer: pluginsdk.ImporterValidatingResourceIdOrIdentity(func(id string) error {
 			_, err := maintenanceconfigurations.ParseMaintenanceConfigurationIDInsensitively(id)
 			return err
-		}),
+		}, pluginsdk.IdentityImporter(&maintenanceconfigurations.MaintenanceConfigurationId{})),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&maintenanceconfigurations.MaintenanceConfigurationId{}),
+		},
 
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
@@ -297,6 +303,10 @@ func resourceMaintenanceConfigurationCreate(d *pluginsdk.ResourceData, meta inte
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
+
 	return resourceMaintenanceConfigurationRead(d, meta)
 }
 
@@ -408,7 +418,7 @@ func resourceMaintenanceConfigurationRead(d *pluginsdk.ResourceData, meta interf
 			return err
 		}
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceMaintenanceConfigurationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/keyvault"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/devcenter/2025-02-01/projects"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/devopsinfrastructure/2025-09-20/pools"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
@@ -25,9 +26,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name managed_devops_pool -service-package-name manageddevopspools -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type ManagedDevOpsPoolResource
+
 var (
 	_ sdk.ResourceWithUpdate        = ManagedDevOpsPoolResource{}
 	_ sdk.ResourceWithCustomizeDiff = ManagedDevOpsPoolResource{}
+	_ sdk.ResourceWithIdentity      = ManagedDevOpsPoolResource{}
 )
 
 type ManagedDevOpsPoolResource struct{}
@@ -454,7 +458,7 @@ func (r ManagedDevOpsPoolResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -616,6 +620,10 @@ func (ManagedDevOpsPoolResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -645,6 +653,10 @@ func (ManagedDevOpsPoolResource) IDValidationFunc() pluginsdk.SchemaValidateFunc
 	return pools.ValidatePoolID
 }
 
+func (ManagedDevOpsPoolResource) Identity() resourceids.ResourceId {
+	return &pools.PoolId{}
+}
+
 func (ManagedDevOpsPoolResource) CustomizeDiff() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
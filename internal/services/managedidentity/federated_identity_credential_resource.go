@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/managedidentity/2024-11-30/federatedidentitycredentials"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
@@ -18,7 +19,10 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name federated_identity_credential -service-package-name managedidentity -properties "name" -compare-values "subscription_id:user_assigned_identity_id,resource_group_name:user_assigned_identity_id,user_assigned_identity_name:user_assigned_identity_id" -test-resource-type FederatedIdentityCredentialTestResource
+
 var _ sdk.Resource = FederatedIdentityCredentialResource{}
+var _ sdk.ResourceWithIdentity = FederatedIdentityCredentialResource{}
 
 type FederatedIdentityCredentialResource struct{}
 
@@ -43,6 +47,10 @@ func (r FederatedIdentityCredentialResource) IDValidationFunc() pluginsdk.Schema
 	return federatedidentitycredentials.ValidateFederatedIdentityCredentialID
 }
 
+func (r FederatedIdentityCredentialResource) Identity() resourceids.ResourceId {
+	return &federatedidentitycredentials.FederatedIdentityCredentialId{}
+}
+
 func (r FederatedIdentityCredentialResource) ResourceType() string {
 	return "azurerm_federated_identity_credential"
 }
@@ -157,7 +165,7 @@ func (r FederatedIdentityCredentialResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -195,6 +203,10 @@ func (r FederatedIdentityCredentialResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&schema)
 		},
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/managedidentity/2024-11-30/identities"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -21,9 +22,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name user_assigned_identity -service-package-name managedidentity -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type UserAssignedIdentityTestResource
+
 var (
 	_ sdk.Resource                   = UserAssignedIdentityResource{}
 	_ sdk.ResourceWithStateMigration = UserAssignedIdentityResource{}
+	_ sdk.ResourceWithIdentity       = UserAssignedIdentityResource{}
 )
 
 type UserAssignedIdentityResource struct{}
@@ -56,6 +60,10 @@ func (r UserAssignedIdentityResource) IDValidationFunc() pluginsdk.SchemaValidat
 	return commonids.ValidateUserAssignedIdentityID
 }
 
+func (r UserAssignedIdentityResource) Identity() resourceids.ResourceId {
+	return &commonids.UserAssignedIdentityId{}
+}
+
 func (r UserAssignedIdentityResource) ResourceType() string {
 	return "azurerm_user_assigned_identity"
 }
@@ -142,7 +150,7 @@ func (r UserAssignedIdentityResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -184,6 +192,10 @@ func (r UserAssignedIdentityResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&schema)
 		},
 	}
@@ -21,6 +21,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name managed_redis_geo_replication -service-package-name managedredis -compare-values "subscription_id:managed_redis_id,resource_group_name:managed_redis_id,name:managed_redis_id"
+
 // Geo-replication / linked databases are managed as a separate resource because when dbs are linked, ARM
 // will mutate the state of all dbs out of bound, causing unexpected plan diff.
 //
@@ -33,6 +35,7 @@ type ManagedRedisGeoReplicationResource struct{}
 var (
 	_ sdk.ResourceWithCustomizeDiff = ManagedRedisGeoReplicationResource{}
 	_ sdk.ResourceWithUpdate        = ManagedRedisGeoReplicationResource{}
+	_ sdk.ResourceWithIdentity      = ManagedRedisGeoReplicationResource{}
 )
 
 type ManagedRedisGeoReplicationResourceModel struct {
@@ -78,6 +81,10 @@ func (r ManagedRedisGeoReplicationResource) IDValidationFunc() pluginsdk.SchemaV
 	return redisenterprise.ValidateRedisEnterpriseID
 }
 
+func (r ManagedRedisGeoReplicationResource) Identity() resourceids.ResourceId {
+	return &redisenterprise.RedisEnterpriseId{}
+}
+
 func (r ManagedRedisGeoReplicationResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -99,7 +106,7 @@ func (r ManagedRedisGeoReplicationResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, id)
 		},
 	}
 }
@@ -146,6 +153,10 @@ func (r ManagedRedisGeoReplicationResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, clusterId); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
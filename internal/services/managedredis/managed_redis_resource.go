@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/keyvault"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/redisenterprise/2025-07-01/databases"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/redisenterprise/2025-07-01/redisenterprise"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
@@ -29,6 +30,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name managed_redis -service-package-name managedredis -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 // Azure Managed Redis (AMR) consists of two ARM resource types: cluster and database. Cluster is where compute, load
 // balancer, network and other infrastructure is setup. Database refers to the Redis instance / process itself. Database
 // is a child of cluster with 1-1 mapping.
@@ -42,6 +45,7 @@ import (
 type ManagedRedisResource struct{}
 
 var _ sdk.ResourceWithUpdate = ManagedRedisResource{}
+var _ sdk.ResourceWithIdentity = ManagedRedisResource{}
 
 type ManagedRedisResourceModel struct {
 	Name              string `tfschema:"name"`
@@ -292,6 +296,10 @@ func (r ManagedRedisResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return redisenterprise.ValidateRedisEnterpriseID
 }
 
+func (r ManagedRedisResource) Identity() resourceids.ResourceId {
+	return &redisenterprise.RedisEnterpriseId{}
+}
+
 func (r ManagedRedisResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 45 * time.Minute,
@@ -345,6 +353,9 @@ func (r ManagedRedisResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(clusterId)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &clusterId); err != nil {
+				return err
+			}
 
 			pollerType := custompollers.NewClusterStatePoller(clusterClient, clusterId)
 			poller := pollers.NewPoller(pollerType, 15*time.Second, pollers.DefaultNumberOfDroppedConnectionsToAllow)
@@ -456,6 +467,10 @@ func (r ManagedRedisResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, clusterId); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -11,15 +11,19 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mongocluster/2025-09-01/firewallrules"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name mongo_cluster_firewall_rule -service-package-name mongocluster -properties "name" -compare-values "subscription_id:mongo_cluster_id,resource_group_name:mongo_cluster_id,mongo_cluster_name:mongo_cluster_id"
+
 type MongoClusterFirewallRuleResource struct{}
 
 var _ sdk.ResourceWithUpdate = MongoClusterFirewallRuleResource{}
+var _ sdk.ResourceWithIdentity = MongoClusterFirewallRuleResource{}
 
 type MongoClusterFirewallRuleResourceModel struct {
 	MongoClusterId string `tfschema:"mongo_cluster_id"`
@@ -36,6 +40,10 @@ func (r MongoClusterFirewallRuleResource) IDValidationFunc() pluginsdk.SchemaVal
 	return firewallrules.ValidateFirewallRuleID
 }
 
+func (r MongoClusterFirewallRuleResource) Identity() resourceids.ResourceId {
+	return &firewallrules.FirewallRuleId{}
+}
+
 func (r MongoClusterFirewallRuleResource) ResourceType() string {
 	return "azurerm_mongo_cluster_firewall_rule"
 }
@@ -112,6 +120,9 @@ func (r MongoClusterFirewallRuleResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			return nil
 		},
@@ -149,6 +160,10 @@ func (r MongoClusterFirewallRuleResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package mongocluster_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccMongoClusterFirewallRule_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mongo_cluster_firewall_rule", "test")
+	r := MongoClusterFirewallRuleResource{}
+
+	checkedFields := map[string]struct{}{
+		"name":                {},
+		"mongo_cluster_name":  {},
+		"resource_group_name": {},
+		"subscription_id":     {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_mongo_cluster_firewall_rule.test", checkedFields),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_mongo_cluster_firewall_rule.test", tfjsonpath.New("name"), tfjsonpath.New("name")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_mongo_cluster_firewall_rule.test", tfjsonpath.New("mongo_cluster_name"), tfjsonpath.New("mongo_cluster_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_mongo_cluster_firewall_rule.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("mongo_cluster_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_mongo_cluster_firewall_rule.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("mongo_cluster_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/keyvault"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mongocluster/2025-09-01/mongoclusters"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -24,11 +25,14 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name mongo_cluster -service-package-name mongocluster -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 type MongoClusterResource struct{}
 
 var _ sdk.ResourceWithUpdate = MongoClusterResource{}
 
 var _ sdk.ResourceWithCustomizeDiff = MongoClusterResource{}
+var _ sdk.ResourceWithIdentity = MongoClusterResource{}
 
 type MongoClusterResourceModel struct {
 	Name                  string                         `tfschema:"name"`
@@ -80,6 +84,10 @@ func (r MongoClusterResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return mongoclusters.ValidateMongoClusterID
 }
 
+func (r MongoClusterResource) Identity() resourceids.ResourceId {
+	return &mongoclusters.MongoClusterId{}
+}
+
 func (r MongoClusterResource) ResourceType() string {
 	return "azurerm_mongo_cluster"
 }
@@ -455,6 +463,9 @@ func (r MongoClusterResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			return nil
 		},
@@ -688,6 +699,10 @@ func (r MongoClusterResource) Read() sdk.ResourceFunc {
 				state.ConnectionStrings = flattenMongoClusterConnectionStrings(model.ConnectionStrings, state.AdministratorUserName, state.AdministratorPassword)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mongocluster/2025-09-01/mongoclusters"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mongocluster/2025-09-01/users"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -17,9 +18,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name mongo_cluster_user -service-package-name mongocluster -properties "name:object_id" -compare-values "subscription_id:mongo_cluster_id,resource_group_name:mongo_cluster_id,mongo_cluster_name:mongo_cluster_id"
+
 type MongoClusterUserResource struct{}
 
 var _ sdk.Resource = MongoClusterUserResource{}
+var _ sdk.ResourceWithIdentity = MongoClusterUserResource{}
 
 type MongoClusterUserResourceModel struct {
 	IdentityProviderType string              `tfschema:"identity_provider_type"`
@@ -42,6 +46,10 @@ func (r MongoClusterUserResource) IDValidationFunc() pluginsdk.SchemaValidateFun
 	return users.ValidateUserID
 }
 
+func (r MongoClusterUserResource) Identity() resourceids.ResourceId {
+	return &users.UserId{}
+}
+
 func (r MongoClusterUserResource) ResourceType() string {
 	return "azurerm_mongo_cluster_user"
 }
@@ -157,6 +165,9 @@ func (r MongoClusterUserResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			return nil
 		},
@@ -198,6 +209,10 @@ func (r MongoClusterUserResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -26,6 +26,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name monitor_activity_log_alert -service-package-name monitor -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 func resourceMonitorActivityLogAlert() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceMonitorActivityLogAlertCreateUpdate,
@@ -33,10 +35,11 @@ func resourceMonitorActivityLogAlert() *pluginsdk.Resource {
 		Update: resourceMonitorActivityLogAlertCreateUpdate,
 		Delete: resourceMonitorActivityLogAlertDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := activitylogalertsapis.ParseActivityLogAlertID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&activitylogalertsapis.ActivityLogAlertId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&activitylogalertsapis.ActivityLogAlertId{}),
+		},
 
 		SchemaVersion: 1,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
@@ -467,6 +470,9 @@ func resourceMonitorActivityLogAlertCreateUpdate(d *pluginsdk.ResourceData, meta
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 
 	return resourceMonitorActivityLogAlertRead(d, meta)
 }
@@ -491,10 +497,14 @@ func resourceMonitorActivityLogAlertRead(d *pluginsdk.ResourceData, meta interfa
 		return fmt.Errorf("getting Monitor %s: %+v", *id, err)
 	}
 
+	return resourceMonitorActivityLogAlertFlatten(d, id, resp.Model)
+}
+
+func resourceMonitorActivityLogAlertFlatten(d *pluginsdk.ResourceData, id *activitylogalertsapis.ActivityLogAlertId, model *activitylogalertsapis.ActivityLogAlertResource) error {
 	d.Set("name", id.ActivityLogAlertName)
 	d.Set("resource_group_name", id.ResourceGroupName)
 
-	if model := resp.Model; model != nil {
+	if model != nil {
 		d.Set("location", location.NormalizeNilable(model.Location))
 		if props := model.Properties; props != nil {
 			d.Set("enabled", props.Enabled)
@@ -515,12 +525,12 @@ func resourceMonitorActivityLogAlertRead(d *pluginsdk.ResourceData, meta interfa
 				return fmt.Errorf("setting `action`: %+v", err)
 			}
 		}
-		if err = d.Set("tags", utils.FlattenPtrMapStringString(model.Tags)); err != nil {
+		if err := d.Set("tags", utils.FlattenPtrMapStringString(model.Tags)); err != nil {
 			return err
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceMonitorActivityLogAlertDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -10,12 +10,15 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/alertsmanagement/2021-08-08/alertprocessingrules"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name monitor_alert_processing_rule_action_group -service-package-name monitor -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 type AlertProcessingRuleActionGroupModel struct {
 	Name              string                              `tfschema:"name"`
 	ResourceGroupName string                              `tfschema:"resource_group_name"`
@@ -31,6 +34,7 @@ type AlertProcessingRuleActionGroupModel struct {
 type AlertProcessingRuleActionGroupResource struct{}
 
 var _ sdk.ResourceWithUpdate = AlertProcessingRuleActionGroupResource{}
+var _ sdk.ResourceWithIdentity = AlertProcessingRuleActionGroupResource{}
 
 func (r AlertProcessingRuleActionGroupResource) ResourceType() string {
 	return "azurerm_monitor_alert_processing_rule_action_group"
@@ -44,6 +48,10 @@ func (r AlertProcessingRuleActionGroupResource) IDValidationFunc() pluginsdk.Sch
 	return alertprocessingrules.ValidateActionRuleID
 }
 
+func (r AlertProcessingRuleActionGroupResource) Identity() resourceids.ResourceId {
+	return &alertprocessingrules.ActionRuleId{}
+}
+
 func (r AlertProcessingRuleActionGroupResource) Arguments() map[string]*pluginsdk.Schema {
 	arguments := schemaAlertProcessingRule()
 	arguments["add_action_group_ids"] = &pluginsdk.Schema{
@@ -104,7 +112,7 @@ func (r AlertProcessingRuleActionGroupResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -239,6 +247,10 @@ func (r AlertProcessingRuleActionGroupResource) Read() sdk.ResourceFunc {
 				state.Schedule = flattenAlertProcessingRuleSchedule(properties.Schedule)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -10,11 +10,14 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/alertsmanagement/2021-08-08/alertprocessingrules"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name monitor_alert_processing_rule_suppression -service-package-name monitor -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 type AlertProcessingRuleSuppressionModel struct {
 	Name              string                              `tfschema:"name"`
 	ResourceGroupName string                              `tfschema:"resource_group_name"`
@@ -29,6 +32,7 @@ type AlertProcessingRuleSuppressionModel struct {
 type AlertProcessingRuleSuppressionResource struct{}
 
 var _ sdk.ResourceWithUpdate = AlertProcessingRuleSuppressionResource{}
+var _ sdk.ResourceWithIdentity = AlertProcessingRuleSuppressionResource{}
 
 func (r AlertProcessingRuleSuppressionResource) ResourceType() string {
 	return "azurerm_monitor_alert_processing_rule_suppression"
@@ -42,6 +46,10 @@ func (r AlertProcessingRuleSuppressionResource) IDValidationFunc() pluginsdk.Sch
 	return alertprocessingrules.ValidateActionRuleID
 }
 
+func (r AlertProcessingRuleSuppressionResource) Identity() resourceids.ResourceId {
+	return &alertprocessingrules.ActionRuleId{}
+}
+
 func (r AlertProcessingRuleSuppressionResource) Arguments() map[string]*pluginsdk.Schema {
 	arguments := schemaAlertProcessingRule()
 	return arguments
@@ -92,7 +100,7 @@ func (r AlertProcessingRuleSuppressionResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -208,6 +216,10 @@ func (r AlertProcessingRuleSuppressionResource) Read() sdk.ResourceFunc {
 			state.Condition = flattenAlertProcessingRuleConditions(properties.Conditions)
 			state.Schedule = flattenAlertProcessingRuleSchedule(properties.Schedule)
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	prometheusrulegroups "github.com/hashicorp/go-azure-sdk/resource-manager/alertsmanagement/2023-03-01/prometheusrulegroupresources"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -20,6 +21,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name monitor_alert_prometheus_rule_group -service-package-name monitor -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type AlertPrometheusRuleGroupTestResource
+
 type AlertPrometheusRuleGroupResourceModel struct {
 	Name              string                `tfschema:"name"`
 	Location          string                `tfschema:"location"`
@@ -59,6 +62,7 @@ type PrometheusRuleAlertResolutionModel struct {
 type AlertPrometheusRuleGroupResource struct{}
 
 var _ sdk.ResourceWithUpdate = AlertPrometheusRuleGroupResource{}
+var _ sdk.ResourceWithIdentity = AlertPrometheusRuleGroupResource{}
 
 func (r AlertPrometheusRuleGroupResource) ResourceType() string {
 	return "azurerm_monitor_alert_prometheus_rule_group"
@@ -104,6 +108,10 @@ func (r AlertPrometheusRuleGroupResource) IDValidationFunc() pluginsdk.SchemaVal
 	return prometheusrulegroups.ValidatePrometheusRuleGroupID
 }
 
+func (r AlertPrometheusRuleGroupResource) Identity() resourceids.ResourceId {
+	return &prometheusrulegroups.PrometheusRuleGroupId{}
+}
+
 func (r AlertPrometheusRuleGroupResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -300,7 +308,7 @@ func (r AlertPrometheusRuleGroupResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -397,6 +405,10 @@ func (r AlertPrometheusRuleGroupResource) Read() sdk.ResourceFunc {
 				state.Scopes = resp.Model.Properties.Scopes
 				state.Tags = pointer.From(resp.Model.Tags)
 			}
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2023-03-11/datacollectionendpoints"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -19,9 +20,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name monitor_data_collection_endpoint -service-package-name monitor -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 var (
-	_ sdk.Resource           = DataCollectionEndpointResource{}
-	_ sdk.ResourceWithUpdate = DataCollectionEndpointResource{}
+	_ sdk.Resource             = DataCollectionEndpointResource{}
+	_ sdk.ResourceWithUpdate   = DataCollectionEndpointResource{}
+	_ sdk.ResourceWithIdentity = DataCollectionEndpointResource{}
 )
 
 type DataCollectionEndpointResource struct{}
@@ -107,6 +111,10 @@ func (r DataCollectionEndpointResource) IDValidationFunc() pluginsdk.SchemaValid
 	return datacollectionendpoints.ValidateDataCollectionEndpointID
 }
 
+func (r DataCollectionEndpointResource) Identity() resourceids.ResourceId {
+	return &datacollectionendpoints.DataCollectionEndpointId{}
+}
+
 func (r DataCollectionEndpointResource) ModelObject() interface{} {
 	return &DataCollectionEndpoint{}
 }
@@ -152,7 +160,7 @@ func (r DataCollectionEndpointResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 		Timeout: 30 * time.Minute,
 	}
@@ -207,6 +215,10 @@ func (r DataCollectionEndpointResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&DataCollectionEndpoint{
 				ConfigurationAccessEndpoint: configurationAccessEndpoint,
 				Description:                 description,
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2023-03-11/datacollectionendpoints"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2023-03-11/datacollectionruleassociations"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2023-03-11/datacollectionrules"
@@ -19,6 +20,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name monitor_data_collection_rule_association -service-package-name monitor -properties "name,resource_uri:target_resource_id" -no-subscription-id
+
 type DataCollectionRuleAssociationModel struct {
 	Name                     string `tfschema:"name"`
 	TargetResourceId         string `tfschema:"target_resource_id"`
@@ -29,6 +32,8 @@ type DataCollectionRuleAssociationModel struct {
 
 type DataCollectionRuleAssociationResource struct{}
 
+var _ sdk.ResourceWithIdentity = DataCollectionRuleAssociationResource{}
+
 func (r DataCollectionRuleAssociationResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"target_resource_id": {
@@ -81,6 +86,10 @@ func (r DataCollectionRuleAssociationResource) IDValidationFunc() pluginsdk.Sche
 	return datacollectionruleassociations.ValidateScopedDataCollectionRuleAssociationID
 }
 
+func (r DataCollectionRuleAssociationResource) Identity() resourceids.ResourceId {
+	return &datacollectionruleassociations.ScopedDataCollectionRuleAssociationId{}
+}
+
 func (r DataCollectionRuleAssociationResource) ModelObject() interface{} {
 	return &DataCollectionRuleAssociationModel{}
 }
@@ -126,7 +135,7 @@ func (r DataCollectionRuleAssociationResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 		Timeout: 30 * time.Minute,
 	}
@@ -161,6 +170,10 @@ func (r DataCollectionRuleAssociationResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&DataCollectionRuleAssociationModel{
 				Name:                     id.DataCollectionRuleAssociationName,
 				TargetResourceId:         id.ResourceUri,
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-11-01/eventhubs"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2023-03-11/datacollectionendpoints"
@@ -26,9 +27,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name monitor_data_collection_rule -service-package-name monitor -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 var (
 	_ sdk.ResourceWithUpdate        = DataCollectionRuleResource{}
 	_ sdk.ResourceWithCustomizeDiff = DataCollectionRuleResource{}
+	_ sdk.ResourceWithIdentity      = DataCollectionRuleResource{}
 )
 
 type DataCollectionRule struct {
@@ -923,6 +927,10 @@ func (r DataCollectionRuleResource) IDValidationFunc() pluginsdk.SchemaValidateF
 	return datacollectionrules.ValidateDataCollectionRuleID
 }
 
+func (r DataCollectionRuleResource) Identity() resourceids.ResourceId {
+	return &datacollectionrules.DataCollectionRuleId{}
+}
+
 func (r DataCollectionRuleResource) ModelObject() interface{} {
 	return &DataCollectionRule{}
 }
@@ -984,7 +992,7 @@ func (r DataCollectionRuleResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 		Timeout: 30 * time.Minute,
 	}
@@ -1041,6 +1049,10 @@ func (r DataCollectionRuleResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&DataCollectionRule{
 				Name:                     id.DataCollectionRuleName,
 				ResourceGroupName:        id.ResourceGroupName,
@@ -17,6 +17,7 @@ import (
 	authRuleParse "github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-11-01/authorizationrulesnamespaces"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-05-01-preview/diagnosticsettings"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -28,6 +29,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name monitor_diagnostic_setting -service-package-name monitor -properties "name,resource_uri:target_resource_id" -no-subscription-id -test-name storageAccount
+
 func resourceMonitorDiagnosticSetting() *pluginsdk.Resource {
 	resource := &pluginsdk.Resource{
 		Create: resourceMonitorDiagnosticSettingCreate,
@@ -35,10 +38,14 @@ func resourceMonitorDiagnosticSetting() *pluginsdk.Resource {
 		Update: resourceMonitorDiagnosticSettingUpdate,
 		Delete: resourceMonitorDiagnosticSettingDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+		Importer: pluginsdk.ImporterValidatingResourceIdOrIdentity(func(id string) error {
 			_, err := ParseMonitorDiagnosticId(id)
 			return err
-		}),
+		}, monitorDiagnosticSettingIdentityImporter),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&diagnosticsettings.ScopedDiagnosticSettingId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -343,6 +350,9 @@ func resourceMonitorDiagnosticSettingCreate(d *pluginsdk.ResourceData, meta inte
 	}
 
 	d.SetId(resourceId)
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 
 	return resourceMonitorDiagnosticSettingRead(d, meta)
 }
@@ -499,6 +509,10 @@ func resourceMonitorDiagnosticSettingRead(d *pluginsdk.ResourceData, meta interf
 		return fmt.Errorf("retrieving Monitor Diagnostics Setting %q for Resource %q: %+v", id.DiagnosticSettingName, id.ResourceUri, err)
 	}
 
+	return resourceMonitorDiagnosticSettingFlatten(d, id, resp.Model)
+}
+
+func resourceMonitorDiagnosticSettingFlatten(d *pluginsdk.ResourceData, id *diagnosticsettings.ScopedDiagnosticSettingId, model *diagnosticsettings.DiagnosticSettingsResource) error {
 	d.Set("name", id.DiagnosticSettingName)
 	resourceUri := id.ResourceUri
 	if v, err := commonids.ParseKustoClusterIDInsensitively(resourceUri); err == nil {
@@ -506,7 +520,7 @@ func resourceMonitorDiagnosticSettingRead(d *pluginsdk.ResourceData, meta interf
 	}
 	d.Set("target_resource_id", resourceUri)
 
-	if model := resp.Model; model != nil {
+	if model != nil {
 		if props := model.Properties; props != nil {
 			d.Set("eventhub_name", props.EventHubName)
 			eventhubAuthorizationRuleId := ""
@@ -545,29 +559,29 @@ func resourceMonitorDiagnosticSettingRead(d *pluginsdk.ResourceData, meta interf
 			}
 
 			logAnalyticsDestinationType := ""
-			if resp.Model.Properties.LogAnalyticsDestinationType != nil && *resp.Model.Properties.LogAnalyticsDestinationType != "" {
-				logAnalyticsDestinationType = *resp.Model.Properties.LogAnalyticsDestinationType
+			if props.LogAnalyticsDestinationType != nil && *props.LogAnalyticsDestinationType != "" {
+				logAnalyticsDestinationType = *props.LogAnalyticsDestinationType
 			}
 			d.Set("log_analytics_destination_type", logAnalyticsDestinationType)
 
-			enabledLogs := flattenMonitorDiagnosticEnabledLogs(resp.Model.Properties.Logs)
-			if err = d.Set("enabled_log", enabledLogs); err != nil {
+			enabledLogs := flattenMonitorDiagnosticEnabledLogs(props.Logs)
+			if err := d.Set("enabled_log", enabledLogs); err != nil {
 				return fmt.Errorf("setting `enabled_log`: %+v", err)
 			}
 
-			if err = d.Set("enabled_metric", flattenMonitorDiagnosticEnabledMetrics(resp.Model.Properties.Metrics)); err != nil {
+			if err := d.Set("enabled_metric", flattenMonitorDiagnosticEnabledMetrics(props.Metrics)); err != nil {
 				return fmt.Errorf("setting `enabled_metric`: %+v", err)
 			}
 
 			if !features.FivePointOh() {
-				if err := d.Set("metric", flattenMonitorDiagnosticMetrics(resp.Model.Properties.Metrics)); err != nil {
+				if err := d.Set("metric", flattenMonitorDiagnosticMetrics(props.Metrics)); err != nil {
 					return fmt.Errorf("setting `metric`: %+v", err)
 				}
 			}
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceMonitorDiagnosticSettingDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -820,6 +834,23 @@ func ParseMonitorDiagnosticId(monitorId string) (*diagnosticsettings.ScopedDiagn
 	return &identifier, nil
 }
 
+// monitorDiagnosticSettingIdentityImporter sets the ID of the Monitor Diagnostic Setting from the resource identity data,
+// since the ID of this resource is in the format `{resourceId}|{name}` rather than the ID of the Diagnostic Setting
+func monitorDiagnosticSettingIdentityImporter(d *pluginsdk.ResourceData) error {
+	if err := pluginsdk.ValidateResourceIdentityData(d, &diagnosticsettings.ScopedDiagnosticSettingId{}); err != nil {
+		return err
+	}
+
+	id, err := diagnosticsettings.ParseScopedDiagnosticSettingID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s|%s", id.ResourceUri, id.DiagnosticSettingName))
+
+	return nil
+}
+
 func resourceMonitorDiagnosticLogSettingHash(input interface{}) int {
 	var buf bytes.Buffer
 	if rawData, ok := input.(map[string]interface{}); ok {
@@ -0,0 +1,198 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourcegroups"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-05-01-preview/diagnosticsettings"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/resources"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type MonitorDiagnosticSettingListResource struct{}
+
+type MonitorDiagnosticSettingListModel struct {
+	TargetResourceId  types.String `tfsdk:"target_resource_id"`
+	ResourceGroupName types.String `tfsdk:"resource_group_name"`
+	SubscriptionId    types.String `tfsdk:"subscription_id"`
+}
+
+var _ sdk.FrameworkListWrappedResourceWithConfig = new(MonitorDiagnosticSettingListResource)
+
+func (r MonitorDiagnosticSettingListResource) ResourceFunc() *pluginsdk.Resource {
+	return resourceMonitorDiagnosticSetting()
+}
+
+func (r MonitorDiagnosticSettingListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = `azurerm_monitor_diagnostic_setting`
+}
+
+func (r MonitorDiagnosticSettingListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"target_resource_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the Resource to list Diagnostic Settings for.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: azure.ValidateResourceID,
+					},
+					stringvalidator.ExactlyOneOf(path.MatchRoot("resource_group_name")),
+				},
+			},
+
+			"resource_group_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "The name of the Resource Group containing the Resources to list Diagnostic Settings for.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: resourcegroups.ValidateName,
+					},
+				},
+			},
+
+			"subscription_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The Subscription ID containing the `resource_group_name`. Defaults to the value specified in the Provider Configuration.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.IsUUID,
+					},
+					stringvalidator.ConflictsWith(path.MatchRoot("target_resource_id")),
+				},
+			},
+		},
+	}
+}
+
+func (r MonitorDiagnosticSettingListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata sdk.ResourceMetadata) {
+	client := metadata.Client.Monitor.DiagnosticSettingsClient
+
+	var data MonitorDiagnosticSettingListModel
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	targetResourceIds := make([]string, 0)
+	// when listing by Resource Group, not every Resource within it supports Diagnostic Settings - as such these are skipped
+	skipUnsupported := false
+
+	switch {
+	case !data.TargetResourceId.IsNull():
+		targetResourceIds = append(targetResourceIds, data.TargetResourceId.ValueString())
+	default:
+		subscriptionID := metadata.SubscriptionId
+		if !data.SubscriptionId.IsNull() {
+			subscriptionID = data.SubscriptionId.ValueString()
+		}
+
+		options := resources.ListOperationOptions{
+			Filter: pointer.To(fmt.Sprintf("resourceGroup eq '%s'", data.ResourceGroupName.ValueString())),
+		}
+		resp, err := metadata.Client.Resource.ResourcesClient.ListComplete(ctx, commonids.NewSubscriptionID(subscriptionID), options)
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing Resources in Resource Group %q", data.ResourceGroupName.ValueString()), err)
+			return
+		}
+
+		// Diagnostic Settings can also be configured for the Resource Group itself
+		targetResourceIds = append(targetResourceIds, commonids.NewResourceGroupID(subscriptionID, data.ResourceGroupName.ValueString()).ID())
+		for _, item := range resp.Items {
+			if v := pointer.From(item.Id); v != "" {
+				targetResourceIds = append(targetResourceIds, v)
+				targetResourceIds = append(targetResourceIds, monitorDiagnosticSettingChildResourceIds(v, pointer.From(item.Type))...)
+			}
+		}
+		skipUnsupported = true
+	}
+
+	// retrieve the deadline from the supplied context, since the Diagnostic Settings for each Resource are listed as the results are streamed
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		sdk.SetResponseErrorDiagnostic(stream, "internal-error", "context had no deadline")
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		ctx, cancel := context.WithDeadline(context.Background(), deadline)
+		defer cancel()
+
+		for _, targetResourceId := range targetResourceIds {
+			resp, err := client.List(ctx, commonids.NewScopeID(targetResourceId))
+			if err != nil {
+				if skipUnsupported && (response.WasBadRequest(resp.HttpResponse) || response.WasNotFound(resp.HttpResponse)) {
+					continue
+				}
+
+				result := request.NewListResult(ctx)
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("listing `azurerm_monitor_diagnostic_setting` for Resource %q", targetResourceId), err)
+				return
+			}
+
+			if resp.Model == nil {
+				continue
+			}
+
+			for _, setting := range pointer.From(resp.Model.Value) {
+				result := request.NewListResult(ctx)
+				result.DisplayName = pointer.From(setting.Name)
+
+				id := diagnosticsettings.NewScopedDiagnosticSettingID(targetResourceId, pointer.From(setting.Name))
+
+				rd := resourceMonitorDiagnosticSetting().Data(&terraform.InstanceState{})
+				rd.SetId(fmt.Sprintf("%s|%s", id.ResourceUri, id.DiagnosticSettingName))
+
+				if err := resourceMonitorDiagnosticSettingFlatten(rd, &id, &setting); err != nil {
+					sdk.SetErrorDiagnosticAndPushListResult(result, push, "encoding `azurerm_monitor_diagnostic_setting` resource data", err)
+					return
+				}
+
+				sdk.EncodeListResult(ctx, rd, &result)
+				if result.Diagnostics.HasError() {
+					push(result)
+					return
+				}
+
+				if !push(result) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// monitorDiagnosticSettingChildResourceIds returns the IDs of the known child resources of a Resource which support
+// Diagnostic Settings, since only top-level Resources are returned when listing the Resources in a Resource Group
+func monitorDiagnosticSettingChildResourceIds(resourceId string, resourceType string) []string {
+	childResourceIds := make([]string, 0)
+
+	if strings.EqualFold(resourceType, "Microsoft.Storage/storageAccounts") {
+		for _, service := range []string{"blobServices", "fileServices", "queueServices", "tableServices"} {
+			childResourceIds = append(childResourceIds, fmt.Sprintf("%s/%s/default", resourceId, service))
+		}
+	}
+
+	return childResourceIds
+}
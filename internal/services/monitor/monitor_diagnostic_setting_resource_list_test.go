@@ -0,0 +1,119 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package monitor_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestAccMonitorDiagnosticSetting_list(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_diagnostic_setting", "testlist")
+	r := MonitorDiagnosticSettingResource{}
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.storageAccount(data),
+			},
+			{
+				Query:  true,
+				Config: r.basicListQueryByTargetResourceId(),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("azurerm_monitor_diagnostic_setting.list", 1),
+					querycheck.ExpectIdentity(
+						"azurerm_monitor_diagnostic_setting.list",
+						map[string]knownvalue.Check{
+							"name":         knownvalue.StringRegexp(regexp.MustCompile(strconv.Itoa(data.RandomInteger))),
+							"resource_uri": knownvalue.StringRegexp(regexp.MustCompile(strconv.Itoa(data.RandomInteger))),
+						},
+					),
+				},
+			},
+			{
+				Query:  true,
+				Config: r.basicListQueryByResourceGroupName(data),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("azurerm_monitor_diagnostic_setting.list", 1), // only the Key Vault has a Diagnostic Setting
+					querycheck.ExpectIdentity(
+						"azurerm_monitor_diagnostic_setting.list",
+						map[string]knownvalue.Check{
+							"name":         knownvalue.StringRegexp(regexp.MustCompile(strconv.Itoa(data.RandomInteger))),
+							"resource_uri": knownvalue.StringRegexp(regexp.MustCompile(strconv.Itoa(data.RandomInteger))),
+						},
+					),
+				},
+			},
+			{
+				Config: r.storageAccountWithBlobService(data),
+			},
+			{
+				Query:  true,
+				Config: r.basicListQueryByResourceGroupName(data),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("azurerm_monitor_diagnostic_setting.list", 2), // the Key Vault and the Blob Service of the Storage Account
+					querycheck.ExpectIdentity(
+						"azurerm_monitor_diagnostic_setting.list",
+						map[string]knownvalue.Check{
+							"name":         knownvalue.StringExact(fmt.Sprintf("acctest-DS-blob-%d", data.RandomInteger)),
+							"resource_uri": knownvalue.StringRegexp(regexp.MustCompile("/blobServices/default$")),
+						},
+					),
+				},
+			},
+		},
+	})
+}
+
+func (r MonitorDiagnosticSettingResource) basicListQueryByTargetResourceId() string {
+	return `
+list "azurerm_monitor_diagnostic_setting" "list" {
+  provider = azurerm
+  config {
+    target_resource_id = azurerm_key_vault.test.id
+  }
+}
+`
+}
+
+func (r MonitorDiagnosticSettingResource) basicListQueryByResourceGroupName(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+list "azurerm_monitor_diagnostic_setting" "list" {
+  provider = azurerm
+  config {
+    resource_group_name = "acctestRG-%d"
+  }
+}
+`, data.RandomInteger)
+}
+
+func (r MonitorDiagnosticSettingResource) storageAccountWithBlobService(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_diagnostic_setting" "blob" {
+  name               = "acctest-DS-blob-%d"
+  target_resource_id = "${azurerm_storage_account.test.id}/blobServices/default"
+  storage_account_id = azurerm_storage_account.test.id
+
+  enabled_metric {
+    category = "Transaction"
+  }
+}
+`, r.storageAccount(data), data.RandomInteger)
+}
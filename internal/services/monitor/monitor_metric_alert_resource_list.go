@@ -0,0 +1,101 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package monitor
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2018-03-01/metricalerts"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type MonitorMetricAlertListResource struct{}
+
+var _ sdk.FrameworkListWrappedResource = new(MonitorMetricAlertListResource)
+
+func (r MonitorMetricAlertListResource) ResourceFunc() *pluginsdk.Resource {
+	return resourceMonitorMetricAlert()
+}
+
+func (r MonitorMetricAlertListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = `azurerm_monitor_metric_alert`
+}
+
+func (r MonitorMetricAlertListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata sdk.ResourceMetadata) {
+	client := metadata.Client.Monitor.MetricAlertsClient
+
+	var data sdk.DefaultListModel
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	results := make([]metricalerts.MetricAlertResource, 0)
+
+	subscriptionID := metadata.SubscriptionId
+	if !data.SubscriptionId.IsNull() {
+		subscriptionID = data.SubscriptionId.ValueString()
+	}
+
+	switch {
+	case !data.ResourceGroupName.IsNull():
+		resp, err := client.ListByResourceGroup(ctx, commonids.NewResourceGroupID(subscriptionID, data.ResourceGroupName.ValueString()))
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(stream, "listing `azurerm_monitor_metric_alert`", err)
+			return
+		}
+
+		if model := resp.Model; model != nil {
+			results = pointer.From(model.Value)
+		}
+	default:
+		resp, err := client.ListBySubscription(ctx, commonids.NewSubscriptionID(subscriptionID))
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(stream, "listing `azurerm_monitor_metric_alert`", err)
+			return
+		}
+
+		if model := resp.Model; model != nil {
+			results = pointer.From(model.Value)
+		}
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, alert := range results {
+			result := request.NewListResult(ctx)
+			result.DisplayName = pointer.From(alert.Name)
+
+			id, err := metricalerts.ParseMetricAlertIDInsensitively(pointer.From(alert.Id))
+			if err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, "parsing Monitor Metric Alert ID", err)
+				return
+			}
+
+			rd := resourceMonitorMetricAlert().Data(&terraform.InstanceState{})
+			rd.SetId(id.ID())
+
+			if err := resourceMonitorMetricAlertFlatten(rd, id, &alert); err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, "encoding `azurerm_monitor_metric_alert` resource data", err)
+				return
+			}
+
+			sdk.EncodeListResult(ctx, rd, &result)
+			if result.Diagnostics.HasError() {
+				push(result)
+				return
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
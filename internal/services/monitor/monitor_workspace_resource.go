@@ -12,12 +12,15 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/monitor/2023-04-03/azuremonitorworkspaces"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name monitor_workspace -service-package-name monitor -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type WorkspaceTestResource
+
 type WorkspaceResourceModel struct {
 	Name                            string            `tfschema:"name"`
 	ResourceGroupName               string            `tfschema:"resource_group_name"`
@@ -32,6 +35,7 @@ type WorkspaceResourceModel struct {
 type WorkspaceResource struct{}
 
 var _ sdk.ResourceWithUpdate = WorkspaceResource{}
+var _ sdk.ResourceWithIdentity = WorkspaceResource{}
 
 func (r WorkspaceResource) ResourceType() string {
 	return "azurerm_monitor_workspace"
@@ -45,6 +49,10 @@ func (r WorkspaceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return azuremonitorworkspaces.ValidateAccountID
 }
 
+func (r WorkspaceResource) Identity() resourceids.ResourceId {
+	return &azuremonitorworkspaces.AccountId{}
+}
+
 func (r WorkspaceResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -124,7 +132,7 @@ func (r WorkspaceResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -232,6 +240,10 @@ func (r WorkspaceResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
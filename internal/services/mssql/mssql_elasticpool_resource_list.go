@@ -69,7 +69,7 @@ func (r MssqlElasticPoolListResource) List(ctx context.Context, request list.Lis
 			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("parsing Mssql Server ID for `%s`", "azurerm_mssql_elasticpool"), err)
 			return
 		}
-		resp, err := client.ListByServerComplete(ctx, *serverId, elasticpools.DefaultListByServerOperationOptions())
+		resp, err := client.ListByServerComplete(sdk.ListPageLimitContext(ctx, request), *serverId, elasticpools.DefaultListByServerOperationOptions())
 		if err != nil {
 			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", `azurerm_mssql_elasticpool`), err)
 			return
@@ -150,6 +150,7 @@ func (r MsSqlFailoverGroupResource) Attributes() map[string]*pluginsdk.Schema {
 
 func (r MsSqlFailoverGroupResource) CustomizeDiff() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
 			var model MsSqlFailoverGroupModel
 			if err := metadata.DecodeDiff(&model); err != nil {
@@ -71,7 +71,7 @@ func (r MssqlJobAgentListResource) List(ctx context.Context, request list.ListRe
 			return
 		}
 
-		resp, err := client.ListByServerComplete(ctx, *mssqlServerId)
+		resp, err := client.ListByServerComplete(sdk.ListPageLimitContext(ctx, request), *mssqlServerId)
 		if err != nil {
 			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", "azurerm_mssql_job_agent"), err)
 			return
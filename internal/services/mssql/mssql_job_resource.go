@@ -10,12 +10,15 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/jobs"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name mssql_job -service-package-name mssql -properties "name" -compare-values "subscription_id:job_agent_id,resource_group_name:job_agent_id,server_name:job_agent_id,job_agent_name:job_agent_id" -test-resource-type MsSqlJobResource
+
 type MsSqlJobResource struct{}
 
 type MsSqlJobResourceModel struct {
@@ -25,6 +28,7 @@ type MsSqlJobResourceModel struct {
 }
 
 var _ sdk.ResourceWithUpdate = MsSqlJobResource{}
+var _ sdk.ResourceWithIdentity = MsSqlJobResource{}
 
 func (MsSqlJobResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
@@ -98,7 +102,7 @@ func (r MsSqlJobResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -134,6 +138,10 @@ func (MsSqlJobResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -193,3 +201,7 @@ func (MsSqlJobResource) Delete() sdk.ResourceFunc {
 func (MsSqlJobResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return jobs.ValidateJobID
 }
+
+func (MsSqlJobResource) Identity() resourceids.ResourceId {
+	return &jobs.JobId{}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/jobcredentials"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/jobsteps"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/jobtargetgroups"
@@ -20,6 +21,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name mssql_job_step -service-package-name mssql -properties "name" -compare-values "subscription_id:job_id,resource_group_name:job_id,server_name:job_id,job_agent_name:job_id,job_name:job_id" -test-resource-type MsSqlJobStepTestResource
+
 type MsSqlJobStepResource struct{}
 
 type MsSqlJobStepResourceModel struct {
@@ -47,6 +50,7 @@ type JobStepOutputTarget struct {
 var (
 	_ sdk.ResourceWithUpdate        = MsSqlJobStepResource{}
 	_ sdk.ResourceWithCustomizeDiff = MsSqlJobStepResource{}
+	_ sdk.ResourceWithIdentity      = MsSqlJobStepResource{}
 )
 
 func (MsSqlJobStepResource) Arguments() map[string]*pluginsdk.Schema {
@@ -241,7 +245,7 @@ func (r MsSqlJobStepResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -301,6 +305,10 @@ func (r MsSqlJobStepResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -417,6 +425,10 @@ func (r MsSqlJobStepResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return jobsteps.ValidateStepID
 }
 
+func (r MsSqlJobStepResource) Identity() resourceids.ResourceId {
+	return &jobsteps.StepId{}
+}
+
 func expandOutputTarget(input []JobStepOutputTarget) (*jobsteps.JobStepOutput, error) {
 	if len(input) == 0 {
 		return nil, nil
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/jobcredentials"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/jobs"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/jobtargetgroups"
@@ -19,6 +20,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name mssql_job_target_group -service-package-name mssql -properties "name" -compare-values "subscription_id:job_agent_id,resource_group_name:job_agent_id,server_name:job_agent_id,job_agent_name:job_agent_id" -test-resource-type MsSqlJobTargetGroupResource
+
 type MsSqlJobTargetGroupResource struct{}
 
 type MsSqlJobTargetGroupResourceModel struct {
@@ -39,6 +42,7 @@ type MsSqlJobTarget struct {
 var (
 	_ sdk.ResourceWithUpdate        = MsSqlJobTargetGroupResource{}
 	_ sdk.ResourceWithCustomizeDiff = MsSqlJobTargetGroupResource{}
+	_ sdk.ResourceWithIdentity      = MsSqlJobTargetGroupResource{}
 )
 
 func (r MsSqlJobTargetGroupResource) Arguments() map[string]*pluginsdk.Schema {
@@ -167,7 +171,7 @@ func (r MsSqlJobTargetGroupResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -203,6 +207,10 @@ func (r MsSqlJobTargetGroupResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -276,6 +284,10 @@ func (r MsSqlJobTargetGroupResource) IDValidationFunc() pluginsdk.SchemaValidate
 	return jobtargetgroups.ValidateTargetGroupID
 }
 
+func (r MsSqlJobTargetGroupResource) Identity() resourceids.ResourceId {
+	return &jobtargetgroups.TargetGroupId{}
+}
+
 func expandJobTargets(input []MsSqlJobTarget) []jobtargetgroups.JobTarget {
 	targets := make([]jobtargetgroups.JobTarget, 0)
 	if len(input) == 0 {
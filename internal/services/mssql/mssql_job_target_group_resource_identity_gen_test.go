@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package mssql_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccMssqlJobTargetGroup_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_job_target_group", "test")
+	r := MsSqlJobTargetGroupResource{}
+
+	checkedFields := map[string]struct{}{
+		"name":                {},
+		"job_agent_name":      {},
+		"resource_group_name": {},
+		"server_name":         {},
+		"subscription_id":     {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_mssql_job_target_group.test", checkedFields),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_mssql_job_target_group.test", tfjsonpath.New("name"), tfjsonpath.New("name")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_mssql_job_target_group.test", tfjsonpath.New("job_agent_name"), tfjsonpath.New("job_agent_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_mssql_job_target_group.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("job_agent_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_mssql_job_target_group.test", tfjsonpath.New("server_name"), tfjsonpath.New("job_agent_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_mssql_job_target_group.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("job_agent_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
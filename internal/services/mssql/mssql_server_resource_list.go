@@ -48,7 +48,7 @@ func (r MssqlServerListResource) List(ctx context.Context, request list.ListRequ
 
 	switch {
 	case !data.ResourceGroupName.IsNull():
-		resp, err := client.ListByResourceGroupComplete(ctx, commonids.NewResourceGroupID(subscriptionID, data.ResourceGroupName.ValueString()), servers.DefaultListByResourceGroupOperationOptions())
+		resp, err := client.ListByResourceGroupComplete(sdk.ListPageLimitContext(ctx, request), commonids.NewResourceGroupID(subscriptionID, data.ResourceGroupName.ValueString()), servers.DefaultListByResourceGroupOperationOptions())
 		if err != nil {
 			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", `azurerm_mssql_server`), err)
 			return
@@ -56,7 +56,7 @@ func (r MssqlServerListResource) List(ctx context.Context, request list.ListRequ
 
 		results = resp.Items
 	default:
-		resp, err := client.ListComplete(ctx, commonids.NewSubscriptionID(subscriptionID), servers.DefaultListOperationOptions())
+		resp, err := client.ListComplete(sdk.ListPageLimitContext(ctx, request), commonids.NewSubscriptionID(subscriptionID), servers.DefaultListOperationOptions())
 		if err != nil {
 			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", `azurerm_mssql_server`), err)
 			return
@@ -12,6 +12,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-09-01/loadbalancers"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sqlvirtualmachine/2023-10-01/availabilitygrouplisteners"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sqlvirtualmachine/2023-10-01/sqlvirtualmachines"
@@ -27,6 +28,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name mssql_virtual_machine_availability_group_listener -service-package-name mssql -properties "name" -compare-values "subscription_id:sql_virtual_machine_group_id,resource_group_name:sql_virtual_machine_group_id,sql_virtual_machine_group_name:sql_virtual_machine_group_id" -test-name loadBalancerConfiguration -test-resource-type MsSqlVirtualMachineAvailabilityGroupListenerResource
+
 type MsSqlVirtualMachineAvailabilityGroupListenerResource struct{}
 
 type MsSqlVirtualMachineAvailabilityGroupListenerModel struct {
@@ -63,6 +66,7 @@ type ReplicaMsSqlVirtualMachineAvailabilityGroupListener struct {
 }
 
 var _ sdk.Resource = MsSqlVirtualMachineAvailabilityGroupListenerResource{}
+var _ sdk.ResourceWithIdentity = MsSqlVirtualMachineAvailabilityGroupListenerResource{}
 
 func (r MsSqlVirtualMachineAvailabilityGroupListenerResource) ModelObject() interface{} {
 	return &MsSqlVirtualMachineAvailabilityGroupListenerModel{}
@@ -76,6 +80,10 @@ func (r MsSqlVirtualMachineAvailabilityGroupListenerResource) IDValidationFunc()
 	return availabilitygrouplisteners.ValidateAvailabilityGroupListenerID
 }
 
+func (r MsSqlVirtualMachineAvailabilityGroupListenerResource) Identity() resourceids.ResourceId {
+	return &availabilitygrouplisteners.AvailabilityGroupListenerId{}
+}
+
 func (r MsSqlVirtualMachineAvailabilityGroupListenerResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -298,7 +306,7 @@ func (r MsSqlVirtualMachineAvailabilityGroupListenerResource) Create() sdk.Resou
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -355,6 +363,10 @@ func (r MsSqlVirtualMachineAvailabilityGroupListenerResource) Read() sdk.Resourc
 					}
 				}
 			}
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package mssql_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccMssqlVirtualMachineAvailabilityGroupListener_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_virtual_machine_availability_group_listener", "test")
+	r := MsSqlVirtualMachineAvailabilityGroupListenerResource{}
+
+	checkedFields := map[string]struct{}{
+		"name":                           {},
+		"resource_group_name":            {},
+		"sql_virtual_machine_group_name": {},
+		"subscription_id":                {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.loadBalancerConfiguration(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_mssql_virtual_machine_availability_group_listener.test", checkedFields),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_mssql_virtual_machine_availability_group_listener.test", tfjsonpath.New("name"), tfjsonpath.New("name")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_mssql_virtual_machine_availability_group_listener.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("sql_virtual_machine_group_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_mssql_virtual_machine_availability_group_listener.test", tfjsonpath.New("sql_virtual_machine_group_name"), tfjsonpath.New("sql_virtual_machine_group_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_mssql_virtual_machine_availability_group_listener.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("sql_virtual_machine_group_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
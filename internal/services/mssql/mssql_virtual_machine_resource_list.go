@@ -50,7 +50,7 @@ func (r MssqlVirtualMachineListResource) List(ctx context.Context, request list.
 
 	switch {
 	case !data.ResourceGroupName.IsNull():
-		resp, err := client.ListByResourceGroupComplete(ctx, commonids.NewResourceGroupID(subscriptionID, data.ResourceGroupName.ValueString()))
+		resp, err := client.ListByResourceGroupComplete(sdk.ListPageLimitContext(ctx, request), commonids.NewResourceGroupID(subscriptionID, data.ResourceGroupName.ValueString()))
 		if err != nil {
 			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", `azurerm_mssql_virtual_machine`), err)
 			return
@@ -58,7 +58,7 @@ func (r MssqlVirtualMachineListResource) List(ctx context.Context, request list.
 
 		results = resp.Items
 	default:
-		resp, err := client.ListComplete(ctx, commonids.NewSubscriptionID(subscriptionID))
+		resp, err := client.ListComplete(sdk.ListPageLimitContext(ctx, request), commonids.NewSubscriptionID(subscriptionID))
 		if err != nil {
 			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", `azurerm_mssql_virtual_machine`), err)
 			return
@@ -66,7 +66,7 @@ func (r MysqlFlexibleDatabaseListResource) List(ctx context.Context, request lis
 		return
 	}
 
-	resp, err := client.ListByServerComplete(ctx, pointer.From(serverId))
+	resp, err := client.ListByServerComplete(sdk.ListPageLimitContext(ctx, request), pointer.From(serverId))
 	if err != nil {
 		sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", mysqlFlexibleDatabaseResourceName), err)
 		return
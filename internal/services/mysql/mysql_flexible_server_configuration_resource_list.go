@@ -66,7 +66,7 @@ func (r MysqlFlexibleServerConfigurationListResource) List(ctx context.Context,
 		return
 	}
 
-	resp, err := client.ListByServerComplete(ctx, pointer.From(serverId), configurations.DefaultListByServerOperationOptions())
+	resp, err := client.ListByServerComplete(sdk.ListPageLimitContext(ctx, request), pointer.From(serverId), configurations.DefaultListByServerOperationOptions())
 	if err != nil {
 		sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", mysqlFlexibleServerConfigurationResourceName), err)
 		return
@@ -0,0 +1,45 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package netapp_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccNetappSnapshot_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_netapp_snapshot", "test")
+	r := NetAppSnapshotResource{}
+
+	checkedFields := map[string]struct{}{
+		"subscription_id":      {},
+		"capacity_pool_name":   {},
+		"name":                 {},
+		"net_app_account_name": {},
+		"resource_group_name":  {},
+		"volume_name":          {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_netapp_snapshot.test", checkedFields),
+				statecheck.ExpectIdentityValue("azurerm_netapp_snapshot.test", tfjsonpath.New("subscription_id"), knownvalue.StringExact(data.Subscriptions.Primary)),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_netapp_snapshot.test", tfjsonpath.New("capacity_pool_name"), tfjsonpath.New("pool_name")),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_netapp_snapshot.test", tfjsonpath.New("name"), tfjsonpath.New("name")),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_netapp_snapshot.test", tfjsonpath.New("net_app_account_name"), tfjsonpath.New("account_name")),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_netapp_snapshot.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("resource_group_name")),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_netapp_snapshot.test", tfjsonpath.New("volume_name"), tfjsonpath.New("volume_name")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
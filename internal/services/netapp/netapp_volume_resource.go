@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2025-12-01/snapshots"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/netapp/2025-12-01/volumes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
@@ -30,6 +31,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name netapp_volume -service-package-name netapp -properties "name,resource_group_name,net_app_account_name:account_name,capacity_pool_name:pool_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type NetAppVolumeResource
+
 const (
 	// Volume types used in Azure NetApp Files
 	volumeTypeDataProtection = "DataProtection"
@@ -49,10 +52,11 @@ func resourceNetAppVolume() *pluginsdk.Resource {
 			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
 			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
 		},
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := volumes.ParseVolumeID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&volumes.VolumeId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&volumes.VolumeId{}),
+		},
 
 		Schema: map[string]*pluginsdk.Schema{
 			"resource_group_name": commonschema.ResourceGroupName(),
@@ -885,6 +889,9 @@ func resourceNetAppVolumeCreate(d *pluginsdk.ResourceData, meta interface{}) err
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 
 	return resourceNetAppVolumeRead(d, meta)
 }
@@ -1171,7 +1178,7 @@ func resourceNetAppVolumeRead(d *pluginsdk.ResourceData, meta interface{}) error
 			return err
 		}
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceNetAppVolumeDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -46,7 +46,7 @@ func (r ApplicationGatewayListResource) List(ctx context.Context, request list.L
 
 	switch {
 	case !data.ResourceGroupName.IsNull():
-		resp, err := client.ListComplete(ctx, commonids.NewResourceGroupID(subscriptionID, data.ResourceGroupName.ValueString()))
+		resp, err := client.ListComplete(sdk.ListPageLimitContext(ctx, request), commonids.NewResourceGroupID(subscriptionID, data.ResourceGroupName.ValueString()))
 		if err != nil {
 			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", `azurerm_application_gateway`), err)
 			return
@@ -54,7 +54,7 @@ func (r ApplicationGatewayListResource) List(ctx context.Context, request list.L
 
 		results = resp.Items
 	default:
-		resp, err := client.ListAllComplete(ctx, commonids.NewSubscriptionID(subscriptionID))
+		resp, err := client.ListAllComplete(sdk.ListPageLimitContext(ctx, request), commonids.NewSubscriptionID(subscriptionID))
 		if err != nil {
 			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", `azurerm_application_gateway`), err)
 			return
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/expressroutecircuitauthorizations"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
@@ -18,15 +19,19 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name express_route_circuit_authorization -service-package-name network -properties "name,resource_group_name,express_route_circuit_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-name basicConfig
+
 func resourceExpressRouteCircuitAuthorization() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceExpressRouteCircuitAuthorizationCreate,
 		Read:   resourceExpressRouteCircuitAuthorizationRead,
 		Delete: resourceExpressRouteCircuitAuthorizationDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := expressroutecircuitauthorizations.ParseAuthorizationID(id)
-			return err
-		}),
+
+		Importer: pluginsdk.ImporterValidatingIdentity(&expressroutecircuitauthorizations.AuthorizationId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&expressroutecircuitauthorizations.AuthorizationId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -96,6 +101,9 @@ func resourceExpressRouteCircuitAuthorizationCreate(d *pluginsdk.ResourceData, m
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 
 	return resourceExpressRouteCircuitAuthorizationRead(d, meta)
 }
@@ -130,7 +138,7 @@ func resourceExpressRouteCircuitAuthorizationRead(d *pluginsdk.ResourceData, met
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceExpressRouteCircuitAuthorizationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -0,0 +1,41 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccExpressRouteCircuitAuthorization_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_express_route_circuit_authorization", "test")
+	r := ExpressRouteCircuitAuthorizationResource{}
+
+	checkedFields := map[string]struct{}{
+		"subscription_id":            {},
+		"express_route_circuit_name": {},
+		"name":                       {},
+		"resource_group_name":        {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basicConfig(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_express_route_circuit_authorization.test", checkedFields),
+				statecheck.ExpectIdentityValue("azurerm_express_route_circuit_authorization.test", tfjsonpath.New("subscription_id"), knownvalue.StringExact(data.Subscriptions.Primary)),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_express_route_circuit_authorization.test", tfjsonpath.New("express_route_circuit_name"), tfjsonpath.New("express_route_circuit_name")),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_express_route_circuit_authorization.test", tfjsonpath.New("name"), tfjsonpath.New("name")),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_express_route_circuit_authorization.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("resource_group_name")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
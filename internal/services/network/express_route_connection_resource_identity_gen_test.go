@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccExpressRouteConnection_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_express_route_connection", "test")
+	r := ExpressRouteConnectionResource{}
+
+	checkedFields := map[string]struct{}{
+		"name":                       {},
+		"express_route_gateway_name": {},
+		"resource_group_name":        {},
+		"subscription_id":            {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_express_route_connection.test", checkedFields),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_express_route_connection.test", tfjsonpath.New("name"), tfjsonpath.New("name")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_express_route_connection.test", tfjsonpath.New("express_route_gateway_name"), tfjsonpath.New("express_route_gateway_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_express_route_connection.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("express_route_gateway_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_express_route_connection.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("express_route_gateway_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/expressrouteports"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
@@ -25,6 +26,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name express_route_port -service-package-name network -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 var expressRoutePortSchema = &pluginsdk.Schema{
 	Type: pluginsdk.TypeList,
 	// Service will always create a pair of links automatically. Users can't add or remove link, but only manipulate existing ones.
@@ -101,10 +104,11 @@ func resourceArmExpressRoutePort() *pluginsdk.Resource {
 		Update: resourceArmExpressRoutePortUpdate,
 		Delete: resourceArmExpressRoutePortDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := expressrouteports.ParseExpressRoutePortID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&expressrouteports.ExpressRoutePortId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&expressrouteports.ExpressRoutePortId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(120 * time.Minute),
@@ -240,6 +244,9 @@ func resourceArmExpressRoutePortCreate(d *pluginsdk.ResourceData, meta interface
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 
 	return resourceArmExpressRoutePortRead(d, meta)
 }
@@ -377,7 +384,7 @@ func resourceArmExpressRoutePortRead(d *pluginsdk.ResourceData, meta interface{}
 			return err
 		}
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceArmExpressRoutePortDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-09-01/networkgroups"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/adminrulecollections"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -17,6 +18,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name network_manager_admin_rule_collection -service-package-name network -properties "name" -compare-values "subscription_id:security_admin_configuration_id,resource_group_name:security_admin_configuration_id,network_manager_name:security_admin_configuration_id,security_admin_configuration_name:security_admin_configuration_id" -test-resource-type NetworkAdminRuleCollectionResource
+
 type ManagerAdminRuleCollectionModel struct {
 	Name                         string   `tfschema:"name"`
 	SecurityAdminConfigurationId string   `tfschema:"security_admin_configuration_id"`
@@ -27,6 +30,7 @@ type ManagerAdminRuleCollectionModel struct {
 type ManagerAdminRuleCollectionResource struct{}
 
 var _ sdk.ResourceWithUpdate = ManagerAdminRuleCollectionResource{}
+var _ sdk.ResourceWithIdentity = ManagerAdminRuleCollectionResource{}
 
 func (r ManagerAdminRuleCollectionResource) ResourceType() string {
 	return "azurerm_network_manager_admin_rule_collection"
@@ -40,6 +44,10 @@ func (r ManagerAdminRuleCollectionResource) IDValidationFunc() pluginsdk.SchemaV
 	return adminrulecollections.ValidateSecurityAdminConfigurationRuleCollectionID
 }
 
+func (r ManagerAdminRuleCollectionResource) Identity() resourceids.ResourceId {
+	return &adminrulecollections.SecurityAdminConfigurationRuleCollectionId{}
+}
+
 func (r ManagerAdminRuleCollectionResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -118,7 +126,7 @@ func (r ManagerAdminRuleCollectionResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -208,6 +216,10 @@ func (r ManagerAdminRuleCollectionResource) Read() sdk.ResourceFunc {
 				state.Description = *properties.Description
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
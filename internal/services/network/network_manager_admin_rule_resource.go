@@ -10,12 +10,15 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/adminrules"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name network_manager_admin_rule -service-package-name network -properties "name" -compare-values "subscription_id:admin_rule_collection_id,resource_group_name:admin_rule_collection_id,network_manager_name:admin_rule_collection_id,security_admin_configuration_name:admin_rule_collection_id,rule_collection_name:admin_rule_collection_id" -test-resource-type ManagerAdminRuleResource
+
 type ManagerAdminRuleModel struct {
 	Name                    string                                        `tfschema:"name"`
 	NetworkRuleCollectionId string                                        `tfschema:"admin_rule_collection_id"`
@@ -38,6 +41,7 @@ type AddressPrefixItemModel struct {
 type ManagerAdminRuleResource struct{}
 
 var _ sdk.ResourceWithUpdate = ManagerAdminRuleResource{}
+var _ sdk.ResourceWithIdentity = ManagerAdminRuleResource{}
 
 func (r ManagerAdminRuleResource) ResourceType() string {
 	return "azurerm_network_manager_admin_rule"
@@ -51,6 +55,10 @@ func (r ManagerAdminRuleResource) IDValidationFunc() pluginsdk.SchemaValidateFun
 	return adminrules.ValidateSecurityAdminConfigurationRuleCollectionRuleID
 }
 
+func (r ManagerAdminRuleResource) Identity() resourceids.ResourceId {
+	return &adminrules.SecurityAdminConfigurationRuleCollectionRuleId{}
+}
+
 func (r ManagerAdminRuleResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -229,7 +237,7 @@ func (r ManagerAdminRuleResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -376,6 +384,10 @@ func (r ManagerAdminRuleResource) Read() sdk.ResourceFunc {
 				state.SourcePortRanges = *properties.SourcePortRanges
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
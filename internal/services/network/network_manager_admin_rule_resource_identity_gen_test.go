@@ -0,0 +1,44 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccNetworkManagerAdminRule_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_admin_rule", "test")
+	r := ManagerAdminRuleResource{}
+
+	checkedFields := map[string]struct{}{
+		"name":                              {},
+		"network_manager_name":              {},
+		"resource_group_name":               {},
+		"rule_collection_name":              {},
+		"security_admin_configuration_name": {},
+		"subscription_id":                   {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_network_manager_admin_rule.test", checkedFields),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_network_manager_admin_rule.test", tfjsonpath.New("name"), tfjsonpath.New("name")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_network_manager_admin_rule.test", tfjsonpath.New("network_manager_name"), tfjsonpath.New("admin_rule_collection_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_network_manager_admin_rule.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("admin_rule_collection_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_network_manager_admin_rule.test", tfjsonpath.New("rule_collection_name"), tfjsonpath.New("admin_rule_collection_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_network_manager_admin_rule.test", tfjsonpath.New("security_admin_configuration_name"), tfjsonpath.New("admin_rule_collection_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_network_manager_admin_rule.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("admin_rule_collection_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/ipampools"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -21,7 +22,10 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name network_manager_ipam_pool -service-package-name network -properties "name" -compare-values "subscription_id:network_manager_id,resource_group_name:network_manager_id,network_manager_name:network_manager_id" -test-resource-type ManagerIpamPoolResource
+
 var _ sdk.ResourceWithUpdate = ManagerIpamPoolResource{}
+var _ sdk.ResourceWithIdentity = ManagerIpamPoolResource{}
 
 type ManagerIpamPoolResource struct{}
 
@@ -29,6 +33,10 @@ func (ManagerIpamPoolResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return ipampools.ValidateIPamPoolID
 }
 
+func (ManagerIpamPoolResource) Identity() resourceids.ResourceId {
+	return &ipampools.IPamPoolId{}
+}
+
 func (ManagerIpamPoolResource) ResourceType() string {
 	return "azurerm_network_manager_ipam_pool"
 }
@@ -151,6 +159,9 @@ func (r ManagerIpamPoolResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			return nil
 		},
@@ -194,6 +205,10 @@ func (r ManagerIpamPoolResource) Read() sdk.ResourceFunc {
 				schema.ParentPoolName = pointer.From(props.ParentPoolName)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&schema)
 		},
 	}
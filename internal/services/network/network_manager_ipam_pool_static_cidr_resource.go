@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/staticcidrs"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
@@ -19,7 +20,10 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name network_manager_ipam_pool_static_cidr -service-package-name network -properties "name" -compare-values "subscription_id:ipam_pool_id,resource_group_name:ipam_pool_id,network_manager_name:ipam_pool_id,ipam_pool_name:ipam_pool_id" -test-resource-type ManagerIpamPoolStaticCidrResource
+
 var _ sdk.ResourceWithUpdate = ManagerIpamPoolStaticCidrResource{}
+var _ sdk.ResourceWithIdentity = ManagerIpamPoolStaticCidrResource{}
 
 type ManagerIpamPoolStaticCidrResource struct{}
 
@@ -27,6 +31,10 @@ func (ManagerIpamPoolStaticCidrResource) IDValidationFunc() pluginsdk.SchemaVali
 	return staticcidrs.ValidateStaticCidrID
 }
 
+func (ManagerIpamPoolStaticCidrResource) Identity() resourceids.ResourceId {
+	return &staticcidrs.StaticCidrId{}
+}
+
 func (ManagerIpamPoolStaticCidrResource) ResourceType() string {
 	return "azurerm_network_manager_ipam_pool_static_cidr"
 }
@@ -143,6 +151,9 @@ func (r ManagerIpamPoolStaticCidrResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			return nil
 		},
@@ -182,6 +193,10 @@ func (r ManagerIpamPoolStaticCidrResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&schema)
 		},
 	}
@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccNetworkManagerIpamPoolStaticCidr_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_ipam_pool_static_cidr", "test")
+	r := ManagerIpamPoolStaticCidrResource{}
+
+	checkedFields := map[string]struct{}{
+		"name":                 {},
+		"ipam_pool_name":       {},
+		"network_manager_name": {},
+		"resource_group_name":  {},
+		"subscription_id":      {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_network_manager_ipam_pool_static_cidr.test", checkedFields),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_network_manager_ipam_pool_static_cidr.test", tfjsonpath.New("name"), tfjsonpath.New("name")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_network_manager_ipam_pool_static_cidr.test", tfjsonpath.New("ipam_pool_name"), tfjsonpath.New("ipam_pool_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_network_manager_ipam_pool_static_cidr.test", tfjsonpath.New("network_manager_name"), tfjsonpath.New("ipam_pool_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_network_manager_ipam_pool_static_cidr.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("ipam_pool_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_network_manager_ipam_pool_static_cidr.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("ipam_pool_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
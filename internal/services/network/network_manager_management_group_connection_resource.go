@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-09-01/networkmanagers"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/networkmanagerconnections"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -18,6 +19,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name network_manager_management_group_connection -service-package-name network -properties "name,management_group_id" -no-subscription-id -test-resource-type ManagerManagementGroupConnectionResource
+
 type ManagerManagementGroupConnectionModel struct {
 	Name              string `tfschema:"name"`
 	ManagementGroupId string `tfschema:"management_group_id"`
@@ -29,6 +32,7 @@ type ManagerManagementGroupConnectionModel struct {
 type ManagerManagementGroupConnectionResource struct{}
 
 var _ sdk.ResourceWithUpdate = ManagerManagementGroupConnectionResource{}
+var _ sdk.ResourceWithIdentity = ManagerManagementGroupConnectionResource{}
 
 func (r ManagerManagementGroupConnectionResource) ResourceType() string {
 	return "azurerm_network_manager_management_group_connection"
@@ -42,6 +46,10 @@ func (r ManagerManagementGroupConnectionResource) IDValidationFunc() pluginsdk.S
 	return networkmanagerconnections.ValidateProviders2NetworkManagerConnectionID
 }
 
+func (r ManagerManagementGroupConnectionResource) Identity() resourceids.ResourceId {
+	return &networkmanagerconnections.Providers2NetworkManagerConnectionId{}
+}
+
 func (r ManagerManagementGroupConnectionResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -124,7 +132,7 @@ func (r ManagerManagementGroupConnectionResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -222,6 +230,10 @@ func (r ManagerManagementGroupConnectionResource) Read() sdk.ResourceFunc {
 				state.NetworkManagerId = *properties.NetworkManagerId
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
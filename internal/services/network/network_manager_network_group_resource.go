@@ -10,12 +10,15 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/networkgroups"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name network_manager_network_group -service-package-name network -properties "name" -compare-values "subscription_id:network_manager_id,resource_group_name:network_manager_id,network_manager_name:network_manager_id" -test-resource-type ManagerNetworkGroupResource
+
 type ManagerNetworkGroupModel struct {
 	Name             string `tfschema:"name"`
 	NetworkManagerId string `tfschema:"network_manager_id"`
@@ -26,6 +29,7 @@ type ManagerNetworkGroupModel struct {
 type ManagerNetworkGroupResource struct{}
 
 var _ sdk.ResourceWithUpdate = ManagerNetworkGroupResource{}
+var _ sdk.ResourceWithIdentity = ManagerNetworkGroupResource{}
 
 func (r ManagerNetworkGroupResource) ResourceType() string {
 	return "azurerm_network_manager_network_group"
@@ -39,6 +43,10 @@ func (r ManagerNetworkGroupResource) IDValidationFunc() pluginsdk.SchemaValidate
 	return networkgroups.ValidateNetworkGroupID
 }
 
+func (r ManagerNetworkGroupResource) Identity() resourceids.ResourceId {
+	return &networkgroups.NetworkGroupId{}
+}
+
 func (r ManagerNetworkGroupResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -113,7 +121,7 @@ func (r ManagerNetworkGroupResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -201,6 +209,10 @@ func (r ManagerNetworkGroupResource) Read() sdk.ResourceFunc {
 				MemberType:       pointer.FromEnum(properties.MemberType),
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
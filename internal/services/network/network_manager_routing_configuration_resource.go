@@ -12,13 +12,17 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/networkmanagerroutingconfigurations"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name network_manager_routing_configuration -service-package-name network -properties "name" -compare-values "subscription_id:network_manager_id,resource_group_name:network_manager_id,network_manager_name:network_manager_id" -test-resource-type ManagerRoutingConfigurationResource
+
 var _ sdk.ResourceWithUpdate = ManagerRoutingConfigurationResource{}
+var _ sdk.ResourceWithIdentity = ManagerRoutingConfigurationResource{}
 
 type ManagerRoutingConfigurationResource struct{}
 
@@ -26,6 +30,10 @@ func (ManagerRoutingConfigurationResource) IDValidationFunc() pluginsdk.SchemaVa
 	return networkmanagerroutingconfigurations.ValidateRoutingConfigurationID
 }
 
+func (ManagerRoutingConfigurationResource) Identity() resourceids.ResourceId {
+	return &networkmanagerroutingconfigurations.RoutingConfigurationId{}
+}
+
 func (ManagerRoutingConfigurationResource) ResourceType() string {
 	return "azurerm_network_manager_routing_configuration"
 }
@@ -114,6 +122,9 @@ func (r ManagerRoutingConfigurationResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			return nil
 		},
@@ -151,6 +162,10 @@ func (r ManagerRoutingConfigurationResource) Read() sdk.ResourceFunc {
 				schema.RouteTableUsageMode = pointer.FromEnum(model.Properties.RouteTableUsageMode)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&schema)
 		},
 	}
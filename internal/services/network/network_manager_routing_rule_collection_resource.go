@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-09-01/networkgroups"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/routingrulecollections"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -19,7 +20,10 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name network_manager_routing_rule_collection -service-package-name network -properties "name" -compare-values "subscription_id:routing_configuration_id,resource_group_name:routing_configuration_id,network_manager_name:routing_configuration_id,routing_configuration_name:routing_configuration_id" -test-resource-type ManagerRoutingRuleCollectionResource
+
 var _ sdk.ResourceWithUpdate = ManagerRoutingRuleCollectionResource{}
+var _ sdk.ResourceWithIdentity = ManagerRoutingRuleCollectionResource{}
 
 type ManagerRoutingRuleCollectionResource struct{}
 
@@ -27,6 +31,10 @@ func (ManagerRoutingRuleCollectionResource) IDValidationFunc() pluginsdk.SchemaV
 	return routingrulecollections.ValidateRuleCollectionID
 }
 
+func (ManagerRoutingRuleCollectionResource) Identity() resourceids.ResourceId {
+	return &routingrulecollections.RuleCollectionId{}
+}
+
 func (ManagerRoutingRuleCollectionResource) ResourceType() string {
 	return "azurerm_network_manager_routing_rule_collection"
 }
@@ -122,6 +130,9 @@ func (r ManagerRoutingRuleCollectionResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			return nil
 		},
@@ -167,6 +178,10 @@ func (r ManagerRoutingRuleCollectionResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&schema)
 		},
 	}
@@ -10,12 +10,15 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/securityadminconfigurations"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name network_manager_security_admin_configuration -service-package-name network -properties "name" -compare-values "subscription_id:network_manager_id,resource_group_name:network_manager_id,network_manager_name:network_manager_id" -test-resource-type ManagerSecurityAdminConfigurationResource
+
 type ManagerSecurityAdminConfigurationModel struct {
 	Name                                    string   `tfschema:"name"`
 	NetworkManagerId                        string   `tfschema:"network_manager_id"`
@@ -26,6 +29,7 @@ type ManagerSecurityAdminConfigurationModel struct {
 type ManagerSecurityAdminConfigurationResource struct{}
 
 var _ sdk.ResourceWithUpdate = ManagerSecurityAdminConfigurationResource{}
+var _ sdk.ResourceWithIdentity = ManagerSecurityAdminConfigurationResource{}
 
 func (r ManagerSecurityAdminConfigurationResource) ResourceType() string {
 	return "azurerm_network_manager_security_admin_configuration"
@@ -39,6 +43,10 @@ func (r ManagerSecurityAdminConfigurationResource) IDValidationFunc() pluginsdk.
 	return securityadminconfigurations.ValidateSecurityAdminConfigurationID
 }
 
+func (r ManagerSecurityAdminConfigurationResource) Identity() resourceids.ResourceId {
+	return &securityadminconfigurations.SecurityAdminConfigurationId{}
+}
+
 func (r ManagerSecurityAdminConfigurationResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -121,7 +129,7 @@ func (r ManagerSecurityAdminConfigurationResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -216,6 +224,10 @@ func (r ManagerSecurityAdminConfigurationResource) Read() sdk.ResourceFunc {
 				state.Description = *properties.Description
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
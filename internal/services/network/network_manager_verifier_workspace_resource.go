@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/verifierworkspaces"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -21,7 +22,10 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name network_manager_verifier_workspace -service-package-name network -properties "name" -compare-values "subscription_id:network_manager_id,resource_group_name:network_manager_id,network_manager_name:network_manager_id" -test-resource-type ManagerVerifierWorkspaceResource
+
 var _ sdk.ResourceWithUpdate = ManagerVerifierWorkspaceResource{}
+var _ sdk.ResourceWithIdentity = ManagerVerifierWorkspaceResource{}
 
 type ManagerVerifierWorkspaceResource struct{}
 
@@ -29,6 +33,10 @@ func (ManagerVerifierWorkspaceResource) IDValidationFunc() pluginsdk.SchemaValid
 	return verifierworkspaces.ValidateVerifierWorkspaceID
 }
 
+func (ManagerVerifierWorkspaceResource) Identity() resourceids.ResourceId {
+	return &verifierworkspaces.VerifierWorkspaceId{}
+}
+
 func (ManagerVerifierWorkspaceResource) ResourceType() string {
 	return "azurerm_network_manager_verifier_workspace"
 }
@@ -116,6 +124,9 @@ func (r ManagerVerifierWorkspaceResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			return nil
 		},
@@ -157,6 +168,10 @@ func (r ManagerVerifierWorkspaceResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&schema)
 		},
 	}
@@ -16,12 +16,19 @@ import (
 
 type NetworkSecurityGroupListResource struct{}
 
-var _ sdk.FrameworkListWrappedResource = new(NetworkSecurityGroupListResource)
+var (
+	_ sdk.FrameworkListWrappedResource                  = new(NetworkSecurityGroupListResource)
+	_ sdk.FrameworkListWrappedResourceWithResourceGraph = new(NetworkSecurityGroupListResource)
+)
 
 func (r NetworkSecurityGroupListResource) ResourceFunc() *pluginsdk.Resource {
 	return resourceNetworkSecurityGroup()
 }
 
+func (r NetworkSecurityGroupListResource) ResourceGraphResourceType() string {
+	return "Microsoft.Network/networkSecurityGroups"
+}
+
 func (r NetworkSecurityGroupListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
 	response.TypeName = networkSecurityGroupResourceName
 }
@@ -45,7 +52,7 @@ func (r NetworkSecurityGroupListResource) List(ctx context.Context, request list
 
 	switch {
 	case !data.ResourceGroupName.IsNull():
-		resp, err := client.ListComplete(ctx, commonids.NewResourceGroupID(subscriptionID, data.ResourceGroupName.ValueString()))
+		resp, err := client.ListComplete(sdk.ListPageLimitContext(ctx, request), commonids.NewResourceGroupID(subscriptionID, data.ResourceGroupName.ValueString()))
 		if err != nil {
 			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", networkSecurityGroupResourceName), err)
 			return
@@ -53,7 +60,7 @@ func (r NetworkSecurityGroupListResource) List(ctx context.Context, request list
 
 		results = resp.Items
 	default:
-		resp, err := client.ListAllComplete(ctx, commonids.NewSubscriptionID(subscriptionID))
+		resp, err := client.ListAllComplete(sdk.ListPageLimitContext(ctx, request), commonids.NewSubscriptionID(subscriptionID))
 		if err != nil {
 			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", networkSecurityGroupResourceName), err)
 			return
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/networksecurityperimeteraccessrules"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/networksecurityperimeterprofiles"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -19,7 +20,10 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name network_security_perimeter_access_rule -service-package-name network -properties "name" -compare-values "subscription_id:network_security_perimeter_profile_id,resource_group_name:network_security_perimeter_profile_id,network_security_perimeter_name:network_security_perimeter_profile_id,profile_name:network_security_perimeter_profile_id" -test-resource-type NetworkSecurityPerimeterAccessRuleTestResource
+
 var _ sdk.Resource = NetworkSecurityPerimeterAccessRuleResource{}
+var _ sdk.ResourceWithIdentity = NetworkSecurityPerimeterAccessRuleResource{}
 
 type NetworkSecurityPerimeterAccessRuleResource struct{}
 
@@ -194,7 +198,7 @@ func (r NetworkSecurityPerimeterAccessRuleResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -288,6 +292,10 @@ func (NetworkSecurityPerimeterAccessRuleResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -317,6 +325,10 @@ func (NetworkSecurityPerimeterAccessRuleResource) IDValidationFunc() pluginsdk.S
 	return networksecurityperimeteraccessrules.ValidateAccessRuleID
 }
 
+func (NetworkSecurityPerimeterAccessRuleResource) Identity() resourceids.ResourceId {
+	return &networksecurityperimeteraccessrules.AccessRuleId{}
+}
+
 func expandAccessRuleSubscriptionIDs(subscriptionIDs []string) *[]networksecurityperimeteraccessrules.SubscriptionId {
 	if len(subscriptionIDs) == 0 {
 		return nil
@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccNetworkSecurityPerimeterAccessRule_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_security_perimeter_access_rule", "test")
+	r := NetworkSecurityPerimeterAccessRuleTestResource{}
+
+	checkedFields := map[string]struct{}{
+		"name":                            {},
+		"network_security_perimeter_name": {},
+		"profile_name":                    {},
+		"resource_group_name":             {},
+		"subscription_id":                 {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_network_security_perimeter_access_rule.test", checkedFields),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_network_security_perimeter_access_rule.test", tfjsonpath.New("name"), tfjsonpath.New("name")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_network_security_perimeter_access_rule.test", tfjsonpath.New("network_security_perimeter_name"), tfjsonpath.New("network_security_perimeter_profile_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_network_security_perimeter_access_rule.test", tfjsonpath.New("profile_name"), tfjsonpath.New("network_security_perimeter_profile_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_network_security_perimeter_access_rule.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("network_security_perimeter_profile_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_network_security_perimeter_access_rule.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("network_security_perimeter_profile_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
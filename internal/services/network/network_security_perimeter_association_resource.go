@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/networksecurityperimeterassociations"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/networksecurityperimeterprofiles"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
@@ -19,7 +20,10 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name network_security_perimeter_association -service-package-name network -properties "name" -compare-values "subscription_id:network_security_perimeter_profile_id,resource_group_name:network_security_perimeter_profile_id,network_security_perimeter_name:network_security_perimeter_profile_id" -test-resource-type NetworkSecurityPerimeterAssociationTestResource
+
 var _ sdk.Resource = NetworkSecurityPerimeterAssociationResource{}
+var _ sdk.ResourceWithIdentity = NetworkSecurityPerimeterAssociationResource{}
 
 type NetworkSecurityPerimeterAssociationResource struct{}
 
@@ -125,7 +129,7 @@ func (r NetworkSecurityPerimeterAssociationResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -211,6 +215,10 @@ func (NetworkSecurityPerimeterAssociationResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -240,3 +248,7 @@ func (NetworkSecurityPerimeterAssociationResource) Delete() sdk.ResourceFunc {
 func (NetworkSecurityPerimeterAssociationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return networksecurityperimeterassociations.ValidateResourceAssociationID
 }
+
+func (NetworkSecurityPerimeterAssociationResource) Identity() resourceids.ResourceId {
+	return &networksecurityperimeterassociations.ResourceAssociationId{}
+}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/networksecurityperimeterprofiles"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/networksecurityperimeters"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -17,7 +18,10 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name network_security_perimeter_profile -service-package-name network -properties "name" -compare-values "subscription_id:network_security_perimeter_id,resource_group_name:network_security_perimeter_id,network_security_perimeter_name:network_security_perimeter_id" -test-resource-type NetworkSecurityPerimeterProfileTestResource
+
 var _ sdk.Resource = NetworkSecurityPerimeterProfileResource{}
+var _ sdk.ResourceWithIdentity = NetworkSecurityPerimeterProfileResource{}
 
 type NetworkSecurityPerimeterProfileResource struct{}
 
@@ -93,7 +97,7 @@ func (r NetworkSecurityPerimeterProfileResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -126,6 +130,10 @@ func (NetworkSecurityPerimeterProfileResource) Read() sdk.ResourceFunc {
 				PerimeterId: perimeterId.ID(),
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -154,3 +162,7 @@ func (NetworkSecurityPerimeterProfileResource) Delete() sdk.ResourceFunc {
 func (NetworkSecurityPerimeterProfileResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return networksecurityperimeterprofiles.ValidateProfileID
 }
+
+func (NetworkSecurityPerimeterProfileResource) Identity() resourceids.ResourceId {
+	return &networksecurityperimeterprofiles.ProfileId{}
+}
@@ -13,13 +13,17 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/networksecurityperimeters"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name network_security_perimeter -service-package-name network -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type NetworkSecurityPerimeterTestResource
+
 var _ sdk.Resource = NetworkSecurityPerimeterResource{}
+var _ sdk.ResourceWithIdentity = NetworkSecurityPerimeterResource{}
 
 type NetworkSecurityPerimeterResource struct{}
 
@@ -94,7 +98,7 @@ func (r NetworkSecurityPerimeterResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -161,6 +165,10 @@ func (NetworkSecurityPerimeterResource) Read() sdk.ResourceFunc {
 				state.Location = location.Normalize(model.Location)
 				state.Tags = pointer.From(model.Tags)
 			}
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -189,3 +197,7 @@ func (NetworkSecurityPerimeterResource) Delete() sdk.ResourceFunc {
 func (NetworkSecurityPerimeterResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return networksecurityperimeters.ValidateNetworkSecurityPerimeterID
 }
+
+func (NetworkSecurityPerimeterResource) Identity() resourceids.ResourceId {
+	return &networksecurityperimeters.NetworkSecurityPerimeterId{}
+}
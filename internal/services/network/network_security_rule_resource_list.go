@@ -70,7 +70,7 @@ func (r NetworkSecurityRuleListResource) List(ctx context.Context, request list.
 			return
 		}
 
-		resp, err := client.ListComplete(ctx, *networksecuritygroupId)
+		resp, err := client.ListComplete(sdk.ListPageLimitContext(ctx, request), *networksecuritygroupId)
 		if err != nil {
 			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", "azurerm_network_security_rule"), err)
 			return
@@ -13,22 +13,27 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/networkwatchers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name network_watcher -service-package-name network -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-name basicConfig
+
 func resourceNetworkWatcher() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceNetworkWatcherCreate,
 		Read:   resourceNetworkWatcherRead,
 		Update: resourceNetworkWatcherUpdate,
 		Delete: resourceNetworkWatcherDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := networkwatchers.ParseNetworkWatcherID(id)
-			return err
-		}),
+
+		Importer: pluginsdk.ImporterValidatingIdentity(&networkwatchers.NetworkWatcherId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&networkwatchers.NetworkWatcherId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -82,6 +87,9 @@ func resourceNetworkWatcherCreate(d *pluginsdk.ResourceData, meta interface{}) e
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 
 	return resourceNetworkWatcherRead(d, meta)
 }
@@ -149,7 +157,7 @@ func resourceNetworkWatcherRead(d *pluginsdk.ResourceData, meta interface{}) err
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceNetworkWatcherDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -19,12 +19,19 @@ import (
 
 type PublicIpListResource struct{}
 
-var _ sdk.FrameworkListWrappedResource = new(PublicIpListResource)
+var (
+	_ sdk.FrameworkListWrappedResource                  = new(PublicIpListResource)
+	_ sdk.FrameworkListWrappedResourceWithResourceGraph = new(PublicIpListResource)
+)
 
 func (r PublicIpListResource) ResourceFunc() *pluginsdk.Resource {
 	return resourcePublicIp()
 }
 
+func (r PublicIpListResource) ResourceGraphResourceType() string {
+	return "Microsoft.Network/publicIPAddresses"
+}
+
 func (r PublicIpListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
 	response.TypeName = "azurerm_public_ip"
 }
@@ -46,7 +53,7 @@ func (r PublicIpListResource) List(ctx context.Context, request list.ListRequest
 
 	switch {
 	case !data.ResourceGroupName.IsNull():
-		resp, err := client.ListComplete(ctx, commonids.NewResourceGroupID(subscriptionID, data.ResourceGroupName.ValueString()))
+		resp, err := client.ListComplete(sdk.ListPageLimitContext(ctx, request), commonids.NewResourceGroupID(subscriptionID, data.ResourceGroupName.ValueString()))
 		if err != nil {
 			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", `azurerm_public_ip`), err)
 			return
@@ -54,7 +61,7 @@ func (r PublicIpListResource) List(ctx context.Context, request list.ListRequest
 
 		results = resp.Items
 	default:
-		resp, err := client.ListAllComplete(ctx, commonids.NewSubscriptionID(subscriptionID))
+		resp, err := client.ListAllComplete(sdk.ListPageLimitContext(ctx, request), commonids.NewSubscriptionID(subscriptionID))
 		if err != nil {
 			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", `azurerm_public_ip`), err)
 			return
@@ -70,7 +70,7 @@ func (r RouteListResource) List(ctx context.Context, request list.ListRequest, s
 			return
 		}
 
-		resp, err := client.ListComplete(ctx, *routetableId)
+		resp, err := client.ListComplete(sdk.ListPageLimitContext(ctx, request), *routetableId)
 		if err != nil {
 			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", "azurerm_route"), err)
 			return
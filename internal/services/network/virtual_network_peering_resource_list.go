@@ -71,7 +71,7 @@ func (r VirtualNetworkPeeringListResource) List(ctx context.Context, request lis
 			return
 		}
 
-		resp, err := client.ListComplete(ctx, *virtualnetworkId)
+		resp, err := client.ListComplete(sdk.ListPageLimitContext(ctx, request), *virtualnetworkId)
 		if err != nil {
 			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", "azurerm_virtual_network_peering"), err)
 			return
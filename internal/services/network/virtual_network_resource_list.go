@@ -63,7 +63,7 @@ func (r VirtualNetworkListResource) List(ctx context.Context, request list.ListR
 		return
 	}
 
-	resp, err := client.ListComplete(ctx, commonids.NewResourceGroupID(metadata.SubscriptionId, data.ResourceGroupName.ValueString()))
+	resp, err := client.ListComplete(sdk.ListPageLimitContext(ctx, request), commonids.NewResourceGroupID(metadata.SubscriptionId, data.ResourceGroupName.ValueString()))
 	if err != nil {
 		sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing %s", VirtualNetworkResourceName), err)
 		return
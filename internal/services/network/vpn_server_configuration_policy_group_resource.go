@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/virtualwans"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
@@ -19,6 +20,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name vpn_server_configuration_policy_group -service-package-name network -properties "name" -compare-values "subscription_id:vpn_server_configuration_id,resource_group_name:vpn_server_configuration_id,vpn_server_configuration_name:vpn_server_configuration_id" -test-resource-type VPNServerConfigurationPolicyGroupResource
+
 func resourceVPNServerConfigurationPolicyGroup() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceVPNServerConfigurationPolicyGroupCreate,
@@ -26,10 +29,11 @@ func resourceVPNServerConfigurationPolicyGroup() *pluginsdk.Resource {
 		Update: resourceVPNServerConfigurationPolicyGroupUpdate,
 		Delete: resourceVPNServerConfigurationPolicyGroupDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := virtualwans.ParseConfigurationPolicyGroupID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&virtualwans.ConfigurationPolicyGroupId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&virtualwans.ConfigurationPolicyGroupId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -139,6 +143,10 @@ func resourceVPNServerConfigurationPolicyGroupCreate(d *pluginsdk.ResourceData,
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
+
 	return resourceVPNServerConfigurationPolicyGroupRead(d, meta)
 }
 
@@ -178,7 +186,7 @@ func resourceVPNServerConfigurationPolicyGroupRead(d *pluginsdk.ResourceData, me
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceVPNServerConfigurationPolicyGroupUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
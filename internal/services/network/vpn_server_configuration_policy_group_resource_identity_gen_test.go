@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccVpnServerConfigurationPolicyGroup_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_vpn_server_configuration_policy_group", "test")
+	r := VPNServerConfigurationPolicyGroupResource{}
+
+	checkedFields := map[string]struct{}{
+		"name":                          {},
+		"resource_group_name":           {},
+		"subscription_id":               {},
+		"vpn_server_configuration_name": {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_vpn_server_configuration_policy_group.test", checkedFields),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_vpn_server_configuration_policy_group.test", tfjsonpath.New("name"), tfjsonpath.New("name")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_vpn_server_configuration_policy_group.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("vpn_server_configuration_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_vpn_server_configuration_policy_group.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("vpn_server_configuration_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_vpn_server_configuration_policy_group.test", tfjsonpath.New("vpn_server_configuration_name"), tfjsonpath.New("vpn_server_configuration_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
@@ -12,12 +12,15 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/networkfunction/2022-11-01/azuretrafficcollectors"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name network_function_azure_traffic_collector -service-package-name networkfunction -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 type NetworkFunctionAzureTrafficCollectorModel struct {
 	Name              string            `tfschema:"name"`
 	ResourceGroupName string            `tfschema:"resource_group_name"`
@@ -30,6 +33,7 @@ type NetworkFunctionAzureTrafficCollectorModel struct {
 type NetworkFunctionAzureTrafficCollectorResource struct{}
 
 var _ sdk.ResourceWithUpdate = NetworkFunctionAzureTrafficCollectorResource{}
+var _ sdk.ResourceWithIdentity = NetworkFunctionAzureTrafficCollectorResource{}
 
 func (r NetworkFunctionAzureTrafficCollectorResource) ResourceType() string {
 	return "azurerm_network_function_azure_traffic_collector"
@@ -43,6 +47,10 @@ func (r NetworkFunctionAzureTrafficCollectorResource) IDValidationFunc() plugins
 	return azuretrafficcollectors.ValidateAzureTrafficCollectorID
 }
 
+func (r NetworkFunctionAzureTrafficCollectorResource) Identity() resourceids.ResourceId {
+	return &azuretrafficcollectors.AzureTrafficCollectorId{}
+}
+
 func (r NetworkFunctionAzureTrafficCollectorResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -115,7 +123,7 @@ func (r NetworkFunctionAzureTrafficCollectorResource) Create() sdk.ResourceFunc
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -196,6 +204,10 @@ func (r NetworkFunctionAzureTrafficCollectorResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
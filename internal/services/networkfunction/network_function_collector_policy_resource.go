@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/networkfunction/2022-11-01/azuretrafficcollectors"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/networkfunction/2022-11-01/collectorpolicies"
@@ -23,6 +24,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name network_function_collector_policy -service-package-name networkfunction -properties "name" -compare-values "subscription_id:traffic_collector_id,resource_group_name:traffic_collector_id,azure_traffic_collector_name:traffic_collector_id"
+
 type NetworkFunctionCollectorPolicyModel struct {
 	Name                                   string                 `tfschema:"name"`
 	NetworkFunctionAzureTrafficCollectorId string                 `tfschema:"traffic_collector_id"`
@@ -43,6 +46,7 @@ type IpfxIngestionModel struct {
 type NetworkFunctionCollectorPolicyResource struct{}
 
 var _ sdk.ResourceWithUpdate = NetworkFunctionCollectorPolicyResource{}
+var _ sdk.ResourceWithIdentity = NetworkFunctionCollectorPolicyResource{}
 
 func (r NetworkFunctionCollectorPolicyResource) ResourceType() string {
 	return "azurerm_network_function_collector_policy"
@@ -56,6 +60,10 @@ func (r NetworkFunctionCollectorPolicyResource) IDValidationFunc() pluginsdk.Sch
 	return collectorpolicies.ValidateCollectorPolicyID
 }
 
+func (r NetworkFunctionCollectorPolicyResource) Identity() resourceids.ResourceId {
+	return &collectorpolicies.CollectorPolicyId{}
+}
+
 func (r NetworkFunctionCollectorPolicyResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -170,7 +178,7 @@ func (r NetworkFunctionCollectorPolicyResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -251,6 +259,10 @@ func (r NetworkFunctionCollectorPolicyResource) Read() sdk.ResourceFunc {
 				state.Tags = tags.Flatten(model.Tags)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package networkfunction_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccNetworkFunctionCollectorPolicy_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_function_collector_policy", "test")
+	r := NetworkFunctionCollectorPolicyResource{}
+
+	checkedFields := map[string]struct{}{
+		"name":                         {},
+		"azure_traffic_collector_name": {},
+		"resource_group_name":          {},
+		"subscription_id":              {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_network_function_collector_policy.test", checkedFields),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_network_function_collector_policy.test", tfjsonpath.New("name"), tfjsonpath.New("name")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_network_function_collector_policy.test", tfjsonpath.New("azure_traffic_collector_name"), tfjsonpath.New("traffic_collector_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_network_function_collector_policy.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("traffic_collector_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_network_function_collector_policy.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("traffic_collector_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/keyvault"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/nginx/2024-11-01-preview/nginxcertificate"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/nginx/2024-11-01-preview/nginxdeployment"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
@@ -19,6 +20,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name nginx_certificate -service-package-name nginx -properties "name" -compare-values "subscription_id:nginx_deployment_id,resource_group_name:nginx_deployment_id,nginx_deployment_name:nginx_deployment_id" -test-resource-type CertificateResource
+
 type CertificateModel struct {
 	Name                   string `tfschema:"name"`
 	NginxDeploymentId      string `tfschema:"nginx_deployment_id"`
@@ -30,6 +33,7 @@ type CertificateModel struct {
 type CertificateResource struct{}
 
 var _ sdk.ResourceWithUpdate = (*CertificateResource)(nil)
+var _ sdk.ResourceWithIdentity = (*CertificateResource)(nil)
 
 func (m CertificateResource) Arguments() map[string]*pluginsdk.Schema {
 	args := map[string]*pluginsdk.Schema{
@@ -122,7 +126,7 @@ func (m CertificateResource) Create() sdk.ResourceFunc {
 			}
 
 			meta.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(meta.ResourceData, &id)
 		},
 	}
 }
@@ -203,6 +207,10 @@ func (m CertificateResource) Read() sdk.ResourceFunc {
 			output.KeyVirtualPath = pointer.From(prop.KeyVirtualPath)
 			output.KeyVaultSecretId = pointer.From(prop.KeyVaultSecretId)
 			output.CertificateVirtualPath = pointer.From(prop.CertificateVirtualPath)
+			if err := pluginsdk.SetResourceIdentityData(meta.ResourceData, id); err != nil {
+				return err
+			}
+
 			return meta.Encode(&output)
 		},
 	}
@@ -232,3 +240,7 @@ func (m CertificateResource) Delete() sdk.ResourceFunc {
 func (m CertificateResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return nginxcertificate.ValidateCertificateID
 }
+
+func (m CertificateResource) Identity() resourceids.ResourceId {
+	return &nginxcertificate.CertificateId{}
+}
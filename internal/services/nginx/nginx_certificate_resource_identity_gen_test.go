@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package nginx_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccNginxCertificate_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_nginx_certificate", "test")
+	r := CertificateResource{}
+
+	checkedFields := map[string]struct{}{
+		"name":                  {},
+		"nginx_deployment_name": {},
+		"resource_group_name":   {},
+		"subscription_id":       {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_nginx_certificate.test", checkedFields),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_nginx_certificate.test", tfjsonpath.New("name"), tfjsonpath.New("name")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_nginx_certificate.test", tfjsonpath.New("nginx_deployment_name"), tfjsonpath.New("nginx_deployment_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_nginx_certificate.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("nginx_deployment_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_nginx_certificate.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("nginx_deployment_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/nginx/2024-11-01-preview/nginxdeployment"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -20,6 +21,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name nginx_deployment -service-package-name nginx -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type DeploymentResource
+
 type FrontendPrivate struct {
 	IpAddress        string `tfschema:"ip_address"`
 	AllocationMethod string `tfschema:"allocation_method"`
@@ -129,6 +132,7 @@ func expandNetworkProfile(public []FrontendPublic, private []FrontendPrivate, ne
 type DeploymentResource struct{}
 
 var _ sdk.ResourceWithUpdate = (*DeploymentResource)(nil)
+var _ sdk.ResourceWithIdentity = (*DeploymentResource)(nil)
 
 func (m DeploymentResource) Arguments() map[string]*pluginsdk.Schema {
 	resource := map[string]*pluginsdk.Schema{
@@ -473,7 +477,7 @@ func (m DeploymentResource) Create() sdk.ResourceFunc {
 			}
 
 			meta.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(meta.ResourceData, &id)
 		},
 	}
 }
@@ -638,6 +642,10 @@ func (m DeploymentResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(meta.ResourceData, id); err != nil {
+				return err
+			}
+
 			return meta.Encode(&output)
 		},
 	}
@@ -777,3 +785,7 @@ func (m DeploymentResource) Delete() sdk.ResourceFunc {
 func (m DeploymentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return nginxdeployment.ValidateNginxDeploymentID
 }
+
+func (m DeploymentResource) Identity() resourceids.ResourceId {
+	return &nginxdeployment.NginxDeploymentId{}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/notificationhubs/2023-09-01/hubs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
@@ -19,16 +20,20 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name notification_hub_authorization_rule -service-package-name notificationhub -properties "name,resource_group_name,namespace_name,notification_hub_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-name listen
+
 func resourceNotificationHubAuthorizationRule() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceNotificationHubAuthorizationRuleCreateUpdate,
 		Read:   resourceNotificationHubAuthorizationRuleRead,
 		Update: resourceNotificationHubAuthorizationRuleCreateUpdate,
 		Delete: resourceNotificationHubAuthorizationRuleDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := hubs.ParseNotificationHubAuthorizationRuleID(id)
-			return err
-		}),
+
+		Importer: pluginsdk.ImporterValidatingIdentity(&hubs.NotificationHubAuthorizationRuleId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&hubs.NotificationHubAuthorizationRuleId{}),
+		},
 		// TODO: customizeDiff for send+listen when manage selected
 
 		Timeouts: &pluginsdk.ResourceTimeout{
@@ -149,6 +154,10 @@ func resourceNotificationHubAuthorizationRuleCreateUpdate(d *pluginsdk.ResourceD
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
+
 	return resourceNotificationHubAuthorizationRuleRead(d, meta)
 }
 
@@ -199,7 +208,7 @@ func resourceNotificationHubAuthorizationRuleRead(d *pluginsdk.ResourceData, met
 		d.Set("secondary_connection_string", keysModel.SecondaryConnectionString)
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceNotificationHubAuthorizationRuleDelete(d *pluginsdk.ResourceData, meta interface{}) error {
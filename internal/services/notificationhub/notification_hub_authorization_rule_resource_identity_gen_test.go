@@ -0,0 +1,43 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package notificationhub_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccNotificationHubAuthorizationRule_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_notification_hub_authorization_rule", "test")
+	r := NotificationHubAuthorizationRuleResource{}
+
+	checkedFields := map[string]struct{}{
+		"subscription_id":       {},
+		"name":                  {},
+		"namespace_name":        {},
+		"notification_hub_name": {},
+		"resource_group_name":   {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.listen(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_notification_hub_authorization_rule.test", checkedFields),
+				statecheck.ExpectIdentityValue("azurerm_notification_hub_authorization_rule.test", tfjsonpath.New("subscription_id"), knownvalue.StringExact(data.Subscriptions.Primary)),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_notification_hub_authorization_rule.test", tfjsonpath.New("name"), tfjsonpath.New("name")),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_notification_hub_authorization_rule.test", tfjsonpath.New("namespace_name"), tfjsonpath.New("namespace_name")),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_notification_hub_authorization_rule.test", tfjsonpath.New("notification_hub_name"), tfjsonpath.New("notification_hub_name")),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_notification_hub_authorization_rule.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("resource_group_name")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
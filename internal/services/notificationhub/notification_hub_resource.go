@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/notificationhubs/2023-09-01/hubs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/notificationhub/migration"
@@ -24,6 +25,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name notification_hub -service-package-name notificationhub -properties "name,resource_group_name,namespace_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 var notificationHubResourceName = "azurerm_notification_hub"
 
 const (
@@ -40,10 +43,11 @@ func resourceNotificationHub() *pluginsdk.Resource {
 		Update: resourceNotificationHubCreateUpdate,
 		Delete: resourceNotificationHubDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := hubs.ParseNotificationHubID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&hubs.NotificationHubId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&hubs.NotificationHubId{}),
+		},
 
 		SchemaVersion: 1,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
@@ -234,6 +238,10 @@ func resourceNotificationHubCreateUpdate(d *pluginsdk.ResourceData, meta interfa
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
+
 	return resourceNotificationHubRead(d, meta)
 }
 
@@ -310,7 +318,7 @@ func resourceNotificationHubRead(d *pluginsdk.ResourceData, meta interface{}) er
 		return d.Set("tags", tags.Flatten(model.Tags))
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceNotificationHubDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/oracledatabase/2025-09-01/autonomousdatabasebackups"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/oracledatabase/2025-09-01/autonomousdatabases"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -19,7 +20,10 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name oracle_autonomous_database_backup -service-package-name oracle -properties "name" -compare-values "subscription_id:autonomous_database_id,resource_group_name:autonomous_database_id,autonomous_database_name:autonomous_database_id" -test-resource-type AutonomousDatabaseBackupResource
+
 var _ sdk.Resource = AutonomousDatabaseBackupResource{}
+var _ sdk.ResourceWithIdentity = AutonomousDatabaseBackupResource{}
 
 type AutonomousDatabaseBackupResource struct{}
 
@@ -130,7 +134,7 @@ func (r AutonomousDatabaseBackupResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -176,6 +180,10 @@ func (r AutonomousDatabaseBackupResource) Read() sdk.ResourceFunc {
 				state.Type = pointer.FromEnum(props.BackupType)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -251,3 +259,7 @@ func (r AutonomousDatabaseBackupResource) Delete() sdk.ResourceFunc {
 func (r AutonomousDatabaseBackupResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return autonomousdatabasebackups.ValidateAutonomousDatabaseBackupID
 }
+
+func (r AutonomousDatabaseBackupResource) Identity() resourceids.ResourceId {
+	return &autonomousdatabasebackups.AutonomousDatabaseBackupId{}
+}
@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package oracle_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccOracleAutonomousDatabaseBackup_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_oracle_autonomous_database_backup", "test")
+	r := AutonomousDatabaseBackupResource{}
+
+	checkedFields := map[string]struct{}{
+		"name":                     {},
+		"autonomous_database_name": {},
+		"resource_group_name":      {},
+		"subscription_id":          {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_oracle_autonomous_database_backup.test", checkedFields),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_oracle_autonomous_database_backup.test", tfjsonpath.New("name"), tfjsonpath.New("name")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_oracle_autonomous_database_backup.test", tfjsonpath.New("autonomous_database_name"), tfjsonpath.New("autonomous_database_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_oracle_autonomous_database_backup.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("autonomous_database_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_oracle_autonomous_database_backup.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("autonomous_database_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
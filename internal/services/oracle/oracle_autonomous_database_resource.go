@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/oracledatabase/2025-09-01/autonomousdatabases"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/oracle/validate"
@@ -20,7 +21,10 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name oracle_autonomous_database -service-package-name oracle -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type AdbsRegularResource
+
 var _ sdk.Resource = AutonomousDatabaseRegularResource{}
+var _ sdk.ResourceWithIdentity = AutonomousDatabaseRegularResource{}
 
 type AutonomousDatabaseRegularResource struct{}
 
@@ -318,7 +322,7 @@ func (r AutonomousDatabaseRegularResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -471,6 +475,10 @@ func (AutonomousDatabaseRegularResource) Read() sdk.ResourceFunc {
 				state.LongTermBackUpSchedule = FlattenLongTermBackUpScheduleDetails(props.LongTermBackupSchedule)
 				state.AllowedIps = pointer.From(props.WhitelistedIPs)
 			}
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -500,6 +508,10 @@ func (AutonomousDatabaseRegularResource) IDValidationFunc() pluginsdk.SchemaVali
 	return autonomousdatabases.ValidateAutonomousDatabaseID
 }
 
+func (AutonomousDatabaseRegularResource) Identity() resourceids.ResourceId {
+	return &autonomousdatabases.AutonomousDatabaseId{}
+}
+
 func expandAdbsCustomerContacts(customerContactsList []string) []autonomousdatabases.CustomerContact {
 	customerContacts := make([]autonomousdatabases.CustomerContact, 0, len(customerContactsList))
 	for _, customerContact := range customerContactsList {
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/oracledatabase/2025-09-01/cloudexadatainfrastructures"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/oracledatabase/2025-09-01/cloudvmclusters"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -22,7 +23,10 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name oracle_cloud_vm_cluster -service-package-name oracle -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type CloudVmClusterResource
+
 var _ sdk.Resource = CloudVmClusterResource{}
+var _ sdk.ResourceWithIdentity = CloudVmClusterResource{}
 
 type CloudVmClusterResource struct{}
 
@@ -429,7 +433,7 @@ func (r CloudVmClusterResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -541,6 +545,10 @@ func (CloudVmClusterResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -570,6 +578,10 @@ func (CloudVmClusterResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return cloudvmclusters.ValidateCloudVMClusterID
 }
 
+func (CloudVmClusterResource) Identity() resourceids.ResourceId {
+	return &cloudvmclusters.CloudVMClusterId{}
+}
+
 func FlattenDataCollectionOptions(dataCollectionOptions *cloudvmclusters.DataCollectionOptions) []DataCollectionOptionsModel {
 	output := make([]DataCollectionOptionsModel, 0)
 	if dataCollectionOptions != nil {
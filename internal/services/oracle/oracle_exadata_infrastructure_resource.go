@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/zones"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/oracledatabase/2025-09-01/cloudexadatainfrastructures"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -20,7 +21,10 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name oracle_exadata_infrastructure -service-package-name oracle -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type ExadataInfraResource
+
 var _ sdk.Resource = ExadataInfraResource{}
+var _ sdk.ResourceWithIdentity = ExadataInfraResource{}
 
 type ExadataInfraResource struct{}
 
@@ -270,7 +274,7 @@ func (r ExadataInfraResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -353,6 +357,10 @@ func (ExadataInfraResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -382,6 +390,10 @@ func (ExadataInfraResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return cloudexadatainfrastructures.ValidateCloudExadataInfrastructureID
 }
 
+func (ExadataInfraResource) Identity() resourceids.ResourceId {
+	return &cloudexadatainfrastructures.CloudExadataInfrastructureId{}
+}
+
 func ExpandCustomerContacts(customerContactsList []string) []cloudexadatainfrastructures.CustomerContact {
 	customerContacts := make([]cloudexadatainfrastructures.CustomerContact, 0, len(customerContactsList))
 	for _, customerContact := range customerContactsList {
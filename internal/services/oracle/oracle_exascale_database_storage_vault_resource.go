@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/zones"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/oracledatabase/2025-09-01/exascaledbstoragevaults"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -20,7 +21,10 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name oracle_exascale_database_storage_vault -service-package-name oracle -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type ExascaleDatabaseStorageVaultResource
+
 var _ sdk.Resource = ExascaleDatabaseStorageVaultResource{}
+var _ sdk.ResourceWithIdentity = ExascaleDatabaseStorageVaultResource{}
 
 type ExascaleDatabaseStorageVaultResource struct{}
 
@@ -174,7 +178,7 @@ func (r ExascaleDatabaseStorageVaultResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -247,6 +251,10 @@ func (ExascaleDatabaseStorageVaultResource) Read() sdk.ResourceFunc {
 					state.TimeZone = pointer.From(props.TimeZone)
 				}
 			}
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -275,3 +283,7 @@ func (ExascaleDatabaseStorageVaultResource) Delete() sdk.ResourceFunc {
 func (ExascaleDatabaseStorageVaultResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return exascaledbstoragevaults.ValidateExascaleDbStorageVaultID
 }
+
+func (ExascaleDatabaseStorageVaultResource) Identity() resourceids.ResourceId {
+	return &exascaledbstoragevaults.ExascaleDbStorageVaultId{}
+}
@@ -11,13 +11,17 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/oracledatabase/2025-09-01/resourceanchors"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/oracle/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name oracle_resource_anchor -service-package-name oracle -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type ResourceAnchorResource
+
 var _ sdk.Resource = ResourceAnchorResource{}
+var _ sdk.ResourceWithIdentity = ResourceAnchorResource{}
 
 type ResourceAnchorResource struct{}
 
@@ -94,7 +98,7 @@ func (r ResourceAnchorResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -129,6 +133,10 @@ func (ResourceAnchorResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -187,3 +195,7 @@ func (ResourceAnchorResource) Delete() sdk.ResourceFunc {
 func (ResourceAnchorResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return resourceanchors.ValidateResourceAnchorID
 }
+
+func (ResourceAnchorResource) Identity() resourceids.ResourceId {
+	return &resourceanchors.ResourceAnchorId{}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/orbital/2022-11-01/contactprofile"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -19,11 +20,14 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name orbital_contact_profile -service-package-name orbital -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type ContactProfileResource
+
 type ContactProfileResource struct{}
 
 var _ sdk.ResourceWithUpdate = ContactProfileResource{}
 
 var _ sdk.ResourceWithDeprecationAndNoReplacement = ContactProfileResource{}
+var _ sdk.ResourceWithIdentity = ContactProfileResource{}
 
 func (r ContactProfileResource) DeprecationMessage() string {
 	return "The `azurerm_orbital_contact_profile` resource has been deprecated and will be removed in v5.0 of the AzureRM Provider."
@@ -168,7 +172,7 @@ func (r ContactProfileResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -212,6 +216,10 @@ func (r ContactProfileResource) Read() sdk.ResourceFunc {
 				}
 				state.Links = links
 
+				if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+					return err
+				}
+
 				return metadata.Encode(&state)
 			}
 			return nil
@@ -244,6 +252,10 @@ func (r ContactProfileResource) IDValidationFunc() pluginsdk.SchemaValidateFunc
 	return contactprofile.ValidateContactProfileID
 }
 
+func (r ContactProfileResource) Identity() resourceids.ResourceId {
+	return &contactprofile.ContactProfileId{}
+}
+
 func (r ContactProfileResource) Update() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
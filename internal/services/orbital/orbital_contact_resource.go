@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/orbital/2022-11-01/contact"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/orbital/2022-11-01/contactprofile"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/orbital/2022-11-01/spacecraft"
@@ -19,7 +20,10 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name orbital_contact -service-package-name orbital -properties "name" -compare-values "subscription_id:spacecraft_id,resource_group_name:spacecraft_id,spacecraft_name:spacecraft_id" -test-resource-type ContactResource
+
 var _ sdk.ResourceWithDeprecationAndNoReplacement = ContactResource{}
+var _ sdk.ResourceWithIdentity = ContactResource{}
 
 func (r ContactResource) DeprecationMessage() string {
 	return "The `azurerm_orbital_contact` resource has been deprecated and will be removed in v5.0 of the AzureRM Provider."
@@ -140,7 +144,7 @@ func (r ContactResource) Create() sdk.ResourceFunc {
 				return fmt.Errorf("creating %s: %+v", id, err)
 			}
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -176,6 +180,10 @@ func (r ContactResource) Read() sdk.ResourceFunc {
 				state.GroundStationName = props.GroundStationName
 				state.ContactProfileId = props.ContactProfile.Id
 
+				if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+					return err
+				}
+
 				return metadata.Encode(&state)
 			}
 			return nil
@@ -206,3 +214,7 @@ func (r ContactResource) Delete() sdk.ResourceFunc {
 func (r ContactResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return contact.ValidateContactID
 }
+
+func (r ContactResource) Identity() resourceids.ResourceId {
+	return &contact.ContactId{}
+}
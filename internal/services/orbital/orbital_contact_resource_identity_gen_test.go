@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package orbital_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccOrbitalContact_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_orbital_contact", "test")
+	r := ContactResource{}
+
+	checkedFields := map[string]struct{}{
+		"name":                {},
+		"resource_group_name": {},
+		"spacecraft_name":     {},
+		"subscription_id":     {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_orbital_contact.test", checkedFields),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_orbital_contact.test", tfjsonpath.New("name"), tfjsonpath.New("name")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_orbital_contact.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("spacecraft_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_orbital_contact.test", tfjsonpath.New("spacecraft_name"), tfjsonpath.New("spacecraft_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_orbital_contact.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("spacecraft_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/orbital/2022-11-01/spacecraft"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -18,11 +19,14 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name orbital_spacecraft -service-package-name orbital -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type SpacecraftResource
+
 type SpacecraftResource struct{}
 
 var _ sdk.ResourceWithUpdate = SpacecraftResource{}
 
 var _ sdk.ResourceWithDeprecationAndNoReplacement = SpacecraftResource{}
+var _ sdk.ResourceWithIdentity = SpacecraftResource{}
 
 func (r SpacecraftResource) DeprecationMessage() string {
 	return "The `azurerm_orbital_spacecraft` resource has been deprecated and will be removed in v5.0 of the AzureRM Provider."
@@ -137,7 +141,7 @@ func (r SpacecraftResource) Create() sdk.ResourceFunc {
 				return fmt.Errorf("creating %s: %+v", id, err)
 			}
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -180,6 +184,10 @@ func (r SpacecraftResource) Read() sdk.ResourceFunc {
 				}
 				state.Links = spacecraftLinks
 
+				if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+					return err
+				}
+
 				return metadata.Encode(&state)
 			}
 			return nil
@@ -212,6 +220,10 @@ func (r SpacecraftResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return spacecraft.ValidateSpacecraftID
 }
 
+func (r SpacecraftResource) Identity() resourceids.ResourceId {
+	return &spacecraft.SpacecraftId{}
+}
+
 func (r SpacecraftResource) Update() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/keyvault"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	certificateobjectlocalrulestack "github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2025-10-08/certificateobjectlocalrulestackresources"
 	localrulestacks "github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2025-10-08/localrulestackresources"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,9 +22,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name palo_alto_local_rulestack_certificate -service-package-name paloalto -properties "name" -compare-values "subscription_id:rulestack_id,resource_group_name:rulestack_id,local_rulestack_name:rulestack_id" -test-resource-type LocalRulestackCertificateResource
+
 type LocalRuleStackCertificate struct{}
 
 var _ sdk.ResourceWithUpdate = LocalRuleStackCertificate{}
+var _ sdk.ResourceWithIdentity = LocalRuleStackCertificate{}
 
 type LocalRuleStackCertificateModel struct {
 	Name                string `tfschema:"name"`
@@ -38,6 +42,10 @@ func (r LocalRuleStackCertificate) IDValidationFunc() pluginsdk.SchemaValidateFu
 	return certificateobjectlocalrulestack.ValidateLocalRulestackCertificateID
 }
 
+func (r LocalRuleStackCertificate) Identity() resourceids.ResourceId {
+	return &certificateobjectlocalrulestack.LocalRulestackCertificateId{}
+}
+
 func (r LocalRuleStackCertificate) ResourceType() string {
 	return "azurerm_palo_alto_local_rulestack_certificate"
 }
@@ -155,6 +163,9 @@ func (r LocalRuleStackCertificate) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			if err = rulestackClient.LocalRulestackscommitThenPoll(ctx, *rulestackId); err != nil {
 				return fmt.Errorf("committing Local RuleStack config for %s: %+v", id, err)
@@ -198,6 +209,10 @@ func (r LocalRuleStackCertificate) Read() sdk.ResourceFunc {
 				state.SelfSigned = boolEnumAsBoolCert(props.CertificateSelfSigned)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
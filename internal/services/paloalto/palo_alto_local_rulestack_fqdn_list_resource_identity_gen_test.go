@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package paloalto_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccPaloAltoLocalRulestackFqdnList_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_palo_alto_local_rulestack_fqdn_list", "test")
+	r := LocalRulestackFQDNList{}
+
+	checkedFields := map[string]struct{}{
+		"name":                 {},
+		"local_rulestack_name": {},
+		"resource_group_name":  {},
+		"subscription_id":      {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_palo_alto_local_rulestack_fqdn_list.test", checkedFields),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_palo_alto_local_rulestack_fqdn_list.test", tfjsonpath.New("name"), tfjsonpath.New("name")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_palo_alto_local_rulestack_fqdn_list.test", tfjsonpath.New("local_rulestack_name"), tfjsonpath.New("rulestack_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_palo_alto_local_rulestack_fqdn_list.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("rulestack_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_palo_alto_local_rulestack_fqdn_list.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("rulestack_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
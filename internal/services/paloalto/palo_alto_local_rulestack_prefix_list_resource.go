@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	localrulestacks "github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2025-10-08/localrulestackresources"
 	prefixlistlocalrulestack "github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2025-10-08/prefixlistresources"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -20,9 +21,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name palo_alto_local_rulestack_prefix_list -service-package-name paloalto -properties "name" -compare-values "subscription_id:rulestack_id,resource_group_name:rulestack_id,local_rulestack_name:rulestack_id" -test-resource-type LocalRuleStackPrefixList
+
 type LocalRuleStackPrefixList struct{}
 
 var _ sdk.ResourceWithUpdate = LocalRuleStackPrefixList{}
+var _ sdk.ResourceWithIdentity = LocalRuleStackPrefixList{}
 
 type LocalRuleStackPrefixListModel struct {
 	Name         string   `tfschema:"name"`
@@ -36,6 +40,10 @@ func (r LocalRuleStackPrefixList) IDValidationFunc() pluginsdk.SchemaValidateFun
 	return prefixlistlocalrulestack.ValidateLocalRulestackPrefixListID
 }
 
+func (r LocalRuleStackPrefixList) Identity() resourceids.ResourceId {
+	return &prefixlistlocalrulestack.LocalRulestackPrefixListId{}
+}
+
 func (r LocalRuleStackPrefixList) ResourceType() string {
 	return "azurerm_palo_alto_local_rulestack_prefix_list"
 }
@@ -138,6 +146,9 @@ func (r LocalRuleStackPrefixList) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			if err = rulestackClient.LocalRulestackscommitThenPoll(ctx, *rulestackId); err != nil {
 				return fmt.Errorf("committing Local RuleStack config for %s: %+v", id, err)
@@ -179,6 +190,10 @@ func (r LocalRuleStackPrefixList) Read() sdk.ResourceFunc {
 				state.Description = pointer.From(props.Description)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
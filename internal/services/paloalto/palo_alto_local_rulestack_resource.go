@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	localrulestacks "github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2025-10-08/localrulestackresources"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -20,9 +21,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name palo_alto_local_rulestack -service-package-name paloalto -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type LocalRulestackResource
+
 type LocalRuleStack struct{}
 
 var _ sdk.ResourceWithUpdate = LocalRuleStack{}
+var _ sdk.ResourceWithIdentity = LocalRuleStack{}
 
 const (
 	RuleStackSecurityServicesCustom       string = "Custom"
@@ -47,6 +51,10 @@ func (r LocalRuleStack) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return localrulestacks.ValidateLocalRulestackID
 }
 
+func (r LocalRuleStack) Identity() resourceids.ResourceId {
+	return &localrulestacks.LocalRulestackId{}
+}
+
 func (r LocalRuleStack) ResourceType() string {
 	return "azurerm_palo_alto_local_rulestack"
 }
@@ -202,6 +210,9 @@ func (r LocalRuleStack) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			return nil
 		},
@@ -259,6 +270,10 @@ func (r LocalRuleStack) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	certificates "github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2025-10-08/certificateobjectlocalrulestackresources"
 	localrules "github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2025-10-08/localrulesresources"
 	localrulestacks "github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2025-10-08/localrulestackresources"
@@ -24,9 +25,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name palo_alto_local_rulestack_rule -service-package-name paloalto -properties "name" -compare-values "subscription_id:rulestack_id,resource_group_name:rulestack_id,local_rulestack_name:rulestack_id" -test-resource-type LocalRuleResource
+
 type LocalRuleStackRule struct{}
 
 var _ sdk.ResourceWithUpdate = LocalRuleStackRule{}
+var _ sdk.ResourceWithIdentity = LocalRuleStackRule{}
 
 var protocolApplicationDefault = "application-default"
 
@@ -57,6 +61,10 @@ func (r LocalRuleStackRule) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return localrules.ValidateLocalRuleID
 }
 
+func (r LocalRuleStackRule) Identity() resourceids.ResourceId {
+	return &localrules.LocalRuleId{}
+}
+
 func (r LocalRuleStackRule) ResourceType() string {
 	return "azurerm_palo_alto_local_rulestack_rule"
 }
@@ -290,6 +298,9 @@ func (r LocalRuleStackRule) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			if err = rulestackClient.LocalRulestackscommitThenPoll(ctx, *rulestackId); err != nil {
 				return fmt.Errorf("committing Local Rulestack config for %s: %+v", id, err)
@@ -352,6 +363,10 @@ func (r LocalRuleStackRule) Read() sdk.ResourceFunc {
 				state.Tags = flattenTagsFromRule(props.Tags)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
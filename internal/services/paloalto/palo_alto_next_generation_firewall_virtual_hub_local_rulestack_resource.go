@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	firewalls "github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2025-10-08/firewallresources"
 	localrulestacks "github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2025-10-08/localrulestackresources"
@@ -24,6 +25,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name palo_alto_next_generation_firewall_virtual_hub_local_rulestack -service-package-name paloalto -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type NextGenerationFirewallVWanResource
+
 type NextGenerationFirewallVHubLocalRuleStackResource struct{}
 
 type NextGenerationFirewallVHubLocalRuleStackModel struct {
@@ -40,6 +43,7 @@ type NextGenerationFirewallVHubLocalRuleStackModel struct {
 }
 
 var _ sdk.ResourceWithUpdate = NextGenerationFirewallVHubLocalRuleStackResource{}
+var _ sdk.ResourceWithIdentity = NextGenerationFirewallVHubLocalRuleStackResource{}
 
 func (r NextGenerationFirewallVHubLocalRuleStackResource) ModelObject() interface{} {
 	return &NextGenerationFirewallVHubLocalRuleStackModel{}
@@ -49,6 +53,10 @@ func (r NextGenerationFirewallVHubLocalRuleStackResource) IDValidationFunc() plu
 	return firewalls.ValidateFirewallID
 }
 
+func (r NextGenerationFirewallVHubLocalRuleStackResource) Identity() resourceids.ResourceId {
+	return &firewalls.FirewallId{}
+}
+
 func (r NextGenerationFirewallVHubLocalRuleStackResource) ResourceType() string {
 	return "azurerm_palo_alto_next_generation_firewall_virtual_hub_local_rulestack"
 }
@@ -173,6 +181,9 @@ func (r NextGenerationFirewallVHubLocalRuleStackResource) Create() sdk.ResourceF
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			return nil
 		},
@@ -226,6 +237,10 @@ func (r NextGenerationFirewallVHubLocalRuleStackResource) Read() sdk.ResourceFun
 				state.Tags = tags.Flatten(model.Tags)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	firewalls "github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2025-10-08/firewallresources"
 	helpersValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
@@ -23,6 +24,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name palo_alto_next_generation_firewall_virtual_hub_panorama -service-package-name paloalto -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type NextGenerationFirewallVHubPanoramaResource
+
 type NextGenerationFirewallVHubPanoramaResource struct{}
 
 type NextGenerationFirewallVHubPanoramaResourceModel struct {
@@ -42,6 +45,7 @@ type NextGenerationFirewallVHubPanoramaResourceModel struct {
 }
 
 var _ sdk.ResourceWithUpdate = NextGenerationFirewallVHubPanoramaResource{}
+var _ sdk.ResourceWithIdentity = NextGenerationFirewallVHubPanoramaResource{}
 
 func (r NextGenerationFirewallVHubPanoramaResource) ModelObject() interface{} {
 	return &NextGenerationFirewallVHubPanoramaResourceModel{}
@@ -51,6 +55,10 @@ func (r NextGenerationFirewallVHubPanoramaResource) IDValidationFunc() pluginsdk
 	return firewalls.ValidateFirewallID
 }
 
+func (r NextGenerationFirewallVHubPanoramaResource) Identity() resourceids.ResourceId {
+	return &firewalls.FirewallId{}
+}
+
 func (r NextGenerationFirewallVHubPanoramaResource) ResourceType() string {
 	return "azurerm_palo_alto_next_generation_firewall_virtual_hub_panorama"
 }
@@ -162,6 +170,9 @@ func (r NextGenerationFirewallVHubPanoramaResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			return nil
 		},
@@ -225,6 +236,10 @@ func (r NextGenerationFirewallVHubPanoramaResource) Read() sdk.ResourceFunc {
 				state.Tags = tags.Flatten(model.Tags)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
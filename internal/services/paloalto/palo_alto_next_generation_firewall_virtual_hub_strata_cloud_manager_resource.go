@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	firewalls "github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2025-10-08/firewallresources"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -22,6 +23,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name palo_alto_next_generation_firewall_virtual_hub_strata_cloud_manager -service-package-name paloalto -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type NextGenerationFirewallVHubStrataCloudManagerResource
+
 type NextGenerationFirewallVHubStrataCloudManagerResource struct{}
 
 type NextGenerationFirewallVHubStrataCloudManagerModel struct {
@@ -41,6 +44,7 @@ type NextGenerationFirewallVHubStrataCloudManagerModel struct {
 var _ sdk.ResourceWithUpdate = NextGenerationFirewallVHubStrataCloudManagerResource{}
 
 var _ sdk.ResourceWithCustomizeDiff = NextGenerationFirewallVHubStrataCloudManagerResource{}
+var _ sdk.ResourceWithIdentity = NextGenerationFirewallVHubStrataCloudManagerResource{}
 
 func (r NextGenerationFirewallVHubStrataCloudManagerResource) ModelObject() interface{} {
 	return &NextGenerationFirewallVHubStrataCloudManagerModel{}
@@ -155,6 +159,9 @@ func (r NextGenerationFirewallVHubStrataCloudManagerResource) Create() sdk.Resou
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			return nil
 		},
@@ -214,6 +221,10 @@ func (r NextGenerationFirewallVHubStrataCloudManagerResource) Read() sdk.Resourc
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -243,6 +254,10 @@ func (r NextGenerationFirewallVHubStrataCloudManagerResource) IDValidationFunc()
 	return firewalls.ValidateFirewallID
 }
 
+func (r NextGenerationFirewallVHubStrataCloudManagerResource) Identity() resourceids.ResourceId {
+	return &firewalls.FirewallId{}
+}
+
 func (r NextGenerationFirewallVHubStrataCloudManagerResource) Update() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 3 * time.Hour,
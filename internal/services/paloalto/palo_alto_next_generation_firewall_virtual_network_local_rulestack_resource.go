@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	firewalls "github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2025-10-08/firewallresources"
 	localrulestacks "github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2025-10-08/localrulestackresources"
@@ -24,6 +25,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name palo_alto_next_generation_firewall_virtual_network_local_rulestack -service-package-name paloalto -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type NextGenerationFirewallVnetResource
+
 type NextGenerationFirewallVNetLocalRulestackResource struct{}
 
 type NextGenerationFirewallVnetLocalRulestackModel struct {
@@ -39,6 +42,7 @@ type NextGenerationFirewallVnetLocalRulestackModel struct {
 }
 
 var _ sdk.ResourceWithUpdate = NextGenerationFirewallVNetLocalRulestackResource{}
+var _ sdk.ResourceWithIdentity = NextGenerationFirewallVNetLocalRulestackResource{}
 
 func (r NextGenerationFirewallVNetLocalRulestackResource) ModelObject() interface{} {
 	return &NextGenerationFirewallVnetLocalRulestackModel{}
@@ -168,6 +172,9 @@ func (r NextGenerationFirewallVNetLocalRulestackResource) Create() sdk.ResourceF
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			return nil
 		},
@@ -217,6 +224,10 @@ func (r NextGenerationFirewallVNetLocalRulestackResource) Read() sdk.ResourceFun
 				state.Tags = tags.Flatten(existing.Model.Tags)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -246,6 +257,10 @@ func (r NextGenerationFirewallVNetLocalRulestackResource) IDValidationFunc() plu
 	return firewalls.ValidateFirewallID
 }
 
+func (r NextGenerationFirewallVNetLocalRulestackResource) Identity() resourceids.ResourceId {
+	return &firewalls.FirewallId{}
+}
+
 func (r NextGenerationFirewallVNetLocalRulestackResource) Update() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 3 * time.Hour,
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	firewalls "github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2025-10-08/firewallresources"
 	helpersValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
@@ -23,6 +24,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name palo_alto_next_generation_firewall_virtual_network_panorama -service-package-name paloalto -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type NextGenerationFirewallVNetPanoramaResource
+
 type NextGenerationFirewallVNetPanoramaResource struct{}
 
 type NextGenerationFirewallVnetPanoramaModel struct {
@@ -40,6 +43,7 @@ type NextGenerationFirewallVnetPanoramaModel struct {
 }
 
 var _ sdk.ResourceWithUpdate = NextGenerationFirewallVNetPanoramaResource{}
+var _ sdk.ResourceWithIdentity = NextGenerationFirewallVNetPanoramaResource{}
 
 func (r NextGenerationFirewallVNetPanoramaResource) ModelObject() interface{} {
 	return &NextGenerationFirewallVnetPanoramaModel{}
@@ -157,6 +161,9 @@ func (r NextGenerationFirewallVNetPanoramaResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			return nil
 		},
@@ -216,6 +223,10 @@ func (r NextGenerationFirewallVNetPanoramaResource) Read() sdk.ResourceFunc {
 				state.Tags = tags.Flatten(model.Tags)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -245,6 +256,10 @@ func (r NextGenerationFirewallVNetPanoramaResource) IDValidationFunc() pluginsdk
 	return firewalls.ValidateFirewallID
 }
 
+func (r NextGenerationFirewallVNetPanoramaResource) Identity() resourceids.ResourceId {
+	return &firewalls.FirewallId{}
+}
+
 func (r NextGenerationFirewallVNetPanoramaResource) Update() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 3 * time.Hour,
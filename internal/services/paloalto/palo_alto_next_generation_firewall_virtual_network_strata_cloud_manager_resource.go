@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	firewalls "github.com/hashicorp/go-azure-sdk/resource-manager/paloaltonetworks/2025-10-08/firewallresources"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -22,6 +23,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name palo_alto_next_generation_firewall_virtual_network_strata_cloud_manager -service-package-name paloalto -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type NextGenerationFirewallVNetStrataCloudManagerResource
+
 type NextGenerationFirewallVNetStrataCloudManagerResource struct{}
 
 type NextGenerationFirewallVNetStrataCloudManagerModel struct {
@@ -41,6 +44,7 @@ type NextGenerationFirewallVNetStrataCloudManagerModel struct {
 var _ sdk.ResourceWithUpdate = NextGenerationFirewallVNetStrataCloudManagerResource{}
 
 var _ sdk.ResourceWithCustomizeDiff = NextGenerationFirewallVNetStrataCloudManagerResource{}
+var _ sdk.ResourceWithIdentity = NextGenerationFirewallVNetStrataCloudManagerResource{}
 
 func (r NextGenerationFirewallVNetStrataCloudManagerResource) ModelObject() interface{} {
 	return &NextGenerationFirewallVNetStrataCloudManagerModel{}
@@ -157,6 +161,9 @@ func (r NextGenerationFirewallVNetStrataCloudManagerResource) Create() sdk.Resou
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			return nil
 		},
@@ -208,6 +215,10 @@ func (r NextGenerationFirewallVNetStrataCloudManagerResource) Read() sdk.Resourc
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -237,6 +248,10 @@ func (r NextGenerationFirewallVNetStrataCloudManagerResource) IDValidationFunc()
 	return firewalls.ValidateFirewallID
 }
 
+func (r NextGenerationFirewallVNetStrataCloudManagerResource) Identity() resourceids.ResourceId {
+	return &firewalls.FirewallId{}
+}
+
 func (r NextGenerationFirewallVNetStrataCloudManagerResource) Update() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 3 * time.Hour,
@@ -173,53 +173,62 @@ func (br assignmentBaseResource) readFunc(scopeFieldName string) sdk.ResourceFun
 				return fmt.Errorf("reading nil model")
 			}
 
-			model := resp.Model
-			metadata.ResourceData.Set("name", id.PolicyAssignmentName)
-			metadata.ResourceData.Set("location", location.NormalizeNilable(model.Location))
-			// lintignore:R001
-			metadata.ResourceData.Set(scopeFieldName, id.Scope)
-
-			identityIns, err := identity.FlattenSystemOrUserAssignedMap(model.Identity)
-			if err != nil {
-				return fmt.Errorf("FlattenSystemOrUserAssignedMap: %+v", err)
-			}
-			if err = metadata.ResourceData.Set("identity", identityIns); err != nil {
-				return fmt.Errorf("setting `identity`: %+v", err)
+			if err := br.flatten(metadata, scopeFieldName, *id, *resp.Model); err != nil {
+				return err
 			}
 
-			if props := model.Properties; props != nil {
-				metadata.ResourceData.Set("description", props.Description)
-				metadata.ResourceData.Set("display_name", props.DisplayName)
-				var enforce bool
-				if mode := props.EnforcementMode; mode != nil {
-					enforce = (*props.EnforcementMode) == policyassignments.EnforcementModeDefault
-				}
-				metadata.ResourceData.Set("enforce", enforce)
-				metadata.ResourceData.Set("not_scopes", props.NotScopes)
-				metadata.ResourceData.Set("policy_definition_id", props.PolicyDefinitionId)
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, id)
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
 
-				metadata.ResourceData.Set("non_compliance_message", br.flattenNonComplianceMessages(props.NonComplianceMessages))
+// flatten populates the Resource Data from the Policy Assignment, which is used when both reading and listing
+// Policy Assignments
+func (br assignmentBaseResource) flatten(metadata sdk.ResourceMetaData, scopeFieldName string, id policyassignments.ScopedPolicyAssignmentId, model policyassignments.PolicyAssignment) error {
+	metadata.ResourceData.Set("name", id.PolicyAssignmentName)
+	metadata.ResourceData.Set("location", location.NormalizeNilable(model.Location))
+	// lintignore:R001
+	metadata.ResourceData.Set(scopeFieldName, id.Scope)
+
+	identityIns, err := identity.FlattenSystemOrUserAssignedMap(model.Identity)
+	if err != nil {
+		return fmt.Errorf("FlattenSystemOrUserAssignedMap: %+v", err)
+	}
+	if err = metadata.ResourceData.Set("identity", identityIns); err != nil {
+		return fmt.Errorf("setting `identity`: %+v", err)
+	}
 
-				flattenedMetaData := flattenJSON(pointer.From(props.Metadata))
-				metadata.ResourceData.Set("metadata", flattenedMetaData)
+	if props := model.Properties; props != nil {
+		metadata.ResourceData.Set("description", props.Description)
+		metadata.ResourceData.Set("display_name", props.DisplayName)
+		var enforce bool
+		if mode := props.EnforcementMode; mode != nil {
+			enforce = (*props.EnforcementMode) == policyassignments.EnforcementModeDefault
+		}
+		metadata.ResourceData.Set("enforce", enforce)
+		metadata.ResourceData.Set("not_scopes", props.NotScopes)
+		metadata.ResourceData.Set("policy_definition_id", props.PolicyDefinitionId)
 
-				flattenedParameters, err := flattenParameterValuesValueToStringV2(props.Parameters)
-				if err != nil {
-					return fmt.Errorf("serializing JSON from `parameters`: %+v", err)
-				}
-				metadata.ResourceData.Set("parameters", flattenedParameters)
+		metadata.ResourceData.Set("non_compliance_message", br.flattenNonComplianceMessages(props.NonComplianceMessages))
 
-				overrides := br.flattenOverrides(props.Overrides)
-				metadata.ResourceData.Set("overrides", overrides)
+		flattenedMetaData := flattenJSON(pointer.From(props.Metadata))
+		metadata.ResourceData.Set("metadata", flattenedMetaData)
 
-				resourceSel := br.flattenResourceSelectors(props.ResourceSelectors)
-				metadata.ResourceData.Set("resource_selectors", resourceSel)
-			}
+		flattenedParameters, err := flattenParameterValuesValueToStringV2(props.Parameters)
+		if err != nil {
+			return fmt.Errorf("serializing JSON from `parameters`: %+v", err)
+		}
+		metadata.ResourceData.Set("parameters", flattenedParameters)
 
-			return nil
-		},
-		Timeout: 5 * time.Minute,
+		overrides := br.flattenOverrides(props.Overrides)
+		metadata.ResourceData.Set("overrides", overrides)
+
+		resourceSel := br.flattenResourceSelectors(props.ResourceSelectors)
+		metadata.ResourceData.Set("resource_selectors", resourceSel)
 	}
+
+	return nil
 }
 
 func (br assignmentBaseResource) updateFunc() sdk.ResourceFunc {
@@ -515,6 +524,14 @@ func (br assignmentBaseResource) arguments(fields map[string]*pluginsdk.Schema)
 	return output
 }
 
+func (br assignmentBaseResource) identitySchema() map[string]*pluginsdk.Schema {
+	return pluginsdk.GenerateIdentitySchema(&policyassignments.ScopedPolicyAssignmentId{})()
+}
+
+func (br assignmentBaseResource) identityImporter() pluginsdk.IdentityImporterFunc {
+	return pluginsdk.IdentityImporter(&policyassignments.ScopedPolicyAssignmentId{})
+}
+
 func (br assignmentBaseResource) attributes() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{}
 }
@@ -0,0 +1,142 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package policy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-06-01/policyassignments"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// list streams the Policy Assignments within the configured Scope which were made at the type of Scope managed by
+// `resource`, as determined by `isScopeType`
+func (br assignmentBaseResource) list(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata sdk.ResourceMetadata, resource sdk.Resource, scopeFieldName string, isScopeType func(parse.PolicyScopeId) bool) {
+	client := metadata.Client.Policy.AssignmentsClient
+
+	// retrieve the deadline from the supplied context
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		// This *should* never happen given the List Wrapper instantiates a context with a timeout
+		sdk.SetResponseErrorDiagnostic(stream, "internal-error", "context had no deadline")
+		return
+	}
+
+	var data PolicyAssignmentScopeListModel
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	items, err := listPolicyAssignmentsAtScope(ctx, client, data.Scope.ValueString(), data.filter())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", resource.ResourceType()), err)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		deadlineCtx, cancel := context.WithDeadline(context.Background(), deadline)
+		defer cancel()
+
+		for _, item := range items {
+			result := request.NewListResult(deadlineCtx)
+			result.DisplayName = pointer.From(item.Name)
+
+			id, err := policyassignments.ParseScopedPolicyAssignmentIDInsensitively(pointer.From(item.Id))
+			if err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, "parsing Policy Assignment ID", err)
+				return
+			}
+
+			scopeId, err := parse.PolicyScopeID(id.Scope)
+			if err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("parsing Scope for %s", id), err)
+				return
+			}
+
+			policyDefinitionId := ""
+			if item.Properties != nil {
+				policyDefinitionId = pointer.From(item.Properties.PolicyDefinitionId)
+			}
+
+			if !isScopeType(scopeId) || !data.matches(id.Scope, policyDefinitionId) {
+				continue
+			}
+
+			meta := sdk.NewResourceMetaData(metadata.Client, resource)
+			meta.SetID(id)
+
+			if err := br.flatten(meta, scopeFieldName, *id, item); err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("encoding `%s` resource data", resource.ResourceType()), err)
+				return
+			}
+
+			if err := pluginsdk.SetResourceIdentityData(meta.ResourceData, id); err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("setting `%s` resource identity", resource.ResourceType()), err)
+				return
+			}
+
+			sdk.EncodeListResult(deadlineCtx, meta.ResourceData, &result)
+			if result.Diagnostics.HasError() {
+				push(result)
+				return
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+func listPolicyAssignmentsAtScope(ctx context.Context, client *policyassignments.PolicyAssignmentsClient, scope string, filter *string) ([]policyassignments.PolicyAssignment, error) {
+	scopeId, err := parse.PolicyScopeID(scope)
+	if err != nil {
+		return nil, err
+	}
+
+	switch v := scopeId.(type) {
+	case parse.ScopeAtManagementGroup:
+		resp, err := client.ListForManagementGroupComplete(ctx, commonids.NewManagementGroupID(v.ManagementGroupName), policyassignments.ListForManagementGroupOperationOptions{
+			Filter: filter,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return resp.Items, nil
+
+	case parse.ScopeAtSubscription:
+		resp, err := client.ListComplete(ctx, commonids.NewSubscriptionID(v.SubscriptionId), policyassignments.ListOperationOptions{
+			Filter: filter,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return resp.Items, nil
+
+	case parse.ScopeAtResourceGroup:
+		resp, err := client.ListForResourceGroupComplete(ctx, commonids.NewResourceGroupID(v.SubscriptionId, v.ResourceGroup), policyassignments.ListForResourceGroupOperationOptions{
+			Filter: filter,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return resp.Items, nil
+	}
+
+	resp, err := client.ListForResourceComplete(ctx, commonids.NewScopeID(scope), policyassignments.ListForResourceOperationOptions{
+		Filter: filter,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Items, nil
+}
@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package policy
+
+import (
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/resources/mgmt/2021-06-01-preview/policy" // nolint: staticcheck
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// flattenPolicyExemption populates the Resource Data from the Policy Exemption, which is used when both reading and
+// listing Policy Exemptions at each Scope
+func flattenPolicyExemption(d *pluginsdk.ResourceData, scopeFieldName string, scope string, exemption policy.Exemption) error {
+	d.Set("name", exemption.Name)
+	// lintignore:R001
+	d.Set(scopeFieldName, scope)
+	if props := exemption.ExemptionProperties; props != nil {
+		d.Set("policy_assignment_id", props.PolicyAssignmentID)
+		d.Set("display_name", props.DisplayName)
+		d.Set("description", props.Description)
+		d.Set("exemption_category", string(props.ExemptionCategory))
+
+		if err := d.Set("policy_definition_reference_ids", utils.FlattenStringSlice(props.PolicyDefinitionReferenceIds)); err != nil {
+			return fmt.Errorf("setting `policy_definition_reference_ids: %+v", err)
+		}
+
+		expiresOn := ""
+		if expiresTime := props.ExpiresOn; expiresTime != nil {
+			expiresOn = expiresTime.String()
+		}
+		d.Set("expires_on", expiresOn)
+
+		if metadataStr := flattenJSON(props.Metadata); metadataStr != "" {
+			d.Set("metadata", metadataStr)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,151 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package policy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/resources/mgmt/2021-06-01-preview/policy" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// listPolicyExemptions streams the Policy Exemptions within the configured Scope which were made at the type of Scope
+// managed by the resource, as determined by `isScopeType`
+func listPolicyExemptions(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata sdk.ResourceMetadata, resourceType string, resourceFunc func() *pluginsdk.Resource, scopeFieldName string, isScopeType func(parse.PolicyScopeId) bool) {
+	client := metadata.Client.Policy.ExemptionsClient
+
+	// retrieve the deadline from the supplied context
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		// This *should* never happen given the List Wrapper instantiates a context with a timeout
+		sdk.SetResponseErrorDiagnostic(stream, "internal-error", "context had no deadline")
+		return
+	}
+
+	var data PolicyExemptionScopeListModel
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	items, err := listPolicyExemptionsAtScope(ctx, client, data)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", resourceType), err)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		deadlineCtx, cancel := context.WithDeadline(context.Background(), deadline)
+		defer cancel()
+
+		for _, item := range items {
+			result := request.NewListResult(deadlineCtx)
+			result.DisplayName = pointer.From(item.Name)
+
+			id, err := parse.ScopedPolicyExemptionIDInsensitively(pointer.From(item.ID))
+			if err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, "parsing Policy Exemption ID", err)
+				return
+			}
+
+			scopeId, err := parse.PolicyScopeID(id.Scope)
+			if err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("parsing Scope for %s", id), err)
+				return
+			}
+
+			policyAssignmentId := ""
+			if item.ExemptionProperties != nil {
+				policyAssignmentId = pointer.From(item.ExemptionProperties.PolicyAssignmentID)
+			}
+
+			if !isScopeType(scopeId) || !data.matches(id.Scope, policyAssignmentId) {
+				continue
+			}
+
+			rd := resourceFunc().Data(&terraform.InstanceState{})
+			rd.SetId(id.ID())
+
+			if err := flattenPolicyExemption(rd, scopeFieldName, id.Scope, item); err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("encoding `%s` resource data", resourceType), err)
+				return
+			}
+
+			if err := pluginsdk.SetResourceIdentityData(rd, id); err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("setting `%s` resource identity", resourceType), err)
+				return
+			}
+
+			sdk.EncodeListResult(deadlineCtx, rd, &result)
+			if result.Diagnostics.HasError() {
+				push(result)
+				return
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+func listPolicyExemptionsAtScope(ctx context.Context, client *policy.ExemptionsClient, data PolicyExemptionScopeListModel) ([]policy.Exemption, error) {
+	scopeId, err := parse.PolicyScopeID(data.Scope.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	// the Exemptions Client lists Policy Exemptions within the Subscription it was configured with
+	subscriptionClient := *client
+
+	var iterator policy.ExemptionListResultIterator
+	switch v := scopeId.(type) {
+	case parse.ScopeAtManagementGroup:
+		iterator, err = client.ListForManagementGroupComplete(ctx, v.ManagementGroupName, data.filter(scopeId))
+
+	case parse.ScopeAtSubscription:
+		subscriptionClient.SubscriptionID = v.SubscriptionId
+		iterator, err = subscriptionClient.ListComplete(ctx, data.filter(scopeId))
+
+	case parse.ScopeAtResourceGroup:
+		subscriptionClient.SubscriptionID = v.SubscriptionId
+		iterator, err = subscriptionClient.ListForResourceGroupComplete(ctx, v.ResourceGroup, data.filter(scopeId))
+
+	default:
+		// listing the Policy Exemptions for a Resource requires the Resource ID to be split into its components, so these
+		// are instead listed at the Resource Group (which includes those for the Resources within it) and then filtered
+		resourceId, err := azure.ParseAzureResourceID(data.Scope.ValueString())
+		if err != nil {
+			return nil, err
+		}
+
+		subscriptionClient.SubscriptionID = resourceId.SubscriptionID
+		iterator, err = subscriptionClient.ListForResourceGroupComplete(ctx, resourceId.ResourceGroup, data.filter(scopeId))
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]policy.Exemption, 0)
+	for iterator.NotDone() {
+		results = append(results, iterator.Value())
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return results, nil
+}
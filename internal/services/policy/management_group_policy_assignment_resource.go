@@ -13,7 +13,10 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
-var _ sdk.ResourceWithUpdate = ManagementGroupAssignmentResource{}
+var (
+	_ sdk.ResourceWithUpdate         = ManagementGroupAssignmentResource{}
+	_ sdk.ResourceWithCustomIdentity = ManagementGroupAssignmentResource{}
+)
 
 type ManagementGroupAssignmentResource struct {
 	base assignmentBaseResource
@@ -58,6 +61,14 @@ func (r ManagementGroupAssignmentResource) IDValidationFunc() pluginsdk.SchemaVa
 	return validate.ManagementGroupAssignmentID
 }
 
+func (r ManagementGroupAssignmentResource) IdentityImporter() pluginsdk.IdentityImporterFunc {
+	return r.base.identityImporter()
+}
+
+func (r ManagementGroupAssignmentResource) IdentitySchema() map[string]*pluginsdk.Schema {
+	return r.base.identitySchema()
+}
+
 func (r ManagementGroupAssignmentResource) ModelObject() interface{} {
 	return nil
 }
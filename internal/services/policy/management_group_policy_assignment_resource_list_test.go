@@ -0,0 +1,56 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package policy_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestAccManagementGroupPolicyAssignment_list(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_management_group_policy_assignment", "testlist")
+	r := ManagementGroupAssignmentTestResource{}
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{Config: r.withBuiltInPolicySetBasic(data)},
+			{
+				Query:  true,
+				Config: r.listQuery(),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("azurerm_management_group_policy_assignment.list", 1),
+					querycheck.ExpectIdentity("azurerm_management_group_policy_assignment.list", map[string]knownvalue.Check{
+						"name":  knownvalue.StringExact(fmt.Sprintf("acctestpol-mg-%s", data.RandomString)),
+						"scope": knownvalue.NotNull(),
+					}),
+				},
+			},
+		},
+	})
+}
+
+func (r ManagementGroupAssignmentTestResource) listQuery() string {
+	return `
+list "azurerm_management_group_policy_assignment" "list" {
+  provider = azurerm
+  config {
+    scope                = azurerm_management_group.test.id
+    at_scope_only        = true
+    policy_definition_id = data.azurerm_policy_set_definition.test.id
+  }
+}
+`
+}
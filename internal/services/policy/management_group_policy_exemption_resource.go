@@ -26,6 +26,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name management_group_policy_exemption -service-package-name policy -properties "name,scope:management_group_id" -no-subscription-id
+
 func resourceArmManagementGroupPolicyExemption() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceArmManagementGroupPolicyExemptionCreateUpdate,
@@ -33,10 +35,14 @@ func resourceArmManagementGroupPolicyExemption() *pluginsdk.Resource {
 		Update: resourceArmManagementGroupPolicyExemptionCreateUpdate,
 		Delete: resourceArmManagementGroupPolicyExemptionDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+		Importer: pluginsdk.ImporterValidatingResourceIdOrIdentity(func(id string) error {
 			_, err := parse.ResourcePolicyExemptionID(id)
 			return err
-		}),
+		}, pluginsdk.IdentityImporter(&parse.ScopedPolicyExemptionId{})),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.ScopedPolicyExemptionId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -198,30 +204,11 @@ func resourceArmManagementGroupPolicyExemptionRead(d *pluginsdk.ResourceData, me
 		return fmt.Errorf("reading %s: %+v", id.ID(), err)
 	}
 
-	d.Set("name", resp.Name)
-	d.Set("management_group_id", managementGroupId.ID())
-	if props := resp.ExemptionProperties; props != nil {
-		d.Set("policy_assignment_id", props.PolicyAssignmentID)
-		d.Set("display_name", props.DisplayName)
-		d.Set("description", props.Description)
-		d.Set("exemption_category", string(props.ExemptionCategory))
-
-		if err := d.Set("policy_definition_reference_ids", utils.FlattenStringSlice(props.PolicyDefinitionReferenceIds)); err != nil {
-			return fmt.Errorf("setting `policy_definition_reference_ids: %+v", err)
-		}
-
-		expiresOn := ""
-		if expiresTime := props.ExpiresOn; expiresTime != nil {
-			expiresOn = expiresTime.String()
-		}
-		d.Set("expires_on", expiresOn)
-
-		if metadataStr := flattenJSON(props.Metadata); metadataStr != "" {
-			d.Set("metadata", metadataStr)
-		}
+	if err := flattenPolicyExemption(d, "management_group_id", managementGroupId.ID(), resp); err != nil {
+		return err
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, pointer.To(parse.NewScopedPolicyExemptionID(managementGroupId.ID(), id.Name)))
 }
 
 func resourceArmManagementGroupPolicyExemptionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -11,12 +11,15 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2025-01-01/policysetdefinitions"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name management_group_policy_set_definition -service-package-name policy -properties "name" -compare-values "management_group_name:management_group_id" -no-subscription-id -test-resource-type ManagementGroupPolicySetDefinitionResourceTest
+
 type ManagementGroupPolicySetDefinitionResource struct{}
 
 type ManagementGroupPolicySetDefinitionResourceModel struct {
@@ -34,6 +37,7 @@ type ManagementGroupPolicySetDefinitionResourceModel struct {
 var (
 	_ sdk.ResourceWithUpdate        = ManagementGroupPolicySetDefinitionResource{}
 	_ sdk.ResourceWithCustomizeDiff = ManagementGroupPolicySetDefinitionResource{}
+	_ sdk.ResourceWithIdentity      = ManagementGroupPolicySetDefinitionResource{}
 )
 
 func (r ManagementGroupPolicySetDefinitionResource) Arguments() map[string]*pluginsdk.Schema {
@@ -176,7 +180,7 @@ func (r ManagementGroupPolicySetDefinitionResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -236,6 +240,10 @@ func (r ManagementGroupPolicySetDefinitionResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -350,6 +358,10 @@ func (r ManagementGroupPolicySetDefinitionResource) IDValidationFunc() pluginsdk
 	return policysetdefinitions.ValidateProviders2PolicySetDefinitionID
 }
 
+func (r ManagementGroupPolicySetDefinitionResource) Identity() resourceids.ResourceId {
+	return &policysetdefinitions.Providers2PolicySetDefinitionId{}
+}
+
 func (r ManagementGroupPolicySetDefinitionResource) CustomizeDiff() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 10 * time.Minute,
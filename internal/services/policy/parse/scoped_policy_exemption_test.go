@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.Id = ScopedPolicyExemptionId{}
+
+func TestScopedPolicyExemptionIDFormatter(t *testing.T) {
+	actual := NewScopedPolicyExemptionID("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1", "exemption1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Authorization/policyExemptions/exemption1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestScopedPolicyExemptionID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *ScopedPolicyExemptionId
+	}{
+		{
+			Input: "",
+			Error: true,
+		},
+		{
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1",
+			Error: true,
+		},
+		{
+			Input: "/providers/Microsoft.Authorization/policyExemptions/exemption1",
+			Error: true,
+		},
+		{
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Authorization/policyExemptions/",
+			Error: true,
+		},
+		{
+			Input: "/providers/Microsoft.Management/managementGroups/group1/providers/Microsoft.Authorization/policyExemptions/exemption1",
+			Expected: &ScopedPolicyExemptionId{
+				Scope:               "/providers/Microsoft.Management/managementGroups/group1",
+				PolicyExemptionName: "exemption1",
+			},
+		},
+		{
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/policyExemptions/exemption1",
+			Expected: &ScopedPolicyExemptionId{
+				Scope:               "/subscriptions/12345678-1234-9876-4563-123456789012",
+				PolicyExemptionName: "exemption1",
+			},
+		},
+		{
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworks/network1/providers/Microsoft.Authorization/policyExemptions/exemption1",
+			Expected: &ScopedPolicyExemptionId{
+				Scope:               "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworks/network1",
+				PolicyExemptionName: "exemption1",
+			},
+		},
+		{
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.AUTHORIZATION/POLICYEXEMPTIONS/EXEMPTION1",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ScopedPolicyExemptionID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.Scope != v.Expected.Scope {
+			t.Fatalf("Expected %q but got %q for Scope", v.Expected.Scope, actual.Scope)
+		}
+
+		if actual.PolicyExemptionName != v.Expected.PolicyExemptionName {
+			t.Fatalf("Expected %q but got %q for PolicyExemptionName", v.Expected.PolicyExemptionName, actual.PolicyExemptionName)
+		}
+	}
+}
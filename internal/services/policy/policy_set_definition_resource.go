@@ -14,6 +14,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2025-01-01/policysetdefinitions"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -28,6 +29,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name policy_set_definition -service-package-name policy -properties "name" -known-values "subscription_id:data.Subscriptions.Primary" -test-name builtIn -test-resource-type PolicySetDefinitionResourceTest
+
 // BEGIN
 // TODO: Remove from here until the `END` comment on ln836 post 5.0
 func resourceArmPolicySetDefinition() *pluginsdk.Resource {
@@ -915,6 +918,7 @@ var (
 	_ sdk.ResourceWithUpdate         = PolicySetDefinitionResource{}
 	_ sdk.ResourceWithStateMigration = PolicySetDefinitionResource{}
 	_ sdk.ResourceWithCustomizeDiff  = PolicySetDefinitionResource{}
+	_ sdk.ResourceWithIdentity       = PolicySetDefinitionResource{}
 )
 
 func (r PolicySetDefinitionResource) StateUpgraders() sdk.StateUpgradeData {
@@ -1055,7 +1059,7 @@ func (r PolicySetDefinitionResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -1114,6 +1118,10 @@ func (r PolicySetDefinitionResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -1228,6 +1236,10 @@ func (r PolicySetDefinitionResource) IDValidationFunc() pluginsdk.SchemaValidate
 	return policysetdefinitions.ValidateProviderPolicySetDefinitionID
 }
 
+func (r PolicySetDefinitionResource) Identity() resourceids.ResourceId {
+	return &policysetdefinitions.ProviderPolicySetDefinitionId{}
+}
+
 func getPolicySetDefinition(ctx context.Context, client *policysetdefinitions.PolicySetDefinitionsClient, id policysetdefinitions.ProviderPolicySetDefinitionId) (*http.Response, *policysetdefinitions.PolicySetDefinition, error) {
 	resp, err := client.GetBuiltIn(ctx, policysetdefinitions.NewPolicySetDefinitionID(id.PolicySetDefinitionName), policysetdefinitions.DefaultGetBuiltInOperationOptions())
 	if response.WasNotFound(resp.HttpResponse) {
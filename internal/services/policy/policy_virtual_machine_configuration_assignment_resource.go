@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/guestconfiguration/2024-04-05/guestconfigurationassignments"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -22,6 +23,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name policy_virtual_machine_configuration_assignment -service-package-name policy -properties "name" -compare-values "subscription_id:virtual_machine_id,resource_group_name:virtual_machine_id,virtual_machine_name:virtual_machine_id"
+
 func resourcePolicyVirtualMachineConfigurationAssignment() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourcePolicyVirtualMachineConfigurationAssignmentCreateUpdate,
@@ -36,10 +39,11 @@ func resourcePolicyVirtualMachineConfigurationAssignment() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := guestconfigurationassignments.ParseVirtualMachineProviders2GuestConfigurationAssignmentID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&guestconfigurationassignments.VirtualMachineProviders2GuestConfigurationAssignmentId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&guestconfigurationassignments.VirtualMachineProviders2GuestConfigurationAssignmentId{}),
+		},
 
 		Schema: resourcePolicyVirtualMachineConfigurationAssignmentSchema(),
 	}
@@ -173,6 +177,9 @@ func resourcePolicyVirtualMachineConfigurationAssignmentCreateUpdate(d *pluginsd
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 
 	return resourcePolicyVirtualMachineConfigurationAssignmentRead(d, meta)
 }
@@ -211,7 +218,7 @@ func resourcePolicyVirtualMachineConfigurationAssignmentRead(d *pluginsdk.Resour
 			}
 		}
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourcePolicyVirtualMachineConfigurationAssignmentDelete(d *pluginsdk.ResourceData, meta interface{}) error {
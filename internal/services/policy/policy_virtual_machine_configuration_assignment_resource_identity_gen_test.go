@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package policy_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccPolicyVirtualMachineConfigurationAssignment_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_policy_virtual_machine_configuration_assignment", "test")
+	r := PolicyVirtualMachineConfigurationAssignmentResource{}
+
+	checkedFields := map[string]struct{}{
+		"name":                 {},
+		"resource_group_name":  {},
+		"subscription_id":      {},
+		"virtual_machine_name": {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_policy_virtual_machine_configuration_assignment.test", checkedFields),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_policy_virtual_machine_configuration_assignment.test", tfjsonpath.New("name"), tfjsonpath.New("name")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_policy_virtual_machine_configuration_assignment.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("virtual_machine_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_policy_virtual_machine_configuration_assignment.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("virtual_machine_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_policy_virtual_machine_configuration_assignment.test", tfjsonpath.New("virtual_machine_name"), tfjsonpath.New("virtual_machine_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
@@ -107,5 +107,14 @@ func (r Registration) EphemeralResources() []func() ephemeral.EphemeralResource
 }
 
 func (r Registration) ListResources() []sdk.FrameworkListWrappedResource {
-	return []sdk.FrameworkListWrappedResource{}
+	return []sdk.FrameworkListWrappedResource{
+		ManagementGroupAssignmentListResource{},
+		ManagementGroupPolicyExemptionListResource{},
+		ResourceAssignmentListResource{},
+		ResourceGroupAssignmentListResource{},
+		ResourceGroupPolicyExemptionListResource{},
+		ResourcePolicyExemptionListResource{},
+		SubscriptionAssignmentListResource{},
+		SubscriptionPolicyExemptionListResource{},
+	}
 }
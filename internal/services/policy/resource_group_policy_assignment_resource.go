@@ -13,7 +13,10 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
-var _ sdk.ResourceWithUpdate = ResourceGroupAssignmentResource{}
+var (
+	_ sdk.ResourceWithUpdate         = ResourceGroupAssignmentResource{}
+	_ sdk.ResourceWithCustomIdentity = ResourceGroupAssignmentResource{}
+)
 
 type ResourceGroupAssignmentResource struct {
 	base assignmentBaseResource
@@ -58,6 +61,14 @@ func (r ResourceGroupAssignmentResource) IDValidationFunc() pluginsdk.SchemaVali
 	return validate.ResourceGroupAssignmentID
 }
 
+func (r ResourceGroupAssignmentResource) IdentityImporter() pluginsdk.IdentityImporterFunc {
+	return r.base.identityImporter()
+}
+
+func (r ResourceGroupAssignmentResource) IdentitySchema() map[string]*pluginsdk.Schema {
+	return r.base.identitySchema()
+}
+
 func (r ResourceGroupAssignmentResource) ModelObject() interface{} {
 	return nil
 }
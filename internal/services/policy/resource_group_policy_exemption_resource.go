@@ -26,6 +26,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name resource_group_policy_exemption -service-package-name policy -properties "name,scope:resource_group_id" -no-subscription-id
+
 func resourceArmResourceGroupPolicyExemption() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceArmResourceGroupPolicyExemptionCreateUpdate,
@@ -33,10 +35,14 @@ func resourceArmResourceGroupPolicyExemption() *pluginsdk.Resource {
 		Update: resourceArmResourceGroupPolicyExemptionCreateUpdate,
 		Delete: resourceArmResourceGroupPolicyExemptionDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+		Importer: pluginsdk.ImporterValidatingResourceIdOrIdentity(func(id string) error {
 			_, err := parse.ResourceGroupPolicyExemptionID(id)
 			return err
-		}),
+		}, pluginsdk.IdentityImporter(&parse.ScopedPolicyExemptionId{})),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.ScopedPolicyExemptionId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -196,30 +202,11 @@ func resourceArmResourceGroupPolicyExemptionRead(d *pluginsdk.ResourceData, meta
 		return fmt.Errorf("reading %s: %+v", id.ID(), err)
 	}
 
-	d.Set("name", resp.Name)
-	d.Set("resource_group_id", resourceGroupId.ID())
-	if props := resp.ExemptionProperties; props != nil {
-		d.Set("policy_assignment_id", props.PolicyAssignmentID)
-		d.Set("display_name", props.DisplayName)
-		d.Set("description", props.Description)
-		d.Set("exemption_category", string(props.ExemptionCategory))
-
-		if err := d.Set("policy_definition_reference_ids", utils.FlattenStringSlice(props.PolicyDefinitionReferenceIds)); err != nil {
-			return fmt.Errorf("setting `policy_definition_reference_ids: %+v", err)
-		}
-
-		expiresOn := ""
-		if expiresTime := props.ExpiresOn; expiresTime != nil {
-			expiresOn = expiresTime.String()
-		}
-		d.Set("expires_on", expiresOn)
-
-		if metadataStr := flattenJSON(props.Metadata); metadataStr != "" {
-			d.Set("metadata", metadataStr)
-		}
+	if err := flattenPolicyExemption(d, "resource_group_id", resourceGroupId.ID(), resp); err != nil {
+		return err
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, pointer.To(parse.NewScopedPolicyExemptionID(resourceGroupId.ID(), id.PolicyExemptionName)))
 }
 
 func resourceArmResourceGroupPolicyExemptionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -0,0 +1,37 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package policy
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ResourceGroupPolicyExemptionListResource struct{}
+
+var _ sdk.FrameworkListWrappedResourceWithConfig = new(ResourceGroupPolicyExemptionListResource)
+
+func (ResourceGroupPolicyExemptionListResource) ResourceFunc() *pluginsdk.Resource {
+	return resourceArmResourceGroupPolicyExemption()
+}
+
+func (ResourceGroupPolicyExemptionListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "azurerm_resource_group_policy_exemption"
+}
+
+func (ResourceGroupPolicyExemptionListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = policyScopeListResourceConfigSchema("Policy Exemptions", "policy_assignment_id", "Only return Policy Exemptions for this Policy Assignment ID.")
+}
+
+func (ResourceGroupPolicyExemptionListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata sdk.ResourceMetadata) {
+	listPolicyExemptions(ctx, request, stream, metadata, "azurerm_resource_group_policy_exemption", resourceArmResourceGroupPolicyExemption, "resource_group_id", func(scopeId parse.PolicyScopeId) bool {
+		_, ok := scopeId.(parse.ScopeAtResourceGroup)
+		return ok
+	})
+}
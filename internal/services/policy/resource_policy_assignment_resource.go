@@ -12,7 +12,10 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
-var _ sdk.ResourceWithUpdate = ResourceAssignmentResource{}
+var (
+	_ sdk.ResourceWithUpdate         = ResourceAssignmentResource{}
+	_ sdk.ResourceWithCustomIdentity = ResourceAssignmentResource{}
+)
 
 type ResourceAssignmentResource struct {
 	base assignmentBaseResource
@@ -58,6 +61,14 @@ func (r ResourceAssignmentResource) IDValidationFunc() pluginsdk.SchemaValidateF
 	return validate.ResourceAssignmentId()
 }
 
+func (r ResourceAssignmentResource) IdentityImporter() pluginsdk.IdentityImporterFunc {
+	return r.base.identityImporter()
+}
+
+func (r ResourceAssignmentResource) IdentitySchema() map[string]*pluginsdk.Schema {
+	return r.base.identitySchema()
+}
+
 func (r ResourceAssignmentResource) ModelObject() interface{} {
 	return nil
 }
@@ -0,0 +1,39 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package policy
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ResourceAssignmentListResource struct {
+	base assignmentBaseResource
+}
+
+var _ sdk.FrameworkListWrappedResourceWithConfig = new(ResourceAssignmentListResource)
+
+func (ResourceAssignmentListResource) ResourceFunc() *pluginsdk.Resource {
+	return sdk.WrappedResource(ResourceAssignmentResource{})
+}
+
+func (ResourceAssignmentListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = ResourceAssignmentResource{}.ResourceType()
+}
+
+func (ResourceAssignmentListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = policyScopeListResourceConfigSchema("Policy Assignments", "policy_definition_id", "Only return Policy Assignments of this Policy Definition or Policy Set Definition ID.")
+}
+
+func (r ResourceAssignmentListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata sdk.ResourceMetadata) {
+	r.base.list(ctx, request, stream, metadata, ResourceAssignmentResource{}, "resource_id", func(scopeId parse.PolicyScopeId) bool {
+		_, ok := scopeId.(parse.ScopeAtResource)
+		return ok
+	})
+}
@@ -25,6 +25,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name resource_policy_exemption -service-package-name policy -properties "name,scope:resource_id" -no-subscription-id
+
 func resourceArmResourcePolicyExemption() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceArmResourcePolicyExemptionCreateUpdate,
@@ -32,10 +34,14 @@ func resourceArmResourcePolicyExemption() *pluginsdk.Resource {
 		Update: resourceArmResourcePolicyExemptionCreateUpdate,
 		Delete: resourceArmResourcePolicyExemptionDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+		Importer: pluginsdk.ImporterValidatingResourceIdOrIdentity(func(id string) error {
 			_, err := parse.ResourcePolicyExemptionID(id)
 			return err
-		}),
+		}, pluginsdk.IdentityImporter(&parse.ScopedPolicyExemptionId{})),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.ScopedPolicyExemptionId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -188,30 +194,11 @@ func resourceArmResourcePolicyExemptionRead(d *pluginsdk.ResourceData, meta inte
 		return fmt.Errorf("reading %s: %+v", id.ID(), err)
 	}
 
-	d.Set("name", resp.Name)
-	d.Set("resource_id", id.ResourceId)
-	if props := resp.ExemptionProperties; props != nil {
-		d.Set("policy_assignment_id", props.PolicyAssignmentID)
-		d.Set("display_name", props.DisplayName)
-		d.Set("description", props.Description)
-		d.Set("exemption_category", string(props.ExemptionCategory))
-
-		if err := d.Set("policy_definition_reference_ids", utils.FlattenStringSlice(props.PolicyDefinitionReferenceIds)); err != nil {
-			return fmt.Errorf("setting `policy_definition_reference_ids: %+v", err)
-		}
-
-		expiresOn := ""
-		if expiresTime := props.ExpiresOn; expiresTime != nil {
-			expiresOn = expiresTime.String()
-		}
-		d.Set("expires_on", expiresOn)
-
-		if metadataStr := flattenJSON(props.Metadata); metadataStr != "" {
-			d.Set("metadata", metadataStr)
-		}
+	if err := flattenPolicyExemption(d, "resource_id", id.ResourceId, resp); err != nil {
+		return err
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, pointer.To(parse.NewScopedPolicyExemptionID(id.ResourceId, id.Name)))
 }
 
 func resourceArmResourcePolicyExemptionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
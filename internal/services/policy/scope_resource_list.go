@@ -0,0 +1,132 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package policy
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// PolicyAssignmentScopeListModel is the configuration used to list Policy Assignments at a Scope
+type PolicyAssignmentScopeListModel struct {
+	Scope              types.String `tfsdk:"scope"`
+	AtScopeOnly        types.Bool   `tfsdk:"at_scope_only"`
+	PolicyDefinitionId types.String `tfsdk:"policy_definition_id"`
+}
+
+// PolicyExemptionScopeListModel is the configuration used to list Policy Exemptions at a Scope
+type PolicyExemptionScopeListModel struct {
+	Scope              types.String `tfsdk:"scope"`
+	AtScopeOnly        types.Bool   `tfsdk:"at_scope_only"`
+	PolicyAssignmentId types.String `tfsdk:"policy_assignment_id"`
+}
+
+func policyScopeListResourceConfigSchema(resourceName string, filterAttributeName string, filterDescription string) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"scope": schema.StringAttribute{
+				Required:    true,
+				Description: fmt.Sprintf("The Scope to list %s at, which can be a Management Group, Subscription, Resource Group or Resource ID.", resourceName),
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validate.PolicyScopeID,
+					},
+				},
+			},
+
+			"at_scope_only": schema.BoolAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Only return %s made at the `scope`, rather than at the `scope` and any Scopes beneath it. Defaults to `false`.", resourceName),
+			},
+
+			filterAttributeName: schema.StringAttribute{
+				Optional:    true,
+				Description: filterDescription,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+	}
+}
+
+// filter returns the filter used when listing Policy Assignments, the API only supports a single filter so the
+// remaining filters are applied using `matches`.
+func (m PolicyAssignmentScopeListModel) filter() *string {
+	if m.AtScopeOnly.ValueBool() {
+		return pointer.To("atExactScope()")
+	}
+
+	if v := m.PolicyDefinitionId.ValueString(); v != "" {
+		return pointer.To(fmt.Sprintf("policyDefinitionId eq '%s'", escapeODataString(v)))
+	}
+
+	return nil
+}
+
+func (m PolicyAssignmentScopeListModel) matches(scope string, policyDefinitionId string) bool {
+	if !policyScopeMatches(m.Scope.ValueString(), m.AtScopeOnly.ValueBool(), scope) {
+		return false
+	}
+
+	if v := m.PolicyDefinitionId.ValueString(); v != "" && !strings.EqualFold(v, policyDefinitionId) {
+		return false
+	}
+
+	return true
+}
+
+// filter returns the filter used when listing Policy Exemptions at `scopeId`, the API only supports a single filter so
+// the remaining filters are applied using `matches`.
+func (m PolicyExemptionScopeListModel) filter(scopeId parse.PolicyScopeId) string {
+	// Policy Exemptions for a Resource are listed at the Resource Group, see `listPolicyExemptionsAtScope`
+	if _, ok := scopeId.(parse.ScopeAtResource); m.AtScopeOnly.ValueBool() && !ok {
+		return "atExactScope()"
+	}
+
+	if v := m.PolicyAssignmentId.ValueString(); v != "" {
+		return fmt.Sprintf("policyAssignmentId eq '%s'", escapeODataString(v))
+	}
+
+	return ""
+}
+
+func (m PolicyExemptionScopeListModel) matches(scope string, policyAssignmentId string) bool {
+	if !policyScopeMatches(m.Scope.ValueString(), m.AtScopeOnly.ValueBool(), scope) {
+		return false
+	}
+
+	if v := m.PolicyAssignmentId.ValueString(); v != "" && !strings.EqualFold(v, policyAssignmentId) {
+		return false
+	}
+
+	return true
+}
+
+// policyScopeMatches returns whether an item at `scope` should be returned when listing at `listScope`. The API returns
+// items made at Scopes above `listScope` (which are inherited) and as such these are always excluded.
+func policyScopeMatches(listScope string, atScopeOnly bool, scope string) bool {
+	if atScopeOnly {
+		return strings.EqualFold(strings.TrimSuffix(scope, "/"), strings.TrimSuffix(listScope, "/"))
+	}
+
+	return azure.ScopeIsWithin(scope, listScope)
+}
+
+// escapeODataString escapes the single quotes within a string literal used in an OData filter
+func escapeODataString(input string) string {
+	return strings.ReplaceAll(input, "'", "''")
+}
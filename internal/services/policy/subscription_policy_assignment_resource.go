@@ -13,7 +13,10 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
-var _ sdk.ResourceWithUpdate = SubscriptionAssignmentResource{}
+var (
+	_ sdk.ResourceWithUpdate         = SubscriptionAssignmentResource{}
+	_ sdk.ResourceWithCustomIdentity = SubscriptionAssignmentResource{}
+)
 
 type SubscriptionAssignmentResource struct {
 	base assignmentBaseResource
@@ -58,6 +61,14 @@ func (r SubscriptionAssignmentResource) IDValidationFunc() pluginsdk.SchemaValid
 	return validate.SubscriptionAssignmentID
 }
 
+func (r SubscriptionAssignmentResource) IdentityImporter() pluginsdk.IdentityImporterFunc {
+	return r.base.identityImporter()
+}
+
+func (r SubscriptionAssignmentResource) IdentitySchema() map[string]*pluginsdk.Schema {
+	return r.base.identitySchema()
+}
+
 func (r SubscriptionAssignmentResource) ModelObject() interface{} {
 	return nil
 }
@@ -25,6 +25,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name subscription_policy_exemption -service-package-name policy -properties "name,scope:subscription_id" -no-subscription-id
+
 func resourceArmSubscriptionPolicyExemption() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceArmSubscriptionPolicyExemptionCreateUpdate,
@@ -32,10 +34,14 @@ func resourceArmSubscriptionPolicyExemption() *pluginsdk.Resource {
 		Update: resourceArmSubscriptionPolicyExemptionCreateUpdate,
 		Delete: resourceArmSubscriptionPolicyExemptionDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+		Importer: pluginsdk.ImporterValidatingResourceIdOrIdentity(func(id string) error {
 			_, err := parse.SubscriptionPolicyExemptionID(id)
 			return err
-		}),
+		}, pluginsdk.IdentityImporter(&parse.ScopedPolicyExemptionId{})),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&parse.ScopedPolicyExemptionId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -194,30 +200,11 @@ func resourceArmSubscriptionPolicyExemptionRead(d *pluginsdk.ResourceData, meta
 		return fmt.Errorf("reading %s: %+v", id.ID(), err)
 	}
 
-	d.Set("name", resp.Name)
-	d.Set("subscription_id", subscriptionId.ID())
-	if props := resp.ExemptionProperties; props != nil {
-		d.Set("policy_assignment_id", props.PolicyAssignmentID)
-		d.Set("display_name", props.DisplayName)
-		d.Set("description", props.Description)
-		d.Set("exemption_category", string(props.ExemptionCategory))
-
-		if err := d.Set("policy_definition_reference_ids", utils.FlattenStringSlice(props.PolicyDefinitionReferenceIds)); err != nil {
-			return fmt.Errorf("setting `policy_definition_reference_ids: %+v", err)
-		}
-
-		expiresOn := ""
-		if expiresTime := props.ExpiresOn; expiresTime != nil {
-			expiresOn = expiresTime.String()
-		}
-		d.Set("expires_on", expiresOn)
-
-		if metadataStr := flattenJSON(props.Metadata); metadataStr != "" {
-			d.Set("metadata", metadataStr)
-		}
+	if err := flattenPolicyExemption(d, "subscription_id", subscriptionId.ID(), resp); err != nil {
+		return err
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, pointer.To(parse.NewScopedPolicyExemptionID(subscriptionId.ID(), id.PolicyExemptionName)))
 }
 
 func resourceArmSubscriptionPolicyExemptionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -0,0 +1,37 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package policy
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type SubscriptionPolicyExemptionListResource struct{}
+
+var _ sdk.FrameworkListWrappedResourceWithConfig = new(SubscriptionPolicyExemptionListResource)
+
+func (SubscriptionPolicyExemptionListResource) ResourceFunc() *pluginsdk.Resource {
+	return resourceArmSubscriptionPolicyExemption()
+}
+
+func (SubscriptionPolicyExemptionListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "azurerm_subscription_policy_exemption"
+}
+
+func (SubscriptionPolicyExemptionListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = policyScopeListResourceConfigSchema("Policy Exemptions", "policy_assignment_id", "Only return Policy Exemptions for this Policy Assignment ID.")
+}
+
+func (SubscriptionPolicyExemptionListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata sdk.ResourceMetadata) {
+	listPolicyExemptions(ctx, request, stream, metadata, "azurerm_subscription_policy_exemption", resourceArmSubscriptionPolicyExemption, "subscription_id", func(scopeId parse.PolicyScopeId) bool {
+		_, ok := scopeId.(parse.ScopeAtSubscription)
+		return ok
+	})
+}
@@ -0,0 +1,41 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package postgres_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccPostgresqlFlexibleServerActiveDirectoryAdministrator_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_postgresql_flexible_server_active_directory_administrator", "test")
+	r := PostgresqlFlexibleServerAdministratorResource{}
+
+	checkedFields := map[string]struct{}{
+		"subscription_id":      {},
+		"flexible_server_name": {},
+		"object_id":            {},
+		"resource_group_name":  {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_postgresql_flexible_server_active_directory_administrator.test", checkedFields),
+				statecheck.ExpectIdentityValue("azurerm_postgresql_flexible_server_active_directory_administrator.test", tfjsonpath.New("subscription_id"), knownvalue.StringExact(data.Subscriptions.Primary)),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_postgresql_flexible_server_active_directory_administrator.test", tfjsonpath.New("flexible_server_name"), tfjsonpath.New("server_name")),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_postgresql_flexible_server_active_directory_administrator.test", tfjsonpath.New("object_id"), tfjsonpath.New("object_id")),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_postgresql_flexible_server_active_directory_administrator.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("resource_group_name")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	backupsautomaticandondemand "github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2025-08-01/backupautomaticandondemands"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -18,7 +19,10 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name postgresql_flexible_server_backup -service-package-name postgres -properties "name" -compare-values "subscription_id:server_id,resource_group_name:server_id,flexible_server_name:server_id"
+
 var _ sdk.Resource = PostgresqlFlexibleServerBackupResource{}
+var _ sdk.ResourceWithIdentity = PostgresqlFlexibleServerBackupResource{}
 
 type PostgresqlFlexibleServerBackupResource struct{}
 
@@ -36,6 +40,10 @@ func (r PostgresqlFlexibleServerBackupResource) IDValidationFunc() pluginsdk.Sch
 	return backupsautomaticandondemand.ValidateBackupID
 }
 
+func (r PostgresqlFlexibleServerBackupResource) Identity() resourceids.ResourceId {
+	return &backupsautomaticandondemand.BackupId{}
+}
+
 func (r PostgresqlFlexibleServerBackupResource) ResourceType() string {
 	return "azurerm_postgresql_flexible_server_backup"
 }
@@ -97,7 +105,7 @@ func (r PostgresqlFlexibleServerBackupResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -132,6 +140,10 @@ func (r PostgresqlFlexibleServerBackupResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
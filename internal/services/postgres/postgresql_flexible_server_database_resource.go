@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2025-08-01/databases"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
@@ -20,15 +21,18 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name postgresql_flexible_server_database -service-package-name postgres -properties "name" -compare-values "subscription_id:server_id,resource_group_name:server_id,flexible_server_name:server_id"
+
 func resourcePostgresqlFlexibleServerDatabase() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create: resourcePostgresqlFlexibleServerDatabaseCreate,
-		Read:   resourcePostgresqlFlexibleServerDatabaseRead,
-		Delete: resourcePostgresqlFlexibleServerDatabaseDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := databases.ParseDatabaseID(id)
-			return err
-		}),
+		Create:   resourcePostgresqlFlexibleServerDatabaseCreate,
+		Read:     resourcePostgresqlFlexibleServerDatabaseRead,
+		Delete:   resourcePostgresqlFlexibleServerDatabaseDelete,
+		Importer: pluginsdk.ImporterValidatingIdentity(&databases.DatabaseId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&databases.DatabaseId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -111,11 +115,14 @@ func resourcePostgresqlFlexibleServerDatabaseCreate(d *pluginsdk.ResourceData, m
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
+
 	return resourcePostgresqlFlexibleServerDatabaseRead(d, meta)
 }
 
 func resourcePostgresqlFlexibleServerDatabaseRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	client := meta.(*clients.Client).Postgres.FlexibleServerDatabaseClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -134,17 +141,22 @@ func resourcePostgresqlFlexibleServerDatabaseRead(d *pluginsdk.ResourceData, met
 		}
 		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
+
+	return resourcePostgresqlFlexibleServerDatabaseFlatten(d, id, resp.Model)
+}
+
+func resourcePostgresqlFlexibleServerDatabaseFlatten(d *pluginsdk.ResourceData, id *databases.DatabaseId, model *databases.Database) error {
 	d.Set("name", id.DatabaseName)
-	d.Set("server_id", databases.NewFlexibleServerID(subscriptionId, id.ResourceGroupName, id.FlexibleServerName).ID())
+	d.Set("server_id", databases.NewFlexibleServerID(id.SubscriptionId, id.ResourceGroupName, id.FlexibleServerName).ID())
 
-	if model := resp.Model; model != nil {
+	if model != nil {
 		if props := model.Properties; props != nil {
 			d.Set("charset", props.Charset)
 			d.Set("collation", props.Collation)
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourcePostgresqlFlexibleServerDatabaseDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -0,0 +1,105 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package postgres
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2025-08-01/databases"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type PostgresqlFlexibleServerDatabaseListResource struct{}
+
+type PostgresqlFlexibleServerDatabaseListModel struct {
+	ServerId types.String `tfsdk:"server_id"`
+}
+
+var _ sdk.FrameworkListWrappedResourceWithConfig = new(PostgresqlFlexibleServerDatabaseListResource)
+
+func (r PostgresqlFlexibleServerDatabaseListResource) ResourceFunc() *pluginsdk.Resource {
+	return resourcePostgresqlFlexibleServerDatabase()
+}
+
+func (r PostgresqlFlexibleServerDatabaseListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = `azurerm_postgresql_flexible_server_database`
+}
+
+func (r PostgresqlFlexibleServerDatabaseListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"server_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: databases.ValidateFlexibleServerID,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r PostgresqlFlexibleServerDatabaseListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata sdk.ResourceMetadata) {
+	client := metadata.Client.Postgres.FlexibleServerDatabaseClient
+
+	var data PostgresqlFlexibleServerDatabaseListModel
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	serverId, err := databases.ParseFlexibleServerID(data.ServerId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, "parsing PostgreSQL Flexible Server ID for `azurerm_postgresql_flexible_server_database`", err)
+		return
+	}
+
+	resp, err := client.ListByServerComplete(sdk.ListPageLimitContext(ctx, request), pointer.From(serverId))
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, "listing `azurerm_postgresql_flexible_server_database`", err)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, database := range resp.Items {
+			result := request.NewListResult(ctx)
+			result.DisplayName = pointer.From(database.Name)
+
+			id, err := databases.ParseDatabaseIDInsensitively(pointer.From(database.Id))
+			if err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, "parsing PostgreSQL Flexible Server Database ID", err)
+				return
+			}
+
+			rd := resourcePostgresqlFlexibleServerDatabase().Data(&terraform.InstanceState{})
+			rd.SetId(id.ID())
+
+			if err := resourcePostgresqlFlexibleServerDatabaseFlatten(rd, id, &database); err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, "encoding `azurerm_postgresql_flexible_server_database` resource data", err)
+				return
+			}
+
+			sdk.EncodeListResult(ctx, rd, &result)
+			if result.Diagnostics.HasError() {
+				push(result)
+				return
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
@@ -99,5 +99,8 @@ func (r Registration) EphemeralResources() []func() ephemeral.EphemeralResource
 }
 
 func (r Registration) ListResources() []sdk.FrameworkListWrappedResource {
-	return []sdk.FrameworkListWrappedResource{}
+	return []sdk.FrameworkListWrappedResource{
+		PostgresqlFlexibleServerDatabaseListResource{},
+		PostgresqlFlexibleServerListResource{},
+	}
 }
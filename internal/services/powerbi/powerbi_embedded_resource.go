@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/powerbidedicated/2021-01-01/capacities"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
@@ -23,6 +24,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name powerbi_embedded -service-package-name powerbi -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type PowerBIEmbeddedResource
+
 func resourcePowerBIEmbedded() *pluginsdk.Resource {
 	resource := &pluginsdk.Resource{
 		Create: resourcePowerBIEmbeddedCreate,
@@ -37,10 +40,11 @@ func resourcePowerBIEmbedded() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := capacities.ParseCapacityID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&capacities.CapacityId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&capacities.CapacityId{}),
+		},
 
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
@@ -139,6 +143,10 @@ func resourcePowerBIEmbeddedCreate(d *pluginsdk.ResourceData, meta interface{})
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
+
 	return resourcePowerBIEmbeddedRead(d, meta)
 }
 
@@ -191,7 +199,7 @@ func resourcePowerBIEmbeddedRead(d *pluginsdk.ResourceData, meta interface{}) er
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourcePowerBIEmbeddedUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -73,7 +73,7 @@ func (r PrivateDnsARecordListResource) List(ctx context.Context, request list.Li
 			return
 		}
 
-		resp, err := client.RecordSetsListByTypeComplete(ctx, privateDnsZoneId, privatedns.DefaultRecordSetsListByTypeOperationOptions())
+		resp, err := client.RecordSetsListByTypeComplete(sdk.ListPageLimitContext(ctx, request), privateDnsZoneId, privatedns.DefaultRecordSetsListByTypeOperationOptions())
 		if err != nil {
 			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", "azurerm_private_dns_a_record"), err)
 			return
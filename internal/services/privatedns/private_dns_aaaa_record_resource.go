@@ -4,6 +4,7 @@
 package privatedns
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2024-06-01/privatedns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -20,22 +22,19 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name private_dns_aaaa_record -properties "name,private_dns_zone_name:zone_name,resource_group_name" -compare-values "record_type:id" -test-resource-type PrivateDnsAAAARecordResource
+
 func resourcePrivateDnsAaaaRecord() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create: resourcePrivateDnsAaaaRecordCreateUpdate,
-		Read:   resourcePrivateDnsAaaaRecordRead,
-		Update: resourcePrivateDnsAaaaRecordCreateUpdate,
-		Delete: resourcePrivateDnsAaaaRecordDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			resourceId, err := privatedns.ParseRecordTypeID(id)
-			if err != nil {
-				return err
-			}
-			if resourceId.RecordType != privatedns.RecordTypeAAAA {
-				return fmt.Errorf("importing %s wrong type received: expected %s received %s", id, privatedns.RecordTypeAAAA, resourceId.RecordType)
-			}
-			return nil
-		}),
+		Create:   resourcePrivateDnsAaaaRecordCreateUpdate,
+		Read:     resourcePrivateDnsAaaaRecordRead,
+		Update:   resourcePrivateDnsAaaaRecordCreateUpdate,
+		Delete:   resourcePrivateDnsAaaaRecordDelete,
+		Importer: pluginsdk.ImporterValidatingIdentityThen(&privatedns.RecordTypeId{}, resourcePrivateDnsAaaaRecordImporter),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&privatedns.RecordTypeId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -85,6 +84,17 @@ func resourcePrivateDnsAaaaRecord() *pluginsdk.Resource {
 	}
 }
 
+func resourcePrivateDnsAaaaRecordImporter(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+	resourceId, err := privatedns.ParseRecordTypeID(d.Id())
+	if err != nil {
+		return []*pluginsdk.ResourceData{d}, err
+	}
+	if resourceId.RecordType != privatedns.RecordTypeAAAA {
+		return []*pluginsdk.ResourceData{d}, fmt.Errorf("importing %s wrong type received: expected %s received %s", resourceId, privatedns.RecordTypeAAAA, resourceId.RecordType)
+	}
+	return []*pluginsdk.ResourceData{d}, nil
+}
+
 func resourcePrivateDnsAaaaRecordCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).PrivateDns.RecordSetsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
@@ -123,6 +133,9 @@ func resourcePrivateDnsAaaaRecordCreateUpdate(d *pluginsdk.ResourceData, meta in
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 	return resourcePrivateDnsAaaaRecordRead(d, meta)
 }
 
@@ -145,11 +158,15 @@ func resourcePrivateDnsAaaaRecordRead(d *pluginsdk.ResourceData, meta interface{
 		return fmt.Errorf("retrieving %s: %+v", id, err)
 	}
 
+	return resourcePrivateDnsAaaaRecordFlatten(d, id, resp.Model)
+}
+
+func resourcePrivateDnsAaaaRecordFlatten(d *pluginsdk.ResourceData, id *privatedns.RecordTypeId, model *privatedns.RecordSet) error {
 	d.Set("name", id.RelativeRecordSetName)
 	d.Set("zone_name", id.PrivateDnsZoneName)
 	d.Set("resource_group_name", id.ResourceGroupName)
 
-	if model := resp.Model; model != nil {
+	if model != nil {
 		if props := model.Properties; props != nil {
 			d.Set("ttl", props.Ttl)
 			d.Set("fqdn", props.Fqdn)
@@ -164,7 +181,7 @@ func resourcePrivateDnsAaaaRecordRead(d *pluginsdk.ResourceData, meta interface{
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourcePrivateDnsAaaaRecordDelete(d *pluginsdk.ResourceData, meta interface{}) error {
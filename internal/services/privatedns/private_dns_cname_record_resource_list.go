@@ -73,7 +73,7 @@ func (r PrivateDnsCNameRecordListResource) List(ctx context.Context, request lis
 			return
 		}
 
-		resp, err := client.RecordSetsListByTypeComplete(ctx, privateDnsZoneId, privatedns.DefaultRecordSetsListByTypeOperationOptions())
+		resp, err := client.RecordSetsListByTypeComplete(sdk.ListPageLimitContext(ctx, request), privateDnsZoneId, privatedns.DefaultRecordSetsListByTypeOperationOptions())
 		if err != nil {
 			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", azurePrivateDnsCNameRecordResourceName), err)
 			return
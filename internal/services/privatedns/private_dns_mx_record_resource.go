@@ -5,6 +5,7 @@ package privatedns
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"math"
 	"time"
@@ -14,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2024-06-01/privatedns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -23,22 +25,19 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name private_dns_mx_record -properties "name,private_dns_zone_name:zone_name,resource_group_name" -compare-values "record_type:id"
+
 func resourcePrivateDnsMxRecord() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create: resourcePrivateDnsMxRecordCreateUpdate,
-		Read:   resourcePrivateDnsMxRecordRead,
-		Update: resourcePrivateDnsMxRecordCreateUpdate,
-		Delete: resourcePrivateDnsMxRecordDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			resourceId, err := privatedns.ParseRecordTypeID(id)
-			if err != nil {
-				return err
-			}
-			if resourceId.RecordType != privatedns.RecordTypeMX {
-				return fmt.Errorf("importing %s wrong type received: expected %s received %s", id, privatedns.RecordTypeMX, resourceId.RecordType)
-			}
-			return nil
-		}),
+		Create:   resourcePrivateDnsMxRecordCreateUpdate,
+		Read:     resourcePrivateDnsMxRecordRead,
+		Update:   resourcePrivateDnsMxRecordCreateUpdate,
+		Delete:   resourcePrivateDnsMxRecordDelete,
+		Importer: pluginsdk.ImporterValidatingIdentityThen(&privatedns.RecordTypeId{}, resourcePrivateDnsMxRecordImporter),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&privatedns.RecordTypeId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -106,6 +105,17 @@ func resourcePrivateDnsMxRecord() *pluginsdk.Resource {
 	}
 }
 
+func resourcePrivateDnsMxRecordImporter(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+	resourceId, err := privatedns.ParseRecordTypeID(d.Id())
+	if err != nil {
+		return []*pluginsdk.ResourceData{d}, err
+	}
+	if resourceId.RecordType != privatedns.RecordTypeMX {
+		return []*pluginsdk.ResourceData{d}, fmt.Errorf("importing %s wrong type received: expected %s received %s", resourceId, privatedns.RecordTypeMX, resourceId.RecordType)
+	}
+	return []*pluginsdk.ResourceData{d}, nil
+}
+
 func resourcePrivateDnsMxRecordCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).PrivateDns.RecordSetsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
@@ -144,6 +154,9 @@ func resourcePrivateDnsMxRecordCreateUpdate(d *pluginsdk.ResourceData, meta inte
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 	return resourcePrivateDnsMxRecordRead(d, meta)
 }
 
@@ -167,11 +180,15 @@ func resourcePrivateDnsMxRecordRead(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("retrieving %s: %+v", id, err)
 	}
 
+	return resourcePrivateDnsMxRecordFlatten(d, id, resp.Model)
+}
+
+func resourcePrivateDnsMxRecordFlatten(d *pluginsdk.ResourceData, id *privatedns.RecordTypeId, model *privatedns.RecordSet) error {
 	d.Set("name", id.RelativeRecordSetName)
 	d.Set("zone_name", id.PrivateDnsZoneName)
 	d.Set("resource_group_name", id.ResourceGroupName)
 
-	if model := resp.Model; model != nil {
+	if model != nil {
 		if props := model.Properties; props != nil {
 			d.Set("ttl", props.Ttl)
 			d.Set("fqdn", props.Fqdn)
@@ -186,7 +203,7 @@ func resourcePrivateDnsMxRecordRead(d *pluginsdk.ResourceData, meta interface{})
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourcePrivateDnsMxRecordDelete(d *pluginsdk.ResourceData, meta interface{}) error {
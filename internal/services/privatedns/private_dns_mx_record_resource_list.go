@@ -0,0 +1,118 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package privatedns
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2024-06-01/privatedns"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2024-06-01/privatezones"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type (
+	PrivateDnsMxRecordListResource struct{}
+	PrivateDnsMxRecordListModel    struct {
+		PrivateDnsZoneId types.String `tfsdk:"private_dns_zone_id"`
+	}
+)
+
+var _ sdk.FrameworkListWrappedResourceWithConfig = new(PrivateDnsMxRecordListResource)
+
+func (r PrivateDnsMxRecordListResource) ResourceFunc() *pluginsdk.Resource {
+	return resourcePrivateDnsMxRecord()
+}
+
+func (r PrivateDnsMxRecordListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "azurerm_private_dns_mx_record"
+}
+
+func (r PrivateDnsMxRecordListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"private_dns_zone_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: privatezones.ValidatePrivateDnsZoneID,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r PrivateDnsMxRecordListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata sdk.ResourceMetadata) {
+	client := metadata.Client.PrivateDns.RecordSetsClient
+
+	var data PrivateDnsMxRecordListModel
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	privateZoneId, err := privatezones.ParsePrivateDnsZoneID(data.PrivateDnsZoneId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("parsing Private DNS Zone ID for `%s`", "azurerm_private_dns_mx_record"), err)
+		return
+	}
+
+	zoneId := privatedns.NewPrivateZoneID(privateZoneId.SubscriptionId, privateZoneId.ResourceGroupName, privateZoneId.PrivateDnsZoneName, privatedns.RecordTypeMX)
+	resp, err := client.RecordSetsListByTypeComplete(sdk.ListPageLimitContext(ctx, request), zoneId, privatedns.DefaultRecordSetsListByTypeOperationOptions())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", "azurerm_private_dns_mx_record"), err)
+		return
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		sdk.SetResponseErrorDiagnostic(stream, "internal-error", "context had no deadline")
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		ctx, cancel := context.WithDeadline(context.Background(), deadline)
+		defer cancel()
+
+		for _, record := range resp.Items {
+			result := request.NewListResult(ctx)
+			result.DisplayName = pointer.From(record.Name)
+
+			id, err := privatedns.ParseRecordTypeID(pointer.From(record.Id))
+			if err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, "parsing Private DNS MX Record ID", err)
+				return
+			}
+
+			rd := resourcePrivateDnsMxRecord().Data(&terraform.InstanceState{})
+			rd.SetId(id.ID())
+
+			if err := resourcePrivateDnsMxRecordFlatten(rd, id, &record); err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, fmt.Sprintf("encoding `%s` resource data", "azurerm_private_dns_mx_record"), err)
+				return
+			}
+
+			sdk.EncodeListResult(ctx, rd, &result)
+			if result.Diagnostics.HasError() {
+				push(result)
+				return
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
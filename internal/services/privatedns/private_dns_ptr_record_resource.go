@@ -4,6 +4,7 @@
 package privatedns
 
 import (
+	"context"
 	"fmt"
 	"math"
 	"time"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2024-06-01/privatedns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -22,22 +24,19 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name private_dns_ptr_record -properties "name,private_dns_zone_name:zone_name,resource_group_name" -compare-values "record_type:id"
+
 func resourcePrivateDnsPtrRecord() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create: resourcePrivateDnsPtrRecordCreateUpdate,
-		Read:   resourcePrivateDnsPtrRecordRead,
-		Update: resourcePrivateDnsPtrRecordCreateUpdate,
-		Delete: resourcePrivateDnsPtrRecordDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			resourceId, err := privatedns.ParseRecordTypeID(id)
-			if err != nil {
-				return err
-			}
-			if resourceId.RecordType != privatedns.RecordTypePTR {
-				return fmt.Errorf("importing %s wrong type received: expected %s received %s", id, privatedns.RecordTypePTR, resourceId.RecordType)
-			}
-			return nil
-		}),
+		Create:   resourcePrivateDnsPtrRecordCreateUpdate,
+		Read:     resourcePrivateDnsPtrRecordRead,
+		Update:   resourcePrivateDnsPtrRecordCreateUpdate,
+		Delete:   resourcePrivateDnsPtrRecordDelete,
+		Importer: pluginsdk.ImporterValidatingIdentityThen(&privatedns.RecordTypeId{}, resourcePrivateDnsPtrRecordImporter),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&privatedns.RecordTypeId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -90,6 +89,17 @@ func resourcePrivateDnsPtrRecord() *pluginsdk.Resource {
 	}
 }
 
+func resourcePrivateDnsPtrRecordImporter(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+	resourceId, err := privatedns.ParseRecordTypeID(d.Id())
+	if err != nil {
+		return []*pluginsdk.ResourceData{d}, err
+	}
+	if resourceId.RecordType != privatedns.RecordTypePTR {
+		return []*pluginsdk.ResourceData{d}, fmt.Errorf("importing %s wrong type received: expected %s received %s", resourceId, privatedns.RecordTypePTR, resourceId.RecordType)
+	}
+	return []*pluginsdk.ResourceData{d}, nil
+}
+
 func resourcePrivateDnsPtrRecordCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).PrivateDns.RecordSetsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
@@ -128,6 +138,9 @@ func resourcePrivateDnsPtrRecordCreateUpdate(d *pluginsdk.ResourceData, meta int
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 	return resourcePrivateDnsPtrRecordRead(d, meta)
 }
 
@@ -150,11 +163,15 @@ func resourcePrivateDnsPtrRecordRead(d *pluginsdk.ResourceData, meta interface{}
 		return fmt.Errorf("retrieving %s: %+v", id, err)
 	}
 
+	return resourcePrivateDnsPtrRecordFlatten(d, id, resp.Model)
+}
+
+func resourcePrivateDnsPtrRecordFlatten(d *pluginsdk.ResourceData, id *privatedns.RecordTypeId, model *privatedns.RecordSet) error {
 	d.Set("name", id.RelativeRecordSetName)
 	d.Set("zone_name", id.PrivateDnsZoneName)
 	d.Set("resource_group_name", id.ResourceGroupName)
 
-	if model := resp.Model; model != nil {
+	if model != nil {
 		if props := model.Properties; props != nil {
 			d.Set("ttl", props.Ttl)
 			d.Set("fqdn", props.Fqdn)
@@ -171,7 +188,7 @@ func resourcePrivateDnsPtrRecordRead(d *pluginsdk.ResourceData, meta interface{}
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourcePrivateDnsPtrRecordDelete(d *pluginsdk.ResourceData, meta interface{}) error {
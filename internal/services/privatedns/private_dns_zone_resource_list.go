@@ -53,7 +53,7 @@ func (r PrivateDnsZoneListResource) List(ctx context.Context, request list.ListR
 
 		results = pointer.From(resp.Model)
 	default:
-		resp, err := client.ListComplete(ctx, commonids.NewSubscriptionID(subscriptionID), privatezones.DefaultListOperationOptions())
+		resp, err := client.ListComplete(sdk.ListPageLimitContext(ctx, request), commonids.NewSubscriptionID(subscriptionID), privatezones.DefaultListOperationOptions())
 		if err != nil {
 			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", privateDnsZoneResourceName), err)
 			return
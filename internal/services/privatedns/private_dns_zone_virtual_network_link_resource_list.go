@@ -70,7 +70,7 @@ func (r PrivateDnsZoneVirtualNetworkLinkListResource) List(ctx context.Context,
 			return
 		}
 
-		resp, err := client.ListComplete(ctx, *privatednszoneId, virtualnetworklinks.DefaultListOperationOptions())
+		resp, err := client.ListComplete(sdk.ListPageLimitContext(ctx, request), *privatednszoneId, virtualnetworklinks.DefaultListOperationOptions())
 		if err != nil {
 			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", "azurerm_private_dns_zone_virtual_network_link"), err)
 			return
@@ -19,7 +19,12 @@ func (r Registration) Actions() []func() action.Action {
 func (r Registration) ListResources() []sdk.FrameworkListWrappedResource {
 	return []sdk.FrameworkListWrappedResource{
 		PrivateDnsARecordListResource{},
+		PrivateDnsAaaaRecordListResource{},
 		PrivateDnsCNameRecordListResource{},
+		PrivateDnsMxRecordListResource{},
+		PrivateDnsPtrRecordListResource{},
+		PrivateDnsSrvRecordListResource{},
+		PrivateDnsTxtRecordListResource{},
 		PrivateDnsZoneListResource{},
 		PrivateDnsZoneVirtualNetworkLinkListResource{},
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/dnsresolvers"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/inboundendpoints"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -20,6 +21,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name private_dns_resolver_inbound_endpoint -service-package-name privatednsresolver -properties "name" -compare-values "subscription_id:private_dns_resolver_id,resource_group_name:private_dns_resolver_id,dns_resolver_name:private_dns_resolver_id" -test-resource-type DNSResolverInboundEndpointResource
+
 type PrivateDNSResolverInboundEndpointModel struct {
 	Name                 string                 `tfschema:"name"`
 	PrivateDNSResolverId string                 `tfschema:"private_dns_resolver_id"`
@@ -37,6 +40,7 @@ type IPConfigurationModel struct {
 type PrivateDNSResolverInboundEndpointResource struct{}
 
 var _ sdk.ResourceWithUpdate = PrivateDNSResolverInboundEndpointResource{}
+var _ sdk.ResourceWithIdentity = PrivateDNSResolverInboundEndpointResource{}
 
 func (r PrivateDNSResolverInboundEndpointResource) ResourceType() string {
 	return "azurerm_private_dns_resolver_inbound_endpoint"
@@ -50,6 +54,10 @@ func (r PrivateDNSResolverInboundEndpointResource) IDValidationFunc() pluginsdk.
 	return inboundendpoints.ValidateInboundEndpointID
 }
 
+func (r PrivateDNSResolverInboundEndpointResource) Identity() resourceids.ResourceId {
+	return &inboundendpoints.InboundEndpointId{}
+}
+
 func (r PrivateDNSResolverInboundEndpointResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -153,7 +161,7 @@ func (r PrivateDNSResolverInboundEndpointResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -235,6 +243,10 @@ func (r PrivateDNSResolverInboundEndpointResource) Read() sdk.ResourceFunc {
 				state.Tags = *model.Tags
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/dnsresolvers"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dnsresolver/2022-07-01/outboundendpoints"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -20,6 +21,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name private_dns_resolver_outbound_endpoint -service-package-name privatednsresolver -properties "name" -compare-values "subscription_id:private_dns_resolver_id,resource_group_name:private_dns_resolver_id,dns_resolver_name:private_dns_resolver_id" -test-resource-type DNSResolverOutboundEndpointResource
+
 type PrivateDNSResolverOutboundEndpointModel struct {
 	Name                 string            `tfschema:"name"`
 	PrivateDNSResolverId string            `tfschema:"private_dns_resolver_id"`
@@ -31,6 +34,7 @@ type PrivateDNSResolverOutboundEndpointModel struct {
 type PrivateDNSResolverOutboundEndpointResource struct{}
 
 var _ sdk.ResourceWithUpdate = PrivateDNSResolverOutboundEndpointResource{}
+var _ sdk.ResourceWithIdentity = PrivateDNSResolverOutboundEndpointResource{}
 
 func (r PrivateDNSResolverOutboundEndpointResource) ResourceType() string {
 	return "azurerm_private_dns_resolver_outbound_endpoint"
@@ -44,6 +48,10 @@ func (r PrivateDNSResolverOutboundEndpointResource) IDValidationFunc() pluginsdk
 	return outboundendpoints.ValidateOutboundEndpointID
 }
 
+func (r PrivateDNSResolverOutboundEndpointResource) Identity() resourceids.ResourceId {
+	return &outboundendpoints.OutboundEndpointId{}
+}
+
 func (r PrivateDNSResolverOutboundEndpointResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -117,7 +125,7 @@ func (r PrivateDNSResolverOutboundEndpointResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -198,6 +206,10 @@ func (r PrivateDNSResolverOutboundEndpointResource) Read() sdk.ResourceFunc {
 				state.Tags = *model.Tags
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package privatednsresolver_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccPrivateDnsResolverOutboundEndpoint_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_resolver_outbound_endpoint", "test")
+	r := DNSResolverOutboundEndpointResource{}
+
+	checkedFields := map[string]struct{}{
+		"name":                {},
+		"dns_resolver_name":   {},
+		"resource_group_name": {},
+		"subscription_id":     {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_private_dns_resolver_outbound_endpoint.test", checkedFields),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_private_dns_resolver_outbound_endpoint.test", tfjsonpath.New("name"), tfjsonpath.New("name")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_private_dns_resolver_outbound_endpoint.test", tfjsonpath.New("dns_resolver_name"), tfjsonpath.New("private_dns_resolver_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_private_dns_resolver_outbound_endpoint.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("private_dns_resolver_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_private_dns_resolver_outbound_endpoint.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("private_dns_resolver_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
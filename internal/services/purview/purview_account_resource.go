@@ -26,6 +26,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name purview_account -service-package-name purview -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 func resourcePurviewAccount() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourcePurviewAccountCreate,
@@ -33,10 +35,11 @@ func resourcePurviewAccount() *pluginsdk.Resource {
 		Update: resourcePurviewAccountUpdate,
 		Delete: resourcePurviewAccountDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := account.ParseAccountID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&account.AccountId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&account.AccountId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -191,6 +194,10 @@ func resourcePurviewAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
+
 	return resourcePurviewAccountRead(d, meta)
 }
 
@@ -271,7 +278,7 @@ func resourcePurviewAccountRead(d *pluginsdk.ResourceData, meta interface{}) err
 		d.Set("atlas_kafka_endpoint_secondary_connection_string", "")
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourcePurviewAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2025-01-01/subnets"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/qumulostorage/2024-06-19/filesystems"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -22,7 +23,10 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name qumulo_file_system -service-package-name qumulo -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type FileSystemTestResource
+
 var _ sdk.ResourceWithUpdate = FileSystemResource{}
+var _ sdk.ResourceWithIdentity = FileSystemResource{}
 
 type FileSystemResource struct{}
 
@@ -49,6 +53,10 @@ func (r FileSystemResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return filesystems.ValidateFileSystemID
 }
 
+func (r FileSystemResource) Identity() resourceids.ResourceId {
+	return &filesystems.FileSystemId{}
+}
+
 func (r FileSystemResource) ResourceType() string {
 	return "azurerm_qumulo_file_system"
 }
@@ -185,7 +193,7 @@ func (r FileSystemResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -240,6 +248,10 @@ func (r FileSystemResource) Read() sdk.ResourceFunc {
 				state.SubnetId = subnetId.ID()
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicesbackup/2024-10-01/protectionpolicies"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -23,6 +24,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name backup_policy_vm_workload -service-package-name recoveryservices -properties "name,resource_group_name,vault_name:recovery_vault_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type BackupProtectionPolicyVMWorkloadResource
+
 type BackupProtectionPolicyVMWorkloadModel struct {
 	Name               string             `tfschema:"name"`
 	ResourceGroupName  string             `tfschema:"resource_group_name"`
@@ -87,6 +90,7 @@ type Settings struct {
 type BackupProtectionPolicyVMWorkloadResource struct{}
 
 var _ sdk.ResourceWithUpdate = BackupProtectionPolicyVMWorkloadResource{}
+var _ sdk.ResourceWithIdentity = BackupProtectionPolicyVMWorkloadResource{}
 
 func (r BackupProtectionPolicyVMWorkloadResource) ResourceType() string {
 	return "azurerm_backup_policy_vm_workload"
@@ -100,6 +104,10 @@ func (r BackupProtectionPolicyVMWorkloadResource) IDValidationFunc() pluginsdk.S
 	return protectionpolicies.ValidateBackupPolicyID
 }
 
+func (r BackupProtectionPolicyVMWorkloadResource) Identity() resourceids.ResourceId {
+	return &protectionpolicies.BackupPolicyId{}
+}
+
 func (r BackupProtectionPolicyVMWorkloadResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -456,7 +464,7 @@ func (r BackupProtectionPolicyVMWorkloadResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -545,6 +553,10 @@ func (r BackupProtectionPolicyVMWorkloadResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2025-07-01/resourceguardresources"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dataprotection/2025-07-01/resourceguards"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservices/2024-01-01/vaults"
@@ -21,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name recovery_services_vault_resource_guard_association -service-package-name recoveryservices -compare-values "subscription_id:vault_id,resource_group_name:vault_id,vault_name:vault_id" -known-values "name:VaultProxy" -test-resource-type VaultResourceGuardAssociationResource
+
 const (
 	VaultGuardResourceType           = "Microsoft.RecoveryServices/vaults/backupResourceGuardProxies"
 	VaultGuardProxyDeleteRequestName = "default" // this name does not matter, this value comes from Portal.
@@ -29,6 +32,7 @@ const (
 type VaultGuardProxyResource struct{}
 
 var _ sdk.Resource = VaultGuardProxyResource{}
+var _ sdk.ResourceWithIdentity = VaultGuardProxyResource{}
 
 type VaultGuardProxyModel struct {
 	VaultId         string `tfschema:"vault_id"`
@@ -39,6 +43,10 @@ func (r VaultGuardProxyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc
 	return resourceguardproxy.ValidateBackupResourceGuardProxyID
 }
 
+func (r VaultGuardProxyResource) Identity() resourceids.ResourceId {
+	return &resourceguardproxy.BackupResourceGuardProxyId{}
+}
+
 func (r VaultGuardProxyResource) ModelObject() interface{} {
 	return &VaultGuardProxyModel{}
 }
@@ -103,7 +111,7 @@ func (r VaultGuardProxyResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -136,6 +144,10 @@ func (r VaultGuardProxyResource) Read() sdk.ResourceFunc {
 				state.ResourceGuardId = pointer.From(resp.Model.Properties.ResourceGuardResourceId)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -0,0 +1,41 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package recoveryservices_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccRecoveryServicesVaultResourceGuardAssociation_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_recovery_services_vault_resource_guard_association", "test")
+	r := VaultResourceGuardAssociationResource{}
+
+	checkedFields := map[string]struct{}{
+		"name":                {},
+		"resource_group_name": {},
+		"subscription_id":     {},
+		"vault_name":          {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_recovery_services_vault_resource_guard_association.test", checkedFields),
+				statecheck.ExpectIdentityValue("azurerm_recovery_services_vault_resource_guard_association.test", tfjsonpath.New("name"), knownvalue.StringExact("VaultProxy")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_recovery_services_vault_resource_guard_association.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("vault_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_recovery_services_vault_resource_guard_association.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("vault_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_recovery_services_vault_resource_guard_association.test", tfjsonpath.New("vault_name"), tfjsonpath.New("vault_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
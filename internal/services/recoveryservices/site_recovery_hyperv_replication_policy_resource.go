@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservices/2024-01-01/vaults"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2024-04-01/replicationpolicies"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -17,6 +18,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name site_recovery_hyperv_replication_policy -service-package-name recoveryservices -properties "name" -compare-values "subscription_id:recovery_vault_id,resource_group_name:recovery_vault_id,vault_name:recovery_vault_id" -test-params "2,1,300" -test-resource-type SiteRecoveryReplicationPolicyHyperVResource
+
 type ReplicationPolicyHyperVModel struct {
 	Name                                          string `tfschema:"name"`
 	RecoveryVaultId                               string `tfschema:"recovery_vault_id"`
@@ -28,6 +31,7 @@ type ReplicationPolicyHyperVModel struct {
 type ReplicationPolicyHyperVResource struct{}
 
 var _ sdk.ResourceWithUpdate = ReplicationPolicyHyperVResource{}
+var _ sdk.ResourceWithIdentity = ReplicationPolicyHyperVResource{}
 
 func (r ReplicationPolicyHyperVResource) Arguments() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
@@ -118,6 +122,9 @@ func (r ReplicationPolicyHyperVResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			return nil
 		},
@@ -163,6 +170,10 @@ func (r ReplicationPolicyHyperVResource) Read() sdk.ResourceFunc {
 					}
 				}
 			}
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -229,6 +240,10 @@ func (r ReplicationPolicyHyperVResource) IDValidationFunc() pluginsdk.SchemaVali
 	return replicationpolicies.ValidateReplicationPolicyID
 }
 
+func (r ReplicationPolicyHyperVResource) Identity() resourceids.ResourceId {
+	return &replicationpolicies.ReplicationPolicyId{}
+}
+
 func expandHyperVToAzurePolicyDetail(input *replicationpolicies.Policy) (out *replicationpolicies.HyperVReplicaAzurePolicyDetails, isA2A bool) {
 	if input.Properties == nil {
 		return nil, false
@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package recoveryservices_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccSiteRecoveryHypervReplicationPolicy_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_site_recovery_hyperv_replication_policy", "test")
+	r := SiteRecoveryReplicationPolicyHyperVResource{}
+
+	checkedFields := map[string]struct{}{
+		"name":                {},
+		"resource_group_name": {},
+		"subscription_id":     {},
+		"vault_name":          {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data, 2, 1, 300),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_site_recovery_hyperv_replication_policy.test", checkedFields),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_site_recovery_hyperv_replication_policy.test", tfjsonpath.New("name"), tfjsonpath.New("name")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_site_recovery_hyperv_replication_policy.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("recovery_vault_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_site_recovery_hyperv_replication_policy.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("recovery_vault_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_site_recovery_hyperv_replication_policy.test", tfjsonpath.New("vault_name"), tfjsonpath.New("recovery_vault_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2024-04-01/replicationnetworkmappings"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -22,15 +23,19 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name site_recovery_network_mapping -service-package-name recoveryservices -properties "name,resource_group_name,vault_name:recovery_vault_name,replication_fabric_name:source_recovery_fabric_name" -compare-values "subscription_id:source_network_id,replication_network_name:source_network_id"
+
 func resourceSiteRecoveryNetworkMapping() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceSiteRecoveryNetworkMappingCreate,
 		Read:   resourceSiteRecoveryNetworkMappingRead,
 		Delete: resourceSiteRecoveryNetworkMappingDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := replicationnetworkmappings.ParseReplicationNetworkMappingID(id)
-			return err
-		}),
+
+		Importer: pluginsdk.ImporterValidatingIdentity(&replicationnetworkmappings.ReplicationNetworkMappingId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&replicationnetworkmappings.ReplicationNetworkMappingId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -134,6 +139,9 @@ func resourceSiteRecoveryNetworkMappingCreate(d *pluginsdk.ResourceData, meta in
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 
 	return resourceSiteRecoveryNetworkMappingRead(d, meta)
 }
@@ -177,7 +185,7 @@ func resourceSiteRecoveryNetworkMappingRead(d *pluginsdk.ResourceData, meta inte
 		d.Set("target_recovery_fabric_name", targetFabricId.Name)
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceSiteRecoveryNetworkMappingDelete(d *pluginsdk.ResourceData, meta interface{}) error {
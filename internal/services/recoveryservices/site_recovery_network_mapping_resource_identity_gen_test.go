@@ -0,0 +1,44 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package recoveryservices_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccSiteRecoveryNetworkMapping_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_site_recovery_network_mapping", "test")
+	r := SiteRecoveryNetworkMappingResource{}
+
+	checkedFields := map[string]struct{}{
+		"name":                     {},
+		"replication_fabric_name":  {},
+		"resource_group_name":      {},
+		"vault_name":               {},
+		"replication_network_name": {},
+		"subscription_id":          {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_site_recovery_network_mapping.test", checkedFields),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_site_recovery_network_mapping.test", tfjsonpath.New("name"), tfjsonpath.New("name")),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_site_recovery_network_mapping.test", tfjsonpath.New("replication_fabric_name"), tfjsonpath.New("source_recovery_fabric_name")),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_site_recovery_network_mapping.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("resource_group_name")),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_site_recovery_network_mapping.test", tfjsonpath.New("vault_name"), tfjsonpath.New("recovery_vault_name")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_site_recovery_network_mapping.test", tfjsonpath.New("replication_network_name"), tfjsonpath.New("source_network_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_site_recovery_network_mapping.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("source_network_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
@@ -0,0 +1,43 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package recoveryservices_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccSiteRecoveryProtectionContainer_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_site_recovery_protection_container", "test")
+	r := SiteRecoveryProtectionContainerResource{}
+
+	checkedFields := map[string]struct{}{
+		"subscription_id":         {},
+		"name":                    {},
+		"replication_fabric_name": {},
+		"resource_group_name":     {},
+		"vault_name":              {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_site_recovery_protection_container.test", checkedFields),
+				statecheck.ExpectIdentityValue("azurerm_site_recovery_protection_container.test", tfjsonpath.New("subscription_id"), knownvalue.StringExact(data.Subscriptions.Primary)),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_site_recovery_protection_container.test", tfjsonpath.New("name"), tfjsonpath.New("name")),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_site_recovery_protection_container.test", tfjsonpath.New("replication_fabric_name"), tfjsonpath.New("recovery_fabric_name")),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_site_recovery_protection_container.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("resource_group_name")),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_site_recovery_protection_container.test", tfjsonpath.New("vault_name"), tfjsonpath.New("recovery_vault_name")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
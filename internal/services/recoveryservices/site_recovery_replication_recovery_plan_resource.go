@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/edgezones"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2024-04-01/replicationfabrics"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2024-04-01/replicationrecoveryplans"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -22,6 +23,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name site_recovery_replication_recovery_plan -service-package-name recoveryservices -properties "name" -compare-values "subscription_id:recovery_vault_id,resource_group_name:recovery_vault_id,vault_name:recovery_vault_id" -test-resource-type SiteRecoveryReplicationRecoveryPlan
+
 type SiteRecoveryReplicationRecoveryPlanModel struct {
 	Name                   string                                         `tfschema:"name"`
 	ShutdownRecoveryGroup  []GenericRecoveryGroupModel                    `tfschema:"shutdown_recovery_group"`
@@ -72,6 +75,7 @@ type ReplicationRecoveryPlanA2ASpecificInputModel struct {
 type SiteRecoveryReplicationRecoveryPlanResource struct{}
 
 var _ sdk.ResourceWithUpdate = SiteRecoveryReplicationRecoveryPlanResource{}
+var _ sdk.ResourceWithIdentity = SiteRecoveryReplicationRecoveryPlanResource{}
 
 func (r SiteRecoveryReplicationRecoveryPlanResource) ResourceType() string {
 	return "azurerm_site_recovery_replication_recovery_plan"
@@ -85,6 +89,10 @@ func (r SiteRecoveryReplicationRecoveryPlanResource) IDValidationFunc() pluginsd
 	return replicationrecoveryplans.ValidateReplicationRecoveryPlanID
 }
 
+func (r SiteRecoveryReplicationRecoveryPlanResource) Identity() resourceids.ResourceId {
+	return &replicationrecoveryplans.ReplicationRecoveryPlanId{}
+}
+
 func (r SiteRecoveryReplicationRecoveryPlanResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -380,6 +388,9 @@ func (r SiteRecoveryReplicationRecoveryPlanResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			return nil
 		},
@@ -432,6 +443,10 @@ func (r SiteRecoveryReplicationRecoveryPlanResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
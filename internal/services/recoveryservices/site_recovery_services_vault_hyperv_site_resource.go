@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2024-04-01/replicationfabrics"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -16,6 +17,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name site_recovery_services_vault_hyperv_site -service-package-name recoveryservices -properties "name" -compare-values "subscription_id:recovery_vault_id,resource_group_name:recovery_vault_id,vault_name:recovery_vault_id" -test-resource-type HyperVSiteResource
+
 type HyperVSiteModel struct {
 	Name            string `tfschema:"name"`
 	RecoveryVaultId string `tfschema:"recovery_vault_id"`
@@ -24,6 +27,7 @@ type HyperVSiteModel struct {
 type HyperVSiteResource struct{}
 
 var _ sdk.Resource = HyperVSiteResource{}
+var _ sdk.ResourceWithIdentity = HyperVSiteResource{}
 
 func (r HyperVSiteResource) Arguments() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
@@ -89,7 +93,7 @@ func (r HyperVSiteResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -124,6 +128,10 @@ func (r HyperVSiteResource) Read() sdk.ResourceFunc {
 			vaultId := replicationfabrics.NewVaultID(id.SubscriptionId, id.ResourceGroupName, id.VaultName)
 			state.RecoveryVaultId = vaultId.ID()
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -152,3 +160,7 @@ func (r HyperVSiteResource) Delete() sdk.ResourceFunc {
 func (r HyperVSiteResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return replicationfabrics.ValidateReplicationFabricID
 }
+
+func (r HyperVSiteResource) Identity() resourceids.ResourceId {
+	return &replicationfabrics.ReplicationFabricId{}
+}
@@ -248,6 +248,7 @@ func (r VMWareReplicationPolicyResource) Delete() sdk.ResourceFunc {
 
 func (r VMWareReplicationPolicyResource) CustomizeDiff() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
 			var plan SiteRecoveryReplicationPolicyVmwareModel
 			if err := metadata.DecodeDiff(&plan); err != nil {
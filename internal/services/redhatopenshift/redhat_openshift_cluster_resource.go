@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/redhatopenshift/2023-09-04/openshiftclusters"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
@@ -24,7 +25,10 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name redhat_openshift_cluster -service-package-name redhatopenshift -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type OpenShiftClusterResource
+
 var _ sdk.ResourceWithUpdate = RedHatOpenShiftCluster{}
+var _ sdk.ResourceWithIdentity = RedHatOpenShiftCluster{}
 
 type RedHatOpenShiftCluster struct{}
 
@@ -377,6 +381,10 @@ func (r RedHatOpenShiftCluster) IDValidationFunc() pluginsdk.SchemaValidateFunc
 	return openshiftclusters.ValidateProviderOpenShiftClusterID
 }
 
+func (r RedHatOpenShiftCluster) Identity() resourceids.ResourceId {
+	return &openshiftclusters.ProviderOpenShiftClusterId{}
+}
+
 func (r RedHatOpenShiftCluster) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 90 * time.Minute,
@@ -422,6 +430,9 @@ func (r RedHatOpenShiftCluster) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			return nil
 		},
@@ -524,6 +535,10 @@ func (r RedHatOpenShiftCluster) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/redis/2024-11-01/rediscacheaccesspolicyassignments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -16,9 +17,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name redis_cache_access_policy_assignment -service-package-name redis -properties "name" -compare-values "subscription_id:redis_cache_id,resource_group_name:redis_cache_id,redis_name:redis_cache_id"
+
 type RedisCacheAccessPolicyAssignmentResource struct{}
 
 var _ sdk.Resource = RedisCacheAccessPolicyAssignmentResource{}
+var _ sdk.ResourceWithIdentity = RedisCacheAccessPolicyAssignmentResource{}
 
 type RedisCacheAccessPolicyAssignmentResourceModel struct {
 	Name             string `tfschema:"name"`
@@ -77,6 +81,10 @@ func (r RedisCacheAccessPolicyAssignmentResource) IDValidationFunc() pluginsdk.S
 	return rediscacheaccesspolicyassignments.ValidateAccessPolicyAssignmentID
 }
 
+func (r RedisCacheAccessPolicyAssignmentResource) Identity() resourceids.ResourceId {
+	return &rediscacheaccesspolicyassignments.AccessPolicyAssignmentId{}
+}
+
 func (r RedisCacheAccessPolicyAssignmentResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 5 * time.Minute,
@@ -120,7 +128,7 @@ func (r RedisCacheAccessPolicyAssignmentResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -158,6 +166,10 @@ func (r RedisCacheAccessPolicyAssignmentResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package redis_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccRedisCacheAccessPolicyAssignment_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_redis_cache_access_policy_assignment", "test")
+	r := RedisCacheAccessPolicyAssignmentResource{}
+
+	checkedFields := map[string]struct{}{
+		"name":                {},
+		"redis_name":          {},
+		"resource_group_name": {},
+		"subscription_id":     {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_redis_cache_access_policy_assignment.test", checkedFields),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_redis_cache_access_policy_assignment.test", tfjsonpath.New("name"), tfjsonpath.New("name")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_redis_cache_access_policy_assignment.test", tfjsonpath.New("redis_name"), tfjsonpath.New("redis_cache_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_redis_cache_access_policy_assignment.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("redis_cache_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_redis_cache_access_policy_assignment.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("redis_cache_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
@@ -9,15 +9,19 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/redis/2024-11-01/rediscacheaccesspolicies"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name redis_cache_access_policy -service-package-name redis -properties "name" -compare-values "subscription_id:redis_cache_id,resource_group_name:redis_cache_id,redis_name:redis_cache_id"
+
 type RedisCacheAccessPolicyResource struct{}
 
 var _ sdk.ResourceWithUpdate = RedisCacheAccessPolicyResource{}
+var _ sdk.ResourceWithIdentity = RedisCacheAccessPolicyResource{}
 
 type RedisCacheAccessPolicyResourceModel struct {
 	Name         string `tfschema:"name"`
@@ -61,6 +65,10 @@ func (r RedisCacheAccessPolicyResource) IDValidationFunc() pluginsdk.SchemaValid
 	return rediscacheaccesspolicies.ValidateAccessPolicyID
 }
 
+func (r RedisCacheAccessPolicyResource) Identity() resourceids.ResourceId {
+	return &rediscacheaccesspolicies.AccessPolicyId{}
+}
+
 func (r RedisCacheAccessPolicyResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 5 * time.Minute,
@@ -105,7 +113,7 @@ func (r RedisCacheAccessPolicyResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -187,6 +195,10 @@ func (r RedisCacheAccessPolicyResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
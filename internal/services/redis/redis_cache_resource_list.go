@@ -53,7 +53,7 @@ func (RedisCacheListResource) List(ctx context.Context, request list.ListRequest
 
 	switch {
 	case !data.ResourceGroupName.IsNull():
-		resp, err := client.RedisListByResourceGroupComplete(ctx, commonids.NewResourceGroupID(subscriptionID, data.ResourceGroupName.ValueString()))
+		resp, err := client.RedisListByResourceGroupComplete(sdk.ListPageLimitContext(ctx, request), commonids.NewResourceGroupID(subscriptionID, data.ResourceGroupName.ValueString()))
 		if err != nil {
 			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", redisCacheResourceName), err)
 			return
@@ -61,7 +61,7 @@ func (RedisCacheListResource) List(ctx context.Context, request list.ListRequest
 
 		results = resp.Items
 	default:
-		resp, err := client.RedisListBySubscriptionComplete(ctx, commonids.NewSubscriptionID(subscriptionID))
+		resp, err := client.RedisListBySubscriptionComplete(sdk.ListPageLimitContext(ctx, request), commonids.NewSubscriptionID(subscriptionID))
 		if err != nil {
 			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", redisCacheResourceName), err)
 			return
@@ -66,7 +66,7 @@ func (RedisFirewallRuleListResource) List(ctx context.Context, request list.List
 		return
 	}
 
-	resp, err := client.FirewallRulesListComplete(ctx, pointer.From(cacheId))
+	resp, err := client.FirewallRulesListComplete(sdk.ListPageLimitContext(ctx, request), pointer.From(cacheId))
 	if err != nil {
 		sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", redisFirewallRuleResourceName), err)
 		return
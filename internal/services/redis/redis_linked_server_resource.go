@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/redis/2024-11-01/linkedserver"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/redis/migration"
@@ -22,15 +23,19 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name redis_linked_server -service-package-name redis -properties "resource_group_name,redis_name:target_redis_cache_name" -compare-values "subscription_id:linked_redis_cache_id,name:linked_redis_cache_id"
+
 func resourceRedisLinkedServer() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceRedisLinkedServerCreate,
 		Read:   resourceRedisLinkedServerRead,
 		Delete: resourceRedisLinkedServerDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := linkedserver.ParseLinkedServerID(id)
-			return err
-		}),
+
+		Importer: pluginsdk.ImporterValidatingIdentity(&linkedserver.LinkedServerId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&linkedserver.LinkedServerId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
@@ -143,6 +148,10 @@ func resourceRedisLinkedServerCreate(d *pluginsdk.ResourceData, meta interface{}
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
+
 	return resourceRedisLinkedServerRead(d, meta)
 }
 
@@ -185,7 +194,7 @@ func resourceRedisLinkedServerRead(d *pluginsdk.ResourceData, meta interface{})
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceRedisLinkedServerDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/relay/2021-11-01/hybridconnections"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -19,16 +20,20 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name relay_hybrid_connection -service-package-name relay -properties "name,resource_group_name,namespace_name:relay_namespace_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 func resourceArmRelayHybridConnection() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceArmRelayHybridConnectionCreateUpdate,
 		Read:   resourceArmRelayHybridConnectionRead,
 		Update: resourceArmRelayHybridConnectionCreateUpdate,
 		Delete: resourceArmRelayHybridConnectionDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := hybridconnections.ParseHybridConnectionID(id)
-			return err
-		}),
+
+		Importer: pluginsdk.ImporterValidatingIdentity(&hybridconnections.HybridConnectionId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&hybridconnections.HybridConnectionId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -106,6 +111,10 @@ func resourceArmRelayHybridConnectionCreateUpdate(d *pluginsdk.ResourceData, met
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
+
 	return resourceArmRelayHybridConnectionRead(d, meta)
 }
 
@@ -140,7 +149,7 @@ func resourceArmRelayHybridConnectionRead(d *pluginsdk.ResourceData, meta interf
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceArmRelayHybridConnectionDelete(d *pluginsdk.ResourceData, meta interface{}) error {
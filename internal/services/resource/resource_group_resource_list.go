@@ -25,6 +25,7 @@ type (
 	ResourceGroupListModel    struct {
 		SubscriptionId types.String `tfsdk:"subscription_id"`
 		Filter         types.String `tfsdk:"filter"`
+		Tags           types.Map    `tfsdk:"tags"`
 	}
 )
 
@@ -58,6 +59,12 @@ func (r ResourceGroupListResource) ListResourceConfigSchema(_ context.Context, _
 					stringvalidator.LengthAtLeast(1),
 				},
 			},
+
+			"tags": listschema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Only return Resource Groups which have all of these tags.",
+			},
 		},
 	}
 }
@@ -79,14 +86,32 @@ func (r ResourceGroupListResource) List(ctx context.Context, request list.ListRe
 		subscriptionID = data.SubscriptionId.ValueString()
 	}
 
+	// the API only supports filtering on a single tag, as such all tags are also checked client-side - and since the
+	// wrapper skips the `tags` defined by this schema, these must be handled here
+	t := make(map[string]string)
+	if diags := data.Tags.ElementsAs(ctx, &t, false); diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	tagsFilter := sdk.ListFilter{
+		Tags: t,
+	}
+
 	options := resourcegroups.DefaultListOperationOptions()
-	if !data.Filter.IsNull() {
-		options = resourcegroups.ListOperationOptions{
-			Filter: data.Filter.ValueStringPointer(),
-		}
+	switch {
+	case !data.Filter.IsNull():
+		options.Filter = data.Filter.ValueStringPointer()
+	case len(t) > 0:
+		options.Filter = sdk.ResourceManagerTagFilter(t)
+	}
+
+	listCtx := ctx
+	if len(t) == 0 || (data.Filter.IsNull() && len(t) == 1) {
+		// further pages can only be skipped when none of the Resource Groups returned are filtered client-side
+		listCtx = sdk.ListPageLimitContext(ctx, request)
 	}
 
-	resp, err := client.ListComplete(ctx, commonids.NewSubscriptionID(subscriptionID), options)
+	resp, err := client.ListComplete(listCtx, commonids.NewSubscriptionID(subscriptionID), options)
 	if err != nil {
 		sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", resourceGroupResourceName), err)
 		return
@@ -95,6 +120,10 @@ func (r ResourceGroupListResource) List(ctx context.Context, request list.ListRe
 
 	stream.Results = func(push func(list.ListResult) bool) {
 		for _, group := range results {
+			if !tagsFilter.Matches(pointer.From(group.Name), &group.Location, group.Tags) {
+				continue
+			}
+
 			result := request.NewListResult(ctx)
 			result.DisplayName = pointer.From(group.Name)
 
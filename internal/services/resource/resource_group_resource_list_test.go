@@ -41,6 +41,27 @@ func TestAccResourceGroup_list_basic(t *testing.T) {
 					querycheck.ExpectLength(listResourceAddress, 3),
 				},
 			},
+			{
+				Query:  true,
+				Config: r.basicQueryWithTags(data),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength(listResourceAddress, 3),
+				},
+			},
+			{
+				Query:  true,
+				Config: r.basicQueryWithNameRegexAndLocation(data),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength(listResourceAddress, 1),
+				},
+			},
+			{
+				Query:  true,
+				Config: r.basicQueryWithMaxResultsAndTimeouts(data),
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength(listResourceAddress, 2),
+				},
+			},
 		},
 	})
 }
@@ -88,3 +109,46 @@ list "azurerm_resource_group" "list" {
 }
 `, data.RandomInteger)
 }
+
+func (r ResourceGroupResource) basicQueryWithTags(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+list "azurerm_resource_group" "list" {
+  provider = azurerm
+  config {
+    tags = {
+      "query" = "test-%d"
+    }
+  }
+}
+`, data.RandomInteger)
+}
+
+func (r ResourceGroupResource) basicQueryWithNameRegexAndLocation(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+list "azurerm_resource_group" "list" {
+  provider = azurerm
+  config {
+    name_regex = "^acctestRG4-%d$"
+    location   = "%s"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r ResourceGroupResource) basicQueryWithMaxResultsAndTimeouts(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+list "azurerm_resource_group" "list" {
+  provider = azurerm
+  config {
+    tags = {
+      "query" = "test-%d"
+    }
+    max_results = 2
+
+    timeouts {
+      list = "5m"
+    }
+  }
+}
+`, data.RandomInteger)
+}
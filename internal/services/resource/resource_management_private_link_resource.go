@@ -12,13 +12,17 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2020-05-01/resourcemanagementprivatelink"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name resource_management_private_link -service-package-name resource -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type ResourceManagementPrivateLinkTestResource
+
 var _ sdk.Resource = ResourceManagementPrivateLinkResource{}
+var _ sdk.ResourceWithIdentity = ResourceManagementPrivateLinkResource{}
 
 type ResourceManagementPrivateLinkResource struct{}
 
@@ -36,6 +40,10 @@ func (r ResourceManagementPrivateLinkResource) IDValidationFunc() pluginsdk.Sche
 	return resourcemanagementprivatelink.ValidateResourceManagementPrivateLinkID
 }
 
+func (r ResourceManagementPrivateLinkResource) Identity() resourceids.ResourceId {
+	return &resourcemanagementprivatelink.ResourceManagementPrivateLinkId{}
+}
+
 func (r ResourceManagementPrivateLinkResource) ResourceType() string {
 	return "azurerm_resource_management_private_link"
 }
@@ -91,7 +99,7 @@ func (r ResourceManagementPrivateLinkResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -124,6 +132,10 @@ func (r ResourceManagementPrivateLinkResource) Read() sdk.ResourceFunc {
 				schema.Location = location.NormalizeNilable(model.Location)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&schema)
 		},
 	}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2025-05-01/services"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2025-05-01/sharedprivatelinkresources"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
@@ -20,11 +21,14 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name search_shared_private_link_service -service-package-name search -properties "name" -compare-values "subscription_id:search_service_id,resource_group_name:search_service_id,search_service_name:search_service_id"
+
 type SharedPrivateLinkServiceResource struct{}
 
 var (
-	_ sdk.Resource           = SharedPrivateLinkServiceResource{}
-	_ sdk.ResourceWithUpdate = SharedPrivateLinkServiceResource{}
+	_ sdk.Resource             = SharedPrivateLinkServiceResource{}
+	_ sdk.ResourceWithUpdate   = SharedPrivateLinkServiceResource{}
+	_ sdk.ResourceWithIdentity = SharedPrivateLinkServiceResource{}
 )
 
 type SharedPrivateLinkServiceModel struct {
@@ -95,6 +99,10 @@ func (r SharedPrivateLinkServiceResource) IDValidationFunc() pluginsdk.SchemaVal
 	return sharedprivatelinkresources.ValidateSharedPrivateLinkResourceID
 }
 
+func (r SharedPrivateLinkServiceResource) Identity() resourceids.ResourceId {
+	return &sharedprivatelinkresources.SharedPrivateLinkResourceId{}
+}
+
 func (r SharedPrivateLinkServiceResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
@@ -140,7 +148,7 @@ func (r SharedPrivateLinkServiceResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 		Timeout: 60 * time.Minute,
 	}
@@ -190,6 +198,10 @@ func (r SharedPrivateLinkServiceResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(state)
 		},
 		Timeout: 5 * time.Minute,
@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package search_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccSearchSharedPrivateLinkService_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_shared_private_link_service", "test")
+	r := SearchSharedPrivateLinkServiceResource{}
+
+	checkedFields := map[string]struct{}{
+		"name":                {},
+		"resource_group_name": {},
+		"search_service_name": {},
+		"subscription_id":     {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_search_shared_private_link_service.test", checkedFields),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_search_shared_private_link_service.test", tfjsonpath.New("name"), tfjsonpath.New("name")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_search_shared_private_link_service.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("search_service_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_search_shared_private_link_service.test", tfjsonpath.New("search_service_name"), tfjsonpath.New("search_service_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_search_shared_private_link_service.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("search_service_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
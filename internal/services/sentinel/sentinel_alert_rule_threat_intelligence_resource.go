@@ -10,6 +10,7 @@ import (
 
 	alertruletemplates "github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2021-09-01-preview/securityinsight" // nolint: staticcheck
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2023-12-01-preview/alertrules"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -20,6 +21,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name sentinel_alert_rule_threat_intelligence -service-package-name sentinel -properties "rule_id:name" -compare-values "subscription_id:log_analytics_workspace_id,resource_group_name:log_analytics_workspace_id,workspace_name:log_analytics_workspace_id"
+
 type AlertRuleThreatIntelligenceModel struct {
 	Name         string `tfschema:"name"`
 	WorkspaceId  string `tfschema:"log_analytics_workspace_id"`
@@ -32,6 +35,7 @@ type AlertRuleThreatIntelligenceResource struct{}
 var (
 	_ sdk.ResourceWithCustomImporter = AlertRuleThreatIntelligenceResource{}
 	_ sdk.ResourceWithUpdate         = AlertRuleThreatIntelligenceResource{}
+	_ sdk.ResourceWithIdentity       = AlertRuleThreatIntelligenceResource{}
 )
 
 func (a AlertRuleThreatIntelligenceResource) ModelObject() interface{} {
@@ -46,6 +50,10 @@ func (a AlertRuleThreatIntelligenceResource) IDValidationFunc() pluginsdk.Schema
 	return alertrules.ValidateAlertRuleID
 }
 
+func (a AlertRuleThreatIntelligenceResource) Identity() resourceids.ResourceId {
+	return &alertrules.AlertRuleId{}
+}
+
 func (a AlertRuleThreatIntelligenceResource) CustomImporter() sdk.ResourceRunFunc {
 	return importSentinelAlertRuleForTypedSdk(alertrules.AlertRuleKindThreatIntelligence)
 }
@@ -143,7 +151,7 @@ func (a AlertRuleThreatIntelligenceResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -185,6 +193,10 @@ func (a AlertRuleThreatIntelligenceResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package sentinel_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccSentinelAlertRuleThreatIntelligence_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_alert_rule_threat_intelligence", "test")
+	r := SentinelAlertRuleThreatIntelligenceResource{}
+
+	checkedFields := map[string]struct{}{
+		"rule_id":             {},
+		"resource_group_name": {},
+		"subscription_id":     {},
+		"workspace_name":      {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_sentinel_alert_rule_threat_intelligence.test", checkedFields),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_sentinel_alert_rule_threat_intelligence.test", tfjsonpath.New("rule_id"), tfjsonpath.New("name")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_sentinel_alert_rule_threat_intelligence.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("log_analytics_workspace_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_sentinel_alert_rule_threat_intelligence.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("log_analytics_workspace_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_sentinel_alert_rule_threat_intelligence.test", tfjsonpath.New("workspace_name"), tfjsonpath.New("log_analytics_workspace_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
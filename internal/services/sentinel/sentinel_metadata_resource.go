@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2022-10-01/workspaces"
 	sentinelmetadata "github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2022-10-01-preview/metadata"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name sentinel_metadata -service-package-name sentinel -properties "name" -compare-values "subscription_id:workspace_id,resource_group_name:workspace_id,workspace_name:workspace_id" -test-resource-type MetadataResource
+
 type MetadataModel struct {
 	Name                     string                  `tfschema:"name"`
 	WorkspaceId              string                  `tfschema:"workspace_id"`
@@ -71,6 +74,7 @@ type MetadataCategoryModel struct {
 type MetadataResource struct{}
 
 var _ sdk.ResourceWithUpdate = MetadataResource{}
+var _ sdk.ResourceWithIdentity = MetadataResource{}
 
 func (a MetadataResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
@@ -348,6 +352,10 @@ func (a MetadataResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return sentinelmetadata.ValidateMetadataID
 }
 
+func (a MetadataResource) Identity() resourceids.ResourceId {
+	return &sentinelmetadata.MetadataId{}
+}
+
 func (a MetadataResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -450,7 +458,7 @@ func (a MetadataResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -531,6 +539,10 @@ func (a MetadataResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package sentinel_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccSentinelWatchlistItem_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_watchlist_item", "test")
+	r := WatchlistItemResource{}
+
+	checkedFields := map[string]struct{}{
+		"watchlist_item_id":   {},
+		"resource_group_name": {},
+		"subscription_id":     {},
+		"watchlist_alias":     {},
+		"workspace_name":      {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_sentinel_watchlist_item.test", checkedFields),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_sentinel_watchlist_item.test", tfjsonpath.New("watchlist_item_id"), tfjsonpath.New("name")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_sentinel_watchlist_item.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("watchlist_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_sentinel_watchlist_item.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("watchlist_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_sentinel_watchlist_item.test", tfjsonpath.New("watchlist_alias"), tfjsonpath.New("watchlist_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_sentinel_watchlist_item.test", tfjsonpath.New("workspace_name"), tfjsonpath.New("watchlist_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2022-11-01/watchlists"
 	commonValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
@@ -18,9 +19,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name sentinel_watchlist -service-package-name sentinel -properties "watchlist_alias:name" -compare-values "subscription_id:log_analytics_workspace_id,resource_group_name:log_analytics_workspace_id,workspace_name:log_analytics_workspace_id" -test-resource-type WatchlistResource
+
 type WatchlistResource struct{}
 
 var _ sdk.Resource = WatchlistResource{}
+var _ sdk.ResourceWithIdentity = WatchlistResource{}
 
 type WatchlistModel struct {
 	Name                    string   `tfschema:"name"`
@@ -98,6 +102,10 @@ func (r WatchlistResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return watchlists.ValidateWatchlistID
 }
 
+func (r WatchlistResource) Identity() resourceids.ResourceId {
+	return &watchlists.WatchlistId{}
+}
+
 func (r WatchlistResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -151,7 +159,7 @@ func (r WatchlistResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -189,6 +197,10 @@ func (r WatchlistResource) Read() sdk.ResourceFunc {
 					watchList.DefaultDuration = pointer.From(props.DefaultDuration)
 				}
 			}
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&watchList)
 		},
 	}
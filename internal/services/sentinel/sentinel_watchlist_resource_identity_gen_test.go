@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package sentinel_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccSentinelWatchlist_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_watchlist", "test")
+	r := WatchlistResource{}
+
+	checkedFields := map[string]struct{}{
+		"watchlist_alias":     {},
+		"resource_group_name": {},
+		"subscription_id":     {},
+		"workspace_name":      {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_sentinel_watchlist.test", checkedFields),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_sentinel_watchlist.test", tfjsonpath.New("watchlist_alias"), tfjsonpath.New("name")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_sentinel_watchlist.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("log_analytics_workspace_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_sentinel_watchlist.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("log_analytics_workspace_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_sentinel_watchlist.test", tfjsonpath.New("workspace_name"), tfjsonpath.New("log_analytics_workspace_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
@@ -95,5 +95,10 @@ func (r Registration) EphemeralResources() []func() ephemeral.EphemeralResource
 }
 
 func (r Registration) ListResources() []sdk.FrameworkListWrappedResource {
-	return []sdk.FrameworkListWrappedResource{}
+	return []sdk.FrameworkListWrappedResource{
+		ServiceBusNamespaceListResource{},
+		ServiceBusQueueListResource{},
+		ServiceBusTopicListResource{},
+		ServiceBusSubscriptionListResource{},
+	}
 }
@@ -12,11 +12,14 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/keyvault"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2024-01-01/namespaces"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name servicebus_namespace_customer_managed_key -service-package-name servicebus -compare-values "subscription_id:namespace_id,resource_group_name:namespace_id,name:namespace_id"
+
 type ServiceBusNamespaceCustomerManagedKeyResource struct{}
 
 type ServiceBusNamespaceCustomerManagedKeyModel struct {
@@ -26,6 +29,7 @@ type ServiceBusNamespaceCustomerManagedKeyModel struct {
 }
 
 var _ sdk.ResourceWithUpdate = ServiceBusNamespaceCustomerManagedKeyResource{}
+var _ sdk.ResourceWithIdentity = ServiceBusNamespaceCustomerManagedKeyResource{}
 
 func (r ServiceBusNamespaceCustomerManagedKeyResource) ModelObject() interface{} {
 	return &ServiceBusNamespaceCustomerManagedKeyModel{}
@@ -39,6 +43,10 @@ func (r ServiceBusNamespaceCustomerManagedKeyResource) IDValidationFunc() plugin
 	return namespaces.ValidateNamespaceID
 }
 
+func (r ServiceBusNamespaceCustomerManagedKeyResource) Identity() resourceids.ResourceId {
+	return &namespaces.NamespaceId{}
+}
+
 func (r ServiceBusNamespaceCustomerManagedKeyResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"namespace_id": {
@@ -122,7 +130,7 @@ func (r ServiceBusNamespaceCustomerManagedKeyResource) Create() sdk.ResourceFunc
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, id)
 		},
 	}
 }
@@ -162,6 +170,10 @@ func (r ServiceBusNamespaceCustomerManagedKeyResource) Read() sdk.ResourceFunc {
 					state.InfrastructureEncryptionEnabled = pointer.From(encryption.RequireInfrastructureEncryption)
 				}
 			}
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
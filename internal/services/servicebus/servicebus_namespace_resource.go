@@ -32,6 +32,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name servicebus_namespace -service-package-name servicebus -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type ServiceBusNamespaceResource
+
 // Default Authorization Rule/Policy created by Azure, used to populate the
 // default connection strings and keys
 var (
@@ -46,10 +48,11 @@ func resourceServiceBusNamespace() *pluginsdk.Resource {
 		Update: resourceServiceBusNamespaceUpdate,
 		Delete: resourceServiceBusNamespaceDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := namespaces.ParseNamespaceID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&namespaces.NamespaceId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&namespaces.NamespaceId{}),
+		},
 
 		SchemaVersion: 1,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
@@ -361,6 +364,9 @@ func resourceServiceBusNamespaceCreate(d *pluginsdk.ResourceData, meta interface
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 
 	if err = createNetworkRuleSetForNamespace(ctx, client, id, d.Get("network_rule_set").([]interface{})); err != nil {
 		return err
@@ -478,7 +484,6 @@ func resourceServiceBusNamespaceUpdate(d *pluginsdk.ResourceData, meta interface
 
 func resourceServiceBusNamespaceRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).ServiceBus.NamespacesClient
-	namespaceAuthClient := meta.(*clients.Client).ServiceBus.NamespacesAuthClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -496,10 +501,16 @@ func resourceServiceBusNamespaceRead(d *pluginsdk.ResourceData, meta interface{}
 		return fmt.Errorf("retrieving %s: %+v", id, err)
 	}
 
+	return resourceServiceBusNamespaceFlatten(ctx, d, meta, id, resp.Model, true)
+}
+
+// resourceServiceBusNamespaceFlatten sets the Resource Data from the model, the default Authorization Rule keys and
+// the Network Rule Set require additional API calls and are only retrieved when includeAdditional is true
+func resourceServiceBusNamespaceFlatten(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}, id *namespaces.NamespaceId, model *namespaces.SBNamespace, includeAdditional bool) error {
 	d.Set("name", id.NamespaceName)
 	d.Set("resource_group_name", id.ResourceGroupName)
 
-	if model := resp.Model; model != nil {
+	if model != nil {
 		d.Set("location", location.Normalize(model.Location))
 
 		d.Set("tags", flattenTags(model.Tags))
@@ -550,32 +561,37 @@ func resourceServiceBusNamespaceRead(d *pluginsdk.ResourceData, meta interface{}
 		}
 	}
 
-	authRuleId := namespacesauthorizationrule.NewAuthorizationRuleID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName, serviceBusNamespaceDefaultAuthorizationRule)
+	if includeAdditional {
+		client := meta.(*clients.Client).ServiceBus.NamespacesClient
+		namespaceAuthClient := meta.(*clients.Client).ServiceBus.NamespacesAuthClient
 
-	keys, err := namespaceAuthClient.NamespacesListKeys(ctx, authRuleId)
-	if err != nil {
-		log.Printf("[WARN] listing default keys for %s: %+v", id, err)
-	} else {
-		if keysModel := keys.Model; keysModel != nil {
-			d.Set("default_primary_connection_string", keysModel.PrimaryConnectionString)
-			d.Set("default_secondary_connection_string", keysModel.SecondaryConnectionString)
-			d.Set("default_primary_key", keysModel.PrimaryKey)
-			d.Set("default_secondary_key", keysModel.SecondaryKey)
+		authRuleId := namespacesauthorizationrule.NewAuthorizationRuleID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName, serviceBusNamespaceDefaultAuthorizationRule)
+
+		keys, err := namespaceAuthClient.NamespacesListKeys(ctx, authRuleId)
+		if err != nil {
+			log.Printf("[WARN] listing default keys for %s: %+v", id, err)
+		} else {
+			if keysModel := keys.Model; keysModel != nil {
+				d.Set("default_primary_connection_string", keysModel.PrimaryConnectionString)
+				d.Set("default_secondary_connection_string", keysModel.SecondaryConnectionString)
+				d.Set("default_primary_key", keysModel.PrimaryKey)
+				d.Set("default_secondary_key", keysModel.SecondaryKey)
+			}
 		}
-	}
 
-	networkRuleSet, err := client.GetNetworkRuleSet(ctx, *id)
-	if err != nil {
-		return fmt.Errorf("retrieving network rule set %s: %+v", *id, err)
-	}
+		networkRuleSet, err := client.GetNetworkRuleSet(ctx, *id)
+		if err != nil {
+			return fmt.Errorf("retrieving network rule set %s: %+v", *id, err)
+		}
 
-	if model := networkRuleSet.Model; model != nil {
-		if props := model.Properties; props != nil {
-			d.Set("network_rule_set", flattenServiceBusNamespaceNetworkRuleSet(*props))
+		if model := networkRuleSet.Model; model != nil {
+			if props := model.Properties; props != nil {
+				d.Set("network_rule_set", flattenServiceBusNamespaceNetworkRuleSet(*props))
+			}
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceServiceBusNamespaceDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -600,6 +616,21 @@ func resourceServiceBusNamespaceDelete(d *pluginsdk.ResourceData, meta interface
 	return nil
 }
 
+// serviceBusNamespaceSku returns the SKU of the Namespace, which is required to determine the size of partitioned
+// Queues and Topics
+func serviceBusNamespaceSku(ctx context.Context, client *namespaces.NamespacesClient, id namespaces.NamespaceId) (*namespaces.SkuName, error) {
+	resp, err := client.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	if model := resp.Model; model != nil && model.Sku != nil {
+		return pointer.To(model.Sku.Name), nil
+	}
+
+	return nil, nil
+}
+
 func expandServiceBusNamespaceEncryption(input []interface{}) *namespaces.Encryption {
 	if len(input) == 0 || input[0] == nil {
 		return nil
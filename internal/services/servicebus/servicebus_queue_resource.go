@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2024-01-01/namespaces"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2024-01-01/queues"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -22,6 +23,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name servicebus_queue -service-package-name servicebus -properties "name" -compare-values "subscription_id:namespace_id,resource_group_name:namespace_id,namespace_name:namespace_id" -test-resource-type ServiceBusQueueResource
+
 func resourceServiceBusQueue() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceServiceBusQueueCreateUpdate,
@@ -29,10 +32,11 @@ func resourceServiceBusQueue() *pluginsdk.Resource {
 		Update: resourceServiceBusQueueCreateUpdate,
 		Delete: resourceServiceBusQueueDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := queues.ParseQueueID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&queues.QueueId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&queues.QueueId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -352,6 +356,9 @@ func resourceServiceBusQueueCreateUpdate(d *pluginsdk.ResourceData, meta interfa
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 	return resourceServiceBusQueueRead(d, meta)
 }
 
@@ -374,12 +381,26 @@ func resourceServiceBusQueueRead(d *pluginsdk.ResourceData, meta interface{}) er
 		return fmt.Errorf("retrieving %s: %+v", id, err)
 	}
 
+	// the size of a partitioned Queue depends on the SKU of the Namespace
+	var namespaceSku *namespaces.SkuName
+	if model := resp.Model; model != nil && model.Properties != nil && pointer.From(model.Properties.EnablePartitioning) {
+		namespaceId := namespaces.NewNamespaceID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName)
+		namespaceSku, err = serviceBusNamespaceSku(ctx, meta.(*clients.Client).ServiceBus.NamespacesClient, namespaceId)
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceServiceBusQueueFlatten(d, id, resp.Model, namespaceSku)
+}
+
+func resourceServiceBusQueueFlatten(d *pluginsdk.ResourceData, id *queues.QueueId, model *queues.SBQueue, namespaceSku *namespaces.SkuName) error {
 	namespaceId := namespaces.NewNamespaceID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName)
 
 	d.Set("name", id.QueueName)
 	d.Set("namespace_id", namespaceId.ID())
 
-	if model := resp.Model; model != nil {
+	if model != nil {
 		if props := model.Properties; props != nil {
 			d.Set("auto_delete_on_idle", props.AutoDeleteOnIdle)
 			d.Set("dead_lettering_on_message_expiration", props.DeadLetteringOnMessageExpiration)
@@ -403,24 +424,17 @@ func resourceServiceBusQueueRead(d *pluginsdk.ResourceData, meta interface{}) er
 
 				// If the queue is NOT in a premium namespace (ie. it is Basic or Standard) and partitioning is enabled
 				// then the max size returned by the API will be 16 times greater than the value set.
-				if *props.EnablePartitioning {
-					namespacesClient := meta.(*clients.Client).ServiceBus.NamespacesClient
-					namespace, err := namespacesClient.Get(ctx, namespaceId)
-					if err != nil {
-						return err
-					}
-
-					if nsModel := namespace.Model; nsModel != nil && nsModel.Sku.Name != namespaces.SkuNamePremium {
-						const partitionCount = 16
-						maxSizeInMegabytes = int(*apiMaxSizeInMegabytes / partitionCount)
-					}
+				if pointer.From(props.EnablePartitioning) && namespaceSku != nil && *namespaceSku != namespaces.SkuNamePremium {
+					const partitionCount = 16
+					maxSizeInMegabytes = int(*apiMaxSizeInMegabytes / partitionCount)
 				}
 
 				d.Set("max_size_in_megabytes", maxSizeInMegabytes)
 			}
 		}
 	}
-	return nil
+
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceServiceBusQueueDelete(d *pluginsdk.ResourceData, meta interface{}) error {
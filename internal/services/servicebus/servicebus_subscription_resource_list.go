@@ -0,0 +1,105 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package servicebus
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2024-01-01/subscriptions"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ServiceBusSubscriptionListResource struct{}
+
+type ServiceBusSubscriptionListModel struct {
+	TopicId types.String `tfsdk:"topic_id"`
+}
+
+var _ sdk.FrameworkListWrappedResourceWithConfig = new(ServiceBusSubscriptionListResource)
+
+func (r ServiceBusSubscriptionListResource) ResourceFunc() *pluginsdk.Resource {
+	return resourceServiceBusSubscription()
+}
+
+func (r ServiceBusSubscriptionListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = `azurerm_servicebus_subscription`
+}
+
+func (r ServiceBusSubscriptionListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"topic_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: subscriptions.ValidateTopicID,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ServiceBusSubscriptionListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata sdk.ResourceMetadata) {
+	client := metadata.Client.ServiceBus.SubscriptionsClient
+
+	var data ServiceBusSubscriptionListModel
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	topicId, err := subscriptions.ParseTopicID(data.TopicId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, "parsing Service Bus Topic ID for `azurerm_servicebus_subscription`", err)
+		return
+	}
+
+	resp, err := client.ListByTopicComplete(sdk.ListPageLimitContext(ctx, request), pointer.From(topicId), subscriptions.DefaultListByTopicOperationOptions())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, "listing `azurerm_servicebus_subscription`", err)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, item := range resp.Items {
+			result := request.NewListResult(ctx)
+			result.DisplayName = pointer.From(item.Name)
+
+			id, err := subscriptions.ParseSubscriptions2IDInsensitively(pointer.From(item.Id))
+			if err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, "parsing Service Bus Subscription ID", err)
+				return
+			}
+
+			rd := resourceServiceBusSubscription().Data(&terraform.InstanceState{})
+			rd.SetId(id.ID())
+
+			if err := resourceServiceBusSubscriptionFlatten(rd, id, &item); err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, "encoding `azurerm_servicebus_subscription` resource data", err)
+				return
+			}
+
+			sdk.EncodeListResult(ctx, rd, &result)
+			if result.Diagnostics.HasError() {
+				push(result)
+				return
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2024-01-01/namespaces"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2024-01-01/topics"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -21,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name servicebus_topic -service-package-name servicebus -properties "name" -compare-values "subscription_id:namespace_id,resource_group_name:namespace_id,namespace_name:namespace_id" -test-resource-type ServiceBusTopicResource
+
 func resourceServiceBusTopic() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceServiceBusTopicCreateUpdate,
@@ -28,10 +31,11 @@ func resourceServiceBusTopic() *pluginsdk.Resource {
 		Update: resourceServiceBusTopicCreateUpdate,
 		Delete: resourceServiceBusTopicDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := topics.ParseTopicID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&topics.TopicId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&topics.TopicId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -235,6 +239,9 @@ func resourceServiceBusTopicCreateUpdate(d *pluginsdk.ResourceData, meta interfa
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 	return resourceServiceBusTopicRead(d, meta)
 }
 
@@ -257,10 +264,24 @@ func resourceServiceBusTopicRead(d *pluginsdk.ResourceData, meta interface{}) er
 		return fmt.Errorf("retrieving %s: %+v", id, err)
 	}
 
+	// the size of a partitioned Topic depends on the SKU of the Namespace
+	var namespaceSku *namespaces.SkuName
+	if model := resp.Model; model != nil && model.Properties != nil && pointer.From(model.Properties.EnablePartitioning) {
+		namespaceId := namespaces.NewNamespaceID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName)
+		namespaceSku, err = serviceBusNamespaceSku(ctx, meta.(*clients.Client).ServiceBus.NamespacesClient, namespaceId)
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceServiceBusTopicFlatten(d, id, resp.Model, namespaceSku)
+}
+
+func resourceServiceBusTopicFlatten(d *pluginsdk.ResourceData, id *topics.TopicId, model *topics.SBTopic, namespaceSku *namespaces.SkuName) error {
 	d.Set("name", id.TopicName)
 	d.Set("namespace_id", topics.NewNamespaceID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName).ID())
 
-	if model := resp.Model; model != nil {
+	if model != nil {
 		if props := model.Properties; props != nil {
 			status := ""
 			if v := props.Status; v != nil {
@@ -287,20 +308,9 @@ func resourceServiceBusTopicRead(d *pluginsdk.ResourceData, meta interface{}) er
 
 				// if the topic is in a premium namespace and partitioning is enabled then the
 				// max size returned by the API will be 16 times greater than the value set
-				if partitioning := props.EnablePartitioning; partitioning != nil && *partitioning {
-					namespacesClient := meta.(*clients.Client).ServiceBus.NamespacesClient
-					namespaceId := namespaces.NewNamespaceID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName)
-					namespaceResp, err := namespacesClient.Get(ctx, namespaceId)
-					if err != nil {
-						return err
-					}
-
-					if namespaceModel := namespaceResp.Model; namespaceModel != nil {
-						if namespaceModel.Sku.Name != namespaces.SkuNamePremium {
-							const partitionCount = 16
-							maxSize = int(*props.MaxSizeInMegabytes / partitionCount)
-						}
-					}
+				if pointer.From(props.EnablePartitioning) && namespaceSku != nil && *namespaceSku != namespaces.SkuNamePremium {
+					const partitionCount = 16
+					maxSize = int(*props.MaxSizeInMegabytes / partitionCount)
 				}
 
 				d.Set("max_size_in_megabytes", maxSize)
@@ -308,7 +318,7 @@ func resourceServiceBusTopicRead(d *pluginsdk.ResourceData, meta interface{}) er
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceServiceBusTopicDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -0,0 +1,119 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package servicebus
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2024-01-01/namespaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/servicebus/2024-01-01/topics"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ServiceBusTopicListResource struct{}
+
+type ServiceBusTopicListModel struct {
+	NamespaceId types.String `tfsdk:"namespace_id"`
+}
+
+var _ sdk.FrameworkListWrappedResourceWithConfig = new(ServiceBusTopicListResource)
+
+func (r ServiceBusTopicListResource) ResourceFunc() *pluginsdk.Resource {
+	return resourceServiceBusTopic()
+}
+
+func (r ServiceBusTopicListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = `azurerm_servicebus_topic`
+}
+
+func (r ServiceBusTopicListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"namespace_id": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: topics.ValidateNamespaceID,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ServiceBusTopicListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream, metadata sdk.ResourceMetadata) {
+	client := metadata.Client.ServiceBus.TopicsClient
+
+	var data ServiceBusTopicListModel
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	namespaceId, err := topics.ParseNamespaceID(data.NamespaceId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, "parsing Service Bus Namespace ID for `azurerm_servicebus_topic`", err)
+		return
+	}
+
+	resp, err := client.ListByNamespaceComplete(sdk.ListPageLimitContext(ctx, request), pointer.From(namespaceId), topics.DefaultListByNamespaceOperationOptions())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, "listing `azurerm_servicebus_topic`", err)
+		return
+	}
+
+	// the size of a partitioned Topic depends on the SKU of the Namespace, which is retrieved once for all Topics
+	var namespaceSku *namespaces.SkuName
+	for _, item := range resp.Items {
+		if item.Properties != nil && pointer.From(item.Properties.EnablePartitioning) {
+			namespaceSku, err = serviceBusNamespaceSku(ctx, metadata.Client.ServiceBus.NamespacesClient, namespaces.NewNamespaceID(namespaceId.SubscriptionId, namespaceId.ResourceGroupName, namespaceId.NamespaceName))
+			if err != nil {
+				sdk.SetResponseErrorDiagnostic(stream, "listing `azurerm_servicebus_topic`", err)
+				return
+			}
+			break
+		}
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, item := range resp.Items {
+			result := request.NewListResult(ctx)
+			result.DisplayName = pointer.From(item.Name)
+
+			id, err := topics.ParseTopicIDInsensitively(pointer.From(item.Id))
+			if err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, "parsing Service Bus Topic ID", err)
+				return
+			}
+
+			rd := resourceServiceBusTopic().Data(&terraform.InstanceState{})
+			rd.SetId(id.ID())
+
+			if err := resourceServiceBusTopicFlatten(rd, id, &item, namespaceSku); err != nil {
+				sdk.SetErrorDiagnosticAndPushListResult(result, push, "encoding `azurerm_servicebus_topic` resource data", err)
+				return
+			}
+
+			sdk.EncodeListResult(ctx, rd, &result)
+			if result.Diagnostics.HasError() {
+				push(result)
+				return
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/servicelinker/2022-05-01/links"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/servicelinker/2024-04-01/servicelinker"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,7 +22,10 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name app_service_connection -service-package-name serviceconnector -properties "name,resource_uri:app_service_id" -no-subscription-id -test-name cosmosdbBasic -test-resource-type ServiceConnectorAppServiceResource
+
 var _ sdk.ResourceWithUpdate = AppServiceConnectorResource{}
+var _ sdk.ResourceWithIdentity = AppServiceConnectorResource{}
 
 type AppServiceConnectorResource struct{}
 
@@ -173,7 +177,7 @@ func (r AppServiceConnectorResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -223,6 +227,10 @@ func (r AppServiceConnectorResource) Read() sdk.ResourceFunc {
 					state.SecretStore = flattenSecretStore(*props.SecretStore)
 				}
 
+				if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+					return err
+				}
+
 				return metadata.Encode(&state)
 			}
 			return nil
@@ -311,3 +319,7 @@ func (r AppServiceConnectorResource) Update() sdk.ResourceFunc {
 func (r AppServiceConnectorResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return servicelinker.ValidateScopedLinkerID
 }
+
+func (r AppServiceConnectorResource) Identity() resourceids.ResourceId {
+	return &servicelinker.ScopedLinkerId{}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/servicelinker/2022-05-01/links"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/servicelinker/2024-04-01/servicelinker"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,8 +22,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name function_app_connection -service-package-name serviceconnector -properties "name,resource_uri:function_app_id" -no-subscription-id -test-name cosmosdbBasic -test-resource-type FunctionAppConnectorResource
+
 type FunctionAppConnectorResource struct{}
 
+var _ sdk.ResourceWithIdentity = FunctionAppConnectorResource{}
+
 type FunctionAppConnectorResourceModel struct {
 	Name             string             `tfschema:"name"`
 	FunctionAppId    string             `tfschema:"function_app_id"`
@@ -170,7 +175,7 @@ func (r FunctionAppConnectorResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -220,6 +225,10 @@ func (r FunctionAppConnectorResource) Read() sdk.ResourceFunc {
 					state.SecretStore = flattenSecretStore(*props.SecretStore)
 				}
 
+				if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+					return err
+				}
+
 				return metadata.Encode(&state)
 			}
 			return nil
@@ -307,3 +316,7 @@ func (r FunctionAppConnectorResource) Update() sdk.ResourceFunc {
 func (r FunctionAppConnectorResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return servicelinker.ValidateScopedLinkerID
 }
+
+func (r FunctionAppConnectorResource) Identity() resourceids.ResourceId {
+	return &servicelinker.ScopedLinkerId{}
+}
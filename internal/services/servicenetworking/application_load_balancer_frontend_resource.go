@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/servicenetworking/2025-01-01/frontendsinterface"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/servicenetworking/2025-01-01/trafficcontrollerinterface"
@@ -21,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name application_load_balancer_frontend -service-package-name servicenetworking -properties "name" -compare-values "subscription_id:application_load_balancer_id,resource_group_name:application_load_balancer_id,traffic_controller_name:application_load_balancer_id"
+
 type FrontendsResource struct{}
 
 type FrontendsModel struct {
@@ -31,6 +34,7 @@ type FrontendsModel struct {
 }
 
 var _ sdk.Resource = FrontendsResource{}
+var _ sdk.ResourceWithIdentity = FrontendsResource{}
 
 func (f FrontendsResource) Arguments() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
@@ -73,6 +77,10 @@ func (f FrontendsResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return frontendsinterface.ValidateFrontendID
 }
 
+func (f FrontendsResource) Identity() resourceids.ResourceId {
+	return &frontendsinterface.FrontendId{}
+}
+
 func (f FrontendsResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -125,7 +133,7 @@ func (f FrontendsResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -163,6 +171,10 @@ func (f FrontendsResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
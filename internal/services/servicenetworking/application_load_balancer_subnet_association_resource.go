@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/servicenetworking/2025-01-01/associationsinterface"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/servicenetworking/2025-01-01/trafficcontrollerinterface"
@@ -20,6 +21,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name application_load_balancer_subnet_association -service-package-name servicenetworking -properties "name" -compare-values "subscription_id:application_load_balancer_id,resource_group_name:application_load_balancer_id,traffic_controller_name:application_load_balancer_id" -test-resource-type SubnetAssociationResource
+
 type ApplicationLoadBalancerSubnetAssociationResource struct{}
 
 type AssociationResourceModel struct {
@@ -30,6 +33,7 @@ type AssociationResourceModel struct {
 }
 
 var _ sdk.ResourceWithUpdate = ApplicationLoadBalancerSubnetAssociationResource{}
+var _ sdk.ResourceWithIdentity = ApplicationLoadBalancerSubnetAssociationResource{}
 
 func (t ApplicationLoadBalancerSubnetAssociationResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
@@ -64,6 +68,10 @@ func (t ApplicationLoadBalancerSubnetAssociationResource) IDValidationFunc() plu
 	return associationsinterface.ValidateAssociationID
 }
 
+func (t ApplicationLoadBalancerSubnetAssociationResource) Identity() resourceids.ResourceId {
+	return &associationsinterface.AssociationId{}
+}
+
 func (t ApplicationLoadBalancerSubnetAssociationResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -116,7 +124,7 @@ func (t ApplicationLoadBalancerSubnetAssociationResource) Create() sdk.ResourceF
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -160,6 +168,10 @@ func (t ApplicationLoadBalancerSubnetAssociationResource) Read() sdk.ResourceFun
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package servicenetworking_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccApplicationLoadBalancerSubnetAssociation_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_load_balancer_subnet_association", "test")
+	r := SubnetAssociationResource{}
+
+	checkedFields := map[string]struct{}{
+		"name":                    {},
+		"resource_group_name":     {},
+		"subscription_id":         {},
+		"traffic_controller_name": {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_application_load_balancer_subnet_association.test", checkedFields),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_application_load_balancer_subnet_association.test", tfjsonpath.New("name"), tfjsonpath.New("name")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_application_load_balancer_subnet_association.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("application_load_balancer_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_application_load_balancer_subnet_association.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("application_load_balancer_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_application_load_balancer_subnet_association.test", tfjsonpath.New("traffic_controller_name"), tfjsonpath.New("application_load_balancer_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/webpubsub/2024-03-01/webpubsub"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
@@ -24,6 +25,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name web_pubsub_socketio -service-package-name signalr -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-params "Standard_S1,1" -test-resource-type WebPubSubSocketIOTestResource
+
 type WebPubSubSocketIOResource struct{}
 
 type WebPubSubSocketIOResourceModel struct {
@@ -60,6 +63,7 @@ type WebPubSubSocketIOSkuModel struct {
 var (
 	_ sdk.ResourceWithUpdate        = WebPubSubSocketIOResource{}
 	_ sdk.ResourceWithCustomizeDiff = WebPubSubSocketIOResource{}
+	_ sdk.ResourceWithIdentity      = WebPubSubSocketIOResource{}
 )
 
 func (w WebPubSubSocketIOResource) Arguments() map[string]*pluginsdk.Schema {
@@ -319,7 +323,7 @@ func (w WebPubSubSocketIOResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -402,6 +406,10 @@ func (w WebPubSubSocketIOResource) Read() sdk.ResourceFunc {
 				state.SecondaryConnectionString = pointer.From(keyModel.SecondaryConnectionString)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -524,6 +532,10 @@ func (w WebPubSubSocketIOResource) IDValidationFunc() pluginsdk.SchemaValidateFu
 	return webpubsub.ValidateWebPubSubID
 }
 
+func (w WebPubSubSocketIOResource) Identity() resourceids.ResourceId {
+	return &webpubsub.WebPubSubId{}
+}
+
 func expandLiveTraceConfigFromModel(input WebPubSubSocketIOResourceModel) *webpubsub.LiveTraceConfiguration {
 	resourceCategories := make([]webpubsub.LiveTraceCategory, 0)
 
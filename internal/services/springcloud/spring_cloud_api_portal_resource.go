@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/appplatform/2024-01-01-preview/appplatform"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -19,6 +20,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name spring_cloud_api_portal -service-package-name springcloud -properties "name" -compare-values "subscription_id:spring_cloud_service_id,resource_group_name:spring_cloud_service_id,spring_name:spring_cloud_service_id" -test-resource-type SpringCloudAPIPortalResource
+
 type SpringCloudAPIPortalModel struct {
 	Name                       string              `tfschema:"name"`
 	SpringCloudServiceId       string              `tfschema:"spring_cloud_service_id"`
@@ -48,6 +51,7 @@ var (
 	_ sdk.ResourceWithUpdate                      = SpringCloudAPIPortalResource{}
 	_ sdk.ResourceWithStateMigration              = SpringCloudAPIPortalResource{}
 	_ sdk.ResourceWithDeprecationAndNoReplacement = SpringCloudAPIPortalResource{}
+	_ sdk.ResourceWithIdentity                    = SpringCloudAPIPortalResource{}
 )
 
 func (s SpringCloudAPIPortalResource) ResourceType() string {
@@ -62,6 +66,10 @@ func (s SpringCloudAPIPortalResource) IDValidationFunc() pluginsdk.SchemaValidat
 	return appplatform.ValidateApiPortalID
 }
 
+func (s SpringCloudAPIPortalResource) Identity() resourceids.ResourceId {
+	return &appplatform.ApiPortalId{}
+}
+
 func (s SpringCloudAPIPortalResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -223,7 +231,7 @@ func (s SpringCloudAPIPortalResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -347,6 +355,10 @@ func (s SpringCloudAPIPortalResource) Read() sdk.ResourceFunc {
 					state.InstanceCount = pointer.From(sku.Capacity)
 				}
 			}
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
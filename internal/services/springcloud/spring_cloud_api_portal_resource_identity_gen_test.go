@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package springcloud_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccSpringCloudApiPortal_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_spring_cloud_api_portal", "test")
+	r := SpringCloudAPIPortalResource{}
+
+	checkedFields := map[string]struct{}{
+		"name":                {},
+		"resource_group_name": {},
+		"spring_name":         {},
+		"subscription_id":     {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_spring_cloud_api_portal.test", checkedFields),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_spring_cloud_api_portal.test", tfjsonpath.New("name"), tfjsonpath.New("name")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_spring_cloud_api_portal.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("spring_cloud_service_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_spring_cloud_api_portal.test", tfjsonpath.New("spring_name"), tfjsonpath.New("spring_cloud_service_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_spring_cloud_api_portal.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("spring_cloud_service_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
@@ -21,6 +21,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name spring_cloud_app_dynamics_application_performance_monitoring -service-package-name springcloud -properties "name" -compare-values "subscription_id:spring_cloud_service_id,resource_group_name:spring_cloud_service_id,spring_name:spring_cloud_service_id"
+
 type SpringCloudAppDynamicsApplicationPerformanceMonitoringModel struct {
 	Name                  string `tfschema:"name"`
 	SpringCloudServiceId  string `tfschema:"spring_cloud_service_id"`
@@ -45,6 +47,7 @@ func (s SpringCloudAppDynamicsApplicationPerformanceMonitoringResource) Deprecat
 var (
 	_ sdk.ResourceWithUpdate                      = SpringCloudAppDynamicsApplicationPerformanceMonitoringResource{}
 	_ sdk.ResourceWithDeprecationAndNoReplacement = SpringCloudAppDynamicsApplicationPerformanceMonitoringResource{}
+	_ sdk.ResourceWithIdentity                    = SpringCloudAppDynamicsApplicationPerformanceMonitoringResource{}
 )
 
 func (s SpringCloudAppDynamicsApplicationPerformanceMonitoringResource) ResourceType() string {
@@ -59,6 +62,10 @@ func (s SpringCloudAppDynamicsApplicationPerformanceMonitoringResource) IDValida
 	return appplatform.ValidateApmID
 }
 
+func (s SpringCloudAppDynamicsApplicationPerformanceMonitoringResource) Identity() resourceids.ResourceId {
+	return &appplatform.ApmId{}
+}
+
 func (s SpringCloudAppDynamicsApplicationPerformanceMonitoringResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -184,6 +191,9 @@ func (s SpringCloudAppDynamicsApplicationPerformanceMonitoringResource) Create()
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			if model.GloballyEnabled {
 				apmReference := appplatform.ApmReference{
@@ -380,6 +390,10 @@ func (s SpringCloudAppDynamicsApplicationPerformanceMonitoringResource) Read() s
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -22,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name spring_cloud_application_insights_application_performance_monitoring -service-package-name springcloud -properties "name" -compare-values "subscription_id:spring_cloud_service_id,resource_group_name:spring_cloud_service_id,spring_name:spring_cloud_service_id"
+
 type SpringCloudApplicationInsightsApplicationPerformanceMonitoringModel struct {
 	Name                      string `tfschema:"name"`
 	SpringCloudServiceId      string `tfschema:"spring_cloud_service_id"`
@@ -42,6 +44,7 @@ func (s SpringCloudApplicationInsightsApplicationPerformanceMonitoringResource)
 var (
 	_ sdk.ResourceWithUpdate                      = SpringCloudApplicationInsightsApplicationPerformanceMonitoringResource{}
 	_ sdk.ResourceWithDeprecationAndNoReplacement = SpringCloudApplicationInsightsApplicationPerformanceMonitoringResource{}
+	_ sdk.ResourceWithIdentity                    = SpringCloudApplicationInsightsApplicationPerformanceMonitoringResource{}
 )
 
 func (s SpringCloudApplicationInsightsApplicationPerformanceMonitoringResource) ResourceType() string {
@@ -56,6 +59,10 @@ func (s SpringCloudApplicationInsightsApplicationPerformanceMonitoringResource)
 	return appplatform.ValidateApmID
 }
 
+func (s SpringCloudApplicationInsightsApplicationPerformanceMonitoringResource) Identity() resourceids.ResourceId {
+	return &appplatform.ApmId{}
+}
+
 func (s SpringCloudApplicationInsightsApplicationPerformanceMonitoringResource) Arguments() map[string]*schema.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -165,7 +172,7 @@ func (s SpringCloudApplicationInsightsApplicationPerformanceMonitoringResource)
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -320,6 +327,10 @@ func (s SpringCloudApplicationInsightsApplicationPerformanceMonitoringResource)
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
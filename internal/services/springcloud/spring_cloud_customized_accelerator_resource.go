@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/appplatform/2024-01-01-preview/appplatform"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
@@ -22,6 +23,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name spring_cloud_customized_accelerator -service-package-name springcloud -properties "name" -compare-values "subscription_id:spring_cloud_accelerator_id,resource_group_name:spring_cloud_accelerator_id,spring_name:spring_cloud_accelerator_id,application_accelerator_name:spring_cloud_accelerator_id"
+
 type SpringCloudCustomizedAcceleratorModel struct {
 	Name                     string               `tfschema:"name"`
 	SpringCloudAcceleratorId string               `tfschema:"spring_cloud_accelerator_id"`
@@ -66,6 +69,7 @@ var (
 	_ sdk.ResourceWithUpdate                      = SpringCloudCustomizedAcceleratorResource{}
 	_ sdk.ResourceWithStateMigration              = SpringCloudCustomizedAcceleratorResource{}
 	_ sdk.ResourceWithDeprecationAndNoReplacement = SpringCloudCustomizedAcceleratorResource{}
+	_ sdk.ResourceWithIdentity                    = SpringCloudCustomizedAcceleratorResource{}
 )
 
 func (s SpringCloudCustomizedAcceleratorResource) ResourceType() string {
@@ -80,6 +84,10 @@ func (s SpringCloudCustomizedAcceleratorResource) IDValidationFunc() pluginsdk.S
 	return appplatform.ValidateCustomizedAcceleratorID
 }
 
+func (s SpringCloudCustomizedAcceleratorResource) Identity() resourceids.ResourceId {
+	return &appplatform.CustomizedAcceleratorId{}
+}
+
 func (s SpringCloudCustomizedAcceleratorResource) StateUpgraders() sdk.StateUpgradeData {
 	return sdk.StateUpgradeData{
 		SchemaVersion: 1,
@@ -292,7 +300,7 @@ func (s SpringCloudCustomizedAcceleratorResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -409,6 +417,10 @@ func (s SpringCloudCustomizedAcceleratorResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
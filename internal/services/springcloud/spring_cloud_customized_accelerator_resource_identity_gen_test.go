@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package springcloud_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccSpringCloudCustomizedAccelerator_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_spring_cloud_customized_accelerator", "test")
+	r := SpringCloudCustomizedAcceleratorResource{}
+
+	checkedFields := map[string]struct{}{
+		"name":                         {},
+		"application_accelerator_name": {},
+		"resource_group_name":          {},
+		"spring_name":                  {},
+		"subscription_id":              {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_spring_cloud_customized_accelerator.test", checkedFields),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_spring_cloud_customized_accelerator.test", tfjsonpath.New("name"), tfjsonpath.New("name")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_spring_cloud_customized_accelerator.test", tfjsonpath.New("application_accelerator_name"), tfjsonpath.New("spring_cloud_accelerator_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_spring_cloud_customized_accelerator.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("spring_cloud_accelerator_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_spring_cloud_customized_accelerator.test", tfjsonpath.New("spring_name"), tfjsonpath.New("spring_cloud_accelerator_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_spring_cloud_customized_accelerator.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("spring_cloud_accelerator_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
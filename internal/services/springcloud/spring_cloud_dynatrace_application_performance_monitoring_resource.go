@@ -20,6 +20,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name spring_cloud_dynatrace_application_performance_monitoring -service-package-name springcloud -properties "name" -compare-values "subscription_id:spring_cloud_service_id,resource_group_name:spring_cloud_service_id,spring_name:spring_cloud_service_id"
+
 type SpringCloudDynatraceApplicationPerformanceMonitoringModel struct {
 	Name                 string `tfschema:"name"`
 	SpringCloudServiceId string `tfschema:"spring_cloud_service_id"`
@@ -41,6 +43,7 @@ func (s SpringCloudDynatraceApplicationPerformanceMonitoringResource) Deprecatio
 var (
 	_ sdk.ResourceWithUpdate                      = SpringCloudDynatraceApplicationPerformanceMonitoringResource{}
 	_ sdk.ResourceWithDeprecationAndNoReplacement = SpringCloudDynatraceApplicationPerformanceMonitoringResource{}
+	_ sdk.ResourceWithIdentity                    = SpringCloudDynatraceApplicationPerformanceMonitoringResource{}
 )
 
 func (s SpringCloudDynatraceApplicationPerformanceMonitoringResource) ResourceType() string {
@@ -55,6 +58,10 @@ func (s SpringCloudDynatraceApplicationPerformanceMonitoringResource) IDValidati
 	return appplatform.ValidateApmID
 }
 
+func (s SpringCloudDynatraceApplicationPerformanceMonitoringResource) Identity() resourceids.ResourceId {
+	return &appplatform.ApmId{}
+}
+
 func (s SpringCloudDynatraceApplicationPerformanceMonitoringResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -162,6 +169,9 @@ func (s SpringCloudDynatraceApplicationPerformanceMonitoringResource) Create() s
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			if model.GloballyEnabled {
 				apmReference := appplatform.ApmReference{
@@ -333,6 +343,10 @@ func (s SpringCloudDynatraceApplicationPerformanceMonitoringResource) Read() sdk
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -21,6 +21,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name spring_cloud_elastic_application_performance_monitoring -service-package-name springcloud -properties "name" -compare-values "subscription_id:spring_cloud_service_id,resource_group_name:spring_cloud_service_id,spring_name:spring_cloud_service_id"
+
 type SpringCloudElasticApplicationPerformanceMonitoringModel struct {
 	Name                 string   `tfschema:"name"`
 	SpringCloudServiceId string   `tfschema:"spring_cloud_service_id"`
@@ -39,6 +41,7 @@ func (s SpringCloudElasticApplicationPerformanceMonitoringResource) DeprecationM
 var (
 	_ sdk.ResourceWithUpdate                      = SpringCloudElasticApplicationPerformanceMonitoringResource{}
 	_ sdk.ResourceWithDeprecationAndNoReplacement = SpringCloudElasticApplicationPerformanceMonitoringResource{}
+	_ sdk.ResourceWithIdentity                    = SpringCloudElasticApplicationPerformanceMonitoringResource{}
 )
 
 func (s SpringCloudElasticApplicationPerformanceMonitoringResource) ResourceType() string {
@@ -53,6 +56,10 @@ func (s SpringCloudElasticApplicationPerformanceMonitoringResource) IDValidation
 	return appplatform.ValidateApmID
 }
 
+func (s SpringCloudElasticApplicationPerformanceMonitoringResource) Identity() resourceids.ResourceId {
+	return &appplatform.ApmId{}
+}
+
 func (s SpringCloudElasticApplicationPerformanceMonitoringResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -139,6 +146,9 @@ func (s SpringCloudElasticApplicationPerformanceMonitoringResource) Create() sdk
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			if model.GloballyEnabled {
 				apmReference := appplatform.ApmReference{
@@ -295,6 +305,10 @@ func (s SpringCloudElasticApplicationPerformanceMonitoringResource) Read() sdk.R
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/appplatform/2024-01-01-preview/appplatform"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -19,6 +20,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name spring_cloud_gateway -service-package-name springcloud -properties "name" -compare-values "subscription_id:spring_cloud_service_id,resource_group_name:spring_cloud_service_id,spring_name:spring_cloud_service_id"
+
 type SpringCloudGatewayModel struct {
 	Name                                  string                     `tfschema:"name"`
 	SpringCloudServiceId                  string                     `tfschema:"spring_cloud_service_id"`
@@ -89,6 +92,7 @@ var (
 	_ sdk.ResourceWithUpdate                      = SpringCloudGatewayResource{}
 	_ sdk.ResourceWithStateMigration              = SpringCloudGatewayResource{}
 	_ sdk.ResourceWithDeprecationAndNoReplacement = SpringCloudGatewayResource{}
+	_ sdk.ResourceWithIdentity                    = SpringCloudGatewayResource{}
 )
 
 func (s SpringCloudGatewayResource) ResourceType() string {
@@ -103,6 +107,10 @@ func (s SpringCloudGatewayResource) IDValidationFunc() pluginsdk.SchemaValidateF
 	return appplatform.ValidateGatewayID
 }
 
+func (s SpringCloudGatewayResource) Identity() resourceids.ResourceId {
+	return &appplatform.GatewayId{}
+}
+
 func (s SpringCloudGatewayResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -502,7 +510,7 @@ func (s SpringCloudGatewayResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -661,6 +669,10 @@ func (s SpringCloudGatewayResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
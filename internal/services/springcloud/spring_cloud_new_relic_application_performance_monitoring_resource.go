@@ -23,6 +23,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name spring_cloud_new_relic_application_performance_monitoring -service-package-name springcloud -properties "name" -compare-values "subscription_id:spring_cloud_service_id,resource_group_name:spring_cloud_service_id,spring_name:spring_cloud_service_id"
+
 type SpringCloudNewRelicApplicationPerformanceMonitoringModel struct {
 	Name                         string            `tfschema:"name"`
 	SpringCloudServiceId         string            `tfschema:"spring_cloud_service_id"`
@@ -47,6 +49,7 @@ func (s SpringCloudNewRelicApplicationPerformanceMonitoringResource) Deprecation
 var (
 	_ sdk.ResourceWithUpdate                      = SpringCloudNewRelicApplicationPerformanceMonitoringResource{}
 	_ sdk.ResourceWithDeprecationAndNoReplacement = SpringCloudNewRelicApplicationPerformanceMonitoringResource{}
+	_ sdk.ResourceWithIdentity                    = SpringCloudNewRelicApplicationPerformanceMonitoringResource{}
 )
 
 func (s SpringCloudNewRelicApplicationPerformanceMonitoringResource) ResourceType() string {
@@ -61,6 +64,10 @@ func (s SpringCloudNewRelicApplicationPerformanceMonitoringResource) IDValidatio
 	return appplatform.ValidateApmID
 }
 
+func (s SpringCloudNewRelicApplicationPerformanceMonitoringResource) Identity() resourceids.ResourceId {
+	return &appplatform.ApmId{}
+}
+
 func (s SpringCloudNewRelicApplicationPerformanceMonitoringResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -186,6 +193,9 @@ func (s SpringCloudNewRelicApplicationPerformanceMonitoringResource) Create() sd
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			if model.GloballyEnabled {
 				apmReference := appplatform.ApmReference{
@@ -384,6 +394,10 @@ func (s SpringCloudNewRelicApplicationPerformanceMonitoringResource) Read() sdk.
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -18,7 +18,10 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
-var _ sdk.FrameworkListWrappedResource = &StorageAccountListResource{}
+var (
+	_ sdk.FrameworkListWrappedResource                  = &StorageAccountListResource{}
+	_ sdk.FrameworkListWrappedResourceWithResourceGraph = &StorageAccountListResource{}
+)
 
 type StorageAccountListResource struct{}
 
@@ -26,6 +29,10 @@ func (r StorageAccountListResource) ResourceFunc() *pluginsdk.Resource {
 	return resourceStorageAccount()
 }
 
+func (r StorageAccountListResource) ResourceGraphResourceType() string {
+	return "Microsoft.Storage/storageAccounts"
+}
+
 func (r StorageAccountListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
 	response.TypeName = storageAccountResourceName
 }
@@ -50,7 +57,7 @@ func (r StorageAccountListResource) List(ctx context.Context, request list.ListR
 	switch {
 	case data.ResourceGroupName.ValueString() != "":
 		resourceGroupId := commonids.NewResourceGroupID(subscriptionID, data.ResourceGroupName.ValueString())
-		resp, err := client.ListByResourceGroupComplete(ctx, resourceGroupId)
+		resp, err := client.ListByResourceGroupComplete(sdk.ListPageLimitContext(ctx, request), resourceGroupId)
 		if err != nil {
 			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing %s", storageAccountResourceName), err)
 			return
@@ -59,7 +66,7 @@ func (r StorageAccountListResource) List(ctx context.Context, request list.ListR
 		listResults = resp.Items
 
 	default:
-		resp, err := client.ListComplete(ctx, commonids.NewSubscriptionID(subscriptionID))
+		resp, err := client.ListComplete(sdk.ListPageLimitContext(ctx, request), commonids.NewSubscriptionID(subscriptionID))
 		if err != nil {
 			sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing %s", storageAccountResourceName), err)
 			return
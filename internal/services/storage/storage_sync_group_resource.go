@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storagesync/2020-03-01/storagesyncservicesresource"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storagesync/2020-03-01/syncgroupresource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
@@ -18,16 +19,19 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name storage_sync_group -service-package-name storage -properties "name" -compare-values "subscription_id:storage_sync_id,resource_group_name:storage_sync_id,storage_sync_service_name:storage_sync_id"
+
 func resourceStorageSyncGroup() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceStorageSyncGroupCreate,
 		Read:   resourceStorageSyncGroupRead,
 		Delete: resourceStorageSyncGroupDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := syncgroupresource.ParseSyncGroupID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&syncgroupresource.SyncGroupId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&syncgroupresource.SyncGroupId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -79,6 +83,10 @@ func resourceStorageSyncGroupCreate(d *pluginsdk.ResourceData, meta interface{})
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
+
 	return resourceStorageSyncGroupRead(d, meta)
 }
 
@@ -108,7 +116,7 @@ func resourceStorageSyncGroupRead(d *pluginsdk.ResourceData, meta interface{}) e
 	serviceId := syncgroupresource.NewStorageSyncServiceID(id.SubscriptionId, id.ResourceGroupName, id.StorageSyncServiceName)
 	d.Set("storage_sync_id", serviceId.ID())
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceStorageSyncGroupDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storagesync/2020-03-01/registeredserverresource"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storagesync/2020-03-01/serverendpointresource"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
@@ -20,9 +21,12 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name storage_sync_server_endpoint -service-package-name storage -properties "name" -compare-values "subscription_id:storage_sync_group_id,resource_group_name:storage_sync_group_id,storage_sync_service_name:storage_sync_group_id,sync_group_name:storage_sync_group_id" -test-resource-type StorageSyncServerEndpointTestResource
+
 type SyncServerEndpointResource struct{}
 
 var _ sdk.ResourceWithUpdate = SyncServerEndpointResource{}
+var _ sdk.ResourceWithIdentity = SyncServerEndpointResource{}
 
 func (r SyncServerEndpointResource) ModelObject() interface{} {
 	return &StorageSyncServerEndpointResourceSchema{}
@@ -44,6 +48,10 @@ func (r SyncServerEndpointResource) IDValidationFunc() pluginsdk.SchemaValidateF
 	return serverendpointresource.ValidateServerEndpointID
 }
 
+func (r SyncServerEndpointResource) Identity() resourceids.ResourceId {
+	return &serverendpointresource.ServerEndpointId{}
+}
+
 func (r SyncServerEndpointResource) ResourceType() string {
 	return "azurerm_storage_sync_server_endpoint"
 }
@@ -177,7 +185,7 @@ func (r SyncServerEndpointResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -219,6 +227,10 @@ func (r SyncServerEndpointResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&schema)
 		},
 	}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storagecache/2023-05-01/storagetargets"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagecache/validate"
@@ -21,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name hpc_cache_blob_nfs_target -service-package-name storagecache -properties "name,resource_group_name,cache_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type HPCCacheBlobNFSTargetResource
+
 func resourceHPCCacheBlobNFSTarget() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		DeprecationMessage: "The `azurerm_hpc_cache_blob_nfs_target` resource has been deprecated because the service is retiring on 2025-09-30. This resource will be removed in v5.0 of the AzureRM Provider. See https://aka.ms/hpccacheretirement for more information.",
@@ -30,10 +33,11 @@ func resourceHPCCacheBlobNFSTarget() *pluginsdk.Resource {
 		Update: resourceHPCCacheBlobNFSTargetCreateUpdate,
 		Delete: resourceHPCCacheBlobNFSTargetDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := storagetargets.ParseStorageTargetID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&storagetargets.StorageTargetId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&storagetargets.StorageTargetId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -172,6 +176,9 @@ func resourceHPCCacheBlobNFSTargetCreateUpdate(d *pluginsdk.ResourceData, meta i
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 
 	return resourceHPCCacheBlobNFSTargetRead(d, meta)
 }
@@ -230,7 +237,7 @@ func resourceHPCCacheBlobNFSTargetRead(d *pluginsdk.ResourceData, meta interface
 			d.Set("access_policy_name", accessPolicy)
 		}
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceHPCCacheBlobNFSTargetDelete(d *pluginsdk.ResourceData, meta interface{}) error {
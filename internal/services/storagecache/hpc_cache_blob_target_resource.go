@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storagecache/2023-05-01/storagetargets"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagecache/validate"
@@ -21,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name hpc_cache_blob_target -service-package-name storagecache -properties "name,resource_group_name,cache_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type HPCCacheBlobTargetResource
+
 func resourceHPCCacheBlobTarget() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		DeprecationMessage: "The `azurerm_hpc_cache_blob_target` resource has been deprecated because the service is retiring on 2025-09-30. This resource will be removed in v5.0 of the AzureRM Provider. See https://aka.ms/hpccacheretirement for more information.",
@@ -30,10 +33,11 @@ func resourceHPCCacheBlobTarget() *pluginsdk.Resource {
 		Read:   resourceHPCCacheBlobTargetRead,
 		Delete: resourceHPCCacheBlobTargetDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := storagetargets.ParseStorageTargetID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&storagetargets.StorageTargetId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&storagetargets.StorageTargetId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -129,6 +133,9 @@ func resourceHPCCacheBlobTargetCreateOrUpdate(d *pluginsdk.ResourceData, meta in
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 
 	return resourceHPCCacheBlobTargetRead(d, meta)
 }
@@ -183,7 +190,7 @@ func resourceHPCCacheBlobTargetRead(d *pluginsdk.ResourceData, meta interface{})
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceHPCCacheBlobTargetDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storagecache/2023-05-01/storagetargets"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagecache/validate"
@@ -20,6 +21,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name hpc_cache_nfs_target -service-package-name storagecache -properties "name,resource_group_name,cache_name" -known-values "subscription_id:data.Subscriptions.Primary" -test-resource-type HPCCacheNFSTargetResource
+
 func resourceHPCCacheNFSTarget() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		DeprecationMessage: "The `azurerm_hpc_cache_nfs_target` resource has been deprecated because the service is retiring on 2025-09-30. This resource will be removed in v5.0 of the AzureRM Provider. See https://aka.ms/hpccacheretirement for more information.",
@@ -29,10 +32,11 @@ func resourceHPCCacheNFSTarget() *pluginsdk.Resource {
 		Read:   resourceHPCCacheNFSTargetRead,
 		Delete: resourceHPCCacheNFSTargetDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := storagetargets.ParseStorageTargetID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&storagetargets.StorageTargetId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&storagetargets.StorageTargetId{}),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -180,6 +184,9 @@ func resourceHPCCacheNFSTargetCreateOrUpdate(d *pluginsdk.ResourceData, meta int
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 
 	return resourceHPCCacheNFSTargetRead(d, meta)
 }
@@ -225,7 +232,7 @@ func resourceHPCCacheNFSTargetRead(d *pluginsdk.ResourceData, meta interface{})
 		}
 	}
 
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceHPCCacheNFSTargetDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/keyvault"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storagecache/2024-07-01/amlfilesystems"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -23,6 +24,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name managed_lustre_file_system -service-package-name storagecache -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 type ManagedLustreFileSystemModel struct {
 	Name                string                       `tfschema:"name"`
 	ResourceGroupName   string                       `tfschema:"resource_group_name"`
@@ -73,6 +76,7 @@ type ManagedLustreFileSystemResource struct{}
 var (
 	_ sdk.ResourceWithUpdate        = ManagedLustreFileSystemResource{}
 	_ sdk.ResourceWithCustomizeDiff = ManagedLustreFileSystemResource{}
+	_ sdk.ResourceWithIdentity      = ManagedLustreFileSystemResource{}
 )
 
 func GetSkuPropertiesByName(skuName string) *SkuProperties {
@@ -128,6 +132,10 @@ func (r ManagedLustreFileSystemResource) IDValidationFunc() pluginsdk.SchemaVali
 	return amlfilesystems.ValidateAmlFilesystemID
 }
 
+func (r ManagedLustreFileSystemResource) Identity() resourceids.ResourceId {
+	return &amlfilesystems.AmlFilesystemId{}
+}
+
 func (r ManagedLustreFileSystemResource) Arguments() map[string]*pluginsdk.Schema {
 	args := map[string]*pluginsdk.Schema{
 		"name": {
@@ -374,7 +382,7 @@ func (r ManagedLustreFileSystemResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -475,6 +483,10 @@ func (r ManagedLustreFileSystemResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -65,7 +65,7 @@ func (StorageMoverAgentListResource) List(ctx context.Context, request list.List
 		return
 	}
 
-	resp, err := client.ListComplete(ctx, agents.NewStorageMoverID(storageMoverID.SubscriptionId, storageMoverID.ResourceGroupName, storageMoverID.StorageMoverName))
+	resp, err := client.ListComplete(sdk.ListPageLimitContext(ctx, request), agents.NewStorageMoverID(storageMoverID.SubscriptionId, storageMoverID.ResourceGroupName, storageMoverID.StorageMoverName))
 	if err != nil {
 		sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", StorageMoverAgentResource{}.ResourceType()), err)
 		return
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/streamanalytics/2020-03-01/clusters"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -19,6 +20,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name stream_analytics_cluster -service-package-name streamanalytics -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 type ClusterResource struct{}
 
 type ClusterModel struct {
@@ -32,6 +35,7 @@ type ClusterModel struct {
 var (
 	_ sdk.ResourceWithUpdate         = ClusterResource{}
 	_ sdk.ResourceWithStateMigration = ClusterResource{}
+	_ sdk.ResourceWithIdentity       = ClusterResource{}
 )
 
 func (r ClusterResource) ModelObject() interface{} {
@@ -46,6 +50,10 @@ func (r ClusterResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return clusters.ValidateClusterID
 }
 
+func (r ClusterResource) Identity() resourceids.ResourceId {
+	return &clusters.ClusterId{}
+}
+
 func (r ClusterResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -114,6 +122,9 @@ func (r ClusterResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			return nil
 		},
@@ -155,6 +166,10 @@ func (r ClusterResource) Read() sdk.ResourceFunc {
 				state.StreamingCapacity = capacity
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
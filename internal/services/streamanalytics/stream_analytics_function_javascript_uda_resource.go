@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/streamanalytics/2020-03-01/functions"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/streamanalytics/2020-03-01/streamingjobs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/streamanalytics/migration"
@@ -21,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name stream_analytics_function_javascript_uda -service-package-name streamanalytics -properties "name" -compare-values "subscription_id:stream_analytics_job_id,resource_group_name:stream_analytics_job_id,streaming_job_name:stream_analytics_job_id" -test-resource-type StreamAnalyticsFunctionJavaScriptUDAResource
+
 func resourceStreamAnalyticsFunctionUDA() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceStreamAnalyticsFunctionUDACreate,
@@ -28,10 +31,11 @@ func resourceStreamAnalyticsFunctionUDA() *pluginsdk.Resource {
 		Update: resourceStreamAnalyticsFunctionUDAUpdate,
 		Delete: resourceStreamAnalyticsFunctionUDADelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := functions.ParseFunctionID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&functions.FunctionId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&functions.FunctionId{}),
+		},
 
 		SchemaVersion: 1,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
@@ -165,6 +169,9 @@ func resourceStreamAnalyticsFunctionUDACreate(d *pluginsdk.ResourceData, meta in
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 
 	return resourceStreamAnalyticsFunctionUDARead(d, meta)
 }
@@ -219,7 +226,7 @@ func resourceStreamAnalyticsFunctionUDARead(d *pluginsdk.ResourceData, meta inte
 			}
 		}
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceStreamAnalyticsFunctionUDAUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package streamanalytics_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccStreamAnalyticsFunctionJavascriptUda_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_stream_analytics_function_javascript_uda", "test")
+	r := StreamAnalyticsFunctionJavaScriptUDAResource{}
+
+	checkedFields := map[string]struct{}{
+		"name":                {},
+		"resource_group_name": {},
+		"streaming_job_name":  {},
+		"subscription_id":     {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_stream_analytics_function_javascript_uda.test", checkedFields),
+				statecheck.ExpectIdentityValueMatchesStateAtPath("azurerm_stream_analytics_function_javascript_uda.test", tfjsonpath.New("name"), tfjsonpath.New("name")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_stream_analytics_function_javascript_uda.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("stream_analytics_job_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_stream_analytics_function_javascript_uda.test", tfjsonpath.New("streaming_job_name"), tfjsonpath.New("stream_analytics_job_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_stream_analytics_function_javascript_uda.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("stream_analytics_job_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
@@ -28,6 +28,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name stream_analytics_job -service-package-name streamanalytics -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 func resourceStreamAnalyticsJob() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceStreamAnalyticsJobCreate,
@@ -35,10 +37,11 @@ func resourceStreamAnalyticsJob() *pluginsdk.Resource {
 		Update: resourceStreamAnalyticsJobUpdate,
 		Delete: resourceStreamAnalyticsJobDelete,
 
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			_, err := streamingjobs.ParseStreamingJobID(id)
-			return err
-		}),
+		Importer: pluginsdk.ImporterValidatingIdentity(&streamingjobs.StreamingJobId{}),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(&streamingjobs.StreamingJobId{}),
+		},
 
 		SchemaVersion: 1,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
@@ -337,6 +340,9 @@ func resourceStreamAnalyticsJobCreate(d *pluginsdk.ResourceData, meta interface{
 	}
 
 	d.SetId(id.ID())
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
 
 	return resourceStreamAnalyticsJobRead(d, meta)
 }
@@ -418,7 +424,7 @@ func resourceStreamAnalyticsJobRead(d *pluginsdk.ResourceData, meta interface{})
 			}
 		}
 	}
-	return nil
+	return pluginsdk.SetResourceIdentityData(d, id)
 }
 
 func resourceStreamAnalyticsJobUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/streamanalytics/2021-10-01-preview/streamingjobs"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -16,6 +17,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name stream_analytics_job_storage_account -service-package-name streamanalytics -compare-values "subscription_id:stream_analytics_job_id,resource_group_name:stream_analytics_job_id,name:stream_analytics_job_id"
+
 type JobStorageAccountResource struct{}
 
 type JobStorageAccountModel struct {
@@ -26,6 +29,7 @@ type JobStorageAccountModel struct {
 }
 
 var _ sdk.ResourceWithUpdate = JobStorageAccountResource{}
+var _ sdk.ResourceWithIdentity = JobStorageAccountResource{}
 
 func (r JobStorageAccountResource) ModelObject() interface{} {
 	return &JobStorageAccountModel{}
@@ -39,6 +43,10 @@ func (r JobStorageAccountResource) IDValidationFunc() pluginsdk.SchemaValidateFu
 	return streamingjobs.ValidateStreamingJobID
 }
 
+func (r JobStorageAccountResource) Identity() resourceids.ResourceId {
+	return &streamingjobs.StreamingJobId{}
+}
+
 func (r JobStorageAccountResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"stream_analytics_job_id": {
@@ -133,6 +141,9 @@ func (r JobStorageAccountResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
 
 			return nil
 		},
@@ -172,6 +183,10 @@ func (r JobStorageAccountResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
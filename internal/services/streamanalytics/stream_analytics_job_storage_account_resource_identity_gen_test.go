@@ -0,0 +1,38 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package streamanalytics_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	customstatecheck "github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/statecheck"
+)
+
+func TestAccStreamAnalyticsJobStorageAccount_resourceIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_stream_analytics_job_storage_account", "test")
+	r := StreamAnalyticsJobStorageAccountResource{}
+
+	checkedFields := map[string]struct{}{
+		"name":                {},
+		"resource_group_name": {},
+		"subscription_id":     {},
+	}
+
+	data.ResourceIdentityTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			ConfigStateChecks: []statecheck.StateCheck{
+				customstatecheck.ExpectAllIdentityFieldsAreChecked("azurerm_stream_analytics_job_storage_account.test", checkedFields),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_stream_analytics_job_storage_account.test", tfjsonpath.New("name"), tfjsonpath.New("stream_analytics_job_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_stream_analytics_job_storage_account.test", tfjsonpath.New("resource_group_name"), tfjsonpath.New("stream_analytics_job_id")),
+				customstatecheck.ExpectStateContainsIdentityValueAtPath("azurerm_stream_analytics_job_storage_account.test", tfjsonpath.New("subscription_id"), tfjsonpath.New("stream_analytics_job_id")),
+			},
+		},
+		data.ImportBlockWithResourceIdentityStep(false),
+		data.ImportBlockWithIDStep(false),
+	}, false)
+}
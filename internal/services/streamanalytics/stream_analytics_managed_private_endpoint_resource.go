@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/streamanalytics/2020-03-01/privateendpoints"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/streamanalytics/migration"
@@ -19,6 +20,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name stream_analytics_managed_private_endpoint -service-package-name streamanalytics -properties "name,resource_group_name,cluster_name:stream_analytics_cluster_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 type ManagedPrivateEndpointResource struct{}
 
 type ManagedPrivateEndpointModel struct {
@@ -30,6 +33,7 @@ type ManagedPrivateEndpointModel struct {
 }
 
 var _ sdk.ResourceWithStateMigration = ManagedPrivateEndpointResource{}
+var _ sdk.ResourceWithIdentity = ManagedPrivateEndpointResource{}
 
 func (r ManagedPrivateEndpointResource) ModelObject() interface{} {
 	return &ManagedPrivateEndpointModel{}
@@ -43,6 +47,10 @@ func (r ManagedPrivateEndpointResource) IDValidationFunc() pluginsdk.SchemaValid
 	return privateendpoints.ValidatePrivateEndpointID
 }
 
+func (r ManagedPrivateEndpointResource) Identity() resourceids.ResourceId {
+	return &privateendpoints.PrivateEndpointId{}
+}
+
 func (r ManagedPrivateEndpointResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -122,6 +130,9 @@ func (r ManagedPrivateEndpointResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			return nil
 		},
@@ -164,6 +175,10 @@ func (r ManagedPrivateEndpointResource) Read() sdk.ResourceFunc {
 					}
 				}
 			}
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
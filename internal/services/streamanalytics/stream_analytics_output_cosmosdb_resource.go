@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/streamanalytics/2020-03-01/streamingjobs"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/streamanalytics/2021-10-01-preview/outputs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,11 +22,14 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name stream_analytics_output_cosmosdb -service-package-name streamanalytics -properties "name" -compare-values "subscription_id:stream_analytics_job_id,resource_group_name:stream_analytics_job_id,streaming_job_name:stream_analytics_job_id" -test-resource-type StreamAnalyticsOutputCosmosDBResource
+
 type OutputCosmosDBResource struct{}
 
 var (
 	_ sdk.ResourceWithCustomImporter = OutputCosmosDBResource{}
 	_ sdk.ResourceWithStateMigration = OutputCosmosDBResource{}
+	_ sdk.ResourceWithIdentity       = OutputCosmosDBResource{}
 )
 
 type OutputCosmosDBResourceModel struct {
@@ -166,6 +170,9 @@ func (r OutputCosmosDBResource) Create() sdk.ResourceFunc {
 				return fmt.Errorf("creating %s: %+v", id, err)
 			}
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			return nil
 		},
@@ -229,6 +236,10 @@ func (r OutputCosmosDBResource) Read() sdk.ResourceFunc {
 
 					state.AuthenticationMode = string(pointer.From(output.Properties.AuthenticationMode))
 
+					if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+						return err
+					}
+
 					return metadata.Encode(&state)
 				}
 			}
@@ -263,6 +274,10 @@ func (r OutputCosmosDBResource) IDValidationFunc() pluginsdk.SchemaValidateFunc
 	return outputs.ValidateOutputID
 }
 
+func (r OutputCosmosDBResource) Identity() resourceids.ResourceId {
+	return &outputs.OutputId{}
+}
+
 func (r OutputCosmosDBResource) Update() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/streamanalytics/2021-10-01-preview/outputs"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/streamanalytics/migration"
@@ -18,11 +19,14 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name stream_analytics_output_function -service-package-name streamanalytics -properties "name,resource_group_name,streaming_job_name:stream_analytics_job_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 type OutputFunctionResource struct{}
 
 var (
 	_ sdk.ResourceWithCustomImporter = OutputFunctionResource{}
 	_ sdk.ResourceWithStateMigration = OutputFunctionResource{}
+	_ sdk.ResourceWithIdentity       = OutputFunctionResource{}
 )
 
 type OutputFunctionResourceModel struct {
@@ -103,6 +107,10 @@ func (r OutputFunctionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc
 	return outputs.ValidateOutputID
 }
 
+func (r OutputFunctionResource) Identity() resourceids.ResourceId {
+	return &outputs.OutputId{}
+}
+
 func (r OutputFunctionResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -147,6 +155,9 @@ func (r OutputFunctionResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			return nil
 		},
@@ -194,6 +205,10 @@ func (r OutputFunctionResource) Read() sdk.ResourceFunc {
 							BatchMaxCount:      int64(pointer.From(output.Properties.MaxBatchCount)),
 						}
 
+						if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+							return err
+						}
+
 						return metadata.Encode(&state)
 					}
 				}
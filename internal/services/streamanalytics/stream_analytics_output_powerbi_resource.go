@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/streamanalytics/2020-03-01/streamingjobs"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/streamanalytics/2021-10-01-preview/outputs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -19,11 +20,14 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name stream_analytics_output_powerbi -service-package-name streamanalytics -properties "name" -compare-values "subscription_id:stream_analytics_job_id,resource_group_name:stream_analytics_job_id,streaming_job_name:stream_analytics_job_id" -test-resource-type StreamAnalyticsOutputPowerBIResource
+
 type OutputPowerBIResource struct{}
 
 var (
 	_ sdk.ResourceWithCustomImporter = OutputPowerBIResource{}
 	_ sdk.ResourceWithStateMigration = OutputPowerBIResource{}
+	_ sdk.ResourceWithIdentity       = OutputPowerBIResource{}
 )
 
 type OutputPowerBIResourceModel struct {
@@ -162,6 +166,9 @@ func (r OutputPowerBIResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			return nil
 		},
@@ -300,6 +307,10 @@ func (r OutputPowerBIResource) Read() sdk.ResourceFunc {
 					state.TokenUserDisplayName = metadata.ResourceData.Get("token_user_display_name").(string)
 					state.TokenUserPrincipalName = metadata.ResourceData.Get("token_user_principal_name").(string)
 
+					if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+						return err
+					}
+
 					return metadata.Encode(&state)
 				}
 			}
@@ -334,6 +345,10 @@ func (r OutputPowerBIResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return outputs.ValidateOutputID
 }
 
+func (r OutputPowerBIResource) Identity() resourceids.ResourceId {
+	return &outputs.OutputId{}
+}
+
 func (r OutputPowerBIResource) CustomImporter() sdk.ResourceRunFunc {
 	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
 		id, err := outputs.ParseOutputID(metadata.ResourceData.Id())
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/streamanalytics/2021-10-01-preview/outputs"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/streamanalytics/migration"
@@ -18,11 +19,14 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name stream_analytics_output_table -service-package-name streamanalytics -properties "name,resource_group_name,streaming_job_name:stream_analytics_job_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 type OutputTableResource struct{}
 
 var (
 	_ sdk.ResourceWithCustomImporter = OutputTableResource{}
 	_ sdk.ResourceWithStateMigration = OutputTableResource{}
+	_ sdk.ResourceWithIdentity       = OutputTableResource{}
 )
 
 type OutputTableResourceModel struct {
@@ -120,6 +124,10 @@ func (r OutputTableResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return outputs.ValidateOutputID
 }
 
+func (r OutputTableResource) Identity() resourceids.ResourceId {
+	return &outputs.OutputId{}
+}
+
 func (r OutputTableResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -171,6 +179,9 @@ func (r OutputTableResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			return nil
 		},
@@ -250,6 +261,10 @@ func (r OutputTableResource) Read() sdk.ResourceFunc {
 						}
 						state.ColumnsToRemove = columnsToRemove
 
+						if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+							return err
+						}
+
 						return metadata.Encode(&state)
 					}
 				}
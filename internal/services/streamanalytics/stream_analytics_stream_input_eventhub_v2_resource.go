@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/streamanalytics/2020-03-01/inputs"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/streamanalytics/2020-03-01/streamingjobs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -19,11 +20,14 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name stream_analytics_stream_input_eventhub_v2 -service-package-name streamanalytics -properties "name" -compare-values "subscription_id:stream_analytics_job_id,resource_group_name:stream_analytics_job_id,streaming_job_name:stream_analytics_job_id" -test-name avro -test-resource-type StreamAnalyticsStreamInputEventHubV2Resource
+
 type StreamInputEventHubV2Resource struct{}
 
 var (
 	_ sdk.ResourceWithCustomImporter = StreamInputEventHubV2Resource{}
 	_ sdk.ResourceWithStateMigration = StreamInputEventHubV2Resource{}
+	_ sdk.ResourceWithIdentity       = StreamInputEventHubV2Resource{}
 )
 
 type StreamInputEventHubV2ResourceModel struct {
@@ -189,6 +193,9 @@ func (r StreamInputEventHubV2Resource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+				return err
+			}
 
 			return nil
 		},
@@ -326,6 +333,10 @@ func (r StreamInputEventHubV2Resource) Read() sdk.ResourceFunc {
 						state.Serialization = []Serialization{serialization}
 						state.PartitionKey = partitionKey
 
+						if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+							return err
+						}
+
 						return metadata.Encode(&state)
 					}
 				}
@@ -361,6 +372,10 @@ func (r StreamInputEventHubV2Resource) IDValidationFunc() pluginsdk.SchemaValida
 	return inputs.ValidateInputID
 }
 
+func (r StreamInputEventHubV2Resource) Identity() resourceids.ResourceId {
+	return &inputs.InputId{}
+}
+
 func (r StreamInputEventHubV2Resource) CustomImporter() sdk.ResourceRunFunc {
 	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
 		id, err := inputs.ParseInputID(metadata.ResourceData.Id())
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/extendedlocation/2021-08-15/customlocations"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/systemcentervirtualmachinemanager/2023-10-07/availabilitysets"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/systemcentervirtualmachinemanager/2023-10-07/vmmservers"
@@ -20,6 +21,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name system_center_virtual_machine_manager_availability_set -service-package-name systemcentervirtualmachinemanager -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 type SystemCenterVirtualMachineManagerAvailabilitySetModel struct {
 	Name                                      string            `tfschema:"name"`
 	ResourceGroupName                         string            `tfschema:"resource_group_name"`
@@ -30,8 +33,9 @@ type SystemCenterVirtualMachineManagerAvailabilitySetModel struct {
 }
 
 var (
-	_ sdk.Resource           = SystemCenterVirtualMachineManagerAvailabilitySetResource{}
-	_ sdk.ResourceWithUpdate = SystemCenterVirtualMachineManagerAvailabilitySetResource{}
+	_ sdk.Resource             = SystemCenterVirtualMachineManagerAvailabilitySetResource{}
+	_ sdk.ResourceWithUpdate   = SystemCenterVirtualMachineManagerAvailabilitySetResource{}
+	_ sdk.ResourceWithIdentity = SystemCenterVirtualMachineManagerAvailabilitySetResource{}
 )
 
 type SystemCenterVirtualMachineManagerAvailabilitySetResource struct{}
@@ -44,6 +48,10 @@ func (r SystemCenterVirtualMachineManagerAvailabilitySetResource) IDValidationFu
 	return availabilitysets.ValidateAvailabilitySetID
 }
 
+func (r SystemCenterVirtualMachineManagerAvailabilitySetResource) Identity() resourceids.ResourceId {
+	return &availabilitysets.AvailabilitySetId{}
+}
+
 func (r SystemCenterVirtualMachineManagerAvailabilitySetResource) ResourceType() string {
 	return "azurerm_system_center_virtual_machine_manager_availability_set"
 }
@@ -120,7 +128,7 @@ func (r SystemCenterVirtualMachineManagerAvailabilitySetResource) Create() sdk.R
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -159,6 +167,10 @@ func (r SystemCenterVirtualMachineManagerAvailabilitySetResource) Read() sdk.Res
 				state.SystemCenterVirtualMachineManagerServerId = scvmmServerId.ID()
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
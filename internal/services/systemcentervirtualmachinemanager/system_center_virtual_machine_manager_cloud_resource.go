@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/extendedlocation/2021-08-15/customlocations"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/systemcentervirtualmachinemanager/2023-10-07/clouds"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/systemcentervirtualmachinemanager/2023-10-07/inventoryitems"
@@ -21,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name system_center_virtual_machine_manager_cloud -service-package-name systemcentervirtualmachinemanager -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 type SystemCenterVirtualMachineManagerCloudModel struct {
 	Name                                                   string            `tfschema:"name"`
 	Location                                               string            `tfschema:"location"`
@@ -31,8 +34,9 @@ type SystemCenterVirtualMachineManagerCloudModel struct {
 }
 
 var (
-	_ sdk.Resource           = SystemCenterVirtualMachineManagerCloudResource{}
-	_ sdk.ResourceWithUpdate = SystemCenterVirtualMachineManagerCloudResource{}
+	_ sdk.Resource             = SystemCenterVirtualMachineManagerCloudResource{}
+	_ sdk.ResourceWithUpdate   = SystemCenterVirtualMachineManagerCloudResource{}
+	_ sdk.ResourceWithIdentity = SystemCenterVirtualMachineManagerCloudResource{}
 )
 
 type SystemCenterVirtualMachineManagerCloudResource struct{}
@@ -45,6 +49,10 @@ func (r SystemCenterVirtualMachineManagerCloudResource) IDValidationFunc() plugi
 	return clouds.ValidateCloudID
 }
 
+func (r SystemCenterVirtualMachineManagerCloudResource) Identity() resourceids.ResourceId {
+	return &clouds.CloudId{}
+}
+
 func (r SystemCenterVirtualMachineManagerCloudResource) ResourceType() string {
 	return "azurerm_system_center_virtual_machine_manager_cloud"
 }
@@ -122,7 +130,7 @@ func (r SystemCenterVirtualMachineManagerCloudResource) Create() sdk.ResourceFun
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -158,6 +166,10 @@ func (r SystemCenterVirtualMachineManagerCloudResource) Read() sdk.ResourceFunc
 				state.Tags = pointer.From(model.Tags)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
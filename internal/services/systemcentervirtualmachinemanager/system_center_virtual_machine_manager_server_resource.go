@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/extendedlocation/2021-08-15/customlocations"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/systemcentervirtualmachinemanager/2023-10-07/inventoryitems"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/systemcentervirtualmachinemanager/2023-10-07/vmmservers"
@@ -21,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name system_center_virtual_machine_manager_server -service-package-name systemcentervirtualmachinemanager -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 type SystemCenterVirtualMachineManagerServerModel struct {
 	Name              string            `tfschema:"name"`
 	ResourceGroupName string            `tfschema:"resource_group_name"`
@@ -34,8 +37,9 @@ type SystemCenterVirtualMachineManagerServerModel struct {
 }
 
 var (
-	_ sdk.Resource           = SystemCenterVirtualMachineManagerServerResource{}
-	_ sdk.ResourceWithUpdate = SystemCenterVirtualMachineManagerServerResource{}
+	_ sdk.Resource             = SystemCenterVirtualMachineManagerServerResource{}
+	_ sdk.ResourceWithUpdate   = SystemCenterVirtualMachineManagerServerResource{}
+	_ sdk.ResourceWithIdentity = SystemCenterVirtualMachineManagerServerResource{}
 )
 
 type SystemCenterVirtualMachineManagerServerResource struct{}
@@ -48,6 +52,10 @@ func (r SystemCenterVirtualMachineManagerServerResource) IDValidationFunc() plug
 	return vmmservers.ValidateVMmServerID
 }
 
+func (r SystemCenterVirtualMachineManagerServerResource) Identity() resourceids.ResourceId {
+	return &vmmservers.VMmServerId{}
+}
+
 func (r SystemCenterVirtualMachineManagerServerResource) ResourceType() string {
 	return "azurerm_system_center_virtual_machine_manager_server"
 }
@@ -167,7 +175,7 @@ func (r SystemCenterVirtualMachineManagerServerResource) Create() sdk.ResourceFu
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -207,6 +215,10 @@ func (r SystemCenterVirtualMachineManagerServerResource) Read() sdk.ResourceFunc
 				}
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
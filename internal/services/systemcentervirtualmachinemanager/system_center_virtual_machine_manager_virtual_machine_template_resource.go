@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/extendedlocation/2021-08-15/customlocations"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/systemcentervirtualmachinemanager/2023-10-07/inventoryitems"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/systemcentervirtualmachinemanager/2023-10-07/virtualmachinetemplates"
@@ -21,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name system_center_virtual_machine_manager_virtual_machine_template -service-package-name systemcentervirtualmachinemanager -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 type SystemCenterVirtualMachineManagerVirtualMachineTemplateModel struct {
 	Name                                                   string            `tfschema:"name"`
 	Location                                               string            `tfschema:"location"`
@@ -31,8 +34,9 @@ type SystemCenterVirtualMachineManagerVirtualMachineTemplateModel struct {
 }
 
 var (
-	_ sdk.Resource           = SystemCenterVirtualMachineManagerVirtualMachineTemplateResource{}
-	_ sdk.ResourceWithUpdate = SystemCenterVirtualMachineManagerVirtualMachineTemplateResource{}
+	_ sdk.Resource             = SystemCenterVirtualMachineManagerVirtualMachineTemplateResource{}
+	_ sdk.ResourceWithUpdate   = SystemCenterVirtualMachineManagerVirtualMachineTemplateResource{}
+	_ sdk.ResourceWithIdentity = SystemCenterVirtualMachineManagerVirtualMachineTemplateResource{}
 )
 
 type SystemCenterVirtualMachineManagerVirtualMachineTemplateResource struct{}
@@ -45,6 +49,10 @@ func (r SystemCenterVirtualMachineManagerVirtualMachineTemplateResource) IDValid
 	return virtualmachinetemplates.ValidateVirtualMachineTemplateID
 }
 
+func (r SystemCenterVirtualMachineManagerVirtualMachineTemplateResource) Identity() resourceids.ResourceId {
+	return &virtualmachinetemplates.VirtualMachineTemplateId{}
+}
+
 func (r SystemCenterVirtualMachineManagerVirtualMachineTemplateResource) ResourceType() string {
 	return "azurerm_system_center_virtual_machine_manager_virtual_machine_template"
 }
@@ -122,7 +130,7 @@ func (r SystemCenterVirtualMachineManagerVirtualMachineTemplateResource) Create(
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -158,6 +166,10 @@ func (r SystemCenterVirtualMachineManagerVirtualMachineTemplateResource) Read()
 				state.Tags = pointer.From(model.Tags)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/extendedlocation/2021-08-15/customlocations"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/systemcentervirtualmachinemanager/2023-10-07/inventoryitems"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/systemcentervirtualmachinemanager/2023-10-07/virtualnetworks"
@@ -21,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name system_center_virtual_machine_manager_virtual_network -service-package-name systemcentervirtualmachinemanager -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 type SystemCenterVirtualMachineManagerVirtualNetworkModel struct {
 	Name                                                   string            `tfschema:"name"`
 	Location                                               string            `tfschema:"location"`
@@ -31,8 +34,9 @@ type SystemCenterVirtualMachineManagerVirtualNetworkModel struct {
 }
 
 var (
-	_ sdk.Resource           = SystemCenterVirtualMachineManagerVirtualNetworkResource{}
-	_ sdk.ResourceWithUpdate = SystemCenterVirtualMachineManagerVirtualNetworkResource{}
+	_ sdk.Resource             = SystemCenterVirtualMachineManagerVirtualNetworkResource{}
+	_ sdk.ResourceWithUpdate   = SystemCenterVirtualMachineManagerVirtualNetworkResource{}
+	_ sdk.ResourceWithIdentity = SystemCenterVirtualMachineManagerVirtualNetworkResource{}
 )
 
 type SystemCenterVirtualMachineManagerVirtualNetworkResource struct{}
@@ -45,6 +49,10 @@ func (r SystemCenterVirtualMachineManagerVirtualNetworkResource) IDValidationFun
 	return virtualnetworks.ValidateVirtualNetworkID
 }
 
+func (r SystemCenterVirtualMachineManagerVirtualNetworkResource) Identity() resourceids.ResourceId {
+	return &virtualnetworks.VirtualNetworkId{}
+}
+
 func (r SystemCenterVirtualMachineManagerVirtualNetworkResource) ResourceType() string {
 	return "azurerm_system_center_virtual_machine_manager_virtual_network"
 }
@@ -122,7 +130,7 @@ func (r SystemCenterVirtualMachineManagerVirtualNetworkResource) Create() sdk.Re
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 	}
 }
@@ -157,6 +165,10 @@ func (r SystemCenterVirtualMachineManagerVirtualNetworkResource) Read() sdk.Reso
 				state.Tags = pointer.From(model.Tags)
 			}
 
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
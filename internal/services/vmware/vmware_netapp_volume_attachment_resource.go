@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/vmware/2022-05-01/clusters"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/vmware/2022-05-01/datastores"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -18,6 +19,8 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name vmware_netapp_volume_attachment -service-package-name vmware -properties "name" -compare-values "subscription_id:vmware_cluster_id,resource_group_name:vmware_cluster_id,private_cloud_name:vmware_cluster_id,cluster_name:vmware_cluster_id" -test-resource-type VmwareNetappFileVolumeAttachmentResource
+
 type NetappFileVolumeAttachment struct {
 	Name            string `tfschema:"name"`
 	NetAppVolumeId  string `tfschema:"netapp_volume_id"`
@@ -26,6 +29,8 @@ type NetappFileVolumeAttachment struct {
 
 type NetappFileVolumeAttachmentResource struct{}
 
+var _ sdk.ResourceWithIdentity = NetappFileVolumeAttachmentResource{}
+
 func (r NetappFileVolumeAttachmentResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -67,6 +72,10 @@ func (r NetappFileVolumeAttachmentResource) IDValidationFunc() pluginsdk.SchemaV
 	return datastores.ValidateDataStoreID
 }
 
+func (r NetappFileVolumeAttachmentResource) Identity() resourceids.ResourceId {
+	return &datastores.DataStoreId{}
+}
+
 func (r NetappFileVolumeAttachmentResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
@@ -109,7 +118,7 @@ func (r NetappFileVolumeAttachmentResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
-			return nil
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id)
 		},
 		Timeout: 30 * time.Minute,
 	}
@@ -143,6 +152,10 @@ func (r NetappFileVolumeAttachmentResource) Read() sdk.ResourceFunc {
 					}
 				}
 			}
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&NetappFileVolumeAttachment{
 				Name:            id.DataStoreName,
 				NetAppVolumeId:  netAppVolumeId,
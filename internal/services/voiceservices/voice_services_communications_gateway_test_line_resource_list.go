@@ -69,7 +69,7 @@ func (CommunicationsGatewayTestLineListResource) List(ctx context.Context, reque
 
 	r := CommunicationsGatewayTestLineResource{}
 
-	resp, err := client.ListByCommunicationsGatewayComplete(ctx, *gatewayId)
+	resp, err := client.ListByCommunicationsGatewayComplete(sdk.ListPageLimitContext(ctx, request), *gatewayId)
 	if err != nil {
 		sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing `%s`", r.ResourceType()), err)
 		return
@@ -87,3 +87,46 @@ func ImporterValidatingIdentityThen(id resourceids.ResourceId, thenFunc Importer
 		},
 	}
 }
+
+// IdentityImporterFunc sets the ID of the resource from the resource identity data provided in the import block
+type IdentityImporterFunc = func(d *ResourceData) error
+
+// IdentityImporter returns an IdentityImporterFunc which validates the resource identity data provided in the import block
+// and sets the ID of the resource, based on the expected resource ID type.
+func IdentityImporter(id resourceids.ResourceId, idType ...ResourceTypeForIdentity) IdentityImporterFunc {
+	return func(d *ResourceData) error {
+		return ValidateResourceIdentityData(d, id, idType...)
+	}
+}
+
+// ImporterValidatingResourceIdOrIdentity validates the ID provided at import time is valid using the validateFunc. Where the
+// resource identity data is provided in the import block instead, the ID is first set from this using the identityFunc.
+//
+// This is intended for resources where the ID can't be parsed using the resource ID type of the resource identity, for
+// example where a suffix is appended to the ID, or where only a subset of the IDs supported by the type are valid.
+func ImporterValidatingResourceIdOrIdentity(validateFunc IDValidationFunc, identityFunc IdentityImporterFunc) *schema.ResourceImporter {
+	thenFunc := func(ctx context.Context, d *ResourceData, meta interface{}) ([]*ResourceData, error) {
+		return []*ResourceData{d}, nil
+	}
+
+	return ImporterValidatingResourceIdOrIdentityThen(validateFunc, identityFunc, thenFunc)
+}
+
+// ImporterValidatingResourceIdOrIdentityThen validates the ID provided at import time is valid using the validateFunc, where the
+// resource identity data is provided in the import block instead the ID is first set from this using the identityFunc, then runs
+// the 'thenFunc', allowing the import to be customised.
+func ImporterValidatingResourceIdOrIdentityThen(validateFunc IDValidationFunc, identityFunc IdentityImporterFunc, thenFunc ImporterFunc) *schema.ResourceImporter {
+	importer := ImporterValidatingResourceIdThen(validateFunc, thenFunc)
+
+	return &schema.ResourceImporter{
+		StateContext: func(ctx context.Context, d *ResourceData, meta interface{}) ([]*ResourceData, error) {
+			if d.Id() == "" {
+				if err := identityFunc(d); err != nil {
+					return nil, err
+				}
+			}
+
+			return importer.StateContext(ctx, d, meta)
+		},
+	}
+}
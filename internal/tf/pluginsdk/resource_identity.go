@@ -16,6 +16,8 @@ import (
 
 // These functions support generating the resource identity schema for the following types of identities and resources
 // * Hierarchical IDs (untyped and typed resources)
+// * Data Plane IDs prefixed with a Base URI (untyped resources)
+// * Scoped IDs, where the Scope is an arbitrary Resource ID (untyped and typed resources)
 
 // ResourceTypeForIdentity is used to select different schema generation behaviours depending on the type of resource/resource ID
 type ResourceTypeForIdentity int
@@ -49,6 +51,8 @@ func segmentTypeSupported(segment resourceids.SegmentType) bool {
 		resourceids.SubscriptionIdSegmentType,
 		resourceids.ResourceGroupSegmentType,
 		resourceids.UserSpecifiedSegmentType,
+		resourceids.DataPlaneBaseURISegmentType,
+		resourceids.ScopeSegmentType,
 	}
 
 	return slices.Contains(supportedSegmentTypes, segment)
@@ -131,6 +135,20 @@ func ValidateResourceIdentityData(d *schema.ResourceData, id resourceids.Resourc
 				return fmt.Errorf("error setting id: %+v", err)
 			}
 
+			if segment.Type == resourceids.DataPlaneBaseURISegmentType {
+				// the Base URI is a fully qualified URI rather than a path segment, so it replaces the leading slash
+				identityString = strings.TrimSuffix(value, "/") + "/"
+				continue
+			}
+
+			if segment.Type == resourceids.ScopeSegmentType {
+				// the Scope is a Resource ID spanning multiple path segments, rather than a single path segment
+				if scope := strings.Trim(value, "/"); scope != "" {
+					identityString += scope + "/"
+				}
+				continue
+			}
+
 			identityString += value + "/"
 		}
 	}
@@ -0,0 +1,305 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package check
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/document-lint/model"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/document-lint/util"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+)
+
+type ExampleIssueType int
+
+const (
+	ExampleUnknownProperty ExampleIssueType = iota
+	ExampleReadOnlyProperty
+	ExampleMissingRequired
+	ExampleTypeMismatch
+	ExampleDeprecatedRename
+)
+
+// exampleDiff is an issue in an example configuration of the document, the key is the address of the property within
+// the configuration e.g. `azurerm_subnet.example.address_prefixes`
+type exampleDiff struct {
+	checkBase
+	IssueType ExampleIssueType
+	detail    string // why the value has the wrong type
+	oldName   string // the deprecated name of a renamed property
+	newName   string // the name which supersedes oldName
+	fixable   bool   // whether the property can be renamed without changing its value
+}
+
+func newExampleDiff(line int, key string, typ ExampleIssueType) exampleDiff {
+	return exampleDiff{
+		checkBase: newCheckBase(line, key, nil),
+		IssueType: typ,
+	}
+}
+
+// ShouldSkip examples aren't bound to a documented field, so these are never skipped
+func (c exampleDiff) ShouldSkip() bool {
+	return false
+}
+
+func (c exampleDiff) String() string {
+	switch c.IssueType {
+	case ExampleReadOnlyProperty:
+		return fmt.Sprintf("%s is read-only and can not be set in the example", c.Str())
+	case ExampleMissingRequired:
+		return fmt.Sprintf("%s is required but missing in the example", c.Str())
+	case ExampleTypeMismatch:
+		return fmt.Sprintf("%s has the wrong type in the example: %s", c.Str(), c.detail)
+	case ExampleDeprecatedRename:
+		return fmt.Sprintf("%s is deprecated in the example - use %s instead", c.Str(), util.FixedCode(c.newName))
+	}
+	return fmt.Sprintf("%s used in the example does not exist in the schema", c.Str())
+}
+
+func (c exampleDiff) Fix(line string) (result string, err error) {
+	if c.IssueType != ExampleDeprecatedRename || !c.fixable {
+		return line, nil
+	}
+	reg := regexp.MustCompile(`^(\s*)` + regexp.QuoteMeta(c.oldName) + `(\s*[={])`)
+	return reg.ReplaceAllString(line, "${1}"+c.newName+"${2}"), nil
+}
+
+var _ Checker = exampleDiff{}
+
+type exampleSchemas struct {
+	resources   map[string]*pluginsdk.Resource
+	dataSources map[string]*pluginsdk.Resource
+}
+
+// providerSchemas returns the schemas of every resource and data source, since examples configure their dependencies
+// alongside the documented resource
+var providerSchemas = sync.OnceValue(func() exampleSchemas {
+	p := provider.AzureProvider()
+	return exampleSchemas{
+		resources:   p.ResourcesMap,
+		dataSources: p.DataSourcesMap,
+	}
+})
+
+var (
+	exampleMetaArguments = []string{"count", "depends_on", "for_each", "provider"}
+	exampleMetaBlocks    = []string{"connection", "lifecycle", "provisioner", "timeouts"}
+)
+
+// checkExamples resolves the `azurerm_*` resources and data sources configured in the examples of the document against
+// their schemas. Framework resources aren't in the schemas and are skipped, as are examples which can't be parsed,
+// e.g. those containing placeholders like `...`.
+func checkExamples(doc *model.ResourceDoc, schemas exampleSchemas) (res []Checker) {
+	for _, example := range doc.Examples {
+		file, diags := hclsyntax.ParseConfig([]byte(example.HCL), doc.ResourceName, hcl.Pos{Line: example.Line + 1, Column: 1})
+		if diags.HasErrors() {
+			continue
+		}
+
+		body, ok := file.Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+		for _, block := range body.Blocks {
+			if len(block.Labels) != 2 || !strings.HasPrefix(block.Labels[0], "azurerm_") {
+				continue
+			}
+
+			address := strings.Join(block.Labels, ".")
+			var r *pluginsdk.Resource
+			switch block.Type {
+			case "resource":
+				r = schemas.resources[block.Labels[0]]
+			case "data":
+				r = schemas.dataSources[block.Labels[0]]
+				address = "data." + address
+			}
+			if r == nil {
+				continue
+			}
+
+			res = append(res, checkExampleBody([]byte(example.HCL), block.Body, r.Schema, address, block.TypeRange.Start.Line-1, true)...)
+		}
+	}
+
+	// attributes are iterated from a map, so order the issues as they appear in the document
+	slices.SortStableFunc(res, func(a, b Checker) int {
+		return a.Line() - b.Line()
+	})
+	return res
+}
+
+// exampleShortenedReg matches the comments used to shorten an example, e.g. `# ...` or `# ... other properties ...`
+var exampleShortenedReg = regexp.MustCompile(`(?m)^\s*(?:#|//)\s*\.\.\.`)
+
+func checkExampleBody(src []byte, body *hclsyntax.Body, s map[string]*pluginsdk.Schema, path string, line int, topLevel bool) (res []Checker) {
+	configured := map[string]struct{}{}
+
+	for name, attr := range body.Attributes {
+		if topLevel && slices.Contains(exampleMetaArguments, name) {
+			continue
+		}
+
+		configured[name] = struct{}{}
+		attrLine, key := attr.NameRange.Start.Line-1, path+"."+name
+		sch, ok := s[name]
+		if !ok {
+			res = append(res, newExampleDiff(attrLine, key, ExampleUnknownProperty))
+			continue
+		}
+		if sch.Computed && !sch.Optional {
+			res = append(res, newExampleDiff(attrLine, key, ExampleReadOnlyProperty))
+			continue
+		}
+		if diff, ok := exampleRename(attrLine, key, s); ok {
+			res = append(res, diff)
+		}
+
+		ty, ok := exampleValueType(sch)
+		if !ok {
+			continue
+		}
+		// only literal values can be evaluated without a context, references to other resources are skipped
+		if val, diags := attr.Expr.Value(nil); !diags.HasErrors() {
+			if _, err := convert.Convert(val, ty); err != nil {
+				diff := newExampleDiff(attrLine, key, ExampleTypeMismatch)
+				diff.detail = fmt.Sprintf("expected %s, %s", ty.FriendlyName(), err)
+				res = append(res, diff)
+			}
+		}
+	}
+
+	for _, block := range body.Blocks {
+		if block.Type == "dynamic" {
+			if len(block.Labels) > 0 {
+				configured[block.Labels[0]] = struct{}{}
+			}
+			continue
+		}
+		if topLevel && slices.Contains(exampleMetaBlocks, block.Type) {
+			continue
+		}
+
+		configured[block.Type] = struct{}{}
+		blockLine, key := block.TypeRange.Start.Line-1, path+"."+block.Type
+		sch, ok := s[block.Type]
+		if !ok {
+			res = append(res, newExampleDiff(blockLine, key, ExampleUnknownProperty))
+			continue
+		}
+		if sch.Computed && !sch.Optional {
+			res = append(res, newExampleDiff(blockLine, key, ExampleReadOnlyProperty))
+			continue
+		}
+		elem, ok := sch.Elem.(*pluginsdk.Resource)
+		if !ok {
+			diff := newExampleDiff(blockLine, key, ExampleTypeMismatch)
+			diff.detail = "should be set as an argument rather than a block"
+			res = append(res, diff)
+			continue
+		}
+		if diff, ok := exampleRename(blockLine, key, s); ok {
+			res = append(res, diff)
+		}
+
+		res = append(res, checkExampleBody(src, block.Body, elem.Schema, key, blockLine, false)...)
+	}
+
+	// comments are dropped when parsing, so the required properties of a block which has been shortened can't be checked
+	if exampleShortenedReg.Match(body.SrcRange.SliceBytes(src)) {
+		return res
+	}
+
+	for name, sch := range s {
+		if _, ok := configured[name]; !ok && sch.Required {
+			res = append(res, newExampleDiff(line, path+"."+name, ExampleMissingRequired))
+		}
+	}
+
+	return res
+}
+
+var supersededByReg = regexp.MustCompile("(?:in favou?r of|superseded by|replaced by|renamed to) (?:the )?(?:property )?`([^`]+)`")
+
+// exampleRename returns an issue if the property of the key has been deprecated in favour of another property in the
+// same block. The issue can be fixed by renaming the property when both have the same type and name, disregarding the
+// word order and the `enable`/`enabled` prefix or suffix, e.g. `enable_http2` and `http2_enabled`.
+func exampleRename(line int, key string, s map[string]*pluginsdk.Schema) (diff exampleDiff, ok bool) {
+	name := util.XPathBase(key)
+	sch := s[name]
+	if sch == nil || sch.Deprecated == "" {
+		return diff, false
+	}
+
+	match := supersededByReg.FindStringSubmatchIndex(sch.Deprecated)
+	if match == nil || strings.HasPrefix(sch.Deprecated[match[1]:], " and `") {
+		// superseded by more than one property isn't a rename
+		return diff, false
+	}
+	newName := util.XPathBase(sch.Deprecated[match[2]:match[3]])
+	newSch := s[newName]
+	if newSch == nil || newSch.Deprecated != "" || newName == name {
+		return diff, false
+	}
+
+	diff = newExampleDiff(line, key, ExampleDeprecatedRename)
+	diff.oldName, diff.newName = name, newName
+	diff.fixable = sch.Type == newSch.Type && renameWords(name) == renameWords(newName)
+	return diff, true
+}
+
+func renameWords(name string) string {
+	words := strings.Split(name, "_")
+	for idx, word := range words {
+		if word == "enable" {
+			words[idx] = "enabled"
+		}
+	}
+	slices.Sort(words)
+	return strings.Join(words, "_")
+}
+
+// exampleValueType returns the type a value must be convertible to for the property, this is false for blocks since
+// their contents are checked separately
+func exampleValueType(s *pluginsdk.Schema) (cty.Type, bool) {
+	switch s.Type {
+	case pluginsdk.TypeBool:
+		return cty.Bool, true
+	case pluginsdk.TypeInt, pluginsdk.TypeFloat:
+		return cty.Number, true
+	case pluginsdk.TypeString:
+		return cty.String, true
+	case pluginsdk.TypeMap:
+		if elem, ok := s.Elem.(*pluginsdk.Schema); ok {
+			if ty, ok := exampleValueType(elem); ok {
+				return cty.Map(ty), true
+			}
+		}
+		return cty.Map(cty.String), true
+	case pluginsdk.TypeList, pluginsdk.TypeSet:
+		elem, ok := s.Elem.(*pluginsdk.Schema)
+		if !ok {
+			return cty.NilType, false
+		}
+		ty, ok := exampleValueType(elem)
+		if !ok {
+			return cty.NilType, false
+		}
+		if s.Type == pluginsdk.TypeSet {
+			return cty.Set(ty), true
+		}
+		return cty.List(ty), true
+	}
+	return cty.NilType, false
+}
@@ -0,0 +1,219 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package check
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/document-lint/model"
+)
+
+func TestCheckExamples(t *testing.T) {
+	schemas := exampleSchemas{
+		resources: map[string]*pluginsdk.Resource{
+			"azurerm_example": {
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+					"instance_count": {
+						Type:     pluginsdk.TypeInt,
+						Optional: true,
+					},
+					"enable_http2": {
+						Type:       pluginsdk.TypeBool,
+						Optional:   true,
+						Deprecated: "the `enable_http2` property has been deprecated in favour of the `http2_enabled` property and will be removed in v5.0 of the AzureRM Provider",
+					},
+					"http2_enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+					},
+					"zones": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+					"identity": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"type": {
+									Type:     pluginsdk.TypeString,
+									Required: true,
+								},
+							},
+						},
+					},
+					"fqdn": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+
+	testData := []struct {
+		name     string
+		hcl      string
+		expected []exampleDiff
+	}{
+		{
+			name: "valid",
+			hcl: `resource "azurerm_resource_group" "example" {
+  name     = "example-resources"
+  location = "West Europe"
+}
+
+resource "azurerm_example" "example" {
+  name           = azurerm_resource_group.example.name
+  instance_count = "2"
+  zones          = ["1", 2]
+  count          = 1
+
+  dynamic "identity" {
+    for_each = []
+    content {}
+  }
+
+  lifecycle {
+    ignore_changes = [zones]
+  }
+}`,
+		},
+		{
+			name: "unknown, read-only and missing required",
+			hcl: `resource "azurerm_example" "example" {
+  location = "West Europe"
+  fqdn     = "example.com"
+
+  identity {}
+}`,
+			expected: []exampleDiff{
+				newExampleDiff(10, "azurerm_example.example.name", ExampleMissingRequired),
+				newExampleDiff(11, "azurerm_example.example.location", ExampleUnknownProperty),
+				newExampleDiff(12, "azurerm_example.example.fqdn", ExampleReadOnlyProperty),
+				newExampleDiff(14, "azurerm_example.example.identity.type", ExampleMissingRequired),
+			},
+		},
+		{
+			name: "type mismatch",
+			hcl: `resource "azurerm_example" "example" {
+  name           = "example"
+  instance_count = true
+  zones          = "1"
+  http2_enabled {}
+}`,
+			expected: []exampleDiff{
+				newExampleDiff(12, "azurerm_example.example.instance_count", ExampleTypeMismatch),
+				newExampleDiff(13, "azurerm_example.example.zones", ExampleTypeMismatch),
+				newExampleDiff(14, "azurerm_example.example.http2_enabled", ExampleTypeMismatch),
+			},
+		},
+		{
+			name: "deprecated rename",
+			hcl: `resource "azurerm_example" "example" {
+  name         = "example"
+  enable_http2 = true
+}`,
+			expected: []exampleDiff{
+				newExampleDiff(12, "azurerm_example.example.enable_http2", ExampleDeprecatedRename),
+			},
+		},
+		{
+			name: "shortened",
+			hcl: `resource "azurerm_example" "example" {
+  # ...
+
+  identity {}
+}
+
+resource "azurerm_example" "other" {
+  zones = ["1"]
+
+  identity {
+    #... other properties
+  }
+}`,
+			expected: []exampleDiff{
+				newExampleDiff(13, "azurerm_example.example.identity.type", ExampleMissingRequired),
+			},
+		},
+		{
+			name: "unparseable",
+			hcl: `resource "azurerm_example" "example" {
+  ...
+}`,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		doc := model.NewResourceDoc()
+		doc.Examples = []model.Example{{Line: 10, HCL: v.hcl}}
+		actual := checkExamples(doc, schemas)
+		if len(actual) != len(v.expected) {
+			t.Fatalf("expected %d issues but got %d: %+v", len(v.expected), len(actual), actual)
+		}
+		for i, expected := range v.expected {
+			diff := actual[i].(exampleDiff)
+			if diff.Line() != expected.Line() || diff.Key() != expected.Key() || diff.IssueType != expected.IssueType {
+				t.Fatalf("expected issue %d to be %q on line %d but got %q", i, expected.Key(), expected.Line(), diff.String())
+			}
+		}
+	}
+}
+
+func TestExampleDiffFix(t *testing.T) {
+	testData := []struct {
+		name     string
+		diff     exampleDiff
+		line     string
+		expected string
+	}{
+		{
+			name:     "renamed argument",
+			diff:     exampleDiff{IssueType: ExampleDeprecatedRename, oldName: "enable_http2", newName: "http2_enabled", fixable: true},
+			line:     "  enable_http2 = true",
+			expected: "  http2_enabled = true",
+		},
+		{
+			name:     "renamed block",
+			diff:     exampleDiff{IssueType: ExampleDeprecatedRename, oldName: "retention", newName: "retention_policy", fixable: true},
+			line:     "    retention {",
+			expected: "    retention_policy {",
+		},
+		{
+			name:     "not a pure rename",
+			diff:     exampleDiff{IssueType: ExampleDeprecatedRename, oldName: "storage_account_name", newName: "storage_account_id"},
+			line:     "  storage_account_name = azurerm_storage_account.example.name",
+			expected: "  storage_account_name = azurerm_storage_account.example.name",
+		},
+		{
+			name:     "unknown property",
+			diff:     exampleDiff{IssueType: ExampleUnknownProperty},
+			line:     "  location = \"West Europe\"",
+			expected: "  location = \"West Europe\"",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		actual, err := v.diff.Fix(v.line)
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if actual != v.expected {
+			t.Fatalf("expected %q but got %q", v.expected, actual)
+		}
+	}
+}
@@ -95,4 +95,7 @@ func (r *ResourceDiff) DiffAll() {
 
 	timeouts := diffTimeout(r.tf, r.md)
 	r.Diff = append(r.Diff, timeouts...)
+
+	examples := checkExamples(r.md, providerSchemas())
+	r.Diff = append(r.Diff, examples...)
 }
@@ -115,6 +115,14 @@ func (f *Fixer) TryFix() (err error) {
 			continue
 		}
 
+		// examples are configuration rather than prose, so mustn't end with a full stop
+		if ex, ok := item.(exampleDiff); ok {
+			if lines[ex.Line()], err = ex.Fix(lines[ex.Line()]); err != nil {
+				return err
+			}
+			continue
+		}
+
 		// mdField is nil for no document exists or page title mismatch
 		if item.ShouldSkip() {
 			continue
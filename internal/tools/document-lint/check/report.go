@@ -0,0 +1,155 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package check
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/reporting"
+)
+
+// Rules are the checks made by the document linter, which are identified in machine-readable output
+var Rules = []reporting.Rule{
+	{ID: "block-declaration", Description: "Blocks should be declared like 'One or more `xxx` blocks as defined below.'"},
+	{ID: "circular-reference", Description: "Blocks in the document shouldn't reference each other"},
+	{ID: "default-value", Description: "The documented default value should match the schema"},
+	{ID: "example-deprecated-property", Description: "Examples shouldn't use properties which have been renamed"},
+	{ID: "example-missing-required", Description: "Examples should set the Required properties of resources"},
+	{ID: "example-read-only-property", Description: "Examples shouldn't set read-only properties"},
+	{ID: "example-type-mismatch", Description: "Values in examples should match the type of the property in the schema"},
+	{ID: "example-unknown-property", Description: "Properties set in examples should exist in the schema"},
+	{ID: "force-new", Description: "Properties should be documented as ForceNew when they are ForceNew in the schema"},
+	{ID: "format", Description: "Properties should be formatted as '* `field` - (Required/Optional) Xxx...'"},
+	{ID: "missing-document", Description: "Resources and Data Sources should be documented"},
+	{ID: "possible-values", Description: "The documented possible values should match the schema"},
+	{ID: "property-missing-in-document", Description: "Properties in the schema should be documented"},
+	{ID: "property-missing-in-schema", Description: "Documented properties should exist in the schema"},
+	{ID: "property-misspelled", Description: "Documented properties should be spelled as in the schema"},
+	{ID: "property-wrong-place", Description: "Documented properties should be nested in the same block as in the schema"},
+	{ID: "required", Description: "Properties should be documented as Required/Optional as in the schema"},
+	{ID: "timeouts", Description: "The documented Timeouts should match the resource"},
+}
+
+func ruleID(c Checker) string {
+	switch v := c.(type) {
+	case *circularRef:
+		return "circular-reference"
+	case defaultDiff, *defaultDiff:
+		return "default-value"
+	case exampleDiff:
+		return exampleRuleID(v.IssueType)
+	case forceNewDiff, *forceNewDiff:
+		return "force-new"
+	case formatErr, *formatErr:
+		return "format"
+	case diffWithMessage, *diffWithMessage:
+		return "missing-document"
+	case possibleValueDiff, *possibleValueDiff:
+		return "possible-values"
+	case requireDiff, *requireDiff:
+		return "required"
+	case timeoutDiff, *timeoutDiff:
+		return "timeouts"
+	case propertyMissDiff:
+		return propertyMissRuleID(v.MissType)
+	case *propertyMissDiff:
+		return propertyMissRuleID(v.MissType)
+	}
+	return "unknown"
+}
+
+func propertyMissRuleID(missType MissType) string {
+	switch missType {
+	case MissInCode:
+		return "property-missing-in-schema"
+	case MissBlockDeclare:
+		return "block-declaration"
+	case Misspelling:
+		return "property-misspelled"
+	case MissWrongPlace:
+		return "property-wrong-place"
+	}
+	return "property-missing-in-document"
+}
+
+func exampleRuleID(typ ExampleIssueType) string {
+	switch typ {
+	case ExampleDeprecatedRename:
+		return "example-deprecated-property"
+	case ExampleMissingRequired:
+		return "example-missing-required"
+	case ExampleReadOnlyProperty:
+		return "example-read-only-property"
+	case ExampleTypeMismatch:
+		return "example-type-mismatch"
+	}
+	return "example-unknown-property"
+}
+
+var leadingLineNumber = regexp.MustCompile(`^\d+\s+`)
+
+// message returns the description of an issue without the line number and property key which prefix it in the text
+// output, since these are reported separately.
+func message(c Checker) string {
+	msg := leadingLineNumber.ReplaceAllString(c.String(), "")
+	if key := c.Key(); key != "" && len(msg) > len(key) && strings.HasPrefix(msg, key) {
+		msg = msg[len(key):]
+	}
+	return strings.TrimSpace(strings.TrimLeft(msg, ":"))
+}
+
+// Report returns the issues which weren't skipped as a machine-readable report. Colors should be disabled before it's
+// called, since the messages are built from the text output.
+func (d *DiffResult) Report() reporting.Report {
+	report := reporting.Report{
+		Tool:     "document-lint",
+		Rules:    Rules,
+		Findings: make([]reporting.Finding, 0),
+	}
+
+	for _, rd := range d.result {
+		for _, c := range rd.Diffs() {
+			if c.ShouldSkip() {
+				continue
+			}
+			report.Findings = append(report.Findings, rd.finding(c))
+		}
+	}
+
+	return report
+}
+
+func (d *ResourceDiff) finding(c Checker) reporting.Finding {
+	f := reporting.Finding{
+		RuleID:  ruleID(c),
+		Message: message(c),
+		Path:    d.tf.ResourceType,
+	}
+
+	if _, ok := c.(exampleDiff); ok {
+		// issues in examples are keyed by the address of the property in the configuration
+		f.Path = c.Key()
+	} else if key := c.Key(); key != "" && f.RuleID != "missing-document" {
+		f.Path += "." + key
+	}
+
+	if file := relativePath(d.MDFile, "website"); file != "" {
+		f.File = file
+		f.Line = c.Line() + 1
+	} else {
+		// without a document the issue is reported against the resource
+		f.File = relativePath(d.tf.FilePath, "internal")
+	}
+
+	return f
+}
+
+// relativePath returns the path relative to the root of the repository, which contains the given directory.
+func relativePath(path string, directory string) string {
+	if idx := strings.Index(path, directory); idx >= 0 {
+		return path[idx:]
+	}
+	return ""
+}
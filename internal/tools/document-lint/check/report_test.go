@@ -0,0 +1,92 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package check
+
+import (
+	"testing"
+
+	"github.com/fatih/color"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/document-lint/model"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/document-lint/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/reporting"
+)
+
+func TestDiffResult_Report(t *testing.T) {
+	noColor := color.NoColor
+	color.NoColor = true
+	defer func() {
+		color.NoColor = noColor
+	}()
+
+	field := &model.Field{
+		Name: "sku",
+		Line: 11,
+	}
+	result := &DiffResult{
+		result: []*ResourceDiff{
+			{
+				tf: &schema.Resource{
+					ResourceType: "azurerm_example",
+					FilePath:     "/src/internal/services/example/example_resource.go",
+				},
+				MDFile: "/src/website/docs/r/example.html.markdown",
+				Diff: []Checker{
+					newMissInDoc("sku", field),
+					newRequireDiff(newCheckBase(field.Line, "network.subnet_id", field), ShouldBeRequired),
+					newMissInDoc("skipped", nil),
+					newExampleDiff(40, "azurerm_subnet.example.address_prefix", ExampleUnknownProperty),
+				},
+			},
+			{
+				tf: &schema.Resource{
+					ResourceType: "azurerm_undocumented",
+					FilePath:     "/src/internal/services/example/undocumented_resource.go",
+				},
+				Diff: []Checker{
+					newDiffWithMessage("azurerm_undocumented has no document", false),
+				},
+			},
+		},
+	}
+
+	expected := []reporting.Finding{
+		{
+			RuleID:  "property-missing-in-document",
+			Message: "does not exist in the documentation or is poorly formatted",
+			Path:    "azurerm_example.sku",
+			File:    "website/docs/r/example.html.markdown",
+			Line:    12,
+		},
+		{
+			RuleID:  "required",
+			Message: "should be required",
+			Path:    "azurerm_example.network.subnet_id",
+			File:    "website/docs/r/example.html.markdown",
+			Line:    12,
+		},
+		{
+			RuleID:  "example-unknown-property",
+			Message: "used in the example does not exist in the schema",
+			Path:    "azurerm_subnet.example.address_prefix",
+			File:    "website/docs/r/example.html.markdown",
+			Line:    41,
+		},
+		{
+			RuleID:  "missing-document",
+			Message: "azurerm_undocumented has no document",
+			Path:    "azurerm_undocumented",
+			File:    "internal/services/example/undocumented_resource.go",
+		},
+	}
+
+	report := result.Report()
+	if len(report.Findings) != len(expected) {
+		t.Fatalf("expected %d findings but got %d: %+v", len(expected), len(report.Findings), report.Findings)
+	}
+	for i, v := range expected {
+		if actual := report.Findings[i]; actual != v {
+			t.Fatalf("expected finding %d to be %+v but got %+v", i, v, actual)
+		}
+	}
+}
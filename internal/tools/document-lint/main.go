@@ -9,7 +9,9 @@ import (
 	"log"
 	"os"
 
+	"github.com/fatih/color"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/document-lint/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/reporting"
 )
 
 func printHelp() {
@@ -31,6 +33,8 @@ var (
 	skipResource string
 	skipService  string
 	fileList     string
+	outputFormat string
+	format       = reporting.FormatText
 )
 
 func parseArgs() {
@@ -40,6 +44,7 @@ func parseArgs() {
 	fs.StringVar(&service, "service", os.Getenv("ONLY_SERVICE"), "a list of services names to check")
 	fs.StringVar(&skipService, "skip-service", os.Getenv("SKIP_SERVICE"), "a list of service names to skip the check")
 	fs.StringVar(&fileList, "file-list", os.Getenv("FILE_LIST"), "a list of files to check")
+	fs.StringVar(&outputFormat, "format", string(reporting.FormatText), "the output format, one of `text`, `json`, `sarif` or `markdown`")
 
 	fs.Usage = func() {
 		printHelp()
@@ -59,18 +64,40 @@ func parseArgs() {
 			fs.Usage()
 		}
 	}
+
+	if outputFormat != "" {
+		f, err := reporting.ParseFormat(outputFormat)
+		if err != nil {
+			fmt.Printf("%v\n", err)
+			os.Exit(1)
+		}
+		format = f
+	}
 }
 
 func main() {
 	parseArgs()
 
+	if format != reporting.FormatText {
+		// messages in machine-readable output mustn't contain color codes
+		color.NoColor = true
+	}
+
 	result := check.DiffAll(check.AzurermAllResources(service, skipService, resource, skipResource, fileList), dryRun)
+	if format != reporting.FormatText {
+		if err := result.Report().Write(os.Stdout, format); err != nil {
+			log.Fatalf("error writing %s report: %v", format, err)
+		}
+	}
+
 	if !result.HasDiff() {
 		log.Printf("document linter runs success, time costs: %v", result.CostTime())
 		return
 	}
 
-	log.Printf("%s\n", result.ToString())
+	if format == reporting.FormatText {
+		log.Printf("%s\n", result.ToString())
+	}
 
 	if cmd == "fix" {
 		if err := result.FixDocuments(); err != nil {
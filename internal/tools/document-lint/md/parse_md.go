@@ -403,6 +403,7 @@ func (m *Mark) BuildResourceDoc() *model.ResourceDoc {
 	}
 
 	doc.ResourceName = m.ResourceType
+	doc.Examples = hclExamples(*m.content)
 	for _, item := range m.Items {
 		switch item.Type {
 		case ItemExample:
@@ -414,3 +415,38 @@ func (m *Mark) BuildResourceDoc() *model.ResourceDoc {
 
 	return doc
 }
+
+// hclExamples returns the fenced `hcl` code blocks of the document, which are scanned from the content directly since
+// comments in the configuration look like headers to the item parser
+func hclExamples(content string) (res []model.Example) {
+	var (
+		inFence, isHCL bool
+		start          int
+		lines          []string
+	)
+	for idx, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if !strings.HasPrefix(trimmed, "```") {
+			if inFence && isHCL {
+				lines = append(lines, line)
+			}
+			continue
+		}
+
+		if !inFence {
+			lang := strings.ToLower(strings.TrimPrefix(trimmed, "```"))
+			inFence, isHCL = true, lang == "hcl" || lang == "terraform"
+			start, lines = idx+1, nil
+			continue
+		}
+
+		if isHCL {
+			res = append(res, model.Example{
+				Line: start,
+				HCL:  strings.Join(lines, "\n"),
+			})
+		}
+		inFence = false
+	}
+	return res
+}
@@ -37,3 +37,18 @@ func Test_unmarshalFile(t *testing.T) {
 		}
 	}
 }
+
+func Test_hclExamples(t *testing.T) {
+	content := "# Example\n\n```hcl\n# a comment\nresource \"azurerm_example\" \"example\" {\n}\n```\n\n```shell\nterraform import azurerm_example.example /subscriptions/...\n```\n\n```terraform\nlocals {}\n```\n"
+
+	examples := hclExamples(content)
+	if len(examples) != 2 {
+		t.Fatalf("expected 2 examples, got %d", len(examples))
+	}
+	if examples[0].Line != 3 || examples[0].HCL != "# a comment\nresource \"azurerm_example\" \"example\" {\n}" {
+		t.Fatalf("unexpected first example at line %d: %q", examples[0].Line, examples[0].HCL)
+	}
+	if examples[1].Line != 13 || examples[1].HCL != "locals {}" {
+		t.Fatalf("unexpected second example at line %d: %q", examples[1].Line, examples[1].HCL)
+	}
+}
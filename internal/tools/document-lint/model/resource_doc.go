@@ -231,11 +231,18 @@ func NewPossibleValue(values []string, f *Field) PossibleValue {
 	}
 }
 
+// Example is a fenced HCL code block in the document
+type Example struct {
+	Line int // line number of the first line of the configuration, excluding the fence
+	HCL  string
+}
+
 type ResourceDoc struct {
 	ResourceName string
 	Args         Properties
 	Attr         Properties
 	ExampleHCL   string
+	Examples     []Example
 	Timeouts     *Timeouts // nil if no timeouts part in document
 	Import       Import
 
@@ -0,0 +1,414 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package generators
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+const (
+	// sampleRandomInteger and sampleRandomString are used in place of `data.RandomInteger` and `data.RandomString` when
+	// checking a value against the validation function for a property, and match their format
+	sampleRandomInteger = "230630033910945385"
+	sampleRandomString  = "ab1cd"
+
+	randomIntegerArg = "data.RandomInteger"
+	randomStringArg  = "data.RandomString"
+
+	// maxBlockDepth limits how deeply nested blocks are generated in the `complete` configuration
+	maxBlockDepth = 3
+
+	todoComment = "# TODO: a valid value couldn't be determined from the schema"
+)
+
+// candidate is a value which may be valid for a property, where `%d` and `%s` are replaced by the random values of the
+// test data
+type candidate struct {
+	value string
+	arg   string
+}
+
+var (
+	nameCandidates = []candidate{
+		{value: "acctest-%d", arg: randomIntegerArg},
+		{value: "acctest%d", arg: randomIntegerArg},
+		{value: "acctest-%s", arg: randomStringArg},
+		{value: "acctest%s", arg: randomStringArg},
+	}
+
+	stringCandidates = []candidate{
+		{value: "acctest-%d", arg: randomIntegerArg},
+		{value: "acctest%s", arg: randomStringArg},
+		{value: "10.0.0.0/16"},
+		{value: "10.0.0.4"},
+		{value: "https://www.example.com"},
+		{value: "user@example.com"},
+		{value: "00000000-0000-0000-0000-000000000000"},
+		{value: "PT1H"},
+		{value: "2030-01-01T00:00:00Z"},
+		{value: "Test"},
+	}
+
+	// hintedCandidates are tried first for properties whose name contains one of the keywords (as whole words, e.g.
+	// `address_prefixes` but not `security_group` for `uri`), since properties without validation would otherwise be
+	// given the first of stringCandidates
+	hintedCandidates = []struct {
+		keywords  []string
+		candidate candidate
+	}{
+		{keywords: []string{"address_space", "address_prefix", "address_prefixes", "cidr", "prefix"}, candidate: candidate{value: "10.0.0.0/16"}},
+		{keywords: []string{"ip", "ip_address", "ip_addresses"}, candidate: candidate{value: "10.0.0.4"}},
+		{keywords: []string{"url", "uri", "endpoint"}, candidate: candidate{value: "https://www.example.com"}},
+		{keywords: []string{"email"}, candidate: candidate{value: "user@example.com"}},
+	}
+
+	intCandidates   = []string{"1", "0", "10", "100", "1024", "3600", "65535"}
+	floatCandidates = []string{"1.0", "0.5", "10.0"}
+)
+
+// acceptanceConfig builds the HCL for the test configurations of a resource, tracking the arguments needed for the
+// `fmt.Sprintf` call which renders it. The first argument is always the configuration it builds on, e.g. `template`.
+type acceptanceConfig struct {
+	resourceType string
+	complete     bool
+	args         []string
+}
+
+func newAcceptanceConfig(resourceType string, base string, complete bool) *acceptanceConfig {
+	return &acceptanceConfig{
+		resourceType: resourceType,
+		complete:     complete,
+		args:         []string{base},
+	}
+}
+
+// argIndex returns the (1-based) index of the argument, adding it if it's not yet used.
+func (c *acceptanceConfig) argIndex(arg string) int {
+	for i, v := range c.args {
+		if v == arg {
+			return i + 1
+		}
+	}
+	c.args = append(c.args, arg)
+	return len(c.args)
+}
+
+// render returns the configuration for the resource with the given schema, which is formatted as in `terraform fmt`.
+func (c *acceptanceConfig) render(s map[string]*pluginsdk.Schema) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "resource %q \"test\" {\n", c.resourceType)
+	c.writeBody(&sb, s, "  ", 1)
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// requiresImport returns the configuration for importing the resource created by the `basic` configuration, which
+// references the values of the top-level properties it sets.
+func (c *acceptanceConfig) requiresImport(s map[string]*pluginsdk.Schema) string {
+	keys := c.includedKeys(s, 1)
+
+	attributes := make([][2]string, 0)
+	blockKeys := make([]string, 0)
+	for _, k := range keys {
+		if isBlock(s[k]) {
+			blockKeys = append(blockKeys, k)
+			continue
+		}
+		attributes = append(attributes, [2]string{k, fmt.Sprintf("%s.test.%s", c.resourceType, k)})
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "resource %q \"import\" {\n", c.resourceType)
+	writeAttributes(&sb, attributes, "  ")
+
+	// blocks can't be referenced, so are repeated from the `basic` configuration
+	if len(attributes) > 0 && len(blockKeys) > 0 {
+		sb.WriteString("\n")
+	}
+	c.writeBlocks(&sb, s, blockKeys, "  ", 1)
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+func (c *acceptanceConfig) writeBody(sb *strings.Builder, s map[string]*pluginsdk.Schema, indent string, depth int) {
+	keys := c.includedKeys(s, depth)
+
+	attributes := make([][2]string, 0)
+	blockKeys := make([]string, 0)
+	for _, k := range keys {
+		if isBlock(s[k]) {
+			blockKeys = append(blockKeys, k)
+			continue
+		}
+		attributes = append(attributes, [2]string{k, c.value(k, s[k], indent)})
+	}
+
+	writeAttributes(sb, attributes, indent)
+	if len(attributes) > 0 && len(blockKeys) > 0 {
+		sb.WriteString("\n")
+	}
+	c.writeBlocks(sb, s, blockKeys, indent, depth)
+}
+
+func (c *acceptanceConfig) writeBlocks(sb *strings.Builder, s map[string]*pluginsdk.Schema, keys []string, indent string, depth int) {
+	for i, k := range keys {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		fmt.Fprintf(sb, "%s%s {\n", indent, k)
+		c.writeBody(sb, s[k].Elem.(*pluginsdk.Resource).Schema, indent+"  ", depth+1)
+		fmt.Fprintf(sb, "%s}\n", indent)
+	}
+}
+
+// writeAttributes writes the attributes with their values aligned, as in `terraform fmt`. Attributes with multi-line
+// values (e.g. `tags`) are written last, separated by a blank line.
+func writeAttributes(sb *strings.Builder, attributes [][2]string, indent string) {
+	width := 0
+	multiLine := make([][2]string, 0)
+	for _, a := range attributes {
+		if strings.Contains(a[1], "\n") {
+			multiLine = append(multiLine, a)
+			continue
+		}
+		if len(a[0]) > width {
+			width = len(a[0])
+		}
+	}
+
+	for _, a := range attributes {
+		if !strings.Contains(a[1], "\n") {
+			fmt.Fprintf(sb, "%s%-*s = %s\n", indent, width, a[0], a[1])
+		}
+	}
+	for i, a := range multiLine {
+		if i > 0 || len(multiLine) < len(attributes) {
+			sb.WriteString("\n")
+		}
+		fmt.Fprintf(sb, "%s%s = %s\n", indent, a[0], a[1])
+	}
+}
+
+// includedKeys returns the properties to set within a block, which are the required properties (and in the complete
+// configuration the optional ones, up to maxBlockDepth) ordered with the common properties first. Properties conflicting
+// with one which is already included are skipped, and one of each `ExactlyOneOf`/`AtLeastOneOf` group is included.
+func (c *acceptanceConfig) includedKeys(s map[string]*pluginsdk.Schema, depth int) []string {
+	included := make(map[string]bool)
+	conflicts := func(k string) bool {
+		for _, other := range conflictingKeys(s[k]) {
+			if included[other] {
+				return true
+			}
+		}
+		return false
+	}
+
+	keys := sortedKeys(s)
+	for _, k := range keys {
+		if s[k].Required {
+			included[k] = true
+		}
+	}
+	for _, k := range keys {
+		v := s[k]
+		if !v.Optional || v.Deprecated != "" || included[k] || conflicts(k) {
+			continue
+		}
+
+		group := append(append([]string{}, v.ExactlyOneOf...), v.AtLeastOneOf...)
+		requiredByGroup := len(group) > 0
+		for _, other := range group {
+			if included[lastSegment(other)] {
+				requiredByGroup = false
+			}
+		}
+
+		if (c.complete && (depth < maxBlockDepth || !isBlock(v))) || requiredByGroup {
+			included[k] = true
+		}
+	}
+
+	result := make([]string, 0, len(included))
+	for _, k := range []string{"name", "resource_group_name", "location"} {
+		if included[k] {
+			result = append(result, k)
+		}
+	}
+	for _, k := range keys {
+		switch k {
+		case "name", "resource_group_name", "location", "tags":
+			continue
+		}
+		if included[k] {
+			result = append(result, k)
+		}
+	}
+	if included["tags"] {
+		result = append(result, "tags")
+	}
+
+	return result
+}
+
+func conflictingKeys(s *pluginsdk.Schema) []string {
+	keys := make([]string, 0)
+	for _, v := range append(append([]string{}, s.ConflictsWith...), s.ExactlyOneOf...) {
+		keys = append(keys, lastSegment(v))
+	}
+	return keys
+}
+
+// value returns the HCL expression for an attribute, where indent is the indentation of the attribute.
+func (c *acceptanceConfig) value(key string, s *pluginsdk.Schema, indent string) string {
+	switch key {
+	case "resource_group_name":
+		return "azurerm_resource_group.test.name"
+	case "location":
+		return "azurerm_resource_group.test.location"
+	case "tags":
+		return fmt.Sprintf("{\n%s  ENV = \"Test\"\n%s}", indent, indent)
+	}
+
+	switch s.Type {
+	case pluginsdk.TypeList, pluginsdk.TypeSet:
+		elem, ok := s.Elem.(*pluginsdk.Schema)
+		if !ok {
+			break
+		}
+		// the TODO comment has to follow the list, rather than the element within it
+		if v, ok := c.primitiveValue(key, elem); ok {
+			return fmt.Sprintf("[%s]", v)
+		}
+		return "[] " + todoComment
+	case pluginsdk.TypeMap:
+		return fmt.Sprintf("{\n%s  key = \"value\"\n%s}", indent, indent)
+	}
+
+	if v, ok := c.primitiveValue(key, s); ok {
+		return v
+	}
+	return "null " + todoComment
+}
+
+// primitiveValue returns a value for a bool, number or string property which passes its validation, and whether one
+// was found.
+func (c *acceptanceConfig) primitiveValue(key string, s *pluginsdk.Schema) (string, bool) {
+	switch s.Type {
+	case pluginsdk.TypeBool:
+		if v, ok := s.Default.(bool); ok {
+			return strconv.FormatBool(!v), true
+		}
+		return strconv.FormatBool(c.complete), true
+
+	case pluginsdk.TypeInt:
+		for _, v := range intCandidates {
+			i, _ := strconv.Atoi(v)
+			if isValid(s, i) {
+				return v, true
+			}
+		}
+
+	case pluginsdk.TypeFloat:
+		for _, v := range floatCandidates {
+			f, _ := strconv.ParseFloat(v, 64)
+			if isValid(s, f) {
+				return v, true
+			}
+		}
+
+	case pluginsdk.TypeString:
+		if values := providerjson.PossibleValues(s); len(values) > 0 {
+			return strconv.Quote(escapeVerbs(values[0])), true
+		}
+
+		for _, v := range c.stringCandidates(key) {
+			sample := v.value
+			switch v.arg {
+			case randomIntegerArg:
+				sample = strings.Replace(sample, "%d", sampleRandomInteger, 1)
+			case randomStringArg:
+				sample = strings.Replace(sample, "%s", sampleRandomString, 1)
+			}
+			if !isValid(s, sample) {
+				continue
+			}
+
+			if v.arg == "" {
+				return strconv.Quote(escapeVerbs(v.value)), true
+			}
+			verb := v.value[strings.Index(v.value, "%")+1:][:1]
+			return strconv.Quote(strings.Replace(v.value, "%"+verb, fmt.Sprintf("%%[%d]%s", c.argIndex(v.arg), verb), 1)), true
+		}
+	}
+
+	return "", false
+}
+
+// stringCandidates returns the candidates for a string property, in the order they should be tried.
+func (c *acceptanceConfig) stringCandidates(key string) []candidate {
+	if key == "name" || strings.HasSuffix(key, "_name") {
+		return nameCandidates
+	}
+
+	candidates := make([]candidate, 0, len(stringCandidates))
+	for _, hint := range hintedCandidates {
+		for _, keyword := range hint.keywords {
+			if strings.Contains("_"+key+"_", "_"+keyword+"_") {
+				candidates = append(candidates, hint.candidate)
+				break
+			}
+		}
+	}
+	return append(candidates, stringCandidates...)
+}
+
+// isValid returns whether the value passes the validation function of the property, if it has one.
+func isValid(s *pluginsdk.Schema, value interface{}) (valid bool) {
+	// validation functions aren't expected to be called outside of Terraform, so any which fail are treated as invalid
+	defer func() {
+		if recover() != nil {
+			valid = false
+		}
+	}()
+
+	switch {
+	case s.ValidateFunc != nil:
+		_, errs := s.ValidateFunc(value, "value")
+		return len(errs) == 0
+	case s.ValidateDiagFunc != nil:
+		return !s.ValidateDiagFunc(value, cty.Path{}).HasError()
+	}
+	return true
+}
+
+// escapeVerbs escapes a literal value for use within the `fmt.Sprintf` call rendering the configuration
+func escapeVerbs(input string) string {
+	return strings.ReplaceAll(input, "%", "%%")
+}
+
+func isBlock(s *pluginsdk.Schema) bool {
+	_, ok := s.Elem.(*pluginsdk.Resource)
+	return ok && (s.Type == pluginsdk.TypeList || s.Type == pluginsdk.TypeSet)
+}
+
+func lastSegment(path string) string {
+	parts := strings.Split(path, ".")
+	return parts[len(parts)-1]
+}
+
+func sortedKeys(s map[string]*pluginsdk.Schema) []string {
+	keys := make([]string, 0, len(s))
+	for k := range s {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
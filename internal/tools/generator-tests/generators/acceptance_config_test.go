@@ -0,0 +1,183 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package generators
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+func TestAcceptanceConfig(t *testing.T) {
+	schema := map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile("^[a-z0-9]+$"), "alphanumeric"),
+		},
+		"resource_group_name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+		"location": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+		"address_prefixes": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+		"sku": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{"Standard", "Premium"}, false),
+		},
+		"capacity": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntBetween(2, 10),
+		},
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+		"key_vault_key_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsUUID,
+			ConflictsWith: []string{
+				"managed_key",
+			},
+		},
+		"managed_key": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ConflictsWith: []string{
+				"key_vault_key_id",
+			},
+		},
+		"identity_ids": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsEmpty,
+			},
+		},
+		"old_property": {
+			Type:       pluginsdk.TypeString,
+			Optional:   true,
+			Deprecated: "superseded by `sku`",
+		},
+		"network": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"ip_address": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+				},
+			},
+		},
+		"tags": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+
+	testData := []struct {
+		name     string
+		config   *acceptanceConfig
+		render   func(c *acceptanceConfig) string
+		expected string
+		args     []string
+	}{
+		{
+			name:   "basic",
+			config: newAcceptanceConfig("azurerm_example", "r.template(data)", false),
+			render: func(c *acceptanceConfig) string {
+				return c.render(schema)
+			},
+			expected: `resource "azurerm_example" "test" {
+  name                = "acctest%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  address_prefixes    = ["10.0.0.0/16"]
+}
+`,
+			args: []string{"r.template(data)", "data.RandomInteger"},
+		},
+		{
+			name:   "requiresImport",
+			config: newAcceptanceConfig("azurerm_example", "r.basic(data)", false),
+			render: func(c *acceptanceConfig) string {
+				return c.requiresImport(schema)
+			},
+			expected: `resource "azurerm_example" "import" {
+  name                = azurerm_example.test.name
+  resource_group_name = azurerm_example.test.resource_group_name
+  location            = azurerm_example.test.location
+  address_prefixes    = azurerm_example.test.address_prefixes
+}
+`,
+			args: []string{"r.basic(data)"},
+		},
+		{
+			name:   "complete",
+			config: newAcceptanceConfig("azurerm_example", "r.template(data)", true),
+			render: func(c *acceptanceConfig) string {
+				return c.render(schema)
+			},
+			expected: `resource "azurerm_example" "test" {
+  name                = "acctest%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  address_prefixes    = ["10.0.0.0/16"]
+  capacity            = 10
+  enabled             = false
+  identity_ids        = [] # TODO: a valid value couldn't be determined from the schema
+  key_vault_key_id    = "00000000-0000-0000-0000-000000000000"
+  sku                 = "Standard"
+
+  tags = {
+    ENV = "Test"
+  }
+
+  network {
+    ip_address = "10.0.0.4"
+  }
+}
+`,
+			args: []string{"r.template(data)", "data.RandomInteger"},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		actual := v.render(v.config)
+		if actual != v.expected {
+			t.Fatalf("expected:\n%s\nbut got:\n%s", v.expected, actual)
+		}
+		if len(v.config.args) != len(v.args) {
+			t.Fatalf("expected the arguments %v but got %v", v.args, v.config.args)
+		}
+		for i := range v.args {
+			if v.config.args[i] != v.args[i] {
+				t.Fatalf("expected the arguments %v but got %v", v.args, v.config.args)
+			}
+		}
+	}
+}
@@ -0,0 +1,333 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package generators
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// parseIDFuncPattern matches the functions used to parse a Resource ID, e.g. `commonids.ParseVirtualNetworkID` or
+// `parse.StorageAccountIDInsensitively`
+var parseIDFuncPattern = regexp.MustCompile(`^Parse\w*ID(Insensitively)?$`)
+
+// existsFunc is the information needed to render the Exists function of a test resource, which is found in the Read
+// function of the resource. Body is empty when it couldn't be determined.
+type existsFunc struct {
+	// Imports are the packages used in the body, mapped to their alias (which is empty when it isn't needed)
+	Imports map[string]string
+
+	// Body retrieves the resource, and is followed by `return pointer.To(<ResultCheck>), nil`
+	Body        string
+	ResultCheck string
+}
+
+// findReadFunc returns the Read function of the resource within the service package, for Typed Resources this is the
+// `Read()` method of typeName, otherwise the function assigned to `Read` in the resource returned by the function
+// registered for resourceType.
+func findReadFunc(files []*ast.File, resourceType string, typeName string) *ast.FuncDecl {
+	funcs := make(map[string]*ast.FuncDecl)
+	methods := make(map[string]*ast.FuncDecl)
+	registrations := make([]*ast.FuncDecl, 0)
+	for _, f := range files {
+		for _, decl := range f.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Body == nil {
+				continue
+			}
+			switch {
+			case fn.Recv == nil:
+				funcs[fn.Name.Name] = fn
+			case fn.Name.Name == "SupportedResources":
+				registrations = append(registrations, fn)
+			case receiverTypeName(fn) == typeName:
+				methods[fn.Name.Name] = fn
+			}
+		}
+	}
+
+	if typeName != "" {
+		return methods["Read"]
+	}
+
+	// e.g. `"azurerm_example": resourceExample(),` in `SupportedResources`, since Data Sources can be registered with
+	// the same name
+	var resourceFunc *ast.FuncDecl
+	for _, registration := range registrations {
+		ast.Inspect(registration.Body, func(n ast.Node) bool {
+			kv, ok := n.(*ast.KeyValueExpr)
+			if !ok || resourceFunc != nil {
+				return resourceFunc == nil
+			}
+			if key, ok := kv.Key.(*ast.BasicLit); !ok || key.Value != strconv.Quote(resourceType) {
+				return true
+			}
+			if call, ok := kv.Value.(*ast.CallExpr); ok {
+				if ident, ok := call.Fun.(*ast.Ident); ok {
+					resourceFunc = funcs[ident.Name]
+				}
+			}
+			return true
+		})
+	}
+	if resourceFunc == nil {
+		return nil
+	}
+
+	var read *ast.FuncDecl
+	ast.Inspect(resourceFunc.Body, func(n ast.Node) bool {
+		kv, ok := n.(*ast.KeyValueExpr)
+		if !ok || read != nil {
+			return read == nil
+		}
+		if key, ok := kv.Key.(*ast.Ident); ok && (key.Name == "Read" || key.Name == "ReadContext") {
+			if ident, ok := kv.Value.(*ast.Ident); ok {
+				read = funcs[ident.Name]
+			}
+		}
+		return true
+	})
+	return read
+}
+
+func receiverTypeName(fn *ast.FuncDecl) string {
+	if len(fn.Recv.List) == 0 {
+		return ""
+	}
+	t := fn.Recv.List[0].Type
+	if star, ok := t.(*ast.StarExpr); ok {
+		t = star.X
+	}
+	if ident, ok := t.(*ast.Ident); ok {
+		return ident.Name
+	}
+	return ""
+}
+
+// parseServicePackage parses the (non-test) files of a service package.
+func parseServicePackage(dir string) (*token.FileSet, []*ast.File, error) {
+	fset := token.NewFileSet()
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, nil, fmt.Errorf("reading %s: %+v", dir, err)
+	}
+
+	files := make([]*ast.File, 0)
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".go") || strings.HasSuffix(e.Name(), "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, filepath.Join(dir, e.Name()), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, nil, fmt.Errorf("parsing %s: %+v", e.Name(), err)
+		}
+		files = append(files, f)
+	}
+	return fset, files, nil
+}
+
+// buildExistsFunc builds the Exists function from the Read function of a resource, which parses the ID and retrieves
+// the resource using a client from `clients.Client`, e.g.
+//
+//	id, err := commonids.ParseVirtualNetworkID(metadata.ResourceData.Id())
+//	client := metadata.Client.Network.VirtualNetworks
+//	resp, err := client.Get(ctx, *id, virtualnetworks.DefaultGetOperationOptions())
+func buildExistsFunc(fset *token.FileSet, files []*ast.File, read *ast.FuncDecl) existsFunc {
+	result := existsFunc{
+		Imports: make(map[string]string),
+	}
+	if read == nil {
+		return result
+	}
+
+	var file *ast.File
+	for _, f := range files {
+		if f.Pos() <= read.Pos() && read.End() <= f.End() {
+			file = f
+		}
+	}
+	imports := fileImports(file)
+
+	var parseCall *ast.CallExpr
+	idVar := ""
+	clientVars := make(map[string]string)
+	var getCall *ast.CallExpr
+	respVar := ""
+	ast.Inspect(read.Body, func(n ast.Node) bool {
+		assign, ok := n.(*ast.AssignStmt)
+		if !ok || len(assign.Rhs) != 1 {
+			return true
+		}
+		lhs, ok := assign.Lhs[0].(*ast.Ident)
+		if !ok {
+			return true
+		}
+
+		if client := clientPath(assign.Rhs[0]); client != "" {
+			clientVars[lhs.Name] = client
+		}
+
+		call, ok := assign.Rhs[0].(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		switch {
+		case parseCall == nil && parseIDFuncPattern.MatchString(sel.Sel.Name):
+			if _, ok := imports[exprString(fset, sel.X)]; ok {
+				parseCall, idVar = call, lhs.Name
+			}
+		case getCall == nil && sel.Sel.Name == "Get":
+			if receiver, ok := sel.X.(*ast.Ident); ok && clientVars[receiver.Name] != "" {
+				getCall, respVar = call, lhs.Name
+			}
+		}
+		return true
+	})
+	if parseCall == nil || getCall == nil {
+		return result
+	}
+
+	// the arguments of the Get are rendered as-is, so can only reference the context, ID and imported packages
+	args := make([]string, 0, len(getCall.Args))
+	for _, arg := range getCall.Args {
+		valid := true
+		ast.Inspect(arg, func(n ast.Node) bool {
+			switch v := n.(type) {
+			case *ast.SelectorExpr:
+				if ident, ok := v.X.(*ast.Ident); ok {
+					switch importPath, ok := imports[ident.Name]; {
+					case ok:
+						result.Imports[importPath] = importAlias(ident.Name, importPath)
+					case ident.Name == idVar:
+						ident.Name = "id"
+					default:
+						valid = false
+					}
+				}
+				return false
+			case *ast.Ident:
+				if v.Name == idVar {
+					v.Name = "id"
+				} else if v.Name != "ctx" && v.Name != "nil" {
+					valid = false
+				}
+			}
+			return true
+		})
+		if !valid {
+			return existsFunc{
+				Imports: make(map[string]string),
+			}
+		}
+		args = append(args, exprString(fset, arg))
+	}
+
+	parsePkg := exprString(fset, parseCall.Fun.(*ast.SelectorExpr).X)
+	result.Imports[imports[parsePkg]] = importAlias(parsePkg, imports[parsePkg])
+
+	getReceiver := getCall.Fun.(*ast.SelectorExpr).X.(*ast.Ident).Name
+	result.Body = fmt.Sprintf(`id, err := %s.%s(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.%s.Get(%s)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %%s: %%+v", *id, err)
+	}`, parsePkg, parseCall.Fun.(*ast.SelectorExpr).Sel.Name, clientVars[getReceiver], strings.Join(args, ", "))
+
+	// the go-azure-sdk returns the resource as the Model of the response, otherwise the resource exists when the Get
+	// succeeds
+	result.ResultCheck = "true"
+	ast.Inspect(read.Body, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok && sel.Sel.Name == "Model" {
+			if ident, ok := sel.X.(*ast.Ident); ok && ident.Name == respVar {
+				result.ResultCheck = "resp.Model != nil"
+			}
+		}
+		return true
+	})
+
+	return result
+}
+
+// clientPath returns the path of a client within `clients.Client`, e.g. `Network.VirtualNetworks` for both
+// `metadata.Client.Network.VirtualNetworks` and `meta.(*clients.Client).Network.VirtualNetworks`. Since some services
+// nest their clients (e.g. `metadata.Client.CodeSigning.Client.CodeSigningAccounts`) only the `Client` of the metadata
+// ends the path.
+func clientPath(expr ast.Expr) string {
+	segments := make([]string, 0)
+	for {
+		switch v := expr.(type) {
+		case *ast.SelectorExpr:
+			if _, ok := v.X.(*ast.Ident); ok && v.Sel.Name == "Client" {
+				if len(segments) == 0 {
+					return ""
+				}
+				return strings.Join(segments, ".")
+			}
+			segments = append([]string{v.Sel.Name}, segments...)
+			expr = v.X
+		case *ast.TypeAssertExpr:
+			if star, ok := v.Type.(*ast.StarExpr); ok {
+				if sel, ok := star.X.(*ast.SelectorExpr); ok && sel.Sel.Name == "Client" && len(segments) > 0 {
+					return strings.Join(segments, ".")
+				}
+			}
+			return ""
+		default:
+			return ""
+		}
+	}
+}
+
+// fileImports returns the import paths of a file keyed by the name they're referenced by.
+func fileImports(f *ast.File) map[string]string {
+	imports := make(map[string]string)
+	if f == nil {
+		return imports
+	}
+	for _, imp := range f.Imports {
+		importPath, err := strconv.Unquote(imp.Path.Value)
+		if err != nil {
+			continue
+		}
+		name := path.Base(importPath)
+		if imp.Name != nil {
+			name = imp.Name.Name
+		}
+		imports[name] = importPath
+	}
+	return imports
+}
+
+// importAlias returns the alias needed to import the path with the given name.
+func importAlias(name string, importPath string) string {
+	if path.Base(importPath) == name {
+		return ""
+	}
+	return name
+}
+
+func exprString(fset *token.FileSet, expr ast.Expr) string {
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, fset, expr); err != nil {
+		return ""
+	}
+	return buf.String()
+}
@@ -0,0 +1,255 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package generators
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/templatehelpers"
+	"github.com/iancoleman/strcase"
+	"github.com/mitchellh/cli"
+)
+
+const (
+	atOutputFileFmt = "%s_resource_test.go"
+	modulePath      = "github.com/hashicorp/terraform-provider-azurerm/"
+)
+
+type AcceptanceTestsCommand struct {
+	Ui cli.Ui
+}
+
+type acceptanceTestsData struct {
+	ResourceName       string
+	ResourceType       string
+	ServicePackageName string
+	ServicePackagePath string
+	TestResourceType   string
+	TestName           string
+	Overwrite          bool
+
+	Exists  existsFunc
+	Configs []acceptanceTestConfig
+
+	// typeName is the name of the type implementing a Typed Resource, which is empty for Untyped Resources
+	typeName string
+	schema   map[string]*pluginsdk.Schema
+}
+
+type acceptanceTestConfig struct {
+	Name string
+	HCL  string
+	Args string
+}
+
+var _ cli.Command = &AcceptanceTestsCommand{}
+
+func (c *AcceptanceTestsCommand) Help() string {
+	return `
+Usage: acceptancetests [args]
+Required args:
+	- resource-name [string]
+		the name of the resource to generate the acceptance tests for, the 'azurerm_' prefix is not required.
+
+Optional args:
+	- test-resource-type [string]
+		'test-resource-type' specifies the name of the test resource type to declare in the test package. Defaults to the resource name in CamelCase suffixed with 'Resource'.
+	- overwrite [bool]
+		'overwrite' replaces an existing test file for the resource. Defaults to 'false'.
+
+The test file is written to the Service Package the resource is registered in, and contains:
+	- the 'basic', 'requiresImport', 'complete' and 'update' tests
+	- an 'Exists' function, based on the ID parser and client used in the resource's Read function
+	- the 'template', 'basic', 'requiresImport' and 'complete' configurations, where 'basic' sets the required
+	  properties and 'complete' sets the optional properties too. Values are chosen to pass the validation of each
+	  property where possible, otherwise they're marked with a TODO.
+
+Example:
+acceptancetests -resource-name some_azure_resource
+
+Caveats and TODOs:
+The generated test is a starting point - the configurations should be reviewed, values marked as TODO replaced with
+ones which are valid for the API, and any dependencies (e.g. a Subnet referenced by ID) added to the 'template'.
+`
+}
+
+func (c *AcceptanceTestsCommand) Synopsis() string {
+	return "Generates the acceptance test file skeleton for a resource"
+}
+
+func (c *AcceptanceTestsCommand) Run(args []string) int {
+	data := &acceptanceTestsData{}
+
+	if err := data.parseArgs(args); err != nil {
+		for _, e := range err {
+			c.Ui.Error(e.Error())
+		}
+
+		return 1
+	}
+
+	outputPath, err := data.exec()
+	if err != nil {
+		c.Ui.Error(err.Error())
+		return 2
+	}
+
+	c.Ui.Info(fmt.Sprintf("Generated %s", outputPath))
+	return 0
+}
+
+func (d *acceptanceTestsData) parseArgs(args []string) (errors []error) {
+	argSet := flag.NewFlagSet("at", flag.ExitOnError)
+
+	argSet.StringVar(&d.ResourceName, "resource-name", "", "(Required) the name of the resource to generate the acceptance tests for.")
+	argSet.StringVar(&d.TestResourceType, "test-resource-type", "", "(Optional) the name of the test resource type to declare in the test package. Defaults to the resource name in CamelCase suffixed with `Resource`.")
+	argSet.BoolVar(&d.Overwrite, "overwrite", false, "(Optional) whether to replace an existing test file for the resource. Defaults to `false`.")
+
+	if err := argSet.Parse(args); err != nil {
+		errors = append(errors, err)
+		return
+	}
+
+	if d.ResourceName == "" {
+		errors = append(errors, fmt.Errorf("`resource-name` is required"))
+		return
+	}
+
+	// remove accidental provider prefix
+	d.ResourceName = strings.TrimPrefix(d.ResourceName, "azurerm_")
+	d.ResourceType = "azurerm_" + d.ResourceName
+	d.TestName = strcase.ToCamel(d.ResourceName)
+	if d.TestResourceType == "" {
+		d.TestResourceType = d.TestName + "Resource"
+	}
+
+	return
+}
+
+// findResource finds the Service Package the resource is registered in, along with its schema.
+func (d *acceptanceTestsData) findResource() error {
+	resource, ok := provider.AzureProvider().ResourcesMap[d.ResourceType]
+	if !ok {
+		return fmt.Errorf("%q isn't a registered resource", d.ResourceType)
+	}
+	d.schema = resource.Schema
+
+	var registration interface{}
+	for _, service := range provider.SupportedTypedServices() {
+		for _, r := range service.Resources() {
+			if r.ResourceType() == d.ResourceType {
+				registration = service
+				d.typeName = reflect.Indirect(reflect.ValueOf(r)).Type().Name()
+			}
+		}
+	}
+	for _, service := range provider.SupportedUntypedServices() {
+		if _, ok := service.SupportedResources()[d.ResourceType]; ok {
+			registration = service
+		}
+	}
+	if registration == nil {
+		return fmt.Errorf("the service registration for %q wasn't found", d.ResourceType)
+	}
+
+	pkgPath := reflect.Indirect(reflect.ValueOf(registration)).Type().PkgPath()
+	d.ServicePackagePath = strings.TrimPrefix(pkgPath, modulePath)
+	d.ServicePackageName = filepath.Base(d.ServicePackagePath)
+	return nil
+}
+
+func (d *acceptanceTestsData) exec() (string, error) {
+	if err := d.findResource(); err != nil {
+		return "", err
+	}
+
+	root, err := repositoryRoot()
+	if err != nil {
+		return "", err
+	}
+	dir := filepath.Join(root, filepath.FromSlash(d.ServicePackagePath))
+	outputPath := filepath.Join(dir, fmt.Sprintf(atOutputFileFmt, d.ResourceName))
+	if _, err := os.Stat(outputPath); err == nil && !d.Overwrite {
+		return "", fmt.Errorf("%s already exists, use `-overwrite` to replace it", outputPath)
+	}
+
+	fset, files, err := parseServicePackage(dir)
+	if err != nil {
+		return "", err
+	}
+	d.Exists = buildExistsFunc(fset, files, findReadFunc(files, d.ResourceType, d.typeName))
+	d.Configs = d.configs()
+
+	tpl := template.Must(template.New("acceptance_test.gotpl").Funcs(templatehelpers.TplFuncMap).ParseFS(Templatedir, "templates/acceptance_test.gotpl"))
+
+	var buf bytes.Buffer
+	if err := tpl.Execute(&buf, d); err != nil {
+		return "", fmt.Errorf("failed rendering test file (%s): %+v", outputPath, err)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return "", fmt.Errorf("failed formatting test file (%s): %+v", outputPath, err)
+	}
+
+	if err := os.WriteFile(outputPath, src, 0o644); err != nil {
+		return "", fmt.Errorf("failed writing test file (%s): %+v", outputPath, err)
+	}
+
+	return outputPath, nil
+}
+
+func (d *acceptanceTestsData) configs() []acceptanceTestConfig {
+	basic := newAcceptanceConfig(d.ResourceType, "r.template(data)", false)
+	complete := newAcceptanceConfig(d.ResourceType, "r.template(data)", true)
+	requiresImport := newAcceptanceConfig(d.ResourceType, "r.basic(data)", false)
+
+	return []acceptanceTestConfig{
+		{
+			Name: "basic",
+			HCL:  basic.render(d.schema),
+			Args: strings.Join(basic.args, ", "),
+		},
+		{
+			Name: "requiresImport",
+			HCL:  requiresImport.requiresImport(d.schema),
+			Args: strings.Join(requiresImport.args, ", "),
+		},
+		{
+			Name: "complete",
+			HCL:  complete.render(d.schema),
+			Args: strings.Join(complete.args, ", "),
+		},
+	}
+}
+
+// repositoryRoot returns the root of the repository, which contains the `go.mod` and is a parent of the current
+// working directory.
+func repositoryRoot() (string, error) {
+	dir, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+
+	for {
+		if _, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil {
+			return dir, nil
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", fmt.Errorf("the root of the repository wasn't found, the generator should be run from within it")
+		}
+		dir = parent
+	}
+}
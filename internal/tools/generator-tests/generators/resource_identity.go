@@ -37,6 +37,7 @@ type resourceIdentityData struct {
 	CompareValueMap        map[string]string
 	NoSubscriptionID       bool
 	TestName               string
+	TestResourceType       string
 	TestExpectNonEmptyPlan bool
 	TestSequential         bool
 }
@@ -67,6 +68,8 @@ Optional args:
 		- 'no-subscription-id' can be passed to omit the default subscription ID known value in the test if the resource's ID doesn't contain subscription ID. Defaults to 'false'.
 	- test-name [string]
 		'test-name' specifies the test config name that will be used to test Resource Identity. Defaults to 'basic'.
+	- test-resource-type [string]
+		'test-resource-type' specifies the name of the test resource type declared in the test package, for use when this doesn't match the resource name. e.g. '-test-resource-type CosmosDBAccountResource'. Defaults to the resource name in CamelCase suffixed with 'Resource'.
 	- test-expect-non-empty [bool]
 		'test-expect-non-empty' indicates whether the test should expect (and ignore) a non-empty plan, to be used when the API does not return certain values during import.
 	- test-sequential [bool]
@@ -77,7 +80,7 @@ Example:
 generate-resource-identity -resource-name some_azure_resource -properties "resource_group_name,some_property" -test-params "customSku" -known-values "kind:someApp;linux" -compare-values "parent_resource_name:parent_resource_id,resource_group_name:parent_resource_id"
 
 Caveats and TODOs:
-Expects that the test resource type is already declared in the test package for the service. (e.g. type LinuxFunctionAppResource struct{})
+Expects that the test resource type is already declared in the test package for the service. (e.g. type LinuxFunctionAppResource struct{}), if this is named differently it can be specified using 'test-resource-type'.
 
 CAUTION: Do not implement Resource Identity for resources with numbers in their ID segment names (e.g., 'ServerGroupsv2Name')
 until the strcase.ToSnake() issue is resolved. The current implementation splits on number boundaries,
@@ -122,6 +125,7 @@ func (d *resourceIdentityData) parseArgs(args []string) (errors []error) {
 	argSet.StringVar(&d.CompareValues, "compare-values", "", "(Optional) comma separated list of resource identity names that are contained within a schema property value, formatted as [attribute_name]:[attribute value]. e.g. `parent_name:parent_resource_id;resource_group_name,parent_resource_id`")
 	argSet.BoolVar(&d.NoSubscriptionID, "no-subscription-id", false, "(Optional) 'no-subscription-id' can be passed to omit the default subscription ID known value in the test if the resource's ID doesn't contain subscription ID. Defaults to 'false'.")
 	argSet.StringVar(&d.TestName, "test-name", "basic", "(Optional) the name of the config that will be used to test Resource Identity. Defaults to `basic`.")
+	argSet.StringVar(&d.TestResourceType, "test-resource-type", "", "(Optional) the name of the test resource type declared in the test package. Defaults to the resource name in CamelCase suffixed with `Resource`.")
 	argSet.BoolVar(&d.TestExpectNonEmptyPlan, "test-expect-non-empty", false, "(Optional) Whether to expect (and ignore) a non-empty plan, to be used when the API does not return certain values during import. Defaults to `false`.")
 	argSet.BoolVar(&d.TestSequential, "test-sequential", false, "(Optional) generates a lowercase test function name for use in sequential test suites. Defaults to `false`.")
 
@@ -29,6 +29,11 @@ func realMain(args []string) int {
 	}
 
 	commands := map[string]cli.CommandFactory{
+		"acceptancetests": func() (cli.Command, error) {
+			return &generators.AcceptanceTestsCommand{
+				Ui: ui,
+			}, nil
+		},
 		"resourceidentity": func() (cli.Command, error) {
 			return &generators.ResourceIdentityCommand{
 				Ui: ui,
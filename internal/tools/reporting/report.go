@@ -0,0 +1,129 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package reporting
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Format is the output format of a Report.
+type Format string
+
+const (
+	// FormatText is the default output of each tool, which isn't written by a Report.
+	FormatText     Format = "text"
+	FormatJSON     Format = "json"
+	FormatMarkdown Format = "markdown"
+	FormatSARIF    Format = "sarif"
+)
+
+// PossibleFormats are the values which can be passed to the `-format` flag of a tool.
+var PossibleFormats = []Format{FormatText, FormatJSON, FormatSARIF, FormatMarkdown}
+
+// ParseFormat returns the Format matching input, which is case-insensitive.
+func ParseFormat(input string) (Format, error) {
+	for _, f := range PossibleFormats {
+		if strings.EqualFold(string(f), input) {
+			return f, nil
+		}
+	}
+
+	possible := make([]string, 0, len(PossibleFormats))
+	for _, f := range PossibleFormats {
+		possible = append(possible, string(f))
+	}
+	return "", fmt.Errorf("unsupported format %q, expected one of %s", input, strings.Join(possible, ", "))
+}
+
+// Rule describes a check made by a tool.
+type Rule struct {
+	ID          string `json:"id"`
+	Description string `json:"description,omitempty"`
+}
+
+// Finding is an issue found by a Rule.
+type Finding struct {
+	RuleID  string `json:"ruleId"`
+	Message string `json:"message"`
+
+	// Path is the logical location of the issue, e.g. `azurerm_resource_group.tags`
+	Path string `json:"path,omitempty"`
+
+	// File is the path to the file containing the issue, relative to the root of the repository, and Line is the
+	// 1-based line within it. Both are omitted where the issue can't be attributed to a file.
+	File string `json:"file,omitempty"`
+	Line int    `json:"line,omitempty"`
+}
+
+// Report is the result of running a tool, which can be written in each of the machine-readable Formats.
+type Report struct {
+	Tool     string    `json:"tool"`
+	Rules    []Rule    `json:"rules,omitempty"`
+	Findings []Finding `json:"findings"`
+}
+
+// Write writes the Report to w in the given format.
+func (r Report) Write(w io.Writer, format Format) error {
+	if r.Findings == nil {
+		r.Findings = make([]Finding, 0)
+	}
+
+	switch format {
+	case FormatJSON:
+		return writeJSON(w, r)
+	case FormatMarkdown:
+		return r.writeMarkdown(w)
+	case FormatSARIF:
+		return writeJSON(w, r.sarif())
+	}
+
+	return fmt.Errorf("the %q format isn't supported by a Report", format)
+}
+
+func (r Report) writeMarkdown(w io.Writer) error {
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("## %s\n\n", r.Tool))
+	if len(r.Findings) == 0 {
+		sb.WriteString("No issues found.\n")
+		_, err := io.WriteString(w, sb.String())
+		return err
+	}
+
+	sb.WriteString(fmt.Sprintf("%d issue(s) found:\n\n", len(r.Findings)))
+	sb.WriteString("| Rule | Location | Message |\n")
+	sb.WriteString("| --- | --- | --- |\n")
+	for _, f := range r.Findings {
+		locations := make([]string, 0)
+		if f.Path != "" {
+			locations = append(locations, fmt.Sprintf("`%s`", f.Path))
+		}
+		if f.File != "" {
+			location := f.File
+			if f.Line > 0 {
+				location = fmt.Sprintf("%s:%d", f.File, f.Line)
+			}
+			locations = append(locations, location)
+		}
+
+		sb.WriteString(fmt.Sprintf("| `%s` | %s | %s |\n", f.RuleID, escapeMarkdownCell(strings.Join(locations, "<br>")), escapeMarkdownCell(f.Message)))
+	}
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+func escapeMarkdownCell(input string) string {
+	input = strings.ReplaceAll(input, "|", `\|`)
+	return strings.ReplaceAll(input, "\n", "<br>")
+}
+
+func writeJSON(w io.Writer, input interface{}) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(input)
+}
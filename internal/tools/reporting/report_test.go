@@ -0,0 +1,143 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package reporting
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+var testReport = Report{
+	Tool: "example",
+	Rules: []Rule{
+		{
+			ID:          "property-removed",
+			Description: "Properties can't be removed",
+		},
+	},
+	Findings: []Finding{
+		{
+			RuleID:  "property-removed",
+			Message: `property "tags" has been removed`,
+			Path:    "azurerm_resource_group.tags",
+		},
+		{
+			RuleID:  "possible-values",
+			Message: "the values [A | B] are missing",
+			Path:    "azurerm_resource_group.sku",
+			File:    "website/docs/r/resource_group.html.markdown",
+			Line:    12,
+		},
+	},
+}
+
+func TestParseFormat(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected Format
+		error    bool
+	}{
+		{
+			input:    "text",
+			expected: FormatText,
+		},
+		{
+			input:    "SARIF",
+			expected: FormatSARIF,
+		},
+		{
+			input: "xml",
+			error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.input)
+
+		actual, err := ParseFormat(v.input)
+		if v.error {
+			if err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if actual != v.expected {
+			t.Fatalf("expected %q but got %q", v.expected, actual)
+		}
+	}
+}
+
+func TestReport_JSON(t *testing.T) {
+	var buf bytes.Buffer
+	if err := testReport.Write(&buf, FormatJSON); err != nil {
+		t.Fatalf("writing report: %+v", err)
+	}
+
+	var actual Report
+	if err := json.Unmarshal(buf.Bytes(), &actual); err != nil {
+		t.Fatalf("unmarshaling report: %+v", err)
+	}
+	if len(actual.Findings) != 2 || actual.Findings[1].Line != 12 || actual.Findings[1].RuleID != "possible-values" {
+		t.Fatalf("unexpected findings: %+v", actual.Findings)
+	}
+
+	buf.Reset()
+	if err := (Report{Tool: "example"}).Write(&buf, FormatJSON); err != nil {
+		t.Fatalf("writing report: %+v", err)
+	}
+	if !strings.Contains(buf.String(), `"findings": []`) {
+		t.Fatalf("expected an empty list of findings but got %s", buf.String())
+	}
+}
+
+func TestReport_SARIF(t *testing.T) {
+	var buf bytes.Buffer
+	if err := testReport.Write(&buf, FormatSARIF); err != nil {
+		t.Fatalf("writing report: %+v", err)
+	}
+
+	var actual sarifLog
+	if err := json.Unmarshal(buf.Bytes(), &actual); err != nil {
+		t.Fatalf("unmarshaling report: %+v", err)
+	}
+	if actual.Version != sarifVersion || len(actual.Runs) != 1 {
+		t.Fatalf("unexpected SARIF log: %s", buf.String())
+	}
+
+	run := actual.Runs[0]
+	if len(run.Tool.Driver.Rules) != 2 || run.Tool.Driver.Rules[1].ID != "possible-values" {
+		t.Fatalf("expected rules to be added for each finding but got %+v", run.Tool.Driver.Rules)
+	}
+	if len(run.Results) != 2 {
+		t.Fatalf("expected 2 results but got %d", len(run.Results))
+	}
+
+	if loc := run.Results[0].Locations[0]; loc.PhysicalLocation != nil || loc.LogicalLocations[0].FullyQualifiedName != "azurerm_resource_group.tags" {
+		t.Fatalf("expected only a logical location but got %+v", loc)
+	}
+	if loc := run.Results[1]; loc.RuleIndex != 1 || loc.Locations[0].PhysicalLocation.Region.StartLine != 12 {
+		t.Fatalf("unexpected result: %+v", loc)
+	}
+}
+
+func TestReport_Markdown(t *testing.T) {
+	var buf bytes.Buffer
+	if err := testReport.Write(&buf, FormatMarkdown); err != nil {
+		t.Fatalf("writing report: %+v", err)
+	}
+
+	expected := "| `possible-values` | `azurerm_resource_group.sku`<br>website/docs/r/resource_group.html.markdown:12 | the values [A \\| B] are missing |"
+	if !strings.Contains(buf.String(), expected) {
+		t.Fatalf("expected the output to contain %q but got:\n%s", expected, buf.String())
+	}
+
+	if err := testReport.Write(&buf, FormatText); err == nil {
+		t.Fatalf("expected an error writing the text format but didn't get one")
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package reporting
+
+// the subset of the SARIF 2.1.0 format (https://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html) needed to
+// report findings to code scanning dashboards
+
+const (
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifVersion = "2.1.0"
+)
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name  string      `json:"name"`
+	Rules []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string        `json:"id"`
+	ShortDescription *sarifMessage `json:"shortDescription,omitempty"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	RuleIndex int             `json:"ruleIndex"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations,omitempty"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation *sarifPhysicalLocation `json:"physicalLocation,omitempty"`
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations,omitempty"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine int `json:"startLine"`
+}
+
+type sarifLogicalLocation struct {
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+}
+
+func (r Report) sarif() sarifLog {
+	driver := sarifDriver{
+		Name:  r.Tool,
+		Rules: make([]sarifRule, 0),
+	}
+	ruleIndexes := make(map[string]int)
+	addRule := func(rule Rule) int {
+		if index, ok := ruleIndexes[rule.ID]; ok {
+			return index
+		}
+
+		sr := sarifRule{
+			ID: rule.ID,
+		}
+		if rule.Description != "" {
+			sr.ShortDescription = &sarifMessage{
+				Text: rule.Description,
+			}
+		}
+		driver.Rules = append(driver.Rules, sr)
+		ruleIndexes[rule.ID] = len(driver.Rules) - 1
+		return len(driver.Rules) - 1
+	}
+
+	for _, rule := range r.Rules {
+		addRule(rule)
+	}
+
+	results := make([]sarifResult, 0, len(r.Findings))
+	for _, f := range r.Findings {
+		result := sarifResult{
+			RuleID:    f.RuleID,
+			RuleIndex: addRule(Rule{ID: f.RuleID}),
+			Level:     "error",
+			Message: sarifMessage{
+				Text: f.Message,
+			},
+		}
+
+		location := sarifLocation{}
+		if f.File != "" {
+			location.PhysicalLocation = &sarifPhysicalLocation{
+				ArtifactLocation: sarifArtifactLocation{
+					URI: f.File,
+				},
+			}
+			if f.Line > 0 {
+				location.PhysicalLocation.Region = &sarifRegion{
+					StartLine: f.Line,
+				}
+			}
+		}
+		if f.Path != "" {
+			location.LogicalLocations = []sarifLogicalLocation{
+				{
+					FullyQualifiedName: f.Path,
+				},
+			}
+		}
+		if location.PhysicalLocation != nil || location.LogicalLocations != nil {
+			result.Locations = []sarifLocation{location}
+		}
+
+		results = append(results, result)
+	}
+
+	return sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs: []sarifRun{
+			{
+				Tool: sarifTool{
+					Driver: driver,
+				},
+				Results: results,
+			},
+		},
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package commands
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/templatehelpers"
+	"github.com/iancoleman/strcase"
+	"github.com/mitchellh/cli"
+)
+
+type ActionCommand struct {
+	Ui cli.Ui
+}
+
+var _ cli.Command = &ActionCommand{}
+
+type actionData struct {
+	Name               string
+	ServicePackageName string
+	BrandName          string
+	RPName             string
+	ClientName         string
+	APIVersion         string
+	IdType             string
+	IdTypeParts        []string
+	SDKName            string
+	IdArgument         string
+	NoDocs             bool
+}
+
+var outputActionFileFmt = relativePathToRoot() + "internal/services/%s/%s_action.go"
+
+var outputActionTestFileFmt = relativePathToRoot() + "internal/services/%s/%s_action_test.go"
+
+func (c ActionCommand) Run(args []string) int {
+	data := &actionData{}
+
+	if err := data.parseArgs(args); err != nil {
+		for _, e := range err {
+			c.Ui.Error(e.Error())
+		}
+
+		return 1
+	}
+
+	if err := data.exec(); err != nil {
+		c.Ui.Error(err.Error())
+
+		log.Println(err)
+		return 2
+	}
+
+	if !data.NoDocs {
+		if err := scaffoldDocumentation(data.Name, data.BrandName, "action", ""); err != nil {
+			c.Ui.Warn(err.Error())
+		}
+	}
+
+	return 0
+}
+
+func (c ActionCommand) Synopsis() string {
+	return "create boilerplate for an AzureRM Framework Action to speed development"
+}
+
+func (c ActionCommand) Help() string {
+	return `
+Usage: scaff action -name "some_action_name" -service_package_name="someservice" -rp_name="compute" -client_name="SomeClient" -api_version="2024-11-01" -id_type="virtualmachines.VirtualMachineId" [-sdk_name="virtualmachines"] [-brand_name="Virtual Machine Restart"] [-no_docs=true]
+
+Parameters:
+	-name (Required) the name of the action to scaffold, without the 'azurerm_' prefix.
+	-service_package_name (Required) the name of the service package to scaffold the action into.
+	-rp_name (Required) the name of the resource provider of the resource the action is performed on.
+	-client_name (Required) the name of the client used to perform the action.
+	-api_version (Required) the API version of the SDK used to perform the action. e.g. 2025-01-01
+	-id_type (Required) the type of the ID of the resource the action is performed on. e.g. 'virtualmachines.VirtualMachineId'.
+
+	-sdk_name (Optional) the name of the SDK used to perform the action. If omitted, the first slug of the id_type value will be used.
+	-brand_name (Optional) the name of the action used in the documentation. If omitted, this is derived from the name.
+	-no_docs (Optional) skip scaffolding the documentation using the 'website-scaffold' tool.
+
+The action is registered in the 'Actions' method of the Service Registration, and a test and documentation stub are scaffolded alongside it.
+
+Example:
+go run internal/tools/scaff/main.go action -name="virtual_machine_restart" -service_package_name="Compute" -rp_name="compute" -client_name="VirtualMachinesClient" -api_version="2024-11-01" -id_type="virtualmachines.VirtualMachineId"
+`
+}
+
+func (d *actionData) parseArgs(args []string) (errs []error) {
+	argSet := flag.NewFlagSet("action", flag.ExitOnError)
+
+	argSet.StringVar(&d.Name, "name", "", "(Required) the name of the action to scaffold, without the `azurerm_` prefix.")
+	argSet.StringVar(&d.ServicePackageName, "service_package_name", "", "(Required) the name of the service package to scaffold the action into.")
+	argSet.StringVar(&d.RPName, "rp_name", "", "(Required) the name of the resource provider of the resource the action is performed on.")
+	argSet.StringVar(&d.ClientName, "client_name", "", "(Required) the name of the client used to perform the action.")
+	argSet.StringVar(&d.APIVersion, "api_version", "", "(Required) the API version of the SDK used to perform the action. e.g. 2025-01-01")
+	argSet.StringVar(&d.IdType, "id_type", "", "(Required) the type of the ID of the resource the action is performed on. e.g. `virtualmachines.VirtualMachineId`.")
+	argSet.StringVar(&d.SDKName, "sdk_name", "", "(Optional) the name of the SDK used to perform the action. If omitted, the first slug of the id_type value will be used.")
+	argSet.StringVar(&d.BrandName, "brand_name", "", "(Optional) the name of the action used in the documentation. If omitted, this is derived from the name.")
+	argSet.BoolVar(&d.NoDocs, "no_docs", false, "(Optional) skip scaffolding the documentation using the `website-scaffold` tool.")
+	if err := argSet.Parse(args); err != nil {
+		errs = append(errs, err)
+		return
+	}
+
+	switch {
+	case d.Name == "":
+		errs = append(errs, errors.New("action name is required"))
+	case d.ServicePackageName == "":
+		errs = append(errs, errors.New("service package name is required"))
+	case d.RPName == "":
+		errs = append(errs, errors.New("resource provider name is required"))
+	case d.ClientName == "":
+		errs = append(errs, errors.New("client name is required"))
+	case d.APIVersion == "":
+		errs = append(errs, errors.New("api version is required"))
+	case d.IdType == "":
+		errs = append(errs, errors.New("id_type is required"))
+	}
+
+	d.Name = strings.TrimPrefix(d.Name, "azurerm_")
+
+	d.IdTypeParts = strings.Split(d.IdType, ".")
+	if l := len(d.IdTypeParts); l != 2 {
+		errs = append(errs, fmt.Errorf("id_type has incorrect number of segments, expected 2 got %d", l))
+	}
+	if d.SDKName == "" {
+		d.SDKName = d.IdTypeParts[0]
+	}
+	if d.BrandName == "" {
+		d.BrandName = templatehelpers.ToDelimTitle(d.Name)
+	}
+	d.IdArgument = idArgument(d.IdTypeParts)
+
+	return errs
+}
+
+func (d *actionData) exec() error {
+	if err := writeTemplate("action.gotpl", fmt.Sprintf(outputActionFileFmt, strings.ToLower(d.ServicePackageName), d.Name), d); err != nil {
+		return err
+	}
+
+	if err := writeTemplate("action_test.gotpl", fmt.Sprintf(outputActionTestFileFmt, strings.ToLower(d.ServicePackageName), d.Name), d); err != nil {
+		return err
+	}
+
+	return register(d.ServicePackageName, "Actions", fmt.Sprintf("new%sAction", strcase.ToCamel(d.Name)))
+}
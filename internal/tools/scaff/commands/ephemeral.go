@@ -0,0 +1,202 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package commands
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/templatehelpers"
+	"github.com/iancoleman/strcase"
+	"github.com/mitchellh/cli"
+)
+
+type EphemeralCommand struct {
+	Ui cli.Ui
+}
+
+var _ cli.Command = &EphemeralCommand{}
+
+type ephemeralData struct {
+	Name               string
+	ServicePackageName string
+	BrandName          string
+	RPName             string
+	ClientName         string
+	APIVersion         string
+	IdType             string
+	IdTypeParts        []string
+	SDKName            string
+	IdSegments         string
+	UseReadOptions     bool
+	NoDocs             bool
+
+	// Arguments are the (Required) arguments used to build the ID of the resource being retrieved
+	Arguments []ephemeralArgument
+
+	// IDArgs are the arguments for the ID constructor, e.g. `e.SubscriptionId, data.ResourceGroupName.ValueString()`
+	IDArgs string
+}
+
+type ephemeralArgument struct {
+	Field     string
+	Attribute string
+}
+
+var outputEphemeralFileFmt = relativePathToRoot() + "internal/services/%s/%s_ephemeral.go"
+
+var outputEphemeralTestFileFmt = relativePathToRoot() + "internal/services/%s/%s_ephemeral_test.go"
+
+func (c EphemeralCommand) Run(args []string) int {
+	data := &ephemeralData{}
+
+	if err := data.parseArgs(args); err != nil {
+		for _, e := range err {
+			c.Ui.Error(e.Error())
+		}
+
+		return 1
+	}
+
+	if err := data.exec(); err != nil {
+		c.Ui.Error(err.Error())
+
+		log.Println(err)
+		return 2
+	}
+
+	if !data.NoDocs {
+		if err := scaffoldDocumentation(data.Name, data.BrandName, "ephemeral", ""); err != nil {
+			c.Ui.Warn(err.Error())
+		}
+	}
+
+	return 0
+}
+
+func (c EphemeralCommand) Synopsis() string {
+	return "create boilerplate for an AzureRM Ephemeral Resource to speed development"
+}
+
+func (c EphemeralCommand) Help() string {
+	return `
+Usage: scaff ephemeral -name "some_resource_name" -service_package_name="someservice" -rp_name="sql" -client_name="SomeClient" -api_version="2023-08-01-preview" -id_type="commonids.SqlDatabaseId" -id_segments="SubscriptionId,ResourceGroupName,ServerName,DatabaseName" [-sdk_name="databases"] [-use_read_options=true] [-brand_name="SQL Database"] [-no_docs=true]
+
+Parameters:
+	-name (Required) the name of the ephemeral resource to scaffold, without the 'azurerm_' prefix.
+	-service_package_name (Required) the name of the service package to scaffold the ephemeral resource into.
+	-rp_name (Required) the name of the resource provider of the resource being retrieved.
+	-client_name (Required) the name of the client used to retrieve the resource.
+	-api_version (Required) the API version of the SDK used to retrieve the resource. e.g. 2025-01-01
+	-id_type (Required) the type of the ID of the resource being retrieved. e.g. 'commonids.AppServiceId', or 'virtualmachines.VirtualMachineId'.
+	-id_segments (Required) The User-Specified Segment names for the ID, Order matters. Each segment (other than 'SubscriptionId') becomes a Required argument, with the last segment being 'name'.
+
+	-sdk_name (Optional) the name of the SDK used to retrieve the resource. If omitted, the first slug of the id_type value will be used.
+	-use_read_options (Optional) the resource is retrieved using OperationOptions.
+	-brand_name (Optional) the name of the resource used in the documentation. If omitted, this is derived from the name.
+	-no_docs (Optional) skip scaffolding the documentation using the 'website-scaffold' tool.
+
+The ephemeral resource is registered in the 'EphemeralResources' method of the Service Registration, and a test and documentation stub are scaffolded alongside it.
+
+Example:
+go run internal/tools/scaff/main.go ephemeral -name="mssql_database" -service_package_name="MSSQL" -rp_name="sql" -client_name="DatabasesClient" -api_version="2023-08-01-preview" -id_type="commonids.SqlDatabaseId" -sdk_name="databases" -id_segments="SubscriptionId,ResourceGroupName,ServerName,DatabaseName" -use_read_options=true
+`
+}
+
+func (d *ephemeralData) parseArgs(args []string) (errs []error) {
+	argSet := flag.NewFlagSet("ephemeral", flag.ExitOnError)
+
+	argSet.StringVar(&d.Name, "name", "", "(Required) the name of the ephemeral resource to scaffold, without the `azurerm_` prefix.")
+	argSet.StringVar(&d.ServicePackageName, "service_package_name", "", "(Required) the name of the service package to scaffold the ephemeral resource into.")
+	argSet.StringVar(&d.RPName, "rp_name", "", "(Required) the name of the resource provider of the resource being retrieved.")
+	argSet.StringVar(&d.ClientName, "client_name", "", "(Required) the name of the client used to retrieve the resource.")
+	argSet.StringVar(&d.APIVersion, "api_version", "", "(Required) the API version of the SDK used to retrieve the resource. e.g. 2025-01-01")
+	argSet.StringVar(&d.IdType, "id_type", "", "(Required) the type of the ID of the resource being retrieved. e.g. `commonids.AppServiceId`, or `virtualmachines.VirtualMachineId`.")
+	argSet.StringVar(&d.IdSegments, "id_segments", "", "(Required) The User-Specified Segment names for the ID, Order matters.")
+	argSet.StringVar(&d.SDKName, "sdk_name", "", "(Optional) the name of the SDK used to retrieve the resource. If omitted, the first slug of the id_type value will be used.")
+	argSet.BoolVar(&d.UseReadOptions, "use_read_options", false, "(Optional) the resource is retrieved using OperationOptions.")
+	argSet.StringVar(&d.BrandName, "brand_name", "", "(Optional) the name of the resource used in the documentation. If omitted, this is derived from the name.")
+	argSet.BoolVar(&d.NoDocs, "no_docs", false, "(Optional) skip scaffolding the documentation using the `website-scaffold` tool.")
+	if err := argSet.Parse(args); err != nil {
+		errs = append(errs, err)
+		return
+	}
+
+	switch {
+	case d.Name == "":
+		errs = append(errs, errors.New("ephemeral resource name is required"))
+	case d.ServicePackageName == "":
+		errs = append(errs, errors.New("service package name is required"))
+	case d.RPName == "":
+		errs = append(errs, errors.New("resource provider name is required"))
+	case d.ClientName == "":
+		errs = append(errs, errors.New("client name is required"))
+	case d.APIVersion == "":
+		errs = append(errs, errors.New("api version is required"))
+	case d.IdType == "":
+		errs = append(errs, errors.New("id_type is required"))
+	case d.IdSegments == "":
+		errs = append(errs, errors.New("id_segments is required"))
+	}
+
+	d.Name = strings.TrimPrefix(d.Name, "azurerm_")
+
+	d.IdTypeParts = strings.Split(d.IdType, ".")
+	if l := len(d.IdTypeParts); l != 2 {
+		errs = append(errs, fmt.Errorf("id_type has incorrect number of segments, expected 2 got %d", l))
+	}
+	if d.SDKName == "" {
+		d.SDKName = d.IdTypeParts[0]
+	}
+	if d.BrandName == "" {
+		d.BrandName = templatehelpers.ToDelimTitle(d.Name)
+	}
+
+	d.Arguments, d.IDArgs = ephemeralArguments(strings.Split(d.IdSegments, ","))
+
+	return errs
+}
+
+// ephemeralArguments returns the arguments for the segments of the ID, and the arguments for its constructor - the
+// Subscription ID is taken from the Provider, and the last segment is the `name` of the resource.
+func ephemeralArguments(segments []string) ([]ephemeralArgument, string) {
+	arguments := make([]ephemeralArgument, 0)
+	idArgs := make([]string, 0)
+	for i, segment := range segments {
+		segment = strings.TrimSpace(segment)
+
+		var argument ephemeralArgument
+		switch {
+		case segment == "SubscriptionId":
+			idArgs = append(idArgs, "e.SubscriptionId")
+			continue
+		case i == len(segments)-1:
+			argument = ephemeralArgument{Field: "Name", Attribute: "name"}
+		case segment == "ResourceGroup" || segment == "ResourceGroupName":
+			argument = ephemeralArgument{Field: "ResourceGroupName", Attribute: "resource_group_name"}
+		default:
+			argument = ephemeralArgument{Field: strcase.ToCamel(segment), Attribute: strcase.ToSnake(segment)}
+		}
+
+		arguments = append(arguments, argument)
+		idArgs = append(idArgs, fmt.Sprintf("data.%s.ValueString()", argument.Field))
+	}
+
+	return arguments, strings.Join(idArgs, ", ")
+}
+
+func (d *ephemeralData) exec() error {
+	if err := writeTemplate("ephemeral.gotpl", fmt.Sprintf(outputEphemeralFileFmt, strings.ToLower(d.ServicePackageName), d.Name), d); err != nil {
+		return err
+	}
+
+	if err := writeTemplate("ephemeral_test.gotpl", fmt.Sprintf(outputEphemeralTestFileFmt, strings.ToLower(d.ServicePackageName), d.Name), d); err != nil {
+		return err
+	}
+
+	return register(d.ServicePackageName, "EphemeralResources", fmt.Sprintf("New%sEphemeralResource", strcase.ToCamel(d.Name)))
+}
@@ -0,0 +1,156 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package commands
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/templatehelpers"
+	"github.com/iancoleman/strcase"
+)
+
+var outputRegistrationFileFmt = relativePathToRoot() + "internal/services/%s/registration.go"
+
+// writeTemplate renders the named template from `templates/` into outputPath, and then runs `goimports` on it.
+func writeTemplate(templateName string, outputPath string, data any) error {
+	tpl := template.Must(template.New(templateName).Funcs(templatehelpers.TplFuncMap).ParseFS(Templatedir, "templates/"+templateName))
+
+	var buf bytes.Buffer
+	if err := tpl.Execute(&buf, data); err != nil {
+		return fmt.Errorf("failed rendering output file (%s): %s", outputPath, err.Error())
+	}
+
+	if err := os.WriteFile(outputPath, buf.Bytes(), 0o644); err != nil {
+		return fmt.Errorf("failed writing output file (%s): %s", outputPath, err.Error())
+	}
+
+	return templatehelpers.GoImports(outputPath)
+}
+
+// register adds entry (e.g. `ExampleListResource{}`) to the slice returned by the method of the Service's Registration,
+// e.g. `ListResources`. The Registration must already implement `sdk.FrameworkServiceRegistration`.
+func register(servicePackageName string, method string, entry string) error {
+	registrationPath := fmt.Sprintf(outputRegistrationFileFmt, strings.ToLower(servicePackageName))
+
+	src, err := os.ReadFile(registrationPath)
+	if err != nil {
+		return fmt.Errorf("reading %s: %+v", registrationPath, err)
+	}
+
+	out, err := registerEntry(src, method, entry)
+	if err != nil {
+		return fmt.Errorf("registering %s in %s: %+v", entry, registrationPath, err)
+	}
+
+	if err := os.WriteFile(registrationPath, out, 0o644); err != nil {
+		return fmt.Errorf("writing %s: %+v", registrationPath, err)
+	}
+
+	return nil
+}
+
+// registerEntry returns the source of a `registration.go` file with entry added to the slice literal returned by
+// method, the source is returned unchanged when the entry is already registered.
+func registerEntry(src []byte, method string, entry string) ([]byte, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "registration.go", src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	var literal *ast.CompositeLit
+	for _, decl := range f.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv == nil || fn.Name.Name != method || fn.Body == nil {
+			continue
+		}
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			ret, ok := n.(*ast.ReturnStmt)
+			if !ok || len(ret.Results) != 1 {
+				return true
+			}
+			if lit, ok := ret.Results[0].(*ast.CompositeLit); ok {
+				literal = lit
+			}
+			return false
+		})
+	}
+	if literal == nil {
+		return nil, fmt.Errorf("the Registration doesn't implement `%s` returning a slice literal, the Service must implement `sdk.FrameworkServiceRegistration` before it can be registered", method)
+	}
+
+	for _, elt := range literal.Elts {
+		if string(src[fset.Position(elt.Pos()).Offset:fset.Position(elt.End()).Offset]) == entry {
+			return src, nil
+		}
+	}
+
+	// the entry is added as the last element, with the indentation fixed up by formatting the file afterwards
+	insert := entry + ",\n"
+	if len(literal.Elts) == 0 {
+		insert = "\n" + insert
+	}
+	offset := fset.Position(literal.Rbrace).Offset
+
+	out := make([]byte, 0, len(src)+len(insert))
+	out = append(out, src[:offset]...)
+	out = append(out, insert...)
+	out = append(out, src[offset:]...)
+
+	return format.Source(out)
+}
+
+// scaffoldDocumentation generates the documentation stub for the registered item using the `website-scaffold` tool,
+// which is run using `go run` so that it's built with the newly scaffolded (and registered) code.
+func scaffoldDocumentation(name string, brandName string, docType string, resourceId string) error {
+	root := relativePathToRoot()
+	if root == "" {
+		root = "."
+	}
+
+	args := []string{
+		"run", "./internal/tools/website-scaffold",
+		"-name", "azurerm_" + name,
+		"-brand-name", brandName,
+		"-type", docType,
+		"-website-path", "./website/",
+	}
+	if resourceId != "" {
+		args = append(args, "-resource-id", resourceId)
+	}
+
+	cmd := exec.Command("go", args...)
+	cmd.Dir = root
+	if combined, err := cmd.CombinedOutput(); err != nil {
+		quoted := make([]string, 0, len(args))
+		for _, arg := range args {
+			if strings.Contains(arg, " ") {
+				arg = strconv.Quote(arg)
+			}
+			quoted = append(quoted, arg)
+		}
+		return fmt.Errorf("scaffolding the documentation (once the scaffolded code compiles this can be re-run using `go %s`): %w: %s", strings.Join(quoted, " "), err, combined)
+	}
+
+	return nil
+}
+
+// idArgument returns the name of the argument referencing a Resource by its ID, e.g. `virtual_machine_id` for the
+// ID type `virtualmachines.VirtualMachineId`.
+func idArgument(idTypeParts []string) string {
+	if len(idTypeParts) != 2 {
+		return "resource_id"
+	}
+	return strcase.ToSnake(strings.TrimSuffix(idTypeParts[1], "Id")) + "_id"
+}
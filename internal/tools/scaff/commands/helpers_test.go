@@ -0,0 +1,122 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package commands
+
+import (
+	"testing"
+)
+
+func TestRegisterEntry(t *testing.T) {
+	testData := []struct {
+		name        string
+		input       string
+		method      string
+		entry       string
+		expected    string
+		expectError bool
+	}{
+		{
+			name: "empty",
+			input: `package example
+
+func (r Registration) ListResources() []sdk.FrameworkListWrappedResource {
+	return []sdk.FrameworkListWrappedResource{}
+}
+`,
+			method: "ListResources",
+			entry:  "ExampleListResource{}",
+			expected: `package example
+
+func (r Registration) ListResources() []sdk.FrameworkListWrappedResource {
+	return []sdk.FrameworkListWrappedResource{
+		ExampleListResource{},
+	}
+}
+`,
+		},
+		{
+			name: "existing entries",
+			input: `package example
+
+func (r Registration) Actions() []func() action.Action {
+	return []func() action.Action{
+		newFirstAction,
+	}
+}
+
+func (r Registration) EphemeralResources() []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{}
+}
+`,
+			method: "Actions",
+			entry:  "newSecondAction",
+			expected: `package example
+
+func (r Registration) Actions() []func() action.Action {
+	return []func() action.Action{
+		newFirstAction,
+		newSecondAction,
+	}
+}
+
+func (r Registration) EphemeralResources() []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{}
+}
+`,
+		},
+		{
+			name: "already registered",
+			input: `package example
+
+func (r Registration) Actions() []func() action.Action {
+	return []func() action.Action{
+		newFirstAction,
+	}
+}
+`,
+			method: "Actions",
+			entry:  "newFirstAction",
+			expected: `package example
+
+func (r Registration) Actions() []func() action.Action {
+	return []func() action.Action{
+		newFirstAction,
+	}
+}
+`,
+		},
+		{
+			name: "not a framework registration",
+			input: `package example
+
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{}
+}
+`,
+			method:      "Actions",
+			entry:       "newFirstAction",
+			expectError: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		actual, err := registerEntry([]byte(v.input), v.method, v.entry)
+		if err != nil {
+			if v.expectError {
+				continue
+			}
+
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if v.expectError {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if string(actual) != v.expected {
+			t.Fatalf("expected:\n%s\nbut got:\n%s", v.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package commands
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/templatehelpers"
+	"github.com/iancoleman/strcase"
+	"github.com/mitchellh/cli"
+)
+
+type ListResourceCommand struct {
+	Ui cli.Ui
+}
+
+var _ cli.Command = &ListResourceCommand{}
+
+type listResourceData struct {
+	Name               string
+	ServicePackageName string
+	BrandName          string
+	RPName             string
+	ClientName         string
+	APIVersion         string
+	IdType             string
+	IdTypeParts        []string
+	SDKName            string
+	ModelName          string
+	Typed              bool
+	NoDocs             bool
+}
+
+var outputListResourceFileFmt = relativePathToRoot() + "internal/services/%s/%s_resource_list.go"
+
+var outputListResourceTestFileFmt = relativePathToRoot() + "internal/services/%s/%s_resource_list_test.go"
+
+func (c ListResourceCommand) Run(args []string) int {
+	data := &listResourceData{}
+
+	if err := data.parseArgs(args); err != nil {
+		for _, e := range err {
+			c.Ui.Error(e.Error())
+		}
+
+		return 1
+	}
+
+	if err := data.exec(); err != nil {
+		c.Ui.Error(err.Error())
+
+		log.Println(err)
+		return 2
+	}
+
+	if !data.NoDocs {
+		if err := scaffoldDocumentation(data.Name, data.BrandName, "list", ""); err != nil {
+			c.Ui.Warn(err.Error())
+		}
+	}
+
+	return 0
+}
+
+func (c ListResourceCommand) Synopsis() string {
+	return "create boilerplate for an AzureRM List Resource for an existing resource to speed development"
+}
+
+func (c ListResourceCommand) Help() string {
+	return `
+Usage: scaff list -name "some_resource_name" -service_package_name="someservice" -rp_name="network" -client_name="SomeClient" -api_version="2025-01-01" -id_type="networkprofiles.NetworkProfileId" -model_name="NetworkProfile" [-typed=true] [-sdk_name="networkprofiles"] [-brand_name="Network Profile"] [-no_docs=true]
+
+Parameters:
+	-name (Required) the name of the existing resource to scaffold the List Resource for, without the 'azurerm_' prefix.
+	-service_package_name (Required) the name of the service package the resource is in.
+	-rp_name (Required) the name of the resource provider of the resource.
+	-client_name (Required) the name of the client used to list the resources.
+	-api_version (Required) the API version of the SDK used to list the resources. e.g. 2025-01-01
+	-id_type (Required) the type of the ID of the resource. e.g. 'networkprofiles.NetworkProfileId'.
+	-model_name (Required) the name of the SDK model returned when listing the resources. e.g. 'NetworkProfile'.
+
+	-typed (Optional) whether the resource is a Typed Resource, rather than an Untyped (PluginSDK) Resource.
+	-sdk_name (Optional) the name of the SDK used to list the resources. If omitted, the first slug of the id_type value will be used.
+	-brand_name (Optional) the name of the resource used in the documentation. If omitted, this is derived from the name.
+	-no_docs (Optional) skip scaffolding the documentation using the 'website-scaffold' tool.
+
+The resource must have Resource Identity implemented, and a flatten function for its Read (see the List Resource guide in the 'contributing' directory) - 'resource<Name>Flatten' for Untyped Resources, or the 'flatten' method for Typed Resources.
+The List Resource is registered in the 'ListResources' method of the Service Registration, and a test and documentation stub are scaffolded alongside it.
+
+Example:
+go run internal/tools/scaff/main.go list -name="network_profile" -service_package_name="Network" -rp_name="network" -client_name="NetworkProfiles" -api_version="2025-01-01" -id_type="networkprofiles.NetworkProfileId" -model_name="NetworkProfile"
+`
+}
+
+func (d *listResourceData) parseArgs(args []string) (errs []error) {
+	argSet := flag.NewFlagSet("list", flag.ExitOnError)
+
+	argSet.StringVar(&d.Name, "name", "", "(Required) the name of the existing resource to scaffold the List Resource for, without the `azurerm_` prefix.")
+	argSet.StringVar(&d.ServicePackageName, "service_package_name", "", "(Required) the name of the service package the resource is in.")
+	argSet.StringVar(&d.RPName, "rp_name", "", "(Required) the name of the resource provider of the resource.")
+	argSet.StringVar(&d.ClientName, "client_name", "", "(Required) the name of the client used to list the resources.")
+	argSet.StringVar(&d.APIVersion, "api_version", "", "(Required) the API version of the SDK used to list the resources. e.g. 2025-01-01")
+	argSet.StringVar(&d.IdType, "id_type", "", "(Required) the type of the ID of the resource. e.g. `networkprofiles.NetworkProfileId`.")
+	argSet.StringVar(&d.ModelName, "model_name", "", "(Required) the name of the SDK model returned when listing the resources. e.g. `NetworkProfile`.")
+	argSet.BoolVar(&d.Typed, "typed", false, "(Optional) whether the resource is a Typed Resource, rather than an Untyped (PluginSDK) Resource.")
+	argSet.StringVar(&d.SDKName, "sdk_name", "", "(Optional) the name of the SDK used to list the resources. If omitted, the first slug of the id_type value will be used.")
+	argSet.StringVar(&d.BrandName, "brand_name", "", "(Optional) the name of the resource used in the documentation. If omitted, this is derived from the name.")
+	argSet.BoolVar(&d.NoDocs, "no_docs", false, "(Optional) skip scaffolding the documentation using the `website-scaffold` tool.")
+	if err := argSet.Parse(args); err != nil {
+		errs = append(errs, err)
+		return
+	}
+
+	switch {
+	case d.Name == "":
+		errs = append(errs, errors.New("resource name is required"))
+	case d.ServicePackageName == "":
+		errs = append(errs, errors.New("service package name is required"))
+	case d.RPName == "":
+		errs = append(errs, errors.New("resource provider name is required"))
+	case d.ClientName == "":
+		errs = append(errs, errors.New("client name is required"))
+	case d.APIVersion == "":
+		errs = append(errs, errors.New("api version is required"))
+	case d.IdType == "":
+		errs = append(errs, errors.New("id_type is required"))
+	case d.ModelName == "":
+		errs = append(errs, errors.New("model name is required"))
+	}
+
+	d.Name = strings.TrimPrefix(d.Name, "azurerm_")
+
+	d.IdTypeParts = strings.Split(d.IdType, ".")
+	if l := len(d.IdTypeParts); l != 2 {
+		errs = append(errs, fmt.Errorf("id_type has incorrect number of segments, expected 2 got %d", l))
+	}
+	if d.SDKName == "" {
+		d.SDKName = d.IdTypeParts[0]
+	}
+	if d.BrandName == "" {
+		d.BrandName = templatehelpers.ToDelimTitle(d.Name)
+	}
+
+	return errs
+}
+
+func (d *listResourceData) exec() error {
+	if err := writeTemplate("list_resource.gotpl", fmt.Sprintf(outputListResourceFileFmt, strings.ToLower(d.ServicePackageName), d.Name), d); err != nil {
+		return err
+	}
+
+	if err := writeTemplate("list_resource_test.gotpl", fmt.Sprintf(outputListResourceTestFileFmt, strings.ToLower(d.ServicePackageName), d.Name), d); err != nil {
+		return err
+	}
+
+	return register(d.ServicePackageName, "ListResources", fmt.Sprintf("%sListResource{}", strcase.ToCamel(d.Name)))
+}
@@ -14,6 +14,7 @@ import (
 	"text/template"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/templatehelpers"
+	"github.com/iancoleman/strcase"
 	"github.com/mitchellh/cli"
 )
 
@@ -41,6 +42,9 @@ type resourceData struct {
 	IdTypeParts              []string `json:"id_type_parts"          hcl:"id_type_parts"`
 	SDKName                  string   `json:"sdk_name"               hcl:"sdk_name"`
 	IdSegments               string   `json:"id_segments"            hcl:"id_segments"`
+	BrandName                string   `json:"brand_name"             hcl:"brand_name"`
+	ResourceId               string   `json:"resource_id"            hcl:"resource_id"`
+	NoDocs                   bool     `json:"no_docs"                hcl:"no_docs"`
 	IDSegments               []string
 	ResourceIdentitySegments []string
 	SegmentCount             int
@@ -50,6 +54,8 @@ var outputResourceFileFmt = relativePathToRoot() + "internal/services/%s/%s_reso
 
 var outputModelFileFmt = relativePathToRoot() + "internal/services/%s/%s_resource_models.go"
 
+var outputResourceTestFileFmt = relativePathToRoot() + "internal/services/%s/%s_resource_test.go"
+
 func (c ResourceCommand) Run(args []string) int {
 	data := &resourceData{}
 
@@ -68,6 +74,12 @@ func (c ResourceCommand) Run(args []string) int {
 		return 2
 	}
 
+	if !data.NoDocs {
+		if err := scaffoldDocumentation(data.Name, data.BrandName, "resource", data.ResourceId); err != nil {
+			c.Ui.Warn(err.Error())
+		}
+	}
+
 	return 0
 }
 
@@ -77,7 +89,7 @@ func (c ResourceCommand) Synopsis() string {
 
 func (c ResourceCommand) Help() string {
 	return `
-Usage: scaff resource -name "some_resource_name" -service_package_name="someservice" -rp_name="sql" -client_name="SomeClient" [-updatable=true] [-no_resource_group=true] -api_version="2023-08-01-preview" -id_type="commonids.SqlDatabaseId" [-sdk_name="databases"] -id_segments="SubscriptionId,ResourceGroupName,ServerName,DatabaseName" [-uses_lro_crud=true] [-use_create_options=true] [-use_read_options=true] [-use_update_options=true] [-use_delete_options=true]
+Usage: scaff resource -name "some_resource_name" -service_package_name="someservice" -rp_name="sql" -client_name="SomeClient" [-updatable=true] [-no_resource_group=true] -api_version="2023-08-01-preview" -id_type="commonids.SqlDatabaseId" [-sdk_name="databases"] -id_segments="SubscriptionId,ResourceGroupName,ServerName,DatabaseName" [-uses_lro_crud=true] [-use_create_options=true] [-use_read_options=true] [-use_update_options=true] [-use_delete_options=true] [-brand_name="MSSQL Database"] [-resource_id="/subscriptions/..."] [-no_docs=true]
 
 Parameters:
 	-name (Required) the name of the resource to scaffold the resource for.
@@ -96,6 +108,11 @@ Parameters:
 	-config_validators (Optional) does the resource have configuration validators.
 	-no_resource_group (Optional) Set to true if the resource is not created in a resource group, or if the RG is inferred from a parent resource ID.
 	-sdk_name (Optional) the name of the SDK used to manage the new resource. If omitted, the first slug of the id_type value will be used.
+	-brand_name (Optional) the name of the resource used in the documentation. If omitted, this is derived from the name.
+	-resource_id (Optional) an example Resource ID used in the import section of the documentation.
+	-no_docs (Optional) skip scaffolding the documentation using the 'website-scaffold' tool.
+
+The resource is registered in the 'FrameworkResources' method of the Service Registration, and a test and documentation stub are scaffolded alongside it.
 
 Example:
 scaff resource -name="fw_mssql_database" -service_package_name="MSSQL" -rp_name="sql" -client_name="DatabasesClient" -updatable=true -no_resource_group=true -api_version="2023-08-01-preview" -id_type="commonids.SqlDatabaseId" -sdk_name="databases" -id_segments="SubscriptionId,ResourceGroupName,ServerName,DatabaseName" -uses_lro_crud=true -use_read_options=true
@@ -125,6 +142,9 @@ func (d *resourceData) parseArgs(args []string) (errs []error) {
 	argSet.BoolVar(&d.ConfigValidators, "config_validators", false, "(Optional) does the resource have configuration validators.")
 	argSet.BoolVar(&d.NoResourceGroup, "no_resource_group", false, "(Optional) Set to true if the resource is not created in a resource group, or if the RG is inferred from a parent resource ID.")
 	argSet.StringVar(&d.SDKName, "sdk_name", "", "(Optional) the name of the SDK used to manage the new resource. If omitted, the first slug of the id_type value will be used.")
+	argSet.StringVar(&d.BrandName, "brand_name", "", "(Optional) the name of the resource used in the documentation. If omitted, this is derived from the name.")
+	argSet.StringVar(&d.ResourceId, "resource_id", "", "(Optional) an example Resource ID used in the import section of the documentation.")
+	argSet.BoolVar(&d.NoDocs, "no_docs", false, "(Optional) skip scaffolding the documentation using the `website-scaffold` tool.")
 	if err := argSet.Parse(args); err != nil {
 		errs = append(errs, err)
 		return
@@ -151,6 +171,15 @@ func (d *resourceData) parseArgs(args []string) (errs []error) {
 	if l := len(d.IdTypeParts); l != 2 {
 		errs = append(errs, fmt.Errorf("id_type has incorrect number of segments, expected 2 got %d", l))
 	}
+	if d.SDKName == "" {
+		d.SDKName = d.IdTypeParts[0]
+	}
+	if d.BrandName == "" {
+		d.BrandName = templatehelpers.ToDelimTitle(d.Name)
+	}
+	if d.ResourceId == "" {
+		d.ResourceId = "TODO - an example Resource ID"
+	}
 
 	d.IDSegments = strings.Split(d.IdSegments, ",")
 	d.SegmentCount = len(d.IDSegments) - 1
@@ -171,7 +200,7 @@ func (d *resourceData) exec() error {
 	// Generate Resource
 	tpl := template.Must(template.New("resource.gotpl").Funcs(templatehelpers.TplFuncMap).ParseFS(Templatedir, "templates/resource.gotpl"))
 
-	outputPath := fmt.Sprintf(outputResourceFileFmt, d.ServicePackageName, d.Name)
+	outputPath := fmt.Sprintf(outputResourceFileFmt, strings.ToLower(d.ServicePackageName), d.Name)
 
 	f, err := os.Create(outputPath)
 	if err != nil {
@@ -196,7 +225,7 @@ func (d *resourceData) exec() error {
 	// Generate Resource Model(s)
 	tpl = template.Must(template.New("resource_models.gotpl").Funcs(templatehelpers.TplFuncMap).ParseFS(Templatedir, "templates/resource_models.gotpl"))
 
-	outputPath = fmt.Sprintf(outputModelFileFmt, d.ServicePackageName, d.Name)
+	outputPath = fmt.Sprintf(outputModelFileFmt, strings.ToLower(d.ServicePackageName), d.Name)
 
 	f, err = os.Create(outputPath)
 	if err != nil {
@@ -218,7 +247,12 @@ func (d *resourceData) exec() error {
 		return err
 	}
 
-	return nil
+	// Generate Resource Test
+	if err := writeTemplate("resource_test.gotpl", fmt.Sprintf(outputResourceTestFileFmt, strings.ToLower(d.ServicePackageName), d.Name), d); err != nil {
+		return err
+	}
+
+	return register(d.ServicePackageName, "FrameworkResources", fmt.Sprintf("%sResource{}", strcase.ToCamel(d.Name)))
 }
 
 func relativePathToRoot() string {
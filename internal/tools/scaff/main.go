@@ -29,6 +29,21 @@ func realMain(args []string) int {
 	}
 
 	commands := map[string]cli.CommandFactory{
+		"action": func() (cli.Command, error) {
+			return &commands.ActionCommand{
+				Ui: ui,
+			}, nil
+		},
+		"ephemeral": func() (cli.Command, error) {
+			return &commands.EphemeralCommand{
+				Ui: ui,
+			}, nil
+		},
+		"list": func() (cli.Command, error) {
+			return &commands.ListResourceCommand{
+				Ui: ui,
+			}, nil
+		},
 		"resource": func() (cli.Command, error) {
 			return &commands.ResourceCommand{
 				Ui: ui,
@@ -5,6 +5,7 @@ package differ
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
 	schema_rules "github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/schema-rules"
@@ -15,104 +16,155 @@ type Differ struct {
 	current *providerjson.ProviderWrapper
 }
 
-func (d *Differ) Diff(fileName string, providerName string) []string {
+// Violation is a breaking change between the base and current schemas.
+type Violation struct {
+	RuleID string
+
+	// Resource is the address of the Resource or Data Source, e.g. `azurerm_resource_group` or `data.azurerm_resource_group`
+	Resource string
+
+	// Property is the path to the property within the Resource, e.g. `network_rules.ip_rules`, which is empty when the
+	// Resource itself has changed
+	Property string
+
+	Message string
+}
+
+// Path returns the address of the Resource or property which has changed.
+func (v Violation) Path() string {
+	if v.Property == "" {
+		return v.Resource
+	}
+	return v.Resource + "." + v.Property
+}
+
+func (v Violation) String() string {
+	if v.Property == "" {
+		return v.Message
+	}
+	return fmt.Sprintf("%s: %s", v.Resource, v.Message)
+}
+
+func (d *Differ) Diff(fileName string, providerName string) ([]Violation, error) {
 	if err := d.loadFromProvider(providerjson.LoadData(), providerName); err != nil {
-		return []string{err.Error()}
+		return nil, err
 	}
 
 	if err := d.loadFromFile(fileName); err != nil {
-		return []string{err.Error()}
+		return nil, err
 	}
 
 	if d.base.ProviderName != d.current.ProviderName {
-		return []string{fmt.Sprintf("provider name mismatch, expected %q, got %q", d.base.ProviderName, d.current.ProviderName)}
+		return nil, fmt.Errorf("provider name mismatch, expected %q, got %q", d.base.ProviderName, d.current.ProviderName)
 	}
 
-	violations := make([]string, 0)
+	violations := make([]Violation, 0)
 
-	for resource, rs := range d.current.ProviderSchema.ResourcesMap {
-		_, ok := d.base.ProviderSchema.ResourcesMap[resource]
-		if !ok {
-			// New resource, no breaking changes to worry about
-			continue
-		}
-		for propertyName, propertySchema := range rs.Schema {
-			// Get the same from the base (released) json
-			baseItem, ok := d.base.ProviderSchema.ResourcesMap[resource].Schema[propertyName]
-			if !ok {
-				// New property, could be breaking - Required etc
-				baseItem = providerjson.SchemaJSON{}
-			}
-			if errs := compareNodeResource(baseItem, propertySchema, propertyName); errs != nil {
-				violations = append(violations, errs...)
-			}
+	violations = append(violations, compareResources(false, d.base.ProviderSchema.ResourcesMap, d.current.ProviderSchema.ResourcesMap)...)
+	violations = append(violations, compareResources(true, d.base.ProviderSchema.DataSourcesMap, d.current.ProviderSchema.DataSourcesMap)...)
+
+	return violations, nil
+}
+
+// compareResources checks each Resource (or Data Source) in the base schema for breaking changes, new Resources have
+// no breaking changes to worry about.
+func compareResources(dataSources bool, base map[string]providerjson.ResourceJSON, current map[string]providerjson.ResourceJSON) (violations []Violation) {
+	for _, name := range sortedKeys(base) {
+		address := name
+		if dataSources {
+			address = "data." + name
 		}
-	}
 
-	for dataSource, ds := range d.current.ProviderSchema.DataSourcesMap {
-		_, ok := d.base.ProviderSchema.DataSourcesMap[dataSource]
+		currentResource, ok := current[name]
 		if !ok {
-			// New data source, no breaking changes to worry about
-			continue
-		}
-		for propertyName, propertySchema := range ds.Schema {
-			// Get the same from the base (released) json
-			baseItem, ok := d.base.ProviderSchema.DataSourcesMap[dataSource].Schema[propertyName]
-			if !ok {
-				// New property, could be breaking - Required etc
-				baseItem = providerjson.SchemaJSON{}
+			v := Violation{
+				RuleID:   "resource-removed",
+				Resource: address,
+				Message:  fmt.Sprintf("resource %q has been removed", name),
 			}
-			if errs := compareNodeDataSource(baseItem, propertySchema, propertyName); errs != nil {
-				violations = append(violations, errs...)
+			if dataSources {
+				v.RuleID = "data-source-removed"
+				v.Message = fmt.Sprintf("data source %q has been removed", name)
 			}
+			violations = append(violations, v)
+			continue
+		}
+
+		for _, v := range compareSchemas(base[name].Schema, currentResource.Schema, "") {
+			v.Resource = address
+			violations = append(violations, v)
 		}
 	}
 
-	return violations
+	return
 }
 
-func compareNodeResource(base providerjson.SchemaJSON, current providerjson.SchemaJSON, nodeName string) (errs []string) {
-	if nodeIsBlock(base) {
-		newBaseRaw := base.Elem.(providerjson.ResourceJSON).Schema
-		newCurrent := current.Elem.(*providerjson.ResourceJSON).Schema
-		for k, newBase := range newBaseRaw {
-			errs = append(errs, compareNodeResource(newBase, newCurrent[k], k)...)
-		}
+// compareSchemas checks each property in either schema, a property missing from the base is new and one missing from
+// current has been removed - in both cases the property is compared with an empty schema.
+func compareSchemas(base map[string]providerjson.SchemaJSON, current map[string]providerjson.SchemaJSON, parent string) (violations []Violation) {
+	names := make(map[string]struct{})
+	for k := range base {
+		names[k] = struct{}{}
+	}
+	for k := range current {
+		names[k] = struct{}{}
 	}
 
-	for _, v := range schema_rules.BreakingChangeRules {
-		if err := v.Check(base, current, nodeName); err != nil {
-			errs = append(errs, *err)
+	for _, name := range sortedKeys(names) {
+		path := name
+		if parent != "" {
+			path = parent + "." + name
 		}
+		violations = append(violations, compareNode(base[name], current[name], path, name)...)
 	}
 
 	return
 }
 
-func compareNodeDataSource(base providerjson.SchemaJSON, current providerjson.SchemaJSON, nodeName string) (errs []string) {
-	if nodeIsBlock(base) {
-		newBaseRaw := base.Elem.(providerjson.ResourceJSON).Schema
-		newCurrent := current.Elem.(*providerjson.ResourceJSON).Schema
-		for k, newBase := range newBaseRaw {
-			errs = append(errs, compareNodeDataSource(newBase, newCurrent[k], k)...)
+func compareNode(base providerjson.SchemaJSON, current providerjson.SchemaJSON, path string, nodeName string) (violations []Violation) {
+	if baseBlock, ok := blockSchema(base); ok {
+		if currentBlock, ok := blockSchema(current); ok {
+			violations = append(violations, compareSchemas(baseBlock, currentBlock, path)...)
 		}
 	}
 
-	for _, v := range schema_rules.BreakingChangeRulesDataSource {
+	for _, v := range schema_rules.BreakingChangeRules {
 		if err := v.Check(base, current, nodeName); err != nil {
-			errs = append(errs, *err)
+			violations = append(violations, Violation{
+				RuleID:   v.ID(),
+				Property: path,
+				Message:  *err,
+			})
 		}
 	}
 
 	return
 }
 
-func nodeIsBlock(input providerjson.SchemaJSON) bool {
-	if input.Type == providerjson.SchemaTypeList || input.Type == providerjson.SchemaTypeSet {
-		if _, ok := input.Elem.(providerjson.ResourceJSON); ok {
-			return true
+// blockSchema returns the nested schema of a block. The Elem of a block is a ResourceJSON when loaded from a file and
+// a *ResourceJSON when loaded from the provider.
+func blockSchema(input providerjson.SchemaJSON) (map[string]providerjson.SchemaJSON, bool) {
+	if input.Type != providerjson.SchemaTypeList && input.Type != providerjson.SchemaTypeSet {
+		return nil, false
+	}
+
+	switch elem := input.Elem.(type) {
+	case providerjson.ResourceJSON:
+		return elem.Schema, true
+	case *providerjson.ResourceJSON:
+		if elem != nil {
+			return elem.Schema, true
 		}
 	}
 
-	return false
+	return nil, false
+}
+
+func sortedKeys[T any](input map[string]T) []string {
+	keys := make([]string, 0, len(input))
+	for k := range input {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
 }
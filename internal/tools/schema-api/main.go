@@ -13,6 +13,7 @@ import (
 	"syscall"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/reporting"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/differ"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
 )
@@ -26,12 +27,19 @@ func main() {
 	exportSchema := f.String("export", "", "export the schema to the given path/filename. Intended for use in the release process")
 	detectBreakingChanges := f.String("detect", "", "compare current schema to named dump.")
 	errorOnBreakingChange := f.Bool("error-on-violation", false, "should the detect mode exit with a non-zero error code. Defaults to `false`")
+	outputFormat := f.String("format", string(reporting.FormatText), "the output format of the detect mode, one of `text`, `json`, `sarif` or `markdown`")
 
 	if err := f.Parse(os.Args[1:]); err != nil {
 		fmt.Printf("error parsing args: %+v", err)
 		os.Exit(1)
 	}
 
+	format, err := reporting.ParseFormat(*outputFormat)
+	if err != nil {
+		fmt.Printf("error parsing args: %+v", err)
+		os.Exit(1)
+	}
+
 	data := providerjson.LoadData()
 
 	switch {
@@ -53,13 +61,25 @@ func main() {
 	case pointer.From(detectBreakingChanges) != "":
 		{
 			d := differ.Differ{}
-			if violations := d.Diff(*detectBreakingChanges, *providerName); violations != nil {
-				for _, v := range violations {
-					log.Println(v)
-				}
+			violations, err := d.Diff(*detectBreakingChanges, *providerName)
+			if err != nil {
+				log.Println(err)
 				if pointer.From(errorOnBreakingChange) {
 					os.Exit(1)
 				}
+				os.Exit(0)
+			}
+
+			if format == reporting.FormatText {
+				for _, v := range violations {
+					log.Println(v)
+				}
+			} else if err := writeReport(violations, format); err != nil {
+				log.Fatalf("error writing %s report: %+v", format, err)
+			}
+
+			if len(violations) > 0 && pointer.From(errorOnBreakingChange) {
+				os.Exit(1)
 			}
 
 			os.Exit(0)
@@ -107,3 +127,19 @@ func main() {
 	log.Printf("starting api service on localhost:%d", *apiPort)
 	log.Println(http.ListenAndServe(fmt.Sprintf(":%d", *apiPort), mux))
 }
+
+func writeReport(violations []differ.Violation, format reporting.Format) error {
+	report := reporting.Report{
+		Tool:     "schema-api",
+		Findings: make([]reporting.Finding, 0, len(violations)),
+	}
+	for _, v := range violations {
+		report.Findings = append(report.Findings, reporting.Finding{
+			RuleID:  v.RuleID,
+			Message: v.Message,
+			Path:    v.Path(),
+		})
+	}
+
+	return report.Write(os.Stdout, format)
+}
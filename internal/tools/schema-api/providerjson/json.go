@@ -22,17 +22,18 @@ const (
 type ProviderJSON schema.Provider
 
 type SchemaJSON struct {
-	Type        string      `json:"type,omitempty"` // TODO - Needs to be interface{}
-	ConfigMode  string      `json:"configMode,omitempty"`
-	Optional    bool        `json:"optional,omitempty"`
-	Required    bool        `json:"required,omitempty"`
-	Default     interface{} `json:"default,omitempty"`
-	Description string      `json:"description,omitempty"`
-	Computed    bool        `json:"computed,omitempty"`
-	ForceNew    bool        `json:"forceNew,omitempty"`
-	Elem        interface{} `json:"elem,omitempty"`
-	MaxItems    int         `json:"maxItems,omitempty"`
-	MinItems    int         `json:"minItems,omitempty"`
+	Type           string      `json:"type,omitempty"` // TODO - Needs to be interface{}
+	ConfigMode     string      `json:"configMode,omitempty"`
+	Optional       bool        `json:"optional,omitempty"`
+	Required       bool        `json:"required,omitempty"`
+	Default        interface{} `json:"default,omitempty"`
+	Description    string      `json:"description,omitempty"`
+	Computed       bool        `json:"computed,omitempty"`
+	ForceNew       bool        `json:"forceNew,omitempty"`
+	Elem           interface{} `json:"elem,omitempty"`
+	MaxItems       int         `json:"maxItems,omitempty"`
+	MinItems       int         `json:"minItems,omitempty"`
+	PossibleValues []string    `json:"possibleValues,omitempty"`
 }
 
 func (b *SchemaJSON) UnmarshalJSON(body []byte) error {
@@ -52,8 +53,9 @@ func (b *SchemaJSON) UnmarshalJSON(body []byte) error {
 		b.MaxItems = int(max)
 	}
 	if min, ok := m["minItems"].(float64); ok {
-		b.MaxItems = int(min)
+		b.MinItems = int(min)
 	}
+	b.PossibleValues = decodePossibleValues(m["possibleValues"])
 
 	if def, ok := m["default"]; ok && def != nil {
 		switch def.(type) {
@@ -0,0 +1,93 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package providerjson
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// possibleValuesProbe is validated to find the possible values of a property, it's chosen so that it won't be one of them
+const possibleValuesProbe = "\x00schema-api"
+
+// possibleValuesPattern matches the error returned by `validation.StringInSlice`, which contains the possible values
+// formatted as a quoted slice, e.g. `expected sku to be one of ["Basic" "Standard"], got ...`
+var possibleValuesPattern = regexp.MustCompile(`to be one of \[(.*)\], got `)
+
+// PossibleValues returns the values accepted by a String property validated using `validation.StringInSlice`. The
+// values can't be read from the validation function, so they're parsed from the error returned when validating a
+// value which isn't one of them.
+func PossibleValues(input *schema.Schema) (values []string) {
+	if input.Type != schema.TypeString {
+		return nil
+	}
+
+	// validation functions aren't expected to be called outside of Terraform, so any which fail are ignored
+	defer func() {
+		if recover() != nil {
+			values = nil
+		}
+	}()
+
+	messages := make([]string, 0)
+	switch {
+	case input.ValidateFunc != nil:
+		_, errs := input.ValidateFunc(possibleValuesProbe, "value")
+		for _, err := range errs {
+			messages = append(messages, err.Error())
+		}
+	case input.ValidateDiagFunc != nil:
+		for _, d := range input.ValidateDiagFunc(possibleValuesProbe, cty.Path{}) {
+			messages = append(messages, d.Summary)
+		}
+	}
+
+	for _, message := range messages {
+		if match := possibleValuesPattern.FindStringSubmatch(message); match != nil {
+			return parseQuotedValues(match[1])
+		}
+	}
+
+	return nil
+}
+
+func parseQuotedValues(input string) []string {
+	values := make([]string, 0)
+	for remaining := strings.TrimSpace(input); remaining != ""; remaining = strings.TrimSpace(remaining) {
+		quoted, err := strconv.QuotedPrefix(remaining)
+		if err != nil {
+			return nil
+		}
+		value, err := strconv.Unquote(quoted)
+		if err != nil {
+			return nil
+		}
+		values = append(values, value)
+		remaining = remaining[len(quoted):]
+	}
+
+	if len(values) == 0 {
+		return nil
+	}
+	return values
+}
+
+func decodePossibleValues(input interface{}) []string {
+	raw, ok := input.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	values := make([]string, 0, len(raw))
+	for _, v := range raw {
+		if s, ok := v.(string); ok {
+			values = append(values, s)
+		}
+	}
+	return values
+}
@@ -0,0 +1,67 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package providerjson
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func TestPossibleValues(t *testing.T) {
+	testData := []struct {
+		name     string
+		input    *schema.Schema
+		expected []string
+	}{
+		{
+			name: "no validation",
+			input: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		{
+			name: "other validation",
+			input: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+		{
+			name: "string in slice",
+			input: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{"Basic", "Standard Tier", `Quoted "Value"`}, false),
+			},
+			expected: []string{"Basic", "Standard Tier", `Quoted "Value"`},
+		},
+		{
+			name: "string in slice as a diag func",
+			input: &schema.Schema{
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"Basic", "Standard"}, true)),
+			},
+			expected: []string{"Basic", "Standard"},
+		},
+		{
+			name: "validation which panics",
+			input: &schema.Schema{
+				Type: schema.TypeString,
+				ValidateFunc: func(i interface{}, k string) ([]string, []error) {
+					panic("unexpected value")
+				},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		if actual := PossibleValues(v.input); !reflect.DeepEqual(actual, v.expected) {
+			t.Fatalf("expected %+v but got %+v", v.expected, actual)
+		}
+	}
+}
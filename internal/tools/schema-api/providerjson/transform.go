@@ -57,6 +57,8 @@ func schemaFromRaw(input *schema.Schema) SchemaJSON {
 		Elem:        decodeElem(input.Elem),
 		MaxItems:    input.MaxItems,
 		MinItems:    input.MinItems,
+
+		PossibleValues: PossibleValues(input),
 	}
 }
 
@@ -110,6 +112,8 @@ func SchemaFromMap(input map[string]interface{}) SchemaJSON {
 		result.MaxItems = int(t.(float64))
 	}
 
+	result.PossibleValues = decodePossibleValues(input["possibleValues"])
+
 	return result
 }
 
@@ -12,6 +12,10 @@ import (
 
 type becomeComputedOnly struct{}
 
+func (becomeComputedOnly) ID() string {
+	return "become-computed-only"
+}
+
 var _ BreakingChangeRule = becomeComputedOnly{}
 
 // Check - Checks that an Optional or Required property is not updated to become Computed only
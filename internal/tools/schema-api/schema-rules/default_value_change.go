@@ -12,6 +12,10 @@ import (
 
 type defaultValueChange struct{}
 
+func (defaultValueChange) ID() string {
+	return "default-value-change"
+}
+
 var _ BreakingChangeRule = defaultValueChange{}
 
 // Check - Checks that an Optional or Required property is not updated to become Computed only
@@ -0,0 +1,28 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+var _ BreakingChangeRule = forceNewAdded{}
+
+type forceNewAdded struct{}
+
+func (forceNewAdded) ID() string {
+	return "force-new-added"
+}
+
+// Check - Checks that an existing property is not updated to be ForceNew, since changes which could previously be applied in-place would recreate the resource.
+func (forceNewAdded) Check(base providerjson.SchemaJSON, current providerjson.SchemaJSON, propertyName string) *string {
+	if base.Type != "" && current.Type != "" && !base.ForceNew && current.ForceNew {
+		return pointer.To(fmt.Sprintf("cannot make the existing property %q ForceNew as updates would recreate the resource", propertyName))
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+var _ BreakingChangeRule = forceNewDefaultValueChange{}
+
+type forceNewDefaultValueChange struct{}
+
+func (forceNewDefaultValueChange) ID() string {
+	return "force-new-default-value-change"
+}
+
+// Check - Checks that the Default of a ForceNew property is not changed, since resources relying on the Default would be recreated.
+func (forceNewDefaultValueChange) Check(base providerjson.SchemaJSON, current providerjson.SchemaJSON, propertyName string) *string {
+	if base.Type == "" || !current.ForceNew {
+		return nil
+	}
+
+	// the base is loaded from JSON, so values are compared in their JSON form (e.g. an int and a float64 of 1 are equal)
+	baseDefault, _ := json.Marshal(base.Default)
+	currentDefault, _ := json.Marshal(current.Default)
+	if string(baseDefault) != string(currentDefault) {
+		return pointer.To(fmt.Sprintf("cannot change the Default of the ForceNew property %q (%s to %s) as this would recreate existing resources", propertyName, baseDefault, currentDefault))
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+var forceNewDefaultValueChangeBaseNode = providerjson.SchemaJSON{
+	Type:     providerjson.SchemaTypeInt,
+	Optional: true,
+	ForceNew: true,
+	Default:  float64(1), // loaded from JSON
+}
+
+var forceNewDefaultValueChangePasses = providerjson.SchemaJSON{
+	Type:     providerjson.SchemaTypeInt,
+	Optional: true,
+	ForceNew: true,
+	Default:  1,
+}
+
+var forceNewDefaultValueChangeViolates = providerjson.SchemaJSON{
+	Type:     providerjson.SchemaTypeInt,
+	Optional: true,
+	ForceNew: true,
+	Default:  2, // violation
+}
+
+var forceNewDefaultValueChangeNotForceNew = providerjson.SchemaJSON{
+	Type:     providerjson.SchemaTypeInt,
+	Optional: true,
+	Default:  2,
+}
+
+func TestForceNewDefaultValueChange_Check(t *testing.T) {
+	data := forceNewDefaultValueChange{}
+	if res := data.Check(forceNewDefaultValueChangeBaseNode, forceNewDefaultValueChangePasses, ""); res != nil {
+		t.Errorf("expected no violation, got %+v", res)
+	}
+	if res := data.Check(forceNewDefaultValueChangeBaseNode, forceNewDefaultValueChangeNotForceNew, ""); res != nil {
+		t.Errorf("expected no violation, got %+v", res)
+	}
+	if res := data.Check(forceNewDefaultValueChangeBaseNode, forceNewDefaultValueChangeViolates, ""); res == nil {
+		t.Errorf("expected violation, but didn't get one")
+	}
+}
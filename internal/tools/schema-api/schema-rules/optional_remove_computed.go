@@ -14,6 +14,10 @@ var _ BreakingChangeRule = optionalRemoveComputed{}
 
 type optionalRemoveComputed struct{}
 
+func (optionalRemoveComputed) ID() string {
+	return "optional-remove-computed"
+}
+
 // Check - Checks that Computed is not removed from Optional properties as user configs may not supply the value, but the state will contain one, causing a diff./
 func (optionalRemoveComputed) Check(base providerjson.SchemaJSON, current providerjson.SchemaJSON, propertyName string) *string {
 	if (base.Optional && base.Computed) && (current.Optional && !current.Computed) {
@@ -12,6 +12,10 @@ import (
 
 type optionalToRequired struct{}
 
+func (optionalToRequired) ID() string {
+	return "optional-to-required"
+}
+
 var _ BreakingChangeRule = optionalToRequired{}
 
 // Check - Checks that an Optional property is not update to become Required
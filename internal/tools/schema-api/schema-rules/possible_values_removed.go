@@ -0,0 +1,42 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package schema_rules
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
+)
+
+var _ BreakingChangeRule = possibleValuesRemoved{}
+
+type possibleValuesRemoved struct{}
+
+func (possibleValuesRemoved) ID() string {
+	return "possible-values-removed"
+}
+
+// Check - Checks that values are not removed from the possible values of a property, since they may be used in users configurations.
+// Removing the validation entirely is allowed, since all values are then accepted.
+func (possibleValuesRemoved) Check(base providerjson.SchemaJSON, current providerjson.SchemaJSON, propertyName string) *string {
+	if len(base.PossibleValues) == 0 || len(current.PossibleValues) == 0 {
+		return nil
+	}
+
+	removed := make([]string, 0)
+	for _, v := range base.PossibleValues {
+		if !slices.Contains(current.PossibleValues, v) {
+			removed = append(removed, fmt.Sprintf("%q", v))
+		}
+	}
+
+	if len(removed) > 0 {
+		return pointer.To(fmt.Sprintf("cannot remove the possible values %s from the property %q", strings.Join(removed, ", "), propertyName))
+	}
+
+	return nil
+}
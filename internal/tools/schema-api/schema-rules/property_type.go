@@ -16,6 +16,10 @@ var _ BreakingChangeRule = propertyType{}
 
 type propertyType struct{}
 
+func (propertyType) ID() string {
+	return "property-type"
+}
+
 // Check - Checks for invalid type changes. At the time of writing the only allowed change is a Set to a List
 func (propertyType) Check(base providerjson.SchemaJSON, current providerjson.SchemaJSON, propertyName string) *string {
 	if (base.Type != "" && current.Type != "" && base.Type != providerjson.SchemaTypeSet) && base.Type != current.Type {
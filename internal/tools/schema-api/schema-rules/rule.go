@@ -6,17 +6,22 @@ package schema_rules
 import "github.com/hashicorp/terraform-provider-azurerm/internal/tools/schema-api/providerjson"
 
 type BreakingChangeRule interface {
+	// ID identifies the rule in machine-readable output
+	ID() string
+
 	Check(base providerjson.SchemaJSON, current providerjson.SchemaJSON, propertyName string) *string
 }
 
+// BreakingChangeRules are checked against each property of the Resources and Data Sources which exist in the base schema
 var BreakingChangeRules = []BreakingChangeRule{
 	becomeComputedOnly{},
+	forceNewAdded{},
+	forceNewDefaultValueChange{},
+	maxItemsReduced{},
 	newRequiredPropertyExistingResource{},
 	optionalRemoveComputed{},
 	optionalToRequired{},
-	propertyType{},
-}
-
-var BreakingChangeRulesDataSource = []BreakingChangeRule{
+	possibleValuesRemoved{},
+	propertyRemoved{},
 	propertyType{},
 }
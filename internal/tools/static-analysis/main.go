@@ -11,6 +11,9 @@
 //	go run internal/tools/static-analysis/main.go                           # run all rules
 //	go run internal/tools/static-analysis/main.go -rules=combinedIfErr     # run a specific rule
 //	go run internal/tools/static-analysis/main.go -fail-on-error=false     # log errors without failing
+//
+// Existing violations of a rule can be excepted per file in `exceptions.yml`, entries which are no longer
+// needed are reported as errors so that the list only shrinks.
 
 package main
 
@@ -24,9 +27,16 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/static-analysis/rules"
 )
 
+const exceptionsFile = "internal/tools/static-analysis/exceptions.yml"
+
 var allRules = map[string]rules.Rule{
-	rules.TypedSDKBitCheck{}.Name():   rules.TypedSDKBitCheck{},
-	rules.CombinedIfErrCheck{}.Name(): rules.CombinedIfErrCheck{},
+	rules.TypedSDKBitCheck{}.Name():         rules.TypedSDKBitCheck{},
+	rules.CombinedIfErrCheck{}.Name():       rules.CombinedIfErrCheck{},
+	rules.CustomizeDiffSchemaCheck{}.Name(): rules.CustomizeDiffSchemaCheck{},
+	rules.IgnoredSetErrorCheck{}.Name():     rules.IgnoredSetErrorCheck{},
+	rules.ReadNotFoundCheck{}.Name():        rules.ReadNotFoundCheck{},
+	rules.RequiresImportCheck{}.Name():      rules.RequiresImportCheck{},
+	rules.TypedTimeoutCheck{}.Name():        rules.TypedTimeoutCheck{},
 }
 
 func main() {
@@ -44,6 +54,15 @@ func main() {
 	}
 	specifiedRules := strings.Split(*rulesToCheck, ",")
 
+	exceptions, err := rules.ParseExceptions(exceptionsFile)
+	if err != nil {
+		log.Fatalf("failed to parse exceptions file %s: %v", exceptionsFile, err)
+	}
+	run := func(r rules.Rule) []error {
+		errs := exceptions.Filter(r.Name(), r.Run())
+		return append(errs, exceptions.Unused(r.Name())...)
+	}
+
 	// If `all` is in the list, just reset it to `all`
 	if slices.Contains(specifiedRules, "all") {
 		specifiedRules = []string{"all"}
@@ -53,12 +72,12 @@ func main() {
 	for _, rule := range specifiedRules {
 		if strings.EqualFold(rule, "all") {
 			for _, r := range allRules {
-				errors = append(errors, r.Run()...)
+				errors = append(errors, run(r)...)
 			}
 		}
 
 		if r, ok := allRules[rule]; ok {
-			errors = append(errors, r.Run()...)
+			errors = append(errors, run(r)...)
 		}
 	}
 
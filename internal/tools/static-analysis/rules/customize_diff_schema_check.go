@@ -0,0 +1,162 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"strconv"
+	"strings"
+)
+
+var _ Rule = CustomizeDiffSchemaCheck{}
+
+// resourceDiffMethods are the methods of a ResourceDiff which take the path of a property as their first argument
+var resourceDiffMethods = map[string]bool{
+	"Clear":          true,
+	"ForceNew":       true,
+	"Get":            true,
+	"GetChange":      true,
+	"GetOk":          true,
+	"GetRawConfigAt": true,
+	"HasChange":      true,
+	"NewValueKnown":  true,
+	"SetNew":         true,
+	"SetNewComputed": true,
+}
+
+// CustomizeDiffSchemaCheck flags CustomizeDiff functions which reference properties that aren't defined in any schema
+// within the package, since the ResourceDiff returns the zero value (or panics) for unknown properties.
+type CustomizeDiffSchemaCheck struct{}
+
+func (r CustomizeDiffSchemaCheck) Run() (errors []error) {
+	packages, err := loadServicePackages()
+	if err != nil {
+		return []error{err}
+	}
+
+	for _, pkg := range packages {
+		errors = append(errors, r.checkPackage(pkg)...)
+	}
+
+	return
+}
+
+func (r CustomizeDiffSchemaCheck) checkPackage(pkg *servicePackage) (errors []error) {
+	if !pkg.isServiceRoot() || len(pkg.schemaTypes) == 0 {
+		// the schema these functions are used with is defined elsewhere
+		return nil
+	}
+
+	for _, f := range pkg.files {
+		errors = append(errors, r.checkNode(pkg, f.file, map[string]bool{})...)
+	}
+
+	return
+}
+
+// checkNode checks the calls on a ResourceDiff within node, where receivers are the names of the ResourceDiff
+// parameters of the enclosing functions - which are tracked as each (nested) function is entered.
+func (r CustomizeDiffSchemaCheck) checkNode(pkg *servicePackage, node ast.Node, receivers map[string]bool) (errors []error) {
+	ast.Inspect(node, func(n ast.Node) bool {
+		var fnType *ast.FuncType
+		var body *ast.BlockStmt
+		switch v := n.(type) {
+		case *ast.FuncDecl:
+			fnType, body = v.Type, v.Body
+		case *ast.FuncLit:
+			fnType, body = v.Type, v.Body
+		}
+		if fnType != nil {
+			if body != nil {
+				errors = append(errors, r.checkNode(pkg, body, withResourceDiffParams(receivers, fnType))...)
+			}
+			return false
+		}
+
+		call, receiver, method, ok := methodCall(n)
+		if !ok || !resourceDiffMethods[method] || len(call.Args) == 0 {
+			return true
+		}
+		if !receivers[receiver] && !strings.HasSuffix(receiver, ".ResourceDiff") {
+			return true
+		}
+
+		key, ok := stringLiteral(call.Args[0])
+		if !ok {
+			return true
+		}
+		if unknown := unknownProperty(pkg, key); unknown != "" {
+			errors = append(errors, pkg.violation(call, "%s(%q) references %q which isn't defined in the schema", method, key, unknown))
+		}
+		return true
+	})
+
+	return
+}
+
+// withResourceDiffParams returns the receivers along with the names of the function's parameters of type
+// `*pluginsdk.ResourceDiff`
+func withResourceDiffParams(receivers map[string]bool, fnType *ast.FuncType) map[string]bool {
+	names := make(map[string]bool)
+	for k, v := range receivers {
+		names[k] = v
+	}
+	if fnType.Params == nil {
+		return names
+	}
+
+	for _, param := range fnType.Params.List {
+		star, ok := param.Type.(*ast.StarExpr)
+		if !ok {
+			continue
+		}
+		if _, name := selectorName(star.X); name != "ResourceDiff" {
+			continue
+		}
+		for _, n := range param.Names {
+			names[n.Name] = true
+		}
+	}
+	return names
+}
+
+// unknownProperty returns the first segment of the path (e.g. `network.0.subnet_id`) which isn't defined in any schema
+// within the package, or an empty string if they all are. Properties nested within a schema defined elsewhere (for
+// example `identity.0.type`, where `identity` comes from `commonschema`) can't be checked.
+func unknownProperty(pkg *servicePackage, path string) string {
+	for _, segment := range strings.Split(path, ".") {
+		if _, err := strconv.Atoi(segment); err == nil || segment == "#" || segment == "%" {
+			continue
+		}
+
+		types, ok := pkg.schemaTypes[segment]
+		if !ok {
+			return segment
+		}
+
+		definedInPackage := false
+		for _, t := range types {
+			if t != "" {
+				definedInPackage = true
+			}
+		}
+		if !definedInPackage {
+			return ""
+		}
+	}
+	return ""
+}
+
+func (r CustomizeDiffSchemaCheck) Name() string {
+	return "customizeDiffSchema"
+}
+
+func (r CustomizeDiffSchemaCheck) Description() string {
+	return fmt.Sprintf(`
+The '%s' check ensures the properties referenced from a ResourceDiff in CustomizeDiff functions (e.g.
+'diff.Get("sku_name")' or 'metadata.ResourceDiff.GetChange("network.0.subnet_id")') are defined in a schema
+within the package, since an unknown property silently returns its zero value.
+`, r.Name())
+}
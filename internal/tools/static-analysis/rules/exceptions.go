@@ -0,0 +1,109 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Violation is an issue found by a rule at a position within the source, which can be excepted per file
+type Violation struct {
+	File    string
+	Line    int
+	Message string
+}
+
+func (v Violation) Error() string {
+	return fmt.Sprintf("%s:%d: %s", v.File, v.Line, v.Message)
+}
+
+type exception struct {
+	Rule string `yaml:"rule"`
+	File string `yaml:"file"`
+}
+
+// Exceptions are the files which are (temporarily) allowed to contain violations of a rule, so that a rule can be
+// enforced for new code before the existing violations have been fixed.
+type Exceptions struct {
+	entries []exception
+	used    map[exception]bool
+}
+
+func ParseExceptions(path string) (*Exceptions, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+	return parseExceptions(b)
+}
+
+func parseExceptions(b []byte) (*Exceptions, error) {
+	entries := make([]exception, 0)
+	if err := yaml.Unmarshal(b, &entries); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal yaml: %w", err)
+	}
+
+	if !sort.SliceIsSorted(entries, func(i int, j int) bool {
+		if entries[i].Rule != entries[j].Rule {
+			return entries[i].Rule < entries[j].Rule
+		}
+		return entries[i].File < entries[j].File
+	}) {
+		return nil, fmt.Errorf("entries have to be sorted alphabetically by rule and file")
+	}
+
+	for _, e := range entries {
+		if e.Rule == "" || e.File == "" {
+			return nil, fmt.Errorf("rule and file are required for each entry, got %+v", e)
+		}
+	}
+
+	return &Exceptions{
+		entries: entries,
+		used:    make(map[exception]bool),
+	}, nil
+}
+
+// Filter removes the violations of the rule which are excepted.
+func (e *Exceptions) Filter(rule string, errs []error) []error {
+	excepted := make(map[exception]bool)
+	for _, entry := range e.entries {
+		if entry.Rule == rule {
+			excepted[entry] = true
+		}
+	}
+
+	filtered := make([]error, 0, len(errs))
+	for _, err := range errs {
+		var v Violation
+		if errors.As(err, &v) {
+			key := exception{
+				Rule: rule,
+				File: v.File,
+			}
+			if excepted[key] {
+				e.used[key] = true
+				continue
+			}
+		}
+		filtered = append(filtered, err)
+	}
+	return filtered
+}
+
+// Unused returns an error for each exception of the rule which wasn't needed, so that the entry is removed once the
+// violations in the file have been fixed.
+func (e *Exceptions) Unused(rule string) (errs []error) {
+	for _, entry := range e.entries {
+		if entry.Rule == rule && !e.used[entry] {
+			errs = append(errs, fmt.Errorf("%s: the exception for the %q rule is no longer needed and should be removed", entry.File, rule))
+		}
+	}
+	return
+}
@@ -0,0 +1,98 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestParseExceptions(t *testing.T) {
+	testData := []struct {
+		name  string
+		input string
+		error bool
+	}{
+		{
+			name: "valid",
+			input: `
+- rule: readNotFound
+  file: internal/services/example/example_resource.go
+- rule: requiresImport
+  file: internal/services/example/a_resource.go
+- rule: requiresImport
+  file: internal/services/example/b_resource.go
+`,
+		},
+		{
+			name:  "empty",
+			input: "",
+		},
+		{
+			name: "unsorted",
+			input: `
+- rule: requiresImport
+  file: internal/services/example/b_resource.go
+- rule: requiresImport
+  file: internal/services/example/a_resource.go
+`,
+			error: true,
+		},
+		{
+			name: "missing file",
+			input: `
+- rule: requiresImport
+`,
+			error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		_, err := parseExceptions([]byte(v.input))
+		if v.error && err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+		if !v.error && err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+	}
+}
+
+func TestExceptions_Filter(t *testing.T) {
+	exceptions, err := parseExceptions([]byte(`
+- rule: readNotFound
+  file: internal/services/example/example_resource.go
+- rule: readNotFound
+  file: internal/services/example/fixed_resource.go
+`))
+	if err != nil {
+		t.Fatalf("parsing exceptions: %+v", err)
+	}
+
+	errs := []error{
+		Violation{File: "internal/services/example/example_resource.go", Line: 10, Message: "excepted"},
+		Violation{File: "internal/services/example/other_resource.go", Line: 20, Message: "not excepted"},
+		fmt.Errorf("not a violation"),
+	}
+
+	if actual := exceptions.Filter("requiresImport", errs); len(actual) != 3 {
+		t.Fatalf("expected the exceptions to only apply to their rule but got %+v", actual)
+	}
+
+	actual := exceptions.Filter("readNotFound", errs)
+	if len(actual) != 2 || actual[0].Error() != "internal/services/example/other_resource.go:20: not excepted" {
+		t.Fatalf("expected the violation in the excepted file to be removed but got %+v", actual)
+	}
+
+	unused := exceptions.Unused("readNotFound")
+	if len(unused) != 1 {
+		t.Fatalf("expected 1 unused exception but got %+v", unused)
+	}
+	expected := `internal/services/example/fixed_resource.go: the exception for the "readNotFound" rule is no longer needed and should be removed`
+	if unused[0].Error() != expected {
+		t.Fatalf("expected %q but got %q", expected, unused[0].Error())
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"strings"
+)
+
+var _ Rule = IgnoredSetErrorCheck{}
+
+// IgnoredSetErrorCheck flags calls to `d.Set` for complex (List/Set/Map) properties and to `metadata.Encode` whose
+// error is discarded, since these fail when the value doesn't match the schema.
+type IgnoredSetErrorCheck struct{}
+
+func (r IgnoredSetErrorCheck) Run() (errors []error) {
+	packages, err := loadServicePackages()
+	if err != nil {
+		return []error{err}
+	}
+
+	for _, pkg := range packages {
+		errors = append(errors, r.checkPackage(pkg)...)
+	}
+
+	return
+}
+
+func (r IgnoredSetErrorCheck) checkPackage(pkg *servicePackage) (errors []error) {
+	for _, f := range pkg.files {
+		ast.Inspect(f.file, func(n ast.Node) bool {
+			var call ast.Expr
+			switch v := n.(type) {
+			case *ast.ExprStmt:
+				call = v.X
+			case *ast.AssignStmt:
+				if len(v.Rhs) != 1 || !allBlank(v.Lhs) {
+					return true
+				}
+				call = v.Rhs[0]
+			default:
+				return true
+			}
+
+			if msg := r.ignoredError(pkg, call); msg != "" {
+				errors = append(errors, pkg.violation(call, "%s", msg))
+			}
+			return true
+		})
+	}
+
+	return
+}
+
+// ignoredError returns a description of the call if it's one whose error must be checked.
+func (r IgnoredSetErrorCheck) ignoredError(pkg *servicePackage, expr ast.Expr) string {
+	call, receiver, method, ok := methodCall(expr)
+	if !ok {
+		return ""
+	}
+
+	switch {
+	case method == "Encode" && (receiver == "metadata" || receiver == "meta"):
+		return fmt.Sprintf("the error returned from '%s.Encode' should be checked", receiver)
+
+	case method == "Set" && (receiver == "d" || strings.HasSuffix(receiver, ".ResourceData")) && len(call.Args) == 2:
+		key, ok := stringLiteral(call.Args[0])
+		if !ok || !isComplexProperty(pkg, key) {
+			return ""
+		}
+		return fmt.Sprintf("the error returned from '%s.Set(%q, ...)' should be checked since %q is a List, Set or Map", receiver, key, key)
+	}
+
+	return ""
+}
+
+// isComplexProperty returns whether the property is defined as a List, Set or Map in any schema within the package.
+func isComplexProperty(pkg *servicePackage, key string) bool {
+	for _, t := range pkg.schemaTypes[key] {
+		switch t {
+		case "TypeList", "TypeSet", "TypeMap":
+			return true
+		}
+	}
+	return false
+}
+
+func allBlank(exprs []ast.Expr) bool {
+	for _, expr := range exprs {
+		if ident, ok := expr.(*ast.Ident); !ok || ident.Name != "_" {
+			return false
+		}
+	}
+	return true
+}
+
+func (r IgnoredSetErrorCheck) Name() string {
+	return "ignoredSetError"
+}
+
+func (r IgnoredSetErrorCheck) Description() string {
+	return fmt.Sprintf(`
+The '%s' check ensures the errors returned from 'd.Set' for List, Set and Map properties, and from
+'metadata.Encode', are checked - since these fail when the value doesn't match the schema, which would
+otherwise leave the state silently incomplete.
+`, r.Name())
+}
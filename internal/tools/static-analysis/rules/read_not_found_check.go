@@ -0,0 +1,118 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"strings"
+)
+
+var _ Rule = ReadNotFoundCheck{}
+
+// ReadNotFoundCheck flags the Read functions of Resources which never remove the Resource from the state, meaning
+// a Resource deleted outside of Terraform will cause an error rather than being recreated.
+type ReadNotFoundCheck struct{}
+
+func (r ReadNotFoundCheck) Run() (errors []error) {
+	packages, err := loadServicePackages()
+	if err != nil {
+		return []error{err}
+	}
+
+	for _, pkg := range packages {
+		errors = append(errors, r.checkPackage(pkg)...)
+	}
+
+	return
+}
+
+func (r ReadNotFoundCheck) checkPackage(pkg *servicePackage) (errors []error) {
+	for _, f := range pkg.files {
+		if isDataSourceFile(f.path) {
+			continue
+		}
+
+		for _, decl := range f.file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Body == nil || !isUntypedResourceFunc(fn, "Read") {
+				continue
+			}
+			if !pkg.reaches(fn.Body, removesFromState) {
+				errors = append(errors, pkg.violation(fn, "%s should call 'd.SetId(\"\")' when the resource is not found", fn.Name.Name))
+			}
+		}
+	}
+
+	for name, methods := range pkg.typedResourceMethods() {
+		read := methods["Read"]
+		if read == nil || isDataSourceFile(pkg.fset.Position(read.Pos()).Filename) {
+			continue
+		}
+		if !pkg.reaches(read.Body, removesFromState) {
+			errors = append(errors, pkg.violation(read, "%s.Read should call 'metadata.MarkAsGone' when the resource is not found", name))
+		}
+	}
+
+	return
+}
+
+// isUntypedResourceFunc returns whether fn is a function such as `resourceExampleRead(d *pluginsdk.ResourceData, meta interface{}) error`
+func isUntypedResourceFunc(fn *ast.FuncDecl, suffix string) bool {
+	if fn.Recv != nil || !strings.HasPrefix(fn.Name.Name, "resource") || !strings.HasSuffix(fn.Name.Name, suffix) {
+		return false
+	}
+
+	params := fn.Type.Params.List
+	if len(params) == 0 {
+		return false
+	}
+	star, ok := params[0].Type.(*ast.StarExpr)
+	if !ok {
+		return false
+	}
+	_, name := selectorName(star.X)
+	return name == "ResourceData"
+}
+
+// removesFromState returns whether node calls `metadata.MarkAsGone` or `d.SetId("")`, either of which can be used by
+// Typed Resources.
+func removesFromState(node ast.Node) (found bool) {
+	ast.Inspect(node, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		call, _, method, ok := methodCall(n)
+		if !ok {
+			return true
+		}
+		switch method {
+		case "MarkAsGone":
+			found = true
+		case "SetId":
+			if len(call.Args) == 1 {
+				v, ok := stringLiteral(call.Args[0])
+				found = ok && v == ""
+			}
+		}
+		return !found
+	})
+	return
+}
+
+func isDataSourceFile(path string) bool {
+	return strings.HasSuffix(path, "_data_source.go")
+}
+
+func (r ReadNotFoundCheck) Name() string {
+	return "readNotFound"
+}
+
+func (r ReadNotFoundCheck) Description() string {
+	return fmt.Sprintf(`
+The '%s' check ensures the Read function of each Resource removes it from the state when it's not found,
+by calling 'd.SetId("")' (untyped) or 'metadata.MarkAsGone' (typed), so that a Resource deleted outside
+of Terraform is recreated rather than causing an error.
+`, r.Name())
+}
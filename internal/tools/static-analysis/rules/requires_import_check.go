@@ -0,0 +1,84 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"strings"
+)
+
+var _ Rule = RequiresImportCheck{}
+
+// RequiresImportCheck flags the Create functions of Resources which don't check whether the Resource already exists,
+// meaning an existing Resource would be silently adopted (and overwritten) rather than needing to be imported.
+type RequiresImportCheck struct{}
+
+func (r RequiresImportCheck) Run() (errors []error) {
+	packages, err := loadServicePackages()
+	if err != nil {
+		return []error{err}
+	}
+
+	for _, pkg := range packages {
+		errors = append(errors, r.checkPackage(pkg)...)
+	}
+
+	return
+}
+
+func (r RequiresImportCheck) checkPackage(pkg *servicePackage) (errors []error) {
+	for _, f := range pkg.files {
+		if isDataSourceFile(f.path) {
+			continue
+		}
+
+		for _, decl := range f.file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Body == nil || !(isUntypedResourceFunc(fn, "Create") || isUntypedResourceFunc(fn, "CreateUpdate")) {
+				continue
+			}
+			if !pkg.reaches(fn.Body, checksRequiresImport) {
+				errors = append(errors, pkg.violation(fn, "%s should return 'tf.ImportAsExistsError' when the resource already exists", fn.Name.Name))
+			}
+		}
+	}
+
+	for name, methods := range pkg.typedResourceMethods() {
+		create := methods["Create"]
+		if pkg.reaches(create.Body, checksRequiresImport) {
+			continue
+		}
+		errors = append(errors, pkg.violation(create, "%s.Create should return 'metadata.ResourceRequiresImport' when the resource already exists", name))
+	}
+
+	return
+}
+
+// checksRequiresImport returns whether node returns one of the errors used when a resource already exists, i.e.
+// `metadata.ResourceRequiresImport`, `tf.ImportAsExistsError` or `tf.ImportAsExistsAssociationError`.
+func checksRequiresImport(node ast.Node) (found bool) {
+	ast.Inspect(node, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		if _, receiver, method, ok := methodCall(n); ok {
+			found = method == "ResourceRequiresImport" || (receiver == "tf" && strings.HasPrefix(method, "ImportAsExists"))
+		}
+		return !found
+	})
+	return
+}
+
+func (r RequiresImportCheck) Name() string {
+	return "requiresImport"
+}
+
+func (r RequiresImportCheck) Description() string {
+	return fmt.Sprintf(`
+The '%s' check ensures the Create function of each Resource checks whether it already exists, returning
+'tf.ImportAsExistsError' (untyped) or 'metadata.ResourceRequiresImport' (typed) so that existing
+resources are imported rather than being silently adopted.
+`, r.Name())
+}
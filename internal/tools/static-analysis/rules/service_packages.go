@@ -0,0 +1,368 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// servicesPath is the directory containing the Service Packages checked by the AST-based rules
+const servicesPath = "internal/services"
+
+// servicePackage is the parsed (non-test) source of a single package within a Service, e.g. `internal/services/network`
+type servicePackage struct {
+	dir   string
+	fset  *token.FileSet
+	files []*serviceFile
+
+	// schemaTypes maps each property name defined in a schema within the package to the types it's defined as,
+	// since a property name can be used in multiple schemas. Properties whose schema is returned by a function
+	// (e.g. `commonschema.Tags()`) have an empty type.
+	schemaTypes map[string][]string
+
+	// funcs are the functions and methods declared in the package by name, used to follow calls to helpers
+	funcs map[string][]*ast.FuncDecl
+}
+
+type serviceFile struct {
+	path string
+	file *ast.File
+}
+
+var (
+	parsedServicePackages     []*servicePackage
+	parsedServicePackagesErr  error
+	parseServicePackagesMutex sync.Mutex
+)
+
+// loadServicePackages parses the Service Packages once, so they can be shared between the AST-based rules.
+func loadServicePackages() ([]*servicePackage, error) {
+	parseServicePackagesMutex.Lock()
+	defer parseServicePackagesMutex.Unlock()
+
+	if parsedServicePackages == nil && parsedServicePackagesErr == nil {
+		parsedServicePackages, parsedServicePackagesErr = parseServicePackages(servicesPath)
+	}
+	return parsedServicePackages, parsedServicePackagesErr
+}
+
+func parseServicePackages(root string) ([]*servicePackage, error) {
+	filesByDir := make(map[string][]string)
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+
+		dir := filepath.Dir(path)
+		filesByDir[dir] = append(filesByDir[dir], path)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("walking %s: %+v", root, err)
+	}
+
+	dirs := make([]string, 0, len(filesByDir))
+	for dir := range filesByDir {
+		dirs = append(dirs, dir)
+	}
+	sort.Strings(dirs)
+
+	packages := make([]*servicePackage, 0, len(dirs))
+	for _, dir := range dirs {
+		pkg := newServicePackage(dir)
+		for _, path := range filesByDir[dir] {
+			if err := pkg.parseFile(path, nil); err != nil {
+				return nil, err
+			}
+		}
+		packages = append(packages, pkg)
+	}
+
+	return packages, nil
+}
+
+func newServicePackage(dir string) *servicePackage {
+	return &servicePackage{
+		dir:         dir,
+		fset:        token.NewFileSet(),
+		schemaTypes: make(map[string][]string),
+		funcs:       make(map[string][]*ast.FuncDecl),
+	}
+}
+
+// parseFile parses and indexes a file in the package, the source is read from the path when src is nil.
+func (p *servicePackage) parseFile(path string, src interface{}) error {
+	file, err := parser.ParseFile(p.fset, path, src, parser.SkipObjectResolution)
+	if err != nil {
+		return fmt.Errorf("parsing %s: %+v", path, err)
+	}
+	p.files = append(p.files, &serviceFile{
+		path: path,
+		file: file,
+	})
+	p.indexSchemas(file)
+	p.indexFuncs(file)
+	return nil
+}
+
+// indexSchemas records the properties defined in each `map[string]*pluginsdk.Schema` within the file, including those
+// added to an existing map, e.g. `s["name"] = &pluginsdk.Schema{...}`.
+func (p *servicePackage) indexSchemas(file *ast.File) {
+	ast.Inspect(file, func(n ast.Node) bool {
+		switch v := n.(type) {
+		case *ast.CompositeLit:
+			if !isSchemaMap(v.Type) {
+				return true
+			}
+			for _, elt := range v.Elts {
+				kv, ok := elt.(*ast.KeyValueExpr)
+				if !ok {
+					continue
+				}
+				if name, ok := stringLiteral(kv.Key); ok {
+					p.schemaTypes[name] = append(p.schemaTypes[name], schemaType(kv.Value))
+				}
+			}
+
+		case *ast.AssignStmt:
+			if len(v.Lhs) != 1 || len(v.Rhs) != 1 || !isSchemaLiteral(v.Rhs[0]) {
+				return true
+			}
+			index, ok := v.Lhs[0].(*ast.IndexExpr)
+			if !ok {
+				return true
+			}
+			if name, ok := stringLiteral(index.Index); ok {
+				p.schemaTypes[name] = append(p.schemaTypes[name], schemaType(v.Rhs[0]))
+			}
+		}
+		return true
+	})
+}
+
+func (p *servicePackage) indexFuncs(file *ast.File) {
+	for _, decl := range file.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && fn.Body != nil {
+			p.funcs[fn.Name.Name] = append(p.funcs[fn.Name.Name], fn)
+		}
+	}
+}
+
+// reaches returns whether match is true for node, or for any function within the package which is called from it -
+// since Resources commonly share their Create/Read functions between several resource types.
+func (p *servicePackage) reaches(node ast.Node, match func(ast.Node) bool) bool {
+	return p.reachesFrom(node, match, make(map[*ast.FuncDecl]bool))
+}
+
+func (p *servicePackage) reachesFrom(node ast.Node, match func(ast.Node) bool, visited map[*ast.FuncDecl]bool) (found bool) {
+	if match(node) {
+		return true
+	}
+
+	ast.Inspect(node, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		_, name := selectorName(call.Fun)
+		for _, fn := range p.funcs[name] {
+			if visited[fn] {
+				continue
+			}
+			visited[fn] = true
+			if p.reachesFrom(fn.Body, match, visited) {
+				found = true
+				break
+			}
+		}
+		return !found
+	})
+	return
+}
+
+// isServiceRoot returns whether the package is the root of a Service (e.g. `internal/services/network`) rather than
+// a nested package such as `internal/services/network/validate`, since Resources are defined in the former.
+func (p *servicePackage) isServiceRoot() bool {
+	return filepath.Dir(filepath.Clean(p.dir)) == filepath.Clean(servicesPath)
+}
+
+// violation returns a Violation at the position of node.
+func (p *servicePackage) violation(node ast.Node, format string, a ...interface{}) error {
+	pos := p.fset.Position(node.Pos())
+	return Violation{
+		File:    filepath.ToSlash(pos.Filename),
+		Line:    pos.Line,
+		Message: fmt.Sprintf(format, a...),
+	}
+}
+
+// isSchemaMap returns whether expr is the type `map[string]*pluginsdk.Schema` (or `*schema.Schema`).
+func isSchemaMap(expr ast.Expr) bool {
+	m, ok := expr.(*ast.MapType)
+	if !ok {
+		return false
+	}
+	star, ok := m.Value.(*ast.StarExpr)
+	if !ok {
+		return false
+	}
+	_, name := selectorName(star.X)
+	return name == "Schema"
+}
+
+// isSchemaLiteral returns whether expr is a `&pluginsdk.Schema{...}` literal.
+func isSchemaLiteral(expr ast.Expr) bool {
+	unary, ok := expr.(*ast.UnaryExpr)
+	if !ok || unary.Op != token.AND {
+		return false
+	}
+	lit, ok := unary.X.(*ast.CompositeLit)
+	if !ok {
+		return false
+	}
+	_, name := selectorName(lit.Type)
+	return name == "Schema"
+}
+
+// schemaType returns the `Type` of a schema literal, e.g. `TypeList`, or an empty string if it can't be determined.
+func schemaType(expr ast.Expr) string {
+	if unary, ok := expr.(*ast.UnaryExpr); ok && unary.Op == token.AND {
+		expr = unary.X
+	}
+	lit, ok := expr.(*ast.CompositeLit)
+	if !ok {
+		return ""
+	}
+
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		if key, ok := kv.Key.(*ast.Ident); ok && key.Name == "Type" {
+			_, name := selectorName(kv.Value)
+			return name
+		}
+	}
+	return ""
+}
+
+// selectorName returns the receiver and name of a selector, e.g. `pluginsdk` and `TypeList` for
+// `pluginsdk.TypeList`, where the receiver is rendered as a dotted path, e.g. `metadata.ResourceDiff`.
+func selectorName(expr ast.Expr) (string, string) {
+	switch v := expr.(type) {
+	case *ast.Ident:
+		return "", v.Name
+	case *ast.SelectorExpr:
+		return exprPath(v.X), v.Sel.Name
+	}
+	return "", ""
+}
+
+// exprPath renders an identifier or chain of selectors as a dotted path, or an empty string for other expressions.
+func exprPath(expr ast.Expr) string {
+	switch v := expr.(type) {
+	case *ast.Ident:
+		return v.Name
+	case *ast.SelectorExpr:
+		if x := exprPath(v.X); x != "" {
+			return x + "." + v.Sel.Name
+		}
+	}
+	return ""
+}
+
+// methodCall returns the receiver path and method of a call such as `d.Set(...)`.
+func methodCall(node ast.Node) (*ast.CallExpr, string, string, bool) {
+	call, ok := node.(*ast.CallExpr)
+	if !ok {
+		return nil, "", "", false
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return nil, "", "", false
+	}
+	return call, exprPath(sel.X), sel.Sel.Name, true
+}
+
+func stringLiteral(expr ast.Expr) (string, bool) {
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+	value, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return "", false
+	}
+	return value, true
+}
+
+// receiverTypeName returns the name of the type a method is declared on, e.g. `ExampleResource` for both
+// `func (r ExampleResource)` and `func (r *ExampleResource)`.
+func receiverTypeName(decl *ast.FuncDecl) string {
+	if decl.Recv == nil || len(decl.Recv.List) == 0 {
+		return ""
+	}
+	t := decl.Recv.List[0].Type
+	if star, ok := t.(*ast.StarExpr); ok {
+		t = star.X
+	}
+	if ident, ok := t.(*ast.Ident); ok {
+		return ident.Name
+	}
+	return ""
+}
+
+// typedResourceMethods returns the methods declared on each type in the package, used to find Typed Resources (which
+// have a Create method) and their Read/Create functions.
+func (p *servicePackage) typedResourceMethods() map[string]map[string]*ast.FuncDecl {
+	methods := make(map[string]map[string]*ast.FuncDecl)
+	for _, f := range p.files {
+		for _, decl := range f.file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Body == nil {
+				continue
+			}
+			name := receiverTypeName(fn)
+			if name == "" {
+				continue
+			}
+			if methods[name] == nil {
+				methods[name] = make(map[string]*ast.FuncDecl)
+			}
+			methods[name][fn.Name.Name] = fn
+		}
+	}
+
+	for name, m := range methods {
+		if !returnsResourceFunc(m["Create"]) {
+			delete(methods, name)
+		}
+	}
+	return methods
+}
+
+// returnsResourceFunc returns whether the method returns an `sdk.ResourceFunc`.
+func returnsResourceFunc(fn *ast.FuncDecl) bool {
+	if fn == nil || fn.Type.Results == nil || len(fn.Type.Results.List) != 1 {
+		return false
+	}
+	_, name := selectorName(fn.Type.Results.List[0].Type)
+	return name == "ResourceFunc"
+}
@@ -0,0 +1,235 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"errors"
+	"testing"
+)
+
+const testResourceSource = `package example
+
+func resourceExample() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+			},
+
+			"network": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"subnet_id": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"identity": commonschema.SystemAssignedIdentityOptional(),
+
+			"tags": commonschema.Tags(),
+		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+			diff.Get("network.0.subnet_id")
+			diff.Get("identity.0.type")
+			diff.Get("network.0.subnet")
+			return diff.ForceNew("sku_name")
+		}),
+	}
+}
+
+func resourceExampleCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	existing, err := client.Get(ctx, id)
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_example", id.ID())
+	}
+	return resourceExampleRead(d, meta)
+}
+
+func resourceExampleUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	return resourceExampleRead(d, meta)
+}
+
+func resourceExampleRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	return read(d, meta)
+}
+
+func read(d *pluginsdk.ResourceData, meta interface{}) error {
+	resp, err := client.Get(ctx, id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.Set("name", id.Name)
+	d.Set("network", flattenNetwork(resp.Model))
+	_ = d.Set("tags", resp.Model.Tags)
+	if err := d.Set("network", flattenNetwork(resp.Model)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func resourceOtherCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	return resourceOtherRead(d, meta)
+}
+
+func resourceOtherRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	resp, err := client.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+`
+
+const testTypedResourceSource = `package example
+
+type ExampleResource struct{}
+
+func (r ExampleResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, meta sdk.ResourceMetaData) error {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return meta.ResourceRequiresImport(r.ResourceType(), id)
+			}
+			meta.Encode(&model)
+			return nil
+		},
+	}
+}
+
+func (r ExampleResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+			}
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r ExampleResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			metadata.ResourceDiff.GetChange("unknown_property")
+			return nil
+		},
+	}
+}
+
+type OtherResource struct{}
+
+func (r OtherResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			_ = metadata.Encode(&model)
+			return nil
+		},
+	}
+}
+
+func (r OtherResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			return metadata.Encode(&model)
+		},
+	}
+}
+`
+
+func testServicePackage(t *testing.T) *servicePackage {
+	pkg := newServicePackage("internal/services/example")
+	files := map[string]string{
+		"internal/services/example/example_resource.go":       testResourceSource,
+		"internal/services/example/example_typed_resource.go": testTypedResourceSource,
+	}
+	for path, src := range files {
+		if err := pkg.parseFile(path, src); err != nil {
+			t.Fatalf("parsing %s: %+v", path, err)
+		}
+	}
+	return pkg
+}
+
+func expectViolations(t *testing.T, actual []error, expected []string) {
+	if len(actual) != len(expected) {
+		t.Fatalf("expected %d violations but got %d: %+v", len(expected), len(actual), actual)
+	}
+
+	for _, e := range expected {
+		found := false
+		for _, err := range actual {
+			var v Violation
+			if !errors.As(err, &v) {
+				t.Fatalf("expected a Violation but got %T: %+v", err, err)
+			}
+			if err.Error() == e {
+				found = true
+			}
+		}
+		if !found {
+			t.Fatalf("expected the violation %q but got %+v", e, actual)
+		}
+	}
+}
+
+func TestIgnoredSetErrorCheck(t *testing.T) {
+	expectViolations(t, IgnoredSetErrorCheck{}.checkPackage(testServicePackage(t)), []string{
+		`internal/services/example/example_resource.go:65: the error returned from 'd.Set("network", ...)' should be checked since "network" is a List, Set or Map`,
+		`internal/services/example/example_typed_resource.go:12: the error returned from 'meta.Encode' should be checked`,
+		`internal/services/example/example_typed_resource.go:47: the error returned from 'metadata.Encode' should be checked`,
+	})
+}
+
+func TestReadNotFoundCheck(t *testing.T) {
+	expectViolations(t, ReadNotFoundCheck{}.checkPackage(testServicePackage(t)), []string{
+		`internal/services/example/example_resource.go:77: resourceOtherRead should call 'd.SetId("")' when the resource is not found`,
+		`internal/services/example/example_typed_resource.go:53: OtherResource.Read should call 'metadata.MarkAsGone' when the resource is not found`,
+	})
+}
+
+func TestTypedTimeoutCheck(t *testing.T) {
+	expectViolations(t, TypedTimeoutCheck{}.checkPackage(testServicePackage(t)), []string{
+		"internal/services/example/example_typed_resource.go:33: sdk.ResourceFunc is missing a Timeout",
+		"internal/services/example/example_typed_resource.go:44: sdk.ResourceFunc has a Timeout without a unit, e.g. `time.Minute`",
+	})
+}
+
+func TestCustomizeDiffSchemaCheck(t *testing.T) {
+	expectViolations(t, CustomizeDiffSchemaCheck{}.checkPackage(testServicePackage(t)), []string{
+		`internal/services/example/example_resource.go:32: Get("network.0.subnet") references "subnet" which isn't defined in the schema`,
+		`internal/services/example/example_resource.go:33: ForceNew("sku_name") references "sku_name" which isn't defined in the schema`,
+		`internal/services/example/example_typed_resource.go:35: GetChange("unknown_property") references "unknown_property" which isn't defined in the schema`,
+	})
+
+	nested := newServicePackage("internal/services/example/validate")
+	if err := nested.parseFile("internal/services/example/validate/example.go", testResourceSource); err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+	expectViolations(t, CustomizeDiffSchemaCheck{}.checkPackage(nested), nil)
+}
+
+func TestRequiresImportCheck(t *testing.T) {
+	expectViolations(t, RequiresImportCheck{}.checkPackage(testServicePackage(t)), []string{
+		"internal/services/example/example_resource.go:73: resourceOtherCreate should return 'tf.ImportAsExistsError' when the resource already exists",
+		"internal/services/example/example_typed_resource.go:43: OtherResource.Create should return 'metadata.ResourceRequiresImport' when the resource already exists",
+	})
+}
@@ -0,0 +1,85 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+)
+
+var _ Rule = TypedTimeoutCheck{}
+
+// TypedTimeoutCheck flags `sdk.ResourceFunc`s without a Timeout, since the function is run within a context with that
+// timeout - which would expire immediately.
+type TypedTimeoutCheck struct{}
+
+func (r TypedTimeoutCheck) Run() (errors []error) {
+	packages, err := loadServicePackages()
+	if err != nil {
+		return []error{err}
+	}
+
+	for _, pkg := range packages {
+		errors = append(errors, r.checkPackage(pkg)...)
+	}
+
+	return
+}
+
+func (r TypedTimeoutCheck) checkPackage(pkg *servicePackage) (errors []error) {
+	for _, f := range pkg.files {
+		ast.Inspect(f.file, func(n ast.Node) bool {
+			lit, ok := n.(*ast.CompositeLit)
+			if !ok {
+				return true
+			}
+			if receiver, name := selectorName(lit.Type); receiver != "sdk" || name != "ResourceFunc" {
+				return true
+			}
+
+			timeout := resourceFuncTimeout(lit)
+			switch {
+			case timeout == nil:
+				errors = append(errors, pkg.violation(lit, "sdk.ResourceFunc is missing a Timeout"))
+			case isIntLiteral(timeout):
+				// an untyped constant is a number of nanoseconds, e.g. `Timeout: 5` rather than `5 * time.Minute`
+				errors = append(errors, pkg.violation(lit, "sdk.ResourceFunc has a Timeout without a unit, e.g. `time.Minute`"))
+			}
+			return true
+		})
+	}
+
+	return
+}
+
+func resourceFuncTimeout(lit *ast.CompositeLit) ast.Expr {
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		if key, ok := kv.Key.(*ast.Ident); ok && key.Name == "Timeout" {
+			return kv.Value
+		}
+	}
+	return nil
+}
+
+func isIntLiteral(expr ast.Expr) bool {
+	lit, ok := expr.(*ast.BasicLit)
+	return ok && lit.Kind == token.INT
+}
+
+func (r TypedTimeoutCheck) Name() string {
+	return "typedTimeout"
+}
+
+func (r TypedTimeoutCheck) Description() string {
+	return fmt.Sprintf(`
+The '%s' check ensures each 'sdk.ResourceFunc' (Create/Read/Update/Delete and CustomizeDiff) in a Typed
+Resource specifies a Timeout with a unit (e.g. '5 * time.Minute'), since the function is run with a context which
+expires after it.
+`, r.Name())
+}
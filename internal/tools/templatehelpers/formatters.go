@@ -6,7 +6,6 @@ package templatehelpers
 import (
 	"fmt"
 	"regexp"
-	"slices"
 	"strconv"
 	"strings"
 	"text/template"
@@ -100,7 +99,9 @@ func NewIDResourceIdentityFormatter(idType []string, idSegments []string, prefix
 
 	f := "%s.New%s(%s)"
 	out := make([]string, 0)
-	out = append(out, idSegments...)
+	for _, v := range idSegments {
+		out = append(out, fmt.Sprintf("%s.%s", prefix, v))
+	}
 
 	output := fmt.Sprintf(f, idType[0], IdToID(idType[1]), strings.Join(out, ", "))
 
@@ -114,21 +115,15 @@ func NewIDCreateFormatter(idType []string, idSegments []string, prefix string) s
 
 	f := "%s.New%s(%s)"
 	out := make([]string, 0)
-	out = append(out, idSegments...)
-
-	if slices.Contains(out, "SubscriptionId") {
-		f = "%s.New%s(metadata.Client.Account.SubscriptionId, %s)"
-		out = slices.Delete(out, slices.Index(out, "SubscriptionId"), 1)
-		out = out[:len(out)-1]
-	}
-
-	if slices.Contains(out, "ResourceGroup") {
-		idx := slices.Index(out, "ResourceGroup")
-		out = slices.Replace(out, idx, idx, "ResourceGroupName")
-	}
-
-	for i, v := range out {
-		out = slices.Replace(out, i, i, fmt.Sprintf("%s.%s.ValueString()", prefix, v))
+	for _, v := range idSegments {
+		switch v {
+		case "SubscriptionId":
+			out = append(out, "metadata.Client.Account.SubscriptionId")
+		case "ResourceGroup":
+			out = append(out, fmt.Sprintf("%s.ResourceGroupName.ValueString()", prefix))
+		default:
+			out = append(out, fmt.Sprintf("%s.%s.ValueString()", prefix, v))
+		}
 	}
 
 	return fmt.Sprintf(f, idType[0], IdToID(idType[1]), strings.Join(out, ", "))
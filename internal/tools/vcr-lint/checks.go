@@ -0,0 +1,200 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/vcr"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
+)
+
+var (
+	guid = `[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}`
+
+	subscriptionIdRe = regexp.MustCompile(`(?i)(?:/subscriptions/|"subscriptionId":\s*")(` + guid + `)`)
+	tenantIdRe       = regexp.MustCompile(`(?i)(?:/tenants/|"tenantId":\s*"|login\.microsoftonline\.com/)(` + guid + `)`)
+	emailRe          = regexp.MustCompile(`[A-Za-z0-9._%+-]+@([A-Za-z0-9-]+(?:\.[A-Za-z0-9-]+)*\.[A-Za-z]{2,})`)
+	jwtRe            = regexp.MustCompile(`eyJ[A-Za-z0-9_-]{10,}\.eyJ[A-Za-z0-9_-]{10,}\.[A-Za-z0-9_-]+`)
+
+	placeholderIds = map[string]bool{
+		vcr.SubscriptionPlaceholder:     true,
+		vcr.SubscriptionPlaceholderAlt:  true,
+		vcr.SubscriptionPlaceholderAlt2: true,
+		vcr.TenantPlaceholder:           true,
+	}
+
+	// allowedEmailDomains are domains which are reserved for documentation, or are otherwise not sensitive
+	allowedEmailDomains = []string{
+		"example.com",
+		"example.net",
+		"example.org",
+		"contoso.com",
+		"github.com",
+	}
+)
+
+// Finding is a single problem found in a cassette.
+type Finding struct {
+	Interaction int
+	Location    string
+	Message     string
+}
+
+func (f Finding) String() string {
+	if f.Interaction < 0 {
+		return f.Message
+	}
+	return fmt.Sprintf("interaction %d %s: %s", f.Interaction, f.Location, f.Message)
+}
+
+// secretChecker checks cassettes for values which should have been redacted when recording.
+type secretChecker struct {
+	rules []namedScrubber
+}
+
+type namedScrubber struct {
+	name     string
+	scrubber *vcr.Scrubber
+}
+
+func newSecretChecker() (*secretChecker, error) {
+	rules, err := vcr.ScrubRules()
+	if err != nil {
+		return nil, err
+	}
+
+	checker := &secretChecker{}
+	for _, rule := range rules {
+		scrubber, err := vcr.NewScrubber(rule)
+		if err != nil {
+			return nil, err
+		}
+		checker.rules = append(checker.rules, namedScrubber{
+			name:     rule.Name,
+			scrubber: scrubber,
+		})
+	}
+
+	return checker, nil
+}
+
+// Check returns the findings for each interaction in the cassette.
+func (c *secretChecker) Check(cas *cassette.Cassette) []Finding {
+	findings := make([]Finding, 0)
+	for _, i := range cas.Interactions {
+		values := []struct {
+			location string
+			value    string
+			body     bool
+		}{
+			{location: "request url", value: i.Request.URL},
+			{location: "request body", value: i.Request.Body, body: true},
+			{location: "request headers", value: headersToString(i.Request.Headers)},
+			{location: "response body", value: i.Response.Body, body: true},
+			{location: "response headers", value: headersToString(i.Response.Headers)},
+		}
+
+		for _, v := range values {
+			for _, message := range c.checkValue(v.value, v.body) {
+				findings = append(findings, Finding{
+					Interaction: i.ID,
+					Location:    v.location,
+					Message:     message,
+				})
+			}
+		}
+
+		if auth := i.Request.Headers.Get("Authorization"); auth != "" && auth != "Bearer REDACTED" {
+			findings = append(findings, Finding{
+				Interaction: i.ID,
+				Location:    "request headers",
+				Message:     "unredacted `Authorization` header",
+			})
+		}
+	}
+
+	return findings
+}
+
+func (c *secretChecker) checkValue(value string, body bool) []string {
+	if value == "" {
+		return nil
+	}
+
+	messages := make([]string, 0)
+	for _, rule := range c.rules {
+		scrubbed := rule.scrubber.ScrubString(value)
+		if body {
+			scrubbed = rule.scrubber.ScrubBody(value)
+		}
+		if scrubbed != value {
+			messages = append(messages, fmt.Sprintf("unredacted secret (%s)", rule.name))
+		}
+	}
+
+	for _, match := range subscriptionIdRe.FindAllStringSubmatch(value, -1) {
+		if !placeholderIds[strings.ToLower(match[1])] {
+			messages = append(messages, fmt.Sprintf("unredacted Subscription ID %q", match[1]))
+		}
+	}
+
+	for _, match := range tenantIdRe.FindAllStringSubmatch(value, -1) {
+		if !placeholderIds[strings.ToLower(match[1])] {
+			messages = append(messages, fmt.Sprintf("unredacted Tenant ID %q", match[1]))
+		}
+	}
+
+	for _, match := range emailRe.FindAllStringSubmatch(value, -1) {
+		if !isAllowedEmailDomain(match[1]) {
+			messages = append(messages, fmt.Sprintf("email address %q", match[0]))
+		}
+	}
+
+	if jwtRe.MatchString(value) {
+		messages = append(messages, "JSON Web Token")
+	}
+
+	return dedupe(messages)
+}
+
+func isAllowedEmailDomain(domain string) bool {
+	domain = strings.ToLower(domain)
+	for _, allowed := range allowedEmailDomains {
+		if domain == allowed || strings.HasSuffix(domain, "."+allowed) {
+			return true
+		}
+	}
+	return false
+}
+
+func headersToString(headers http.Header) string {
+	keys := make([]string, 0, len(headers))
+	for k := range headers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	lines := make([]string, 0, len(keys))
+	for _, k := range keys {
+		lines = append(lines, fmt.Sprintf("%s: %s", k, strings.Join(headers[k], ", ")))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func dedupe(input []string) []string {
+	seen := make(map[string]bool)
+	output := make([]string, 0, len(input))
+	for _, v := range input {
+		if !seen[v] {
+			seen[v] = true
+			output = append(output, v)
+		}
+	}
+	return output
+}
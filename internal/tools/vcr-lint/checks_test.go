@@ -0,0 +1,74 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"net/http"
+	"testing"
+
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
+)
+
+func TestSecretChecker(t *testing.T) {
+	checker, err := newSecretChecker()
+	if err != nil {
+		t.Fatalf("building secret checker: %+v", err)
+	}
+
+	testData := []struct {
+		name     string
+		request  cassette.Request
+		response cassette.Response
+		expected []string
+	}{
+		{
+			name: "redacted",
+			request: cassette.Request{
+				URL:     "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/example/listKeys",
+				Headers: http.Header{"Authorization": []string{"Bearer REDACTED"}},
+			},
+			response: cassette.Response{
+				Body: `{"keys":[{"keyName":"key1","value":"REDACTED"}],"tenantId":"00000000-0000-0000-0000-000000000010","owner":"someone@example.com"}`,
+			},
+		},
+		{
+			name: "unredacted",
+			request: cassette.Request{
+				URL:     "https://management.azure.com/subscriptions/12345678-1234-1234-1234-123456789012/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/example/listKeys",
+				Headers: http.Header{"Authorization": []string{"Bearer eyJ0eXAiOiJKV1QiLCJhbGciOiJIUzI1NiJ9.eyJzdWIiOiIxMjM0NTY3ODkwIn0.signature"}},
+			},
+			response: cassette.Response{
+				Body: `{"keys":[{"keyName":"key1","value":"c2VjcmV0MQ=="}],"tenantId":"87654321-4321-4321-4321-210987654321","owner":"someone@corp.net"}`,
+			},
+			expected: []string{
+				`interaction 0 request url: unredacted Subscription ID "12345678-1234-1234-1234-123456789012"`,
+				"interaction 0 request headers: JSON Web Token",
+				"interaction 0 response body: unredacted secret (storage account listKeys)",
+				`interaction 0 response body: unredacted Tenant ID "87654321-4321-4321-4321-210987654321"`,
+				`interaction 0 response body: email address "someone@corp.net"`,
+				"interaction 0 request headers: unredacted `Authorization` header",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		c := cassette.New(v.name)
+		c.AddInteraction(&cassette.Interaction{
+			Request:  v.request,
+			Response: v.response,
+		})
+
+		findings := checker.Check(c)
+		if len(findings) != len(v.expected) {
+			t.Fatalf("expected %d findings but got %d: %+v", len(v.expected), len(findings), findings)
+		}
+		for i, finding := range findings {
+			if finding.String() != v.expected[i] {
+				t.Fatalf("expected finding %d to be %q but got %q", i, v.expected[i], finding.String())
+			}
+		}
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/vcr"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
+)
+
+const (
+	testDataDirectory = "vcrtestdata"
+	cassetteSuffix    = ".yaml"
+)
+
+func main() {
+	path := flag.String("path", "internal/services", "the directory to search for `vcrtestdata` directories")
+	allowUncompressed := flag.Bool("allow-uncompressed", false, "don't report cassettes which haven't been compressed")
+	skipStale := flag.Bool("skip-stale", false, "don't report interactions which weren't requested when the cassette was last replayed")
+	flag.Parse()
+
+	checker, err := newSecretChecker()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "building secret checker: %+v\n", err)
+		os.Exit(1)
+	}
+
+	cassettes, staleReports, err := findFiles(*path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "searching %s for cassettes: %+v\n", *path, err)
+		os.Exit(1)
+	}
+
+	reports := make(map[string][]Finding)
+	for _, name := range cassettes {
+		findings := make([]Finding, 0)
+
+		if !*allowUncompressed && !fileExists(name+cassetteSuffix+vcr.GzipSuffix) {
+			findings = append(findings, Finding{
+				Interaction: -1,
+				Message:     "cassette isn't compressed, re-record the test or gzip the cassette",
+			})
+		}
+
+		c, err := cassette.LoadWithFS(name, &vcr.GzipFS{})
+		if err != nil {
+			findings = append(findings, Finding{
+				Interaction: -1,
+				Message:     fmt.Sprintf("loading cassette: %+v", err),
+			})
+		} else {
+			findings = append(findings, checker.Check(c)...)
+		}
+
+		if len(findings) > 0 {
+			reports[displayName(name)] = findings
+		}
+	}
+
+	if !*skipStale {
+		for _, path := range staleReports {
+			name := strings.TrimSuffix(path, vcr.StaleReportSuffix)
+			interactions, err := vcr.ReadStaleReport(path)
+			if err != nil {
+				reports[displayName(name)] = append(reports[displayName(name)], Finding{
+					Interaction: -1,
+					Message:     fmt.Sprintf("reading stale interactions report %s: %+v", path, err),
+				})
+				continue
+			}
+
+			for _, i := range interactions {
+				reports[displayName(name)] = append(reports[displayName(name)], Finding{
+					Interaction: i.ID,
+					Location:    "request",
+					Message:     fmt.Sprintf("stale interaction `%s %s` wasn't requested when replaying, re-record the test", i.Method, i.URL),
+				})
+			}
+		}
+	}
+
+	if len(reports) == 0 {
+		fmt.Printf("✅ No issues found in %d cassettes in %s.\n", len(cassettes), *path)
+		return
+	}
+
+	files := make([]string, 0, len(reports))
+	for file := range reports {
+		files = append(files, file)
+	}
+	sort.Strings(files)
+
+	fmt.Fprintf(os.Stderr, "❌ Issues found in %d cassettes:\n\n", len(files))
+	for _, file := range files {
+		fmt.Fprintf(os.Stderr, "%s:\n", file)
+		for _, finding := range reports[file] {
+			fmt.Fprintf(os.Stderr, "  - %s\n", finding)
+		}
+		fmt.Fprintln(os.Stderr)
+	}
+	fmt.Fprintf(os.Stderr, `Cassettes must not contain secrets or stale interactions, see internal/vcr/acceptance-testing-with-govcr.md for
+how these are redacted and how to re-record a test.
+
+To rerun this check locally, use: go run ./internal/tools/vcr-lint -path %s
+`, *path)
+	os.Exit(1)
+}
+
+// findFiles returns the names of the cassettes (without the file extension, as expected by go-vcr) and the paths of
+// the stale interaction reports within any `vcrtestdata` directories beneath root.
+func findFiles(root string) ([]string, []string, error) {
+	cassettes := make(map[string]bool)
+	staleReports := make([]string, 0)
+
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !strings.Contains(filepath.ToSlash(path), "/"+testDataDirectory+"/") {
+			return nil
+		}
+
+		switch {
+		case strings.HasSuffix(path, cassetteSuffix+vcr.GzipSuffix):
+			cassettes[strings.TrimSuffix(path, cassetteSuffix+vcr.GzipSuffix)] = true
+		case strings.HasSuffix(path, cassetteSuffix):
+			cassettes[strings.TrimSuffix(path, cassetteSuffix)] = true
+		case strings.HasSuffix(path, vcr.StaleReportSuffix):
+			staleReports = append(staleReports, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	names := make([]string, 0, len(cassettes))
+	for name := range cassettes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	sort.Strings(staleReports)
+
+	return names, staleReports, nil
+}
+
+func displayName(name string) string {
+	if fileExists(name + cassetteSuffix + vcr.GzipSuffix) {
+		return name + cassetteSuffix + vcr.GzipSuffix
+	}
+	return name + cassetteSuffix
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
@@ -4,17 +4,25 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"log"
 	"math"
 	"os"
 	"path/filepath"
+	"reflect"
 	"sort"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
@@ -29,7 +37,7 @@ func main() {
 	resourceName := f.String("name", "", "The name of the Data Source/Resource which should be generated")
 	brandName := f.String("brand-name", "", "The friendly/brand name of this Data Source/Resource (e.g. Resource Group)")
 	resourceId := f.String("resource-id", "", "An Azure Resource ID showing an example of how to Import this Resource")
-	resourceType := f.String("type", "", "Whether this is a Data Source (data) or a Resource (resource)")
+	resourceType := f.String("type", "", "Whether this is a Data Source (data), a Resource (resource), an Action (action), an Ephemeral Resource (ephemeral) or a List Resource (list)")
 	websitePath := f.String("website-path", "", "The relative path to the website folder")
 
 	// example generation related flags
@@ -60,8 +68,10 @@ func main() {
 		return
 	}
 
-	if *resourceType != "data" && *resourceType != "resource" {
-		quitWithError("The type of the Data Source/Resource specified via `-type` must be either `data` or `resource`")
+	switch *resourceType {
+	case typeDataSource, typeResource, typeAction, typeEphemeral, typeList:
+	default:
+		quitWithError("The type of the Data Source/Resource specified via `-type` must be one of `data`, `resource`, `action`, `ephemeral` or `list`")
 		return
 	}
 
@@ -70,8 +80,7 @@ func main() {
 		return
 	}
 
-	isResource := *resourceType == "resource"
-	if isResource && (resourceId == nil || *resourceId == "") {
+	if *resourceType == typeResource && (resourceId == nil || *resourceId == "") {
 		quitWithError("An example of an Azure Resource ID must be specified via `-resource-id` when scaffolding for a Resource")
 		return
 	}
@@ -85,30 +94,41 @@ func main() {
 		}
 	}
 
-	if err := run(*resourceName, *brandName, resourceId, isResource, *websitePath, expsrc); err != nil {
+	if err := run(*resourceName, *brandName, resourceId, *resourceType, *websitePath, expsrc); err != nil {
 		panic(err)
 	}
 }
 
-func run(resourceName, brandName string, resourceId *string, isResource bool, websitePath string, expsrc *examplegen.ExampleSource) error {
-	content, err := getContent(resourceName, brandName, resourceId, isResource, expsrc)
+const (
+	typeDataSource = "data"
+	typeResource   = "resource"
+	typeAction     = "action"
+	typeEphemeral  = "ephemeral"
+	typeList       = "list"
+)
+
+func run(resourceName, brandName string, resourceId *string, resourceType string, websitePath string, expsrc *examplegen.ExampleSource) error {
+	content, err := getContent(resourceName, brandName, resourceId, resourceType, expsrc)
 	if err != nil {
 		return fmt.Errorf("building content: %s", err)
 	}
 
-	return saveContent(resourceName, websitePath, *content, isResource)
+	return saveContent(resourceName, websitePath, *content, resourceType)
 }
 
-func getContent(resourceName, brandName string, resourceId *string, isResource bool, expsrc *examplegen.ExampleSource) (*string, error) {
+func getContent(resourceName, brandName string, resourceId *string, resourceType string, expsrc *examplegen.ExampleSource) (*string, error) {
 	generator := documentationGenerator{
 		resourceName:  resourceName,
 		brandName:     brandName,
 		resourceId:    resourceId,
-		isDataSource:  !isResource,
+		kind:          resourceType,
 		exampleSource: expsrc,
 	}
 
-	if !isResource {
+	ctx := context.TODO()
+
+	switch resourceType {
+	case typeDataSource:
 		for _, service := range provider.SupportedTypedServices() {
 			for _, ds := range service.DataSources() {
 				if ds.ResourceType() == resourceName {
@@ -137,7 +157,7 @@ func getContent(resourceName, brandName string, resourceId *string, isResource b
 		if generator.resource == nil {
 			return nil, fmt.Errorf("data source %q was not registered", resourceName)
 		}
-	} else {
+	case typeResource:
 		for _, service := range provider.SupportedTypedServices() {
 			for _, rs := range service.Resources() {
 				if rs.ResourceType() == resourceName {
@@ -163,21 +183,263 @@ func getContent(resourceName, brandName string, resourceId *string, isResource b
 			}
 		}
 
+		for _, service := range provider.SupportedFrameworkServices() {
+			for _, rs := range service.FrameworkResources() {
+				if rs.ResourceType() == resourceName {
+					generator.resource = frameworkResourceSchema(ctx, rs)
+					generator.websiteCategories = frameworkWebsiteCategories(service)
+					break
+				}
+			}
+		}
+
 		if generator.resource == nil {
 			return nil, fmt.Errorf("resource %q was not registered", resourceName)
 		}
+	case typeAction:
+		for _, service := range provider.SupportedFrameworkServices() {
+			for _, f := range service.Actions() {
+				a := f()
+				metadata := action.MetadataResponse{}
+				a.Metadata(ctx, action.MetadataRequest{ProviderTypeName: "azurerm"}, &metadata)
+				if metadata.TypeName == resourceName {
+					s := action.SchemaResponse{}
+					a.Schema(ctx, action.SchemaRequest{}, &s)
+
+					generator.resource = frameworkSchemaToResource(s.Schema.Attributes, s.Schema.Blocks)
+					generator.websiteCategories = frameworkWebsiteCategories(service)
+					break
+				}
+			}
+		}
+
+		if generator.resource == nil {
+			return nil, fmt.Errorf("action %q was not registered", resourceName)
+		}
+	case typeEphemeral:
+		for _, service := range provider.SupportedFrameworkServices() {
+			for _, f := range service.EphemeralResources() {
+				e := f()
+				metadata := ephemeral.MetadataResponse{}
+				e.Metadata(ctx, ephemeral.MetadataRequest{ProviderTypeName: "azurerm"}, &metadata)
+				if metadata.TypeName == resourceName {
+					s := ephemeral.SchemaResponse{}
+					e.Schema(ctx, ephemeral.SchemaRequest{}, &s)
+
+					generator.resource = frameworkSchemaToResource(s.Schema.Attributes, s.Schema.Blocks)
+					generator.websiteCategories = frameworkWebsiteCategories(service)
+					break
+				}
+			}
+		}
+
+		if generator.resource == nil {
+			return nil, fmt.Errorf("ephemeral resource %q was not registered", resourceName)
+		}
+	case typeList:
+		for _, service := range provider.SupportedFrameworkServices() {
+			for _, l := range service.ListResources() {
+				wrapper := sdk.FrameworkListResourceWrapper{
+					FrameworkListWrappedResource: l,
+				}
+				metadata := fwresource.MetadataResponse{}
+				wrapper.Metadata(ctx, fwresource.MetadataRequest{ProviderTypeName: "azurerm"}, &metadata)
+				if metadata.TypeName == resourceName {
+					s := list.ListResourceSchemaResponse{}
+					wrapper.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &s)
+
+					// the timeouts are documented separately, since these are the same for all List Resources
+					delete(s.Schema.Blocks, "timeouts")
+
+					generator.resource = frameworkSchemaToResource(s.Schema.Attributes, s.Schema.Blocks)
+					generator.websiteCategories = frameworkWebsiteCategories(service)
+					break
+				}
+			}
+		}
+
+		if generator.resource == nil {
+			return nil, fmt.Errorf("list resource %q was not registered", resourceName)
+		}
 	}
 
 	docs := generator.generate()
 	return &docs, nil
 }
 
-func saveContent(resourceName string, websitePath string, content string, isResource bool) error {
-	resourceKind := "r"
-	if !isResource {
-		resourceKind = "d"
+// frameworkResourceSchema returns the schema of the Framework Resource in the same form as a Plugin SDK Resource,
+// including the timeouts defaulted by the `sdk.ResourceMetadata`.
+func frameworkResourceSchema(ctx context.Context, rs sdk.FrameworkWrappedResource) *schema.Resource {
+	s := fwresource.SchemaResponse{}
+	rs.Schema(ctx, fwresource.SchemaRequest{}, &s)
+
+	// the `id` is documented for all Resources
+	delete(s.Schema.Attributes, "id")
+
+	output := frameworkSchemaToResource(s.Schema.Attributes, s.Schema.Blocks)
+	output.Timeouts = &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(30 * time.Minute),
+		Read:   schema.DefaultTimeout(5 * time.Minute),
+		Delete: schema.DefaultTimeout(30 * time.Minute),
+	}
+	if _, ok := rs.(sdk.FrameworkWrappedResourceWithUpdate); ok {
+		output.Timeouts.Update = schema.DefaultTimeout(30 * time.Minute)
+	}
+
+	return output
+}
+
+// frameworkWebsiteCategories returns the website categories for the Service, which Framework Services expose by also
+// implementing the Typed or Untyped Service Registration.
+func frameworkWebsiteCategories(service sdk.FrameworkServiceRegistration) []string {
+	if v, ok := service.(interface{ WebsiteCategories() []string }); ok {
+		return v.WebsiteCategories()
+	}
+
+	return nil
+}
+
+type frameworkAttribute interface {
+	GetDescription() string
+	GetMarkdownDescription() string
+	GetType() attr.Type
+	IsRequired() bool
+	IsOptional() bool
+	IsComputed() bool
+}
+
+// frameworkSchemaToResource converts the attributes and blocks of a Framework schema into a Plugin SDK Resource, so that
+// the documentation can be generated in the same way. Since each type of Framework schema (Resources, Actions,
+// Ephemeral Resources and List Resources) defines its own types for these, the maps are accessed using reflection.
+func frameworkSchemaToResource(attributes any, blocks any) *schema.Resource {
+	output := &schema.Resource{
+		Schema: map[string]*schema.Schema{},
+	}
+
+	if v := reflect.ValueOf(attributes); v.Kind() == reflect.Map {
+		iter := v.MapRange()
+		for iter.Next() {
+			attribute, ok := iter.Value().Interface().(frameworkAttribute)
+			if !ok {
+				continue
+			}
+
+			field := frameworkTypeToSchema(attribute.GetType())
+			field.Required = attribute.IsRequired()
+			field.Optional = attribute.IsOptional()
+			field.Computed = attribute.IsComputed()
+			field.Description = attribute.GetMarkdownDescription()
+			if field.Description == "" {
+				field.Description = attribute.GetDescription()
+			}
+
+			// nested attributes are documented as blocks, but are configured using the attribute syntax
+			if nestedAttributes, _, ok := frameworkNestedObject(iter.Value()); ok {
+				field.Elem = frameworkSchemaToResource(nestedAttributes, nil)
+				field.ConfigMode = schema.SchemaConfigModeAttr
+			}
+
+			output.Schema[iter.Key().String()] = field
+		}
+	}
+
+	if v := reflect.ValueOf(blocks); v.Kind() == reflect.Map {
+		iter := v.MapRange()
+		for iter.Next() {
+			nestedAttributes, nestedBlocks, ok := frameworkNestedObject(iter.Value())
+			if !ok {
+				continue
+			}
+
+			field := &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     frameworkSchemaToResource(nestedAttributes, nestedBlocks),
+			}
+			if block, ok := iter.Value().Interface().(interface{ Type() attr.Type }); ok {
+				switch block.Type().(type) {
+				case basetypes.SetTypable:
+					field.Type = schema.TypeSet
+				case basetypes.ObjectTypable:
+					field.MaxItems = 1
+				}
+			}
+
+			output.Schema[iter.Key().String()] = field
+		}
+	}
+
+	return output
+}
+
+// frameworkNestedObject returns the attributes and blocks of the object nested within a Framework attribute or block.
+func frameworkNestedObject(v reflect.Value) (attributes any, blocks any, ok bool) {
+	if v.Kind() == reflect.Interface {
+		v = v.Elem()
+	}
+
+	method := v.MethodByName("GetNestedObject")
+	if !method.IsValid() {
+		return nil, nil, false
+	}
+	nested := method.Call(nil)[0]
+	if nested.Kind() == reflect.Interface {
+		nested = nested.Elem()
+	}
+
+	if m := nested.MethodByName("GetAttributes"); m.IsValid() {
+		attributes = m.Call(nil)[0].Interface()
+	}
+	if m := nested.MethodByName("GetBlocks"); m.IsValid() {
+		blocks = m.Call(nil)[0].Interface()
+	}
+
+	return attributes, blocks, true
+}
+
+// frameworkTypeToSchema returns the Plugin SDK Schema for the Framework type, the Elem is only populated for collections
+// of primitive types since nested objects are handled separately.
+func frameworkTypeToSchema(t attr.Type) *schema.Schema {
+	output := &schema.Schema{
+		Type: schema.TypeString,
+	}
+
+	switch t.(type) {
+	case basetypes.BoolTypable:
+		output.Type = schema.TypeBool
+	case basetypes.Int64Typable, basetypes.Int32Typable:
+		output.Type = schema.TypeInt
+	case basetypes.Float64Typable, basetypes.Float32Typable, basetypes.NumberTypable:
+		output.Type = schema.TypeFloat
+	case basetypes.ListTypable:
+		output.Type = schema.TypeList
+	case basetypes.SetTypable:
+		output.Type = schema.TypeSet
+	case basetypes.MapTypable:
+		output.Type = schema.TypeMap
+	case basetypes.ObjectTypable:
+		output.Type = schema.TypeList
+		output.MaxItems = 1
+	}
+
+	if v, ok := t.(attr.TypeWithElementType); ok {
+		if _, isObject := v.ElementType().(basetypes.ObjectTypable); !isObject {
+			output.Elem = frameworkTypeToSchema(v.ElementType())
+		}
 	}
 
+	return output
+}
+
+func saveContent(resourceName string, websitePath string, content string, resourceType string) error {
+	resourceKind := map[string]string{
+		typeDataSource: "d",
+		typeResource:   "r",
+		typeAction:     "actions",
+		typeEphemeral:  "ephemeral-resources",
+		typeList:       "list-resources",
+	}[resourceType]
+
 	fileName := strings.TrimPrefix(resourceName, "azurerm_")
 	outputFileName := fmt.Sprintf("%s/docs/%s/%s.html.markdown", websitePath, resourceKind, fileName)
 	outputPath, err := filepath.Abs(outputFileName)
@@ -212,8 +474,8 @@ type documentationGenerator struct {
 	// resourceName is the name of the resource e.g. `azurerm_resource_group`
 	resourceName string
 
-	// isDataSource defines if this is a Data Source (if not it's a Resource)
-	isDataSource bool
+	// kind is the type of documentation being generated, e.g. `data` for a Data Source or `action` for an Action
+	kind string
 
 	// resourceId is an example of the ID used by this Resource
 	resourceId *string
@@ -230,11 +492,26 @@ func (gen documentationGenerator) generate() string {
 	argumentsBlock := gen.argumentsBlock()
 	attributesBlock := gen.attributesBlock()
 	description := gen.description()
-	exampleUsageBlock := gen.exampleUsageBlock()
+	exampleUsageBlock := fmt.Sprintf("[][][]hcl\n%s\n[][][]", gen.exampleUsageBlock())
+	if gen.kind == typeList {
+		exampleUsageBlock = gen.listExampleUsageBlock()
+	}
 	frontMatterBlock := gen.frontMatterBlock()
 	importBlock := gen.importBlock()
 	timeoutsBlock := gen.timeoutsBlock()
 
+	if gen.kind == typeEphemeral {
+		description = fmt.Sprintf("~> **Note:** Ephemeral Resources are supported in Terraform 1.10 and later.\n\n%s", description)
+	}
+
+	// sections which don't apply to this kind of documentation (e.g. Import for a Data Source) are omitted
+	sections := make([]string, 0)
+	for _, section := range []string{argumentsBlock, attributesBlock, timeoutsBlock, importBlock} {
+		if section != "" {
+			sections = append(sections, section)
+		}
+	}
+
 	template := fmt.Sprintf(`%s
 # %s
 
@@ -242,17 +519,9 @@ func (gen documentationGenerator) generate() string {
 
 ## Example Usage
 
-[][][]hcl
-%s
-[][][]
-
 %s
 
-%s
-
-%s
-
-%s`, frontMatterBlock, title, description, exampleUsageBlock, argumentsBlock, attributesBlock, timeoutsBlock, importBlock)
+%s`, frontMatterBlock, title, description, exampleUsageBlock, strings.Join(sections, "\n\n"))
 	return strings.ReplaceAll(template, "[][][]", "```")
 }
 
@@ -334,6 +603,31 @@ func (gen documentationGenerator) argumentsBlock() string {
 
 	fields = strings.TrimSuffix(fields, "\n\n")
 
+	switch gen.kind {
+	case typeAction:
+		return fmt.Sprintf(`## Argument Reference
+
+This action supports the following arguments:
+
+%s`, fields)
+	case typeEphemeral:
+		return fmt.Sprintf(`## Argument Reference
+
+The following arguments are supported:
+
+%s`, fields)
+	case typeList:
+		if _, ok := gen.resource.Schema["subscription_ids"]; ok {
+			fields += "\n\n-> **Note:** When `subscription_ids` or `management_group_id` is specified, up to 8 Subscriptions are queried concurrently. Subscriptions which resources can't be listed in due to missing permissions are reported as warnings and skipped."
+		}
+
+		return fmt.Sprintf(`## Argument Reference
+
+This list resource supports the following arguments:
+
+%s`, fields)
+	}
+
 	return fmt.Sprintf(`## Arguments Reference
 
 The following arguments are supported:
@@ -342,6 +636,11 @@ The following arguments are supported:
 }
 
 func (gen documentationGenerator) attributesBlock() string {
+	// Actions and List Resources don't export any attributes
+	if gen.kind == typeAction || gen.kind == typeList {
+		return ""
+	}
+
 	documentationForAttributes := func(input map[string]*schema.Schema, onlyComputed bool, blockName string) string {
 		fields := ""
 
@@ -363,8 +662,11 @@ func (gen documentationGenerator) attributesBlock() string {
 		return fields
 	}
 
-	// present in everything
-	fields := fmt.Sprintf("* `id` - The ID of the %s.\n\n", gen.brandName)
+	// present in everything other than Ephemeral Resources
+	fields := ""
+	if gen.kind != typeEphemeral {
+		fields += fmt.Sprintf("* `id` - The ID of the %s.\n\n", gen.brandName)
+	}
 
 	// now list all of the top-level fields / blocks alphabetically
 	fields += documentationForAttributes(gen.resource.Schema, true, "")
@@ -382,6 +684,14 @@ func (gen documentationGenerator) attributesBlock() string {
 
 	fields = strings.TrimSuffix(fields, "\n\n")
 
+	if gen.kind == typeEphemeral {
+		return fmt.Sprintf(`## Attributes Reference
+
+The following attributes are exported:
+
+%s`, fields)
+	}
+
 	return fmt.Sprintf(`## Attributes Reference
 
 In addition to the Arguments listed above - the following Attributes are exported: 
@@ -390,8 +700,15 @@ In addition to the Arguments listed above - the following Attributes are exporte
 }
 
 func (gen documentationGenerator) description() string {
-	if gen.isDataSource {
+	switch gen.kind {
+	case typeDataSource:
 		return fmt.Sprintf("Use this data source to access information about an existing %s", gen.brandName)
+	case typeAction:
+		return fmt.Sprintf("Performs a %s", gen.brandName)
+	case typeEphemeral:
+		return fmt.Sprintf("Use this to access information about an existing %s", gen.brandName)
+	case typeList:
+		return fmt.Sprintf("Lists %s resources", gen.brandName)
 	}
 
 	return fmt.Sprintf("Manages a %s", gen.brandName)
@@ -406,7 +723,32 @@ func (gen documentationGenerator) exampleUsageBlock() string {
 
 	requiredFields := gen.requiredFieldsForExampleBlock(gen.resource.Schema, 1)
 
-	if gen.isDataSource {
+	switch gen.kind {
+	case typeAction:
+		requiredFields = gen.requiredFieldsForExampleBlock(gen.resource.Schema, 2)
+		return fmt.Sprintf(`resource "terraform_data" "example" {
+  input = "TODO"
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.%[1]s.example]
+    }
+  }
+}
+
+action "%[1]s" "example" {
+  config {
+%[2]s
+  }
+}`, gen.resourceName, requiredFields)
+	case typeEphemeral:
+		return fmt.Sprintf(`ephemeral "%s" "example" {
+%s
+}`, gen.resourceName, requiredFields)
+	}
+
+	if gen.kind == typeDataSource {
 		return fmt.Sprintf(`data "%s" "example" {
 %s
 }
@@ -421,6 +763,35 @@ output "id" {
 }`, gen.resourceName, requiredFields)
 }
 
+func (gen documentationGenerator) listExampleUsageBlock() string {
+	template := fmt.Sprintf(`### List all %[1]ss
+
+[][][]hcl
+list "%[2]s" "example" {
+  provider = azurerm
+  config {
+  }
+}
+[][][]`, gen.brandName, gen.resourceName)
+
+	if _, ok := gen.resource.Schema["resource_group_name"]; ok {
+		template += fmt.Sprintf(`
+
+### List all %[1]ss in a Resource Group
+
+[][][]hcl
+list "%[2]s" "example" {
+  provider = azurerm
+  config {
+    resource_group_name = "example-resources"
+  }
+}
+[][][]`, gen.brandName, gen.resourceName)
+	}
+
+	return template
+}
+
 func (gen documentationGenerator) frontMatterBlock() string {
 	category := "TODO"
 	if len(gen.websiteCategories) > 0 {
@@ -433,9 +804,17 @@ func (gen documentationGenerator) frontMatterBlock() string {
 
 	title := gen.title()
 	var description string
-	if gen.isDataSource {
+	switch gen.kind {
+	case typeDataSource:
 		description = fmt.Sprintf("Gets information about an existing %s", gen.brandName)
-	} else {
+	case typeAction, typeEphemeral, typeList:
+		// the page title for these is the name alone, since the kind is already part of the navigation
+		title = gen.resourceName
+		description = gen.description()
+		if gen.kind == typeEphemeral {
+			description = fmt.Sprintf("Gets information about an existing %s", gen.brandName)
+		}
+	default:
 		description = fmt.Sprintf("Manages a %s", gen.brandName)
 	}
 
@@ -451,8 +830,8 @@ description: |-
 }
 
 func (gen documentationGenerator) importBlock() string {
-	// data source don't support import
-	if gen.isDataSource {
+	// only resources support import
+	if gen.kind != typeResource {
 		return ""
 	}
 
@@ -467,6 +846,11 @@ terraform import %s.example %s
 }
 
 func (gen documentationGenerator) timeoutsBlock() string {
+	if gen.kind == typeList {
+		// the timeouts for List Resources are handled by the `sdk.FrameworkListResourceWrapper`, rather than the resource
+		return "## Timeouts\n\nThe `timeouts` block allows you to specify [timeouts](https://developer.hashicorp.com/terraform/language/resources/configure#define-operation-timeouts) for certain actions:\n\n* `list` - (Defaults to 60 minutes) Used when listing the resources."
+	}
+
 	if gen.resource.Timeouts == nil {
 		return ""
 	}
@@ -537,8 +921,15 @@ func (gen documentationGenerator) timeoutsBlock() string {
 }
 
 func (gen documentationGenerator) title() string {
-	if gen.isDataSource {
+	switch gen.kind {
+	case typeDataSource:
 		return fmt.Sprintf("Data Source: %s", gen.resourceName)
+	case typeAction:
+		return fmt.Sprintf("Action: %s", gen.resourceName)
+	case typeEphemeral:
+		return fmt.Sprintf("Ephemeral: %s", gen.resourceName)
+	case typeList:
+		return fmt.Sprintf("List resource: %s", gen.resourceName)
 	}
 
 	return gen.resourceName
@@ -564,9 +955,23 @@ func (gen documentationGenerator) buildIndentForExample(level int) string {
 }
 
 func (gen documentationGenerator) buildDescriptionForArgument(name string, field *schema.Schema, blockName string) string {
+	if gen.kind == typeList && blockName == "" {
+		switch name {
+		case "subscription_id":
+			return "The ID of the Subscription to query."
+		case "resource_group_name":
+			return "The name of the Resource Group to query."
+		}
+
+		// the remaining arguments are filters and options described by the `sdk.FrameworkListResourceWrapper`
+		if field.Description != "" {
+			return field.Description
+		}
+	}
+
 	if name == "name" {
 		if blockName == "" {
-			if gen.isDataSource {
+			if gen.kind == typeDataSource || gen.kind == typeEphemeral {
 				return fmt.Sprintf("The name of this %s.", gen.brandName)
 			}
 
@@ -576,14 +981,14 @@ func (gen documentationGenerator) buildDescriptionForArgument(name string, field
 		}
 	}
 	if name == "location" {
-		if gen.isDataSource {
+		if gen.kind == typeDataSource || gen.kind == typeEphemeral {
 			return fmt.Sprintf("The Azure Region where the %s exists.", gen.brandName)
 		}
 
 		return fmt.Sprintf("The Azure Region where the %s should exist.", gen.brandName)
 	}
 	if name == "resource_group_name" {
-		if gen.isDataSource {
+		if gen.kind == typeDataSource || gen.kind == typeEphemeral {
 			return fmt.Sprintf("The name of the Resource Group where the %s exists.", gen.brandName)
 		}
 
@@ -593,6 +998,11 @@ func (gen documentationGenerator) buildDescriptionForArgument(name string, field
 		return fmt.Sprintf("A mapping of tags which should be assigned to the %s.", gen.brandName)
 	}
 
+	// Framework schemas are converted including their descriptions
+	if field.Description != "" {
+		return field.Description
+	}
+
 	if name == "enabled" || strings.HasSuffix(name, "_enabled") {
 		return "Whether to enable the TODO."
 	}
@@ -649,6 +1059,10 @@ func (gen documentationGenerator) buildDescriptionForAttribute(name string, fiel
 		return fmt.Sprintf("A mapping of tags assigned to the %s.", gen.brandName)
 	}
 
+	if field.Description != "" {
+		return field.Description
+	}
+
 	if name == "enabled" || strings.HasSuffix(name, "_enabled") {
 		return "Whether the TODO is enabled."
 	}
@@ -713,7 +1127,7 @@ func (gen documentationGenerator) determineDefaultValueForExample(name string, f
 	}
 
 	if name == "name" || strings.HasSuffix(name, "_name") {
-		if gen.isDataSource {
+		if gen.kind == typeDataSource || gen.kind == typeEphemeral {
 			return "\"existing\""
 		}
 
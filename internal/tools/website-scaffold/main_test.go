@@ -7,6 +7,8 @@ import (
 	"strings"
 	"testing"
 
+	actionschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/sergi/go-diff/diffmatchpatch"
 )
@@ -156,12 +158,76 @@ A 'block3' block supports the following:
 			},
 		},
 	}
-	gen := setupDocGen(false, resource)
+	gen := setupDocGen(typeResource, resource)
 	actualOut := gen.argumentsBlock()
 
 	runTest(t, expectedOut, actualOut)
 }
 
+func TestActionArgumentBlock(t *testing.T) {
+	expectedOut := strings.ReplaceAll(`## Argument Reference
+
+This action supports the following arguments:
+
+* 'foobar_id' - (Required) The ID of the Foobar to perform the action on.
+
+---
+
+* 'count' - (Optional) TODO.
+
+* 'settings' - (Optional) A 'settings' block as defined below.
+
+* 'zones' - (Optional) Specifies a list of TODO.
+
+---
+
+A 'settings' block supports the following:
+
+* 'enabled' - (Required) Whether to enable the TODO.`, "'", "`")
+
+	s := actionschema.Schema{
+		Attributes: map[string]actionschema.Attribute{
+			"foobar_id": actionschema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the Foobar to perform the action on.",
+			},
+			"count": actionschema.Int64Attribute{
+				Optional: true,
+			},
+			"zones": actionschema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]actionschema.Block{
+			"settings": actionschema.SingleNestedBlock{
+				Attributes: map[string]actionschema.Attribute{
+					"enabled": actionschema.BoolAttribute{
+						Required: true,
+					},
+				},
+			},
+		},
+	}
+
+	resource := frameworkSchemaToResource(s.Attributes, s.Blocks)
+	if v := resource.Schema["count"].Type; v != schema.TypeInt {
+		t.Fatalf("expected `count` to be converted to %s but got %s", schema.TypeInt, v)
+	}
+	if v := resource.Schema["zones"].Elem.(*schema.Schema).Type; v != schema.TypeString {
+		t.Fatalf("expected the elements of `zones` to be converted to %s but got %s", schema.TypeString, v)
+	}
+
+	gen := setupDocGen(typeAction, resource)
+	actualOut := gen.argumentsBlock()
+
+	runTest(t, expectedOut, actualOut)
+
+	if actual := gen.attributesBlock(); actual != "" {
+		t.Fatalf("expected no attributes to be documented for an Action but got %q", actual)
+	}
+}
+
 func runTest(t *testing.T, expected, actual string) {
 	dmp := diffmatchpatch.New()
 	diffs := dmp.DiffMain(actual, expected, true)
@@ -177,7 +243,7 @@ func runTest(t *testing.T, expected, actual string) {
 	}
 }
 
-func setupDocGen(isDataSource bool, resource *schema.Resource) documentationGenerator {
+func setupDocGen(kind string, resource *schema.Resource) documentationGenerator {
 	toStrPtr := func(input string) *string {
 		return &input
 	}
@@ -185,7 +251,7 @@ func setupDocGen(isDataSource bool, resource *schema.Resource) documentationGene
 		resourceName:      RESOURCE_NAME,
 		brandName:         BRAND_NAME,
 		resourceId:        toStrPtr(RESOURCE_ID),
-		isDataSource:      isDataSource,
+		kind:              kind,
 		websiteCategories: []string{WEBSITE_CATEGORY},
 		resource:          resource,
 	}
@@ -11,14 +11,19 @@ import (
 	"path/filepath"
 )
 
-// GzipFS is a cassette.FS implementation that reads and writes gzip-compressed files.
-// Note: Not currently active as we successfully removed the "noise" of the RP status caching which was causing 3.4MiB
-// bloat in the recorded cassettes. When in use the cassettes are not human-readable, so troubleshooting / checking /
-// reviewing is inconvenient.
+// GzipSuffix is appended to the name of cassettes written by GzipFS.
+const GzipSuffix = ".gz"
+
+// GzipFS is a cassette.FS implementation that reads and writes gzip-compressed files, storing `name` as
+// `name.gz`. Cassettes which haven't been compressed are read as-is, so `gunzip`-ing a cassette to troubleshoot /
+// review it doesn't break replay - the next recording writes a compressed cassette again.
 type GzipFS struct{}
 
 func (fs *GzipFS) ReadFile(name string) ([]byte, error) {
-	f, err := os.Open(name)
+	f, err := os.Open(name + GzipSuffix)
+	if os.IsNotExist(err) {
+		return os.ReadFile(name)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -41,7 +46,7 @@ func (fs *GzipFS) WriteFile(name string, data []byte) error {
 		}
 	}
 
-	f, err := os.Create(name)
+	f, err := os.Create(name + GzipSuffix)
 	if err != nil {
 		return err
 	}
@@ -56,11 +61,23 @@ func (fs *GzipFS) WriteFile(name string, data []byte) error {
 		return err
 	}
 
-	_, err = f.Write(buf.Bytes())
-	return err
+	if _, err = f.Write(buf.Bytes()); err != nil {
+		return err
+	}
+
+	// remove any uncompressed copy so that it isn't read in preference to the new recording
+	if err := os.Remove(name); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
 }
 
 func (fs *GzipFS) IsFileExists(name string) bool {
-	_, err := os.Stat(name)
-	return !os.IsNotExist(err)
+	for _, n := range []string{name + GzipSuffix, name} {
+		if _, err := os.Stat(n); err == nil {
+			return true
+		}
+	}
+	return false
 }
@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/recorder"
+)
+
+// collapseLongRunningOperationPolls returns a BeforeSaveHook which discards a poll of a Long Running Operation when
+// it's identical to the previous in-progress poll of the same URL.
+//
+// The number of polls made while recording depends on how long Azure takes to complete the operation. Collapsing
+// them leaves a single in-progress poll followed by the terminal response. Replay then doesn't depend on timing,
+// because the poller requests the next interaction for the URL until it receives the terminal response.
+func collapseLongRunningOperationPolls() recorder.HookFunc {
+	previous := make(map[string]string)
+
+	return func(i *cassette.Interaction) error {
+		if i.Request.Method != http.MethodGet {
+			return nil
+		}
+
+		url := i.Request.URL
+		if !isInProgressPoll(i) {
+			delete(previous, url)
+			return nil
+		}
+
+		fingerprint := fmt.Sprintf("%d\n%s", i.Response.Code, i.Response.Body)
+		if previous[url] == fingerprint {
+			i.DiscardOnSave = true
+			return nil
+		}
+
+		previous[url] = fingerprint
+		return nil
+	}
+}
+
+// isInProgressPoll determines whether the interaction is a poll of a Long Running Operation which hasn't completed,
+// either a `202 Accepted` or an Operation Status response with a non-terminal `status`.
+func isInProgressPoll(i *cassette.Interaction) bool {
+	if i.Response.Code == http.StatusAccepted {
+		return true
+	}
+
+	if i.Response.Code != http.StatusOK {
+		return false
+	}
+
+	var operation struct {
+		Status *string `json:"status"`
+	}
+	if err := json.Unmarshal([]byte(i.Response.Body), &operation); err != nil || operation.Status == nil {
+		return false
+	}
+
+	switch strings.ToLower(*operation.Status) {
+	case "accepted", "inprogress", "notstarted", "running":
+		return true
+	}
+
+	return false
+}
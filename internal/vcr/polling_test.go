@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"net/http"
+	"testing"
+
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
+)
+
+func TestCollapseLongRunningOperationPolls(t *testing.T) {
+	pollUrl := "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Example/locations/westeurope/operationStatuses/1234"
+	resourceUrl := "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Example/things/example"
+
+	interaction := func(method, url string, code int, body string) *cassette.Interaction {
+		return &cassette.Interaction{
+			Request: cassette.Request{
+				Method: method,
+				URL:    url,
+			},
+			Response: cassette.Response{
+				Code: code,
+				Body: body,
+			},
+		}
+	}
+
+	interactions := []struct {
+		interaction *cassette.Interaction
+		discarded   bool
+	}{
+		{interaction: interaction(http.MethodPut, resourceUrl, http.StatusCreated, `{"properties":{"provisioningState":"Creating"}}`)},
+		{interaction: interaction(http.MethodGet, pollUrl, http.StatusOK, `{"status":"InProgress"}`)},
+		{interaction: interaction(http.MethodGet, pollUrl, http.StatusOK, `{"status":"InProgress"}`), discarded: true},
+		{interaction: interaction(http.MethodGet, pollUrl, http.StatusOK, `{"status":"InProgress"}`), discarded: true},
+		{interaction: interaction(http.MethodGet, pollUrl, http.StatusOK, `{"status":"InProgress","percentComplete":50}`)},
+		{interaction: interaction(http.MethodGet, pollUrl, http.StatusOK, `{"status":"Succeeded"}`)},
+		{interaction: interaction(http.MethodGet, resourceUrl, http.StatusOK, `{"properties":{"provisioningState":"Succeeded"}}`)},
+		{interaction: interaction(http.MethodGet, resourceUrl, http.StatusOK, `{"properties":{"provisioningState":"Succeeded"}}`)},
+		{interaction: interaction(http.MethodDelete, resourceUrl, http.StatusAccepted, "")},
+		{interaction: interaction(http.MethodGet, pollUrl, http.StatusAccepted, "")},
+		{interaction: interaction(http.MethodGet, pollUrl, http.StatusAccepted, ""), discarded: true},
+		{interaction: interaction(http.MethodGet, pollUrl, http.StatusOK, "")},
+	}
+
+	hook := collapseLongRunningOperationPolls()
+	for idx, v := range interactions {
+		if err := hook(v.interaction); err != nil {
+			t.Fatalf("interaction %d: %+v", idx, err)
+		}
+		if v.interaction.DiscardOnSave != v.discarded {
+			t.Fatalf("interaction %d: expected DiscardOnSave to be %t but got %t", idx, v.discarded, v.interaction.DiscardOnSave)
+		}
+	}
+}
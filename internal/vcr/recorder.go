@@ -35,11 +35,12 @@ const (
 	SubscriptionPlaceholder     = "00000000-0000-0000-0000-000000000000"
 	SubscriptionPlaceholderAlt  = "00000000-0000-0000-0000-000000000001"
 	SubscriptionPlaceholderAlt2 = "00000000-0000-0000-0000-000000000002"
+	TenantPlaceholder           = "00000000-0000-0000-0000-000000000010"
 )
 
 // GetRecorder returns the shared recorder for a given test name, initialising it if necessary.
-// it redacts sensitive information, such as SubscriptionID and Authorization Headers and tailors the matcher to AzureRM
-// requests.
+// it redacts sensitive information, such as SubscriptionID, Authorization Headers and the secrets matched by the
+// registered ScrubRules, and tailors the matcher to AzureRM requests.
 func GetRecorder(testName string, subscriptionId string) (*recorder.Recorder, error) {
 	if testName == "" {
 		return nil, errors.New("testName must be provided to retrieve a recorder")
@@ -67,6 +68,13 @@ func GetRecorder(testName string, subscriptionId string) (*recorder.Recorder, er
 		idReplacements[alt2] = SubscriptionPlaceholderAlt2
 	}
 
+	// the Tenant ID comes from the credentials rather than the provider configuration, so it isn't overridden when
+	// replaying - instead the placeholder is swapped back for the real value in replayed responses
+	tenant := os.Getenv("ARM_TENANT_ID")
+	if tenant != "" {
+		idReplacements[tenant] = TenantPlaceholder
+	}
+
 	// subscriptionRe matches common Azure subscription ID patterns in URLs and JSON
 	subscriptionRe := regexp.MustCompile(`(?i)(/subscriptions/|subscriptionId=|subscription_id=|"subscriptionId":\s*")([0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12})`)
 
@@ -90,28 +98,38 @@ func GetRecorder(testName string, subscriptionId string) (*recorder.Recorder, er
 		})
 	}
 
+	if r, exists := recorders[testName]; exists {
+		return r, nil
+	}
+
+	// secrets (keys, connection strings, SAS tokens and passwords) are scrubbed from URLs, headers and bodies, the
+	// same scrubber is applied in the matcher so that requests containing real secrets match the scrubbed cassette
+	rules, err := ScrubRules()
+	if err != nil {
+		return nil, fmt.Errorf("retrieving scrub rules for %s: %v", testName, err)
+	}
+	scrubber, err := NewScrubber(rules...)
+	if err != nil {
+		return nil, fmt.Errorf("building scrubber for %s: %v", testName, err)
+	}
+
+	redact := func(s string) string {
+		return scrubber.ScrubString(redactSubscriptions(s))
+	}
+
+	redactBody := func(s string) string {
+		return scrubber.ScrubBody(redactSubscriptions(s))
+	}
+
 	redactHeaders := func(headers http.Header) {
 		for k, vals := range headers {
 			for j, v := range vals {
-				headers[k][j] = redactSubscriptions(v)
+				headers[k][j] = redact(v)
 			}
 		}
 	}
 
-	if r, exists := recorders[testName]; exists {
-		return r, nil
-	}
-
-	// default to passthrough, just in case something unexpected is set
-	mode := recorder.ModePassthrough
-	vcrMode := os.Getenv("TC_TEST_VIA_VCR")
-	switch vcrMode {
-	case "record":
-		mode = recorder.ModeRecordOnly
-	case "replay", "true":
-		mode = recorder.ModeReplayOnly
-	}
-
+	mode := currentMode()
 	cassettePath := filepath.Join(testDataPath, testName)
 
 	// ignore volatile per-request headers
@@ -130,14 +148,14 @@ func GetRecorder(testName string, subscriptionId string) (*recorder.Recorder, er
 	)
 
 	matcher := cassette.MatcherFunc(func(r *http.Request, i cassette.Request) bool {
-		// Normalise subscription IDs in the incoming request before matching
-		normalisedURL, err := url.Parse(redactSubscriptions(r.URL.String()))
+		// Normalise subscription IDs and secrets in the incoming request before matching
+		normalisedURL, err := url.Parse(redact(r.URL.String()))
 		if err != nil {
 			return false
 		}
 		rCopy := r.Clone(r.Context())
 		rCopy.URL = normalisedURL
-		rCopy.RequestURI = redactSubscriptions(rCopy.RequestURI)
+		rCopy.RequestURI = redact(rCopy.RequestURI)
 		redactHeaders(rCopy.Header)
 
 		// Redact Body in the incoming request so body matching succeeds
@@ -145,7 +163,7 @@ func GetRecorder(testName string, subscriptionId string) (*recorder.Recorder, er
 			if bodyBytes, err := io.ReadAll(r.Body); err == nil {
 				// Restore original body for proper processing downstream
 				r.Body = io.NopCloser(bytes.NewReader(bodyBytes))
-				redactedBody := redactSubscriptions(string(bodyBytes))
+				redactedBody := redactBody(string(bodyBytes))
 				rCopy.Body = io.NopCloser(strings.NewReader(redactedBody))
 				rCopy.ContentLength = int64(len(redactedBody))
 			}
@@ -153,9 +171,9 @@ func GetRecorder(testName string, subscriptionId string) (*recorder.Recorder, er
 
 		// Also normalise in the cassette interaction copy
 		iCopy := i
-		iCopy.URL = redactSubscriptions(i.URL)
-		iCopy.RequestURI = redactSubscriptions(i.RequestURI)
-		iCopy.Body = redactSubscriptions(i.Body)
+		iCopy.URL = redact(i.URL)
+		iCopy.RequestURI = redact(i.RequestURI)
+		iCopy.Body = redactBody(i.Body)
 		redactHeaders(i.Headers)
 
 		return headerMatcher(rCopy, iCopy)
@@ -179,36 +197,59 @@ func GetRecorder(testName string, subscriptionId string) (*recorder.Recorder, er
 		MaxIdleConnsPerHost:   runtime.GOMAXPROCS(0) + 1,
 	}
 
+	stale := newStaleReport(cassettePath, mode)
+
 	r, err := recorder.New(cassettePath,
 		recorder.WithMode(mode),
 		recorder.WithSkipRequestLatency(true),
 		recorder.WithRealTransport(defaultTransport),
 		recorder.WithMatcher(matcher),
-		// recorder.WithFS(&GzipFS{}),
+		recorder.WithFS(&GzipFS{}),
 		recorder.WithHook(func(i *cassette.Interaction) error {
 			delete(i.Request.Headers, "Authorization")
 			i.Request.Headers["Authorization"] = []string{"Bearer REDACTED"}
 			return nil
 		}, recorder.BeforeSaveHook),
 		recorder.WithHook(func(i *cassette.Interaction) error {
-			i.Request.URL = redactSubscriptions(i.Request.URL)
-			i.Request.RequestURI = redactSubscriptions(i.Request.RequestURI)
-			i.Request.Body = redactSubscriptions(i.Request.Body)
+			i.Request.URL = redact(i.Request.URL)
+			i.Request.RequestURI = redact(i.Request.RequestURI)
+			i.Request.Body = redactBody(i.Request.Body)
 			redactHeaders(i.Request.Headers)
-			i.Response.Body = redactSubscriptions(i.Response.Body)
+			i.Response.Body = redactBody(i.Response.Body)
 			redactHeaders(i.Response.Headers)
 			return nil
 		}, recorder.BeforeSaveHook),
+		recorder.WithHook(collapseLongRunningOperationPolls(), recorder.BeforeSaveHook),
+		recorder.WithHook(func(i *cassette.Interaction) error {
+			if mode != recorder.ModeReplayOnly {
+				return nil
+			}
+
+			// the recorded delays are irrelevant when replaying, so don't honour them
+			delete(i.Response.Headers, "Retry-After")
+
+			if tenant != "" {
+				i.Response.Body = strings.ReplaceAll(i.Response.Body, TenantPlaceholder, tenant)
+				for k, vals := range i.Response.Headers {
+					for j, v := range vals {
+						i.Response.Headers[k][j] = strings.ReplaceAll(v, TenantPlaceholder, tenant)
+					}
+				}
+			}
+			return nil
+		}, recorder.BeforeResponseReplayHook),
+		recorder.WithHook(stale.hook, recorder.OnRecorderStopHook),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create recorder for %s: %v", testName, err)
 	}
 
 	recorders[testName] = r
+	staleReports[testName] = stale
 	return r, nil
 }
 
-// StopRecorder stops and removes the recorder from the map, saving it to disk.
+// StopRecorder stops and removes the recorder and values for the test from the map, saving them to disk.
 func StopRecorder(testName string) error {
 	mu.Lock()
 	defer mu.Unlock()
@@ -220,5 +261,33 @@ func StopRecorder(testName string) error {
 			return fmt.Errorf("failed to stop recorder for %s: %v", testName, err)
 		}
 	}
+
+	if s, exists := staleReports[testName]; exists {
+		err := s.save()
+		delete(staleReports, testName)
+		if err != nil {
+			return fmt.Errorf("failed to save stale interactions report for %s: %v", testName, err)
+		}
+	}
+
+	if v, exists := values[testName]; exists {
+		err := v.save()
+		delete(values, testName)
+		if err != nil {
+			return fmt.Errorf("failed to save values for %s: %v", testName, err)
+		}
+	}
 	return nil
 }
+
+// currentMode returns the recorder mode configured by the `TC_TEST_VIA_VCR` environment variable.
+func currentMode() recorder.Mode {
+	// default to passthrough, just in case something unexpected is set
+	switch os.Getenv("TC_TEST_VIA_VCR") {
+	case "record":
+		return recorder.ModeRecordOnly
+	case "replay", "true":
+		return recorder.ModeReplayOnly
+	}
+	return recorder.ModePassthrough
+}
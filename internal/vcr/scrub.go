@@ -0,0 +1,409 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
+)
+
+// RedactedPlaceholder is the value secrets are replaced with in recorded cassettes. It is deliberately valid base64 so
+// that clients which decode keys (e.g. Storage Shared Key authentication) continue to work during replay.
+const RedactedPlaceholder = "REDACTED"
+
+// ScrubRule describes a single secret to be removed from recorded interactions. Exactly one of JSONPath or Pattern
+// must be set.
+type ScrubRule struct {
+	// Name is a short description of the rule, used in error messages.
+	Name string
+
+	// JSONPath selects string values in JSON bodies to replace. A subset of JSONPath is supported: the path must start
+	// with `$` and may contain child (`.name`), wildcard (`.*` / `[*]`) and recursive descent (`..name`) steps. Keys
+	// are matched case-insensitively.
+	JSONPath string
+
+	// Pattern is a regular expression applied to URLs, headers and bodies.
+	Pattern string
+
+	// Replacement is the value substituted for a match. For JSONPath rules this defaults to RedactedPlaceholder, for
+	// Pattern rules it defaults to RedactedPlaceholder and supports `${n}` expansion of capture groups.
+	Replacement string
+}
+
+var (
+	scrubRuleSets = map[string][]ScrubRule{
+		"storage":    storageScrubRules(),
+		"cosmosdb":   cosmosDBScrubRules(),
+		"eventhub":   sharedAccessScrubRules(),
+		"servicebus": sharedAccessScrubRules(),
+		"passwords":  passwordScrubRules(),
+	}
+	scrubMu sync.RWMutex
+)
+
+// RegisterScrubRuleSet adds rules to the named rule set, creating it if it does not exist. Rules are applied to every
+// interaction recorded after registration.
+func RegisterScrubRuleSet(name string, rules ...ScrubRule) {
+	scrubMu.Lock()
+	defer scrubMu.Unlock()
+
+	scrubRuleSets[name] = append(scrubRuleSets[name], rules...)
+}
+
+// ScrubRules returns the rules for the named rule sets, or for all registered rule sets when no names are given.
+// Rule sets are returned in name order so that scrubbing is deterministic.
+func ScrubRules(names ...string) ([]ScrubRule, error) {
+	scrubMu.RLock()
+	defer scrubMu.RUnlock()
+
+	if len(names) == 0 {
+		for name := range scrubRuleSets {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+	}
+
+	rules := make([]ScrubRule, 0)
+	for _, name := range names {
+		set, ok := scrubRuleSets[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown scrub rule set %q", name)
+		}
+		rules = append(rules, set...)
+	}
+
+	return rules, nil
+}
+
+func storageScrubRules() []ScrubRule {
+	return []ScrubRule{
+		{
+			Name:     "storage account listKeys",
+			JSONPath: "$.keys[*].value",
+		},
+		{
+			Name:        "storage connection string account key",
+			Pattern:     `(?i)(AccountKey=)[^;"&\s]+`,
+			Replacement: "${1}" + RedactedPlaceholder,
+		},
+		{
+			Name:        "shared access signature",
+			Pattern:     `(?i)(\bsig=)[^;"&\s]+`,
+			Replacement: "${1}" + RedactedPlaceholder,
+		},
+	}
+}
+
+func cosmosDBScrubRules() []ScrubRule {
+	return []ScrubRule{
+		{
+			Name:     "cosmosdb primary master key",
+			JSONPath: "$.primaryMasterKey",
+		},
+		{
+			Name:     "cosmosdb secondary master key",
+			JSONPath: "$.secondaryMasterKey",
+		},
+		{
+			Name:     "cosmosdb primary readonly master key",
+			JSONPath: "$.primaryReadonlyMasterKey",
+		},
+		{
+			Name:     "cosmosdb secondary readonly master key",
+			JSONPath: "$.secondaryReadonlyMasterKey",
+		},
+		{
+			Name:     "cosmosdb listConnectionStrings",
+			JSONPath: "$.connectionStrings[*].connectionString",
+		},
+	}
+}
+
+// sharedAccessScrubRules covers the `listKeys` response of Shared Access Policies / Authorization Rules as used by
+// Event Hubs, Service Bus and Relay.
+func sharedAccessScrubRules() []ScrubRule {
+	return []ScrubRule{
+		{
+			Name:     "shared access policy primary key",
+			JSONPath: "$.primaryKey",
+		},
+		{
+			Name:     "shared access policy secondary key",
+			JSONPath: "$.secondaryKey",
+		},
+		{
+			Name:     "shared access policy primary connection string",
+			JSONPath: "$.primaryConnectionString",
+		},
+		{
+			Name:     "shared access policy secondary connection string",
+			JSONPath: "$.secondaryConnectionString",
+		},
+		{
+			Name:     "shared access policy alias primary connection string",
+			JSONPath: "$.aliasPrimaryConnectionString",
+		},
+		{
+			Name:     "shared access policy alias secondary connection string",
+			JSONPath: "$.aliasSecondaryConnectionString",
+		},
+		{
+			Name:        "shared access key in connection strings",
+			Pattern:     `(?i)(SharedAccessKey=)[^;"&\s]+`,
+			Replacement: "${1}" + RedactedPlaceholder,
+		},
+	}
+}
+
+func passwordScrubRules() []ScrubRule {
+	return []ScrubRule{
+		{
+			Name:     "administrator login password",
+			JSONPath: "$..administratorLoginPassword",
+		},
+		{
+			Name:     "admin password",
+			JSONPath: "$..adminPassword",
+		},
+		{
+			Name:     "password",
+			JSONPath: "$..password",
+		},
+		{
+			Name:        "password in connection strings",
+			Pattern:     `(?i)(\bPassword=)[^;"&\s]+`,
+			Replacement: "${1}" + RedactedPlaceholder,
+		},
+	}
+}
+
+// Scrubber applies a set of ScrubRules to recorded interactions. The same Scrubber must be used when saving and when
+// matching so that requests containing secrets still match their scrubbed cassette entries.
+type Scrubber struct {
+	paths    []compiledPath
+	patterns []compiledPattern
+}
+
+type compiledPath struct {
+	steps       []pathStep
+	replacement string
+}
+
+type compiledPattern struct {
+	re          *regexp.Regexp
+	replacement string
+}
+
+type pathStep struct {
+	key       string
+	recursive bool
+}
+
+// NewScrubber validates and compiles the given rules.
+func NewScrubber(rules ...ScrubRule) (*Scrubber, error) {
+	s := &Scrubber{}
+	for _, rule := range rules {
+		replacement := rule.Replacement
+		if replacement == "" {
+			replacement = RedactedPlaceholder
+		}
+
+		switch {
+		case rule.JSONPath != "" && rule.Pattern != "":
+			return nil, fmt.Errorf("scrub rule %q: only one of `JSONPath` or `Pattern` may be specified", rule.Name)
+
+		case rule.JSONPath != "":
+			steps, err := parseJSONPath(rule.JSONPath)
+			if err != nil {
+				return nil, fmt.Errorf("scrub rule %q: %+v", rule.Name, err)
+			}
+			s.paths = append(s.paths, compiledPath{
+				steps:       steps,
+				replacement: replacement,
+			})
+
+		case rule.Pattern != "":
+			re, err := regexp.Compile(rule.Pattern)
+			if err != nil {
+				return nil, fmt.Errorf("scrub rule %q: compiling pattern: %+v", rule.Name, err)
+			}
+			s.patterns = append(s.patterns, compiledPattern{
+				re:          re,
+				replacement: replacement,
+			})
+
+		default:
+			return nil, fmt.Errorf("scrub rule %q: one of `JSONPath` or `Pattern` must be specified", rule.Name)
+		}
+	}
+
+	return s, nil
+}
+
+// ScrubString applies the Pattern rules to the input.
+func (s *Scrubber) ScrubString(input string) string {
+	for _, p := range s.patterns {
+		input = p.re.ReplaceAllString(input, p.replacement)
+	}
+	return input
+}
+
+// ScrubBody applies the JSONPath rules to the input if it is a JSON document, followed by the Pattern rules. The
+// body is only re-encoded when a JSONPath rule matched, and re-encoding is stable, so scrubbing is idempotent.
+func (s *Scrubber) ScrubBody(body string) string {
+	if len(s.paths) > 0 {
+		if trimmed := strings.TrimSpace(body); strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[") {
+			body = s.scrubJSON(body)
+		}
+	}
+	return s.ScrubString(body)
+}
+
+// ScrubHeaders applies the Pattern rules to each header value in place.
+func (s *Scrubber) ScrubHeaders(headers http.Header) {
+	for k, vals := range headers {
+		for j, v := range vals {
+			headers[k][j] = s.ScrubString(v)
+		}
+	}
+}
+
+// ScrubInteraction scrubs the URL, headers and bodies of both the request and response of the interaction.
+func (s *Scrubber) ScrubInteraction(i *cassette.Interaction) {
+	i.Request.URL = s.ScrubString(i.Request.URL)
+	i.Request.RequestURI = s.ScrubString(i.Request.RequestURI)
+	i.Request.Body = s.ScrubBody(i.Request.Body)
+	s.ScrubHeaders(i.Request.Headers)
+	i.Response.Body = s.ScrubBody(i.Response.Body)
+	s.ScrubHeaders(i.Response.Headers)
+}
+
+func (s *Scrubber) scrubJSON(body string) string {
+	decoder := json.NewDecoder(strings.NewReader(body))
+	decoder.UseNumber()
+
+	var doc interface{}
+	if err := decoder.Decode(&doc); err != nil {
+		return body
+	}
+
+	changed := false
+	for _, p := range s.paths {
+		var replaced bool
+		doc, replaced = scrubPath(doc, p.steps, p.replacement)
+		changed = changed || replaced
+	}
+	if !changed {
+		return body
+	}
+
+	buf := &bytes.Buffer{}
+	encoder := json.NewEncoder(buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(doc); err != nil {
+		return body
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// scrubPath replaces the string values in node selected by steps, returning the updated node and whether any value
+// was replaced.
+func scrubPath(node interface{}, steps []pathStep, replacement string) (interface{}, bool) {
+	if len(steps) == 0 {
+		if v, ok := node.(string); ok && v != "" && v != replacement {
+			return replacement, true
+		}
+		return node, false
+	}
+
+	step := steps[0]
+	changed := false
+	switch v := node.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			if step.key == "*" || strings.EqualFold(step.key, key) {
+				updated, replaced := scrubPath(child, steps[1:], replacement)
+				v[key] = updated
+				changed = changed || replaced
+			}
+			if step.recursive {
+				updated, replaced := scrubPath(v[key], steps, replacement)
+				v[key] = updated
+				changed = changed || replaced
+			}
+		}
+
+	case []interface{}:
+		for idx, child := range v {
+			if step.recursive {
+				updated, replaced := scrubPath(child, steps, replacement)
+				v[idx] = updated
+				changed = changed || replaced
+				continue
+			}
+			if step.key == "*" {
+				updated, replaced := scrubPath(child, steps[1:], replacement)
+				v[idx] = updated
+				changed = changed || replaced
+			}
+		}
+	}
+
+	return node, changed
+}
+
+func parseJSONPath(path string) ([]pathStep, error) {
+	if !strings.HasPrefix(path, "$") {
+		return nil, fmt.Errorf("JSONPath %q must start with `$`", path)
+	}
+
+	steps := make([]pathStep, 0)
+	remaining := path[1:]
+	for remaining != "" {
+		step := pathStep{}
+		switch {
+		case strings.HasPrefix(remaining, "[*]"):
+			step.key = "*"
+			remaining = remaining[3:]
+			steps = append(steps, step)
+			continue
+
+		case strings.HasPrefix(remaining, ".."):
+			step.recursive = true
+			remaining = remaining[2:]
+
+		case strings.HasPrefix(remaining, "."):
+			remaining = remaining[1:]
+
+		default:
+			return nil, fmt.Errorf("JSONPath %q: unexpected %q", path, remaining)
+		}
+
+		end := strings.IndexAny(remaining, ".[")
+		if end == -1 {
+			end = len(remaining)
+		}
+		step.key = remaining[:end]
+		if step.key == "" {
+			return nil, fmt.Errorf("JSONPath %q: empty key", path)
+		}
+		remaining = remaining[end:]
+		steps = append(steps, step)
+	}
+
+	if len(steps) == 0 {
+		return nil, fmt.Errorf("JSONPath %q must select at least one key", path)
+	}
+
+	return steps, nil
+}
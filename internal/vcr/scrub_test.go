@@ -0,0 +1,124 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"testing"
+)
+
+func TestScrubber_ScrubBody(t *testing.T) {
+	rules, err := ScrubRules()
+	if err != nil {
+		t.Fatalf("retrieving scrub rules: %+v", err)
+	}
+	scrubber, err := NewScrubber(rules...)
+	if err != nil {
+		t.Fatalf("building scrubber: %+v", err)
+	}
+
+	testData := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "not json",
+			input:    "hello world",
+			expected: "hello world",
+		},
+		{
+			name:     "json without secrets is unchanged",
+			input:    `{"name": "example", "location": "westeurope"}`,
+			expected: `{"name": "example", "location": "westeurope"}`,
+		},
+		{
+			name:     "storage listKeys",
+			input:    `{"keys":[{"keyName":"key1","value":"c2VjcmV0MQ==","permissions":"FULL"},{"keyName":"key2","value":"c2VjcmV0Mg==","permissions":"FULL"}]}`,
+			expected: `{"keys":[{"keyName":"key1","permissions":"FULL","value":"REDACTED"},{"keyName":"key2","permissions":"FULL","value":"REDACTED"}]}`,
+		},
+		{
+			name:     "cosmosdb listKeys",
+			input:    `{"primaryMasterKey":"abc","secondaryMasterKey":"def","primaryReadonlyMasterKey":"ghi","secondaryReadonlyMasterKey":"jkl"}`,
+			expected: `{"primaryMasterKey":"REDACTED","primaryReadonlyMasterKey":"REDACTED","secondaryMasterKey":"REDACTED","secondaryReadonlyMasterKey":"REDACTED"}`,
+		},
+		{
+			name:     "shared access policy listKeys",
+			input:    `{"primaryConnectionString":"Endpoint=sb://example.servicebus.windows.net/;SharedAccessKeyName=RootManageSharedAccessKey;SharedAccessKey=abc123=","primaryKey":"abc123=","keyName":"RootManageSharedAccessKey"}`,
+			expected: `{"keyName":"RootManageSharedAccessKey","primaryConnectionString":"REDACTED","primaryKey":"REDACTED"}`,
+		},
+		{
+			name:     "nested password",
+			input:    `{"properties":{"osProfile":{"adminUsername":"adminuser","adminPassword":"P@ssw0rd1234!"},"size":1}}`,
+			expected: `{"properties":{"osProfile":{"adminPassword":"REDACTED","adminUsername":"adminuser"},"size":1}}`,
+		},
+		{
+			name:     "connection string outside of a known property",
+			input:    `{"value":"DefaultEndpointsProtocol=https;AccountName=example;AccountKey=c2VjcmV0MQ==;EndpointSuffix=core.windows.net"}`,
+			expected: `{"value":"DefaultEndpointsProtocol=https;AccountName=example;AccountKey=REDACTED;EndpointSuffix=core.windows.net"}`,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		actual := scrubber.ScrubBody(v.input)
+		if actual != v.expected {
+			t.Fatalf("expected %q but got %q", v.expected, actual)
+		}
+
+		if again := scrubber.ScrubBody(actual); again != actual {
+			t.Fatalf("expected scrubbing to be idempotent but got %q from %q", again, actual)
+		}
+	}
+}
+
+func TestScrubber_ScrubString(t *testing.T) {
+	rules, err := ScrubRules("storage")
+	if err != nil {
+		t.Fatalf("retrieving scrub rules: %+v", err)
+	}
+	scrubber, err := NewScrubber(rules...)
+	if err != nil {
+		t.Fatalf("building scrubber: %+v", err)
+	}
+
+	input := "https://example.blob.core.windows.net/container?sv=2022-11-02&ss=b&sig=abc%2Bdef%3D&se=2045-01-01"
+	expected := "https://example.blob.core.windows.net/container?sv=2022-11-02&ss=b&sig=REDACTED&se=2045-01-01"
+	if actual := scrubber.ScrubString(input); actual != expected {
+		t.Fatalf("expected %q but got %q", expected, actual)
+	}
+}
+
+func TestNewScrubber_InvalidRules(t *testing.T) {
+	testData := []ScrubRule{
+		{
+			Name: "neither",
+		},
+		{
+			Name:     "both",
+			JSONPath: "$.key",
+			Pattern:  "key",
+		},
+		{
+			Name:     "path without root",
+			JSONPath: "key",
+		},
+		{
+			Name:     "path with empty key",
+			JSONPath: "$.keys..",
+		},
+		{
+			Name:    "invalid pattern",
+			Pattern: "(",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		if _, err := NewScrubber(v); err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/cassette"
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/recorder"
+)
+
+// StaleReportSuffix is appended to the cassette name for the report of interactions which weren't replayed.
+const StaleReportSuffix = ".stale.json"
+
+var staleReports = make(map[string]*staleReport)
+
+// StaleInteraction is an interaction in a cassette which wasn't requested when the cassette was last replayed,
+// meaning the test no longer makes the request and the cassette should be re-recorded.
+type StaleInteraction struct {
+	ID     int    `json:"id"`
+	Method string `json:"method"`
+	URL    string `json:"url"`
+}
+
+type staleReport struct {
+	mu           sync.Mutex
+	path         string
+	mode         recorder.Mode
+	interactions []StaleInteraction
+}
+
+func newStaleReport(cassettePath string, mode recorder.Mode) *staleReport {
+	return &staleReport{
+		path:         cassettePath + StaleReportSuffix,
+		mode:         mode,
+		interactions: make([]StaleInteraction, 0),
+	}
+}
+
+// hook is an OnRecorderStopHook which tracks the interactions which weren't replayed.
+func (s *staleReport) hook(i *cassette.Interaction) error {
+	if s.mode != recorder.ModeReplayOnly || i.WasReplayed() {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.interactions = append(s.interactions, StaleInteraction{
+		ID:     i.ID,
+		Method: i.Request.Method,
+		URL:    i.Request.URL,
+	})
+	return nil
+}
+
+// save writes the report when replaying a cassette which contains stale interactions, otherwise any existing report
+// is removed since either every interaction was replayed or the cassette has been re-recorded.
+func (s *staleReport) save() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.mode == recorder.ModePassthrough {
+		return nil
+	}
+
+	if s.mode == recorder.ModeRecordOnly || len(s.interactions) == 0 {
+		if err := os.Remove(s.path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+
+	contents, err := json.MarshalIndent(s.interactions, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(s.path, append(contents, '\n'), 0o644)
+}
+
+// ReadStaleReport reads the stale interactions report at path.
+func ReadStaleReport(path string) ([]StaleInteraction, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	interactions := make([]StaleInteraction, 0)
+	if err := json.Unmarshal(contents, &interactions); err != nil {
+		return nil, err
+	}
+
+	return interactions, nil
+}
@@ -0,0 +1,155 @@
+// Copyright IBM Corp. 2014, 2025
+// SPDX-License-Identifier: MPL-2.0
+
+package vcr
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"gopkg.in/dnaeon/go-vcr.v4/pkg/recorder"
+)
+
+var values = make(map[string]*Values)
+
+// Values holds the generated values (random names, integers etc.) for a test, which must be identical when replaying
+// a cassette to the values used when it was recorded. They're stored alongside the cassette in `<testName>.values.json`.
+type Values struct {
+	mu      sync.Mutex
+	path    string
+	mode    recorder.Mode
+	cursors map[string]int
+	data    valuesFile
+
+	// seededFromTestName is true when there's no values file, so the values must be derived as they were before
+	// values files were introduced for existing cassettes to replay
+	seededFromTestName bool
+}
+
+type valuesFile struct {
+	// Seed is the seed for any random number generator used by the test.
+	Seed int64 `json:"seed"`
+
+	// Values is the list of values generated for each key, in the order they were generated.
+	Values map[string][]string `json:"values"`
+}
+
+// GetValues returns the shared Values for a given test name, initialising it if necessary. When recording a new seed
+// is generated, when replaying the seed and values are loaded from disk. Cassettes recorded without a values file
+// fall back to a seed derived from the test name, see SeededFromTestName.
+func GetValues(testName string) (*Values, error) {
+	if testName == "" {
+		return nil, errors.New("testName must be provided to retrieve values")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if v, exists := values[testName]; exists {
+		return v, nil
+	}
+
+	v := &Values{
+		path:    filepath.Join(testDataPath, testName+".values.json"),
+		mode:    currentMode(),
+		cursors: make(map[string]int),
+		data: valuesFile{
+			Values: make(map[string][]string),
+		},
+	}
+
+	switch v.mode {
+	case recorder.ModeRecordOnly:
+		v.data.Seed = time.Now().UnixNano()
+
+	case recorder.ModeReplayOnly:
+		contents, err := os.ReadFile(v.path)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("reading values for %s: %v", testName, err)
+		}
+		if err == nil {
+			if err := json.Unmarshal(contents, &v.data); err != nil {
+				return nil, fmt.Errorf("parsing values for %s: %v", testName, err)
+			}
+		} else {
+			v.data.Seed = seedFromTestName(testName)
+			v.seededFromTestName = true
+		}
+		if v.data.Values == nil {
+			v.data.Values = make(map[string][]string)
+		}
+
+	default:
+		v.data.Seed = seedFromTestName(testName)
+		v.seededFromTestName = true
+	}
+
+	values[testName] = v
+	return v, nil
+}
+
+// Seed returns the seed to use for any random number generator in the test.
+func (v *Values) Seed() int64 {
+	return v.data.Seed
+}
+
+// SeededFromTestName returns whether the seed is derived from the test name, rather than loaded from a values file or
+// generated for a recording. Values must then be generated exactly as they were prior to values files, so that
+// cassettes recorded without one continue to match.
+func (v *Values) SeededFromTestName() bool {
+	return v.seededFromTestName
+}
+
+// Value returns the next value for key. When replaying this is the value generated for the same call when recording,
+// otherwise generate is called and, when recording, the result is saved for replay.
+func (v *Values) Value(key string, generate func() string) string {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	idx := v.cursors[key]
+	v.cursors[key]++
+
+	if v.mode == recorder.ModeReplayOnly && idx < len(v.data.Values[key]) {
+		return v.data.Values[key][idx]
+	}
+
+	value := generate()
+	if v.mode == recorder.ModeRecordOnly {
+		v.data.Values[key] = append(v.data.Values[key], value)
+	}
+
+	return value
+}
+
+func (v *Values) save() error {
+	if v.mode != recorder.ModeRecordOnly {
+		return nil
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	contents, err := json.MarshalIndent(v.data, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(v.path), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(v.path, append(contents, '\n'), 0o644)
+}
+
+func seedFromTestName(testName string) int64 {
+	h := fnv.New64a()
+	h.Write([]byte(testName))
+	return int64(h.Sum64())
+}
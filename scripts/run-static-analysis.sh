@@ -7,6 +7,8 @@ function on_failure {
   echo "==> Static analysis failed!"
   echo "    Check the output above for specific violations."
   echo "    Common issues: incorrect Go types in TypedSDK structs, missing required fields."
+  echo "    New violations should be fixed rather than added to internal/tools/static-analysis/exceptions.yml,"
+  echo "    and exceptions which are no longer needed should be removed from it."
   echo "    Run locally: go run internal/tools/static-analysis/main.go -fail-on-error=false"
   echo ""
 }
@@ -0,0 +1,30 @@
+#!/usr/bin/env bash
+# Copyright IBM Corp. 2014, 2025
+# SPDX-License-Identifier: MPL-2.0
+
+# Replays the test for every cassette, which writes the `<testName>.stale.json` reports checked by vcr-lint for any
+# interactions which the test no longer requests.
+
+function replayTests {
+  local root="$1"
+  local result=0
+
+  for dir in $(find "$root" -type d -name vcrtestdata | sort); do
+    local tests
+    tests=$(find "$dir" -maxdepth 1 \( -name '*.yaml' -o -name '*.yaml.gz' \) -exec basename {} \; | sed -E 's/\.yaml(\.gz)?$//' | sort -u | paste -sd '|' -)
+    if [ -z "$tests" ]; then
+      continue
+    fi
+
+    echo "==> Replaying cassettes in ${dir}..."
+    TF_ACC=1 TC_TEST_VIA_VCR=replay go test "./$(dirname "$dir")" -run "^(${tests})\$" -count=1 -timeout=60m || result=1
+  done
+
+  return $result
+}
+
+function main {
+  replayTests "${1:-internal/services}"
+}
+
+main "$@"